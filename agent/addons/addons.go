@@ -44,6 +44,8 @@ type IntrospectionConfig struct {
 	Engine             *dependency.Engine
 	StatePoolReporter  introspection.Reporter
 	PubSubReporter     introspection.Reporter
+	LeaseReporter      introspection.LeaseReporter
+	ChangeStream       introspection.ChangeStreamReporter
 	MachineLock        machinelock.Lock
 	PrometheusGatherer prometheus.Gatherer
 	PresenceRecorder   presence.Recorder
@@ -80,7 +82,8 @@ func StartIntrospection(cfg IntrospectionConfig) error {
 		Clock:              cfg.Clock,
 		LocalHub:           cfg.LocalHub,
 		CentralHub:         cfg.CentralHub,
-		// TODO(leases) - add lease introspection
+		Leases:             cfg.LeaseReporter,
+		ChangeStream:       cfg.ChangeStream,
 	})
 	if err != nil {
 		return errors.Trace(err)
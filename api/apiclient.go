@@ -132,12 +132,13 @@ type conn struct {
 	// access it safely.
 	loggedIn int32
 
-	// tag, password, macaroons and nonce hold the cached login
+	// tag, password, macaroons, nonce and token hold the cached login
 	// credentials. These are only valid if loggedIn is 1.
 	tag       string
 	password  string
 	macaroons []macaroon.Slice
 	nonce     string
+	token     string
 
 	// serverRootAddress holds the cached API server address and port used
 	// to login.
@@ -271,6 +272,7 @@ func Open(info *Info, opts DialOpts) (Connection, error) {
 		password:     info.Password,
 		macaroons:    info.Macaroons,
 		nonce:        info.Nonce,
+		token:        info.Token,
 		tlsConfig:    dialResult.tlsConfig,
 		bakeryClient: bakeryClient,
 		modelTag:     info.ModelTag,
@@ -449,6 +451,9 @@ func (c *conn) connectStream(path string, attrs url.Values, extraHeaders http.He
 	} else {
 		requestHeader = make(http.Header)
 	}
+	if c.token != "" {
+		requestHeader.Set("Authorization", "Bearer "+c.token)
+	}
 	requestHeader.Set(params.JujuClientVersion, jujuversion.Current.String())
 	requestHeader.Set("Origin", "http://localhost/")
 	if c.nonce != "" {
@@ -0,0 +1,214 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+)
+
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// defaultDevicePollInterval is how often the token endpoint is polled
+// when the provider doesn't say, as recommended by RFC 8628.
+const defaultDevicePollInterval = 5 * time.Second
+
+// OIDCDeviceFlow obtains an ID token from an OpenID Connect provider
+// using the OAuth 2.0 device authorization grant (RFC 8628). The user
+// completes the login in a browser, possibly on another machine, while
+// the client polls the provider for the token.
+type OIDCDeviceFlow struct {
+	// Issuer is the URL identifying the provider.
+	Issuer string
+
+	// ClientID is the client the ID token is requested for.
+	ClientID string
+
+	// Scopes are the scopes requested.
+	Scopes []string
+
+	// HTTPClient is used to make requests to the provider. If it's
+	// nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+
+	// Clock is used to wait between polls. If it's nil, the wall
+	// clock is used.
+	Clock clock.Clock
+
+	// Prompt is called to tell the user where to go to complete the
+	// login and the code to enter there.
+	Prompt func(verificationURI, userCode string) error
+}
+
+type oidcDiscovery struct {
+	Issuer                      string `json:"issuer"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+}
+
+type deviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// IDToken runs the device authorization flow and returns the ID token
+// issued when the user has logged in. It fails if the user denies the
+// request or doesn't complete it before it expires.
+func (f OIDCDeviceFlow) IDToken(ctx context.Context) (string, error) {
+	clk := f.Clock
+	if clk == nil {
+		clk = clock.WallClock
+	}
+
+	var discovery oidcDiscovery
+	discoveryURL := strings.TrimSuffix(f.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := f.getJSON(ctx, discoveryURL, &discovery); err != nil {
+		return "", errors.Annotatef(err, "discovering OIDC provider %q", f.Issuer)
+	}
+	if discovery.Issuer != f.Issuer {
+		return "", errors.Errorf("OIDC provider %q claims to be %q", f.Issuer, discovery.Issuer)
+	}
+	if discovery.DeviceAuthorizationEndpoint == "" || discovery.TokenEndpoint == "" {
+		return "", errors.NotSupportedf("device authorization by OIDC provider %q", f.Issuer)
+	}
+
+	var auth deviceAuthorization
+	form := url.Values{"client_id": {f.ClientID}}
+	if len(f.Scopes) > 0 {
+		form.Set("scope", strings.Join(f.Scopes, " "))
+	}
+	if resp, err := f.postForm(ctx, discovery.DeviceAuthorizationEndpoint, form, &auth); err != nil {
+		return "", errors.Annotate(err, "requesting device authorization")
+	} else if resp.Error != "" {
+		return "", errors.Errorf("requesting device authorization: %s", resp.describe())
+	}
+	if auth.DeviceCode == "" || auth.UserCode == "" || auth.VerificationURI == "" {
+		return "", errors.New("requesting device authorization: incomplete response")
+	}
+	verificationURI := auth.VerificationURI
+	if auth.VerificationURIComplete != "" {
+		verificationURI = auth.VerificationURIComplete
+	}
+	if err := f.Prompt(verificationURI, auth.UserCode); err != nil {
+		return "", errors.Trace(err)
+	}
+
+	interval := defaultDevicePollInterval
+	if auth.Interval > 0 {
+		interval = time.Duration(auth.Interval) * time.Second
+	}
+	var expired <-chan time.Time
+	if auth.ExpiresIn > 0 {
+		expired = clk.After(time.Duration(auth.ExpiresIn) * time.Second)
+	}
+	form = url.Values{
+		"client_id":   {f.ClientID},
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {auth.DeviceCode},
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return "", errors.Trace(ctx.Err())
+		case <-expired:
+			return "", errors.Timeoutf("waiting for OIDC login")
+		case <-clk.After(interval):
+		}
+		var token tokenResponse
+		resp, err := f.postForm(ctx, discovery.TokenEndpoint, form, &token)
+		if err != nil {
+			return "", errors.Annotate(err, "requesting ID token")
+		}
+		switch resp.Error {
+		case "":
+			if token.IDToken == "" {
+				return "", errors.New("requesting ID token: no ID token issued")
+			}
+			return token.IDToken, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		case "access_denied":
+			return "", errors.Unauthorizedf("OIDC login denied")
+		case "expired_token":
+			return "", errors.Timeoutf("waiting for OIDC login")
+		default:
+			return "", errors.Errorf("requesting ID token: %s", resp.describe())
+		}
+	}
+}
+
+func (f OIDCDeviceFlow) httpClient() *http.Client {
+	if f.HTTPClient != nil {
+		return f.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (f OIDCDeviceFlow) getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp, err := f.httpClient().Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected HTTP response %q", resp.Status)
+	}
+	return errors.Trace(json.NewDecoder(resp.Body).Decode(v))
+}
+
+// postForm posts the form to an OAuth endpoint, decoding a successful
+// response into v. OAuth errors are returned in the tokenResponse
+// rather than as an error.
+func (f OIDCDeviceFlow) postForm(ctx context.Context, u string, form url.Values, v interface{}) (tokenResponse, error) {
+	var oauthErr tokenResponse
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(form.Encode()))
+	if err != nil {
+		return oauthErr, errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := f.httpClient().Do(req)
+	if err != nil {
+		return oauthErr, errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return oauthErr, errors.Trace(json.NewDecoder(resp.Body).Decode(v))
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		if err := json.NewDecoder(resp.Body).Decode(&oauthErr); err == nil && oauthErr.Error != "" {
+			return oauthErr, nil
+		}
+	}
+	return oauthErr, errors.Errorf("unexpected HTTP response %q", resp.Status)
+}
+
+func (r tokenResponse) describe() string {
+	if r.ErrorDescription != "" {
+		return r.Error + ": " + r.ErrorDescription
+	}
+	return r.Error
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/authentication"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+)
+
+type OIDCDeviceFlowSuite struct {
+	issuer *apiservertesting.OIDCIssuer
+	flow   authentication.OIDCDeviceFlow
+
+	prompted []string
+}
+
+var _ = gc.Suite(&OIDCDeviceFlowSuite{})
+
+func (s *OIDCDeviceFlowSuite) SetUpTest(c *gc.C) {
+	issuer, err := apiservertesting.NewOIDCIssuer("juju")
+	c.Assert(err, jc.ErrorIsNil)
+	s.issuer = issuer
+	s.prompted = nil
+	s.flow = authentication.OIDCDeviceFlow{
+		Issuer:   issuer.URL,
+		ClientID: "juju",
+		Scopes:   []string{"openid", "email"},
+		// Trust the fake provider's certificate.
+		HTTPClient: issuer.Client(),
+		// Poll every millisecond rather than every second.
+		Clock: testclock.NewDilatedWallClock(time.Millisecond),
+		Prompt: func(verificationURI, userCode string) error {
+			s.prompted = append(s.prompted, verificationURI, userCode)
+			return nil
+		},
+	}
+}
+
+func (s *OIDCDeviceFlowSuite) TearDownTest(_ *gc.C) {
+	s.issuer.Close()
+}
+
+func (s *OIDCDeviceFlowSuite) TestIDToken(c *gc.C) {
+	s.issuer.SetDeviceFlow(map[string]interface{}{"email": "mary@example.com"}, 2)
+
+	idToken, err := s.flow.IDToken(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.prompted, jc.DeepEquals, []string{s.issuer.URL + "/activate?user_code=FAKE-CODE", "FAKE-CODE"})
+
+	token, err := jwt.ParseInsecure([]byte(idToken))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(token.Issuer(), gc.Equals, s.issuer.URL)
+	email, _ := token.Get("email")
+	c.Check(email, gc.Equals, "mary@example.com")
+}
+
+func (s *OIDCDeviceFlowSuite) TestIDTokenDenied(c *gc.C) {
+	s.issuer.SetDeviceFlow(map[string]interface{}{"email": "mary@example.com"}, 0)
+	s.issuer.DenyDeviceFlow("access_denied")
+
+	_, err := s.flow.IDToken(context.Background())
+	c.Check(err, gc.ErrorMatches, "OIDC login denied")
+	c.Check(err, jc.ErrorIs, errors.Unauthorized)
+}
+
+func (s *OIDCDeviceFlowSuite) TestIDTokenExpired(c *gc.C) {
+	s.issuer.SetDeviceFlow(map[string]interface{}{"email": "mary@example.com"}, 0)
+	s.issuer.DenyDeviceFlow("expired_token")
+
+	_, err := s.flow.IDToken(context.Background())
+	c.Check(err, jc.ErrorIs, errors.Timeout)
+}
+
+func (s *OIDCDeviceFlowSuite) TestIDTokenUnknownClient(c *gc.C) {
+	s.flow.ClientID = "other"
+
+	_, err := s.flow.IDToken(context.Background())
+	c.Check(err, gc.ErrorMatches, "requesting device authorization: invalid_client")
+	c.Check(s.prompted, gc.HasLen, 0)
+}
+
+func (s *OIDCDeviceFlowSuite) TestIDTokenPromptFails(c *gc.C) {
+	s.flow.Prompt = func(string, string) error {
+		return errors.New("boom")
+	}
+
+	_, err := s.flow.IDToken(context.Background())
+	c.Check(err, gc.ErrorMatches, "boom")
+}
+
+func (s *OIDCDeviceFlowSuite) TestIDTokenCancelled(c *gc.C) {
+	s.issuer.SetDeviceFlow(map[string]interface{}{"email": "mary@example.com"}, 1000)
+	ctx, cancel := context.WithCancel(context.Background())
+	s.flow.Prompt = func(string, string) error {
+		cancel()
+		return nil
+	}
+
+	_, err := s.flow.IDToken(ctx)
+	c.Check(err, jc.ErrorIs, context.Canceled)
+}
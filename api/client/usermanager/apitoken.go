@@ -0,0 +1,72 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// AddAPIToken mints an API token for the logged in user, returning its
+// secret. If the model tag is not empty the token can only be used with
+// that model. The user has no more than maxAccess when logged in with the
+// token, which can't be used after it expires.
+func (c *Client) AddAPIToken(name string, model names.ModelTag, maxAccess string, expires time.Time) (string, error) {
+	arg := params.AddAPIToken{
+		Name:      name,
+		MaxAccess: maxAccess,
+		Expires:   expires,
+	}
+	if model.Id() != "" {
+		arg.ModelTag = model.String()
+	}
+	args := params.AddAPITokens{Tokens: []params.AddAPIToken{arg}}
+	var results params.AddAPITokenResults
+	if err := c.facade.FacadeCall(context.TODO(), "AddAPITokens", args, &results); err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return "", errors.Trace(err)
+	}
+	return results.Results[0].Token, nil
+}
+
+// APITokens returns information about the given user's API tokens, or the
+// logged in user's if the user tag is empty. Only controller superusers can
+// see other users' tokens.
+func (c *Client) APITokens(user names.UserTag) ([]params.APIToken, error) {
+	var arg params.Entity
+	if user.Id() != "" {
+		arg.Tag = user.String()
+	}
+	var result params.APITokensResult
+	if err := c.facade.FacadeCall(context.TODO(), "APITokens", arg, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Tokens, nil
+}
+
+// RemoveAPIToken removes the given user's API token with the given name, so
+// it can no longer be used to log in. If the user tag is empty the logged in
+// user's token is removed. Only controller superusers can remove other
+// users' tokens.
+func (c *Client) RemoveAPIToken(user names.UserTag, name string) error {
+	args := params.APITokenNames{Names: []string{name}}
+	if user.Id() != "" {
+		args.UserTag = user.String()
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(context.TODO(), "RemoveAPITokens", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager_test
+
+import (
+	"time"
+
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/usermanager"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type apiTokenSuite struct{}
+
+var _ = gc.Suite(&apiTokenSuite{})
+
+func (s *apiTokenSuite) TestAddAPIToken(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	expires := time.Date(2026, 11, 18, 12, 0, 0, 0, time.UTC)
+	args := params.AddAPITokens{
+		Tokens: []params.AddAPIToken{{
+			Name: "ci", ModelTag: coretesting.ModelTag.String(), MaxAccess: "write", Expires: expires,
+		}},
+	}
+	result := new(params.AddAPITokenResults)
+	results := params.AddAPITokenResults{Results: []params.AddAPITokenResult{{Token: "secret"}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AddAPITokens", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	secret, err := client.AddAPIToken("ci", coretesting.ModelTag, "write", expires)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(secret, gc.Equals, "secret")
+}
+
+func (s *apiTokenSuite) TestAddAPITokenError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	expires := time.Date(2026, 11, 18, 12, 0, 0, 0, time.UTC)
+	args := params.AddAPITokens{
+		Tokens: []params.AddAPIToken{{Name: "ci", MaxAccess: "read", Expires: expires}},
+	}
+	result := new(params.AddAPITokenResults)
+	results := params.AddAPITokenResults{Results: []params.AddAPITokenResult{{
+		Error: &params.Error{Message: "api token already exists", Code: params.CodeAlreadyExists},
+	}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AddAPITokens", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.AddAPIToken("ci", names.ModelTag{}, "read", expires)
+	c.Assert(err, gc.ErrorMatches, "api token already exists")
+}
+
+func (s *apiTokenSuite) TestAPITokens(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := new(params.APITokensResult)
+	results := params.APITokensResult{Tokens: []params.APIToken{{Name: "ci", MaxAccess: "read"}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "APITokens", params.Entity{}, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	tokens, err := client.APITokens(names.UserTag{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tokens, jc.DeepEquals, []params.APIToken{{Name: "ci", MaxAccess: "read"}})
+}
+
+func (s *apiTokenSuite) TestRemoveAPIToken(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.APITokenNames{Names: []string{"ci"}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveAPITokens", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RemoveAPIToken(names.UserTag{}, "ci")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *apiTokenSuite) TestRemoveAPITokenForUser(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.APITokenNames{UserTag: "user-bob", Names: []string{"ci"}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveAPITokens", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RemoveAPIToken(names.NewUserTag("bob"), "ci")
+	c.Assert(err, jc.ErrorIsNil)
+}
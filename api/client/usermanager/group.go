@@ -0,0 +1,129 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// AddGroup adds a user group with the specified members.
+func (c *Client) AddGroup(name string, members ...string) error {
+	args := params.AddGroups{
+		Groups: []params.AddGroup{{Name: name, Members: members}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(context.TODO(), "AddGroups", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// RemoveGroup removes a user group, along with all access granted to it.
+func (c *Client) RemoveGroup(name string) error {
+	args := params.GroupNames{Names: []string{name}}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(context.TODO(), "RemoveGroups", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// AddGroupMembers adds users to a user group.
+func (c *Client) AddGroupMembers(name string, members ...string) error {
+	return c.modifyGroupMembers(name, params.AddGroupMembers, members)
+}
+
+// RemoveGroupMembers removes users from a user group.
+func (c *Client) RemoveGroupMembers(name string, members ...string) error {
+	return c.modifyGroupMembers(name, params.RemoveGroupMembers, members)
+}
+
+func (c *Client) modifyGroupMembers(name string, action params.GroupMembersAction, members []string) error {
+	for _, member := range members {
+		if !names.IsValidUser(member) {
+			return errors.Errorf("%q is not a valid username", member)
+		}
+	}
+	args := params.ModifyGroupMembersRequest{
+		Changes: []params.ModifyGroupMembers{{
+			Group:   name,
+			Action:  action,
+			Members: members,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(context.TODO(), "ModifyGroupMembers", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// Groups returns information about the named user groups, or about all the
+// groups the user can see if no names are given.
+func (c *Client) Groups(groupNames ...string) ([]params.UserGroup, error) {
+	args := params.GroupNames{Names: groupNames}
+	var results params.UserGroupResults
+	if err := c.facade.FacadeCall(context.TODO(), "UserGroups", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(groupNames) > 0 && len(results.Results) != len(groupNames) {
+		return nil, errors.Errorf("expected %d results, got %d", len(groupNames), len(results.Results))
+	}
+	var errorStrings []string
+	groups := []params.UserGroup{}
+	for i, result := range results.Results {
+		if result.Error != nil {
+			errorStrings = append(errorStrings, errors.Annotate(result.Error, groupNames[i]).Error())
+			continue
+		}
+		if result.Result == nil {
+			return nil, errors.Errorf("unexpected nil result at position %d", i)
+		}
+		groups = append(groups, *result.Result)
+	}
+	if len(errorStrings) > 0 {
+		return nil, errors.New(strings.Join(errorStrings, ", "))
+	}
+	return groups, nil
+}
+
+// GrantGroup grants a user group access to the specified controllers, models
+// or clouds.
+func (c *Client) GrantGroup(group, access string, targets ...names.Tag) error {
+	return c.modifyGroupAccess(group, params.GrantGroupAccess, access, targets)
+}
+
+// RevokeGroup revokes access from a user group to the specified controllers,
+// models or clouds.
+func (c *Client) RevokeGroup(group, access string, targets ...names.Tag) error {
+	return c.modifyGroupAccess(group, params.RevokeGroupAccess, access, targets)
+}
+
+func (c *Client) modifyGroupAccess(group string, action params.GroupAccessAction, access string, targets []names.Tag) error {
+	args := params.ModifyGroupAccessRequest{
+		Changes: make([]params.ModifyGroupAccess, len(targets)),
+	}
+	for i, target := range targets {
+		args.Changes[i] = params.ModifyGroupAccess{
+			Group:     group,
+			Action:    action,
+			Access:    access,
+			TargetTag: target.String(),
+		}
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(context.TODO(), "ModifyGroupAccess", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != len(targets) {
+		return errors.Errorf("expected %d results, got %d", len(targets), len(results.Results))
+	}
+	return results.Combine()
+}
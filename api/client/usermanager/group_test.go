@@ -0,0 +1,161 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager_test
+
+import (
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/usermanager"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type groupSuite struct{}
+
+var _ = gc.Suite(&groupSuite{})
+
+func (s *groupSuite) TestAddGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AddGroups{
+		Groups: []params.AddGroup{{Name: "engineers", Members: []string{"bob", "mary@external"}}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AddGroups", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.AddGroup("engineers", "bob", "mary@external")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *groupSuite) TestRemoveGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.GroupNames{Names: []string{"engineers"}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Message: "group not found", Code: params.CodeNotFound},
+	}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveGroups", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RemoveGroup("engineers")
+	c.Assert(err, gc.ErrorMatches, "group not found")
+}
+
+func (s *groupSuite) TestAddGroupMembers(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ModifyGroupMembersRequest{
+		Changes: []params.ModifyGroupMembers{{
+			Group: "engineers", Action: params.AddGroupMembers, Members: []string{"bob"},
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ModifyGroupMembers", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.AddGroupMembers("engineers", "bob")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *groupSuite) TestRemoveGroupMembersInvalidUser(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	client := usermanager.NewClientFromCaller(mocks.NewMockFacadeCaller(ctrl))
+	err := client.RemoveGroupMembers("engineers", "not@valid@user")
+	c.Assert(err, gc.ErrorMatches, `"not@valid@user" is not a valid username`)
+}
+
+func (s *groupSuite) TestGroups(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.GroupNames{Names: []string{"engineers", "operators"}}
+	result := new(params.UserGroupResults)
+	results := params.UserGroupResults{Results: []params.UserGroupResult{{
+		Result: &params.UserGroup{Name: "engineers", Members: []string{"bob"}},
+	}, {
+		Error: &params.Error{Message: "group not found", Code: params.CodeNotFound},
+	}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "UserGroups", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.Groups("engineers", "operators")
+	c.Assert(err, gc.ErrorMatches, "operators: group not found")
+}
+
+func (s *groupSuite) TestGroupsAll(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.GroupNames{}
+	result := new(params.UserGroupResults)
+	results := params.UserGroupResults{Results: []params.UserGroupResult{{
+		Result: &params.UserGroup{Name: "engineers", Members: []string{"bob"}},
+	}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "UserGroups", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	groups, err := client.Groups()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(groups, jc.DeepEquals, []params.UserGroup{{Name: "engineers", Members: []string{"bob"}}})
+}
+
+func (s *groupSuite) TestGrantGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ModifyGroupAccessRequest{
+		Changes: []params.ModifyGroupAccess{{
+			Group: "engineers", Action: params.GrantGroupAccess, Access: "read", TargetTag: coretesting.ModelTag.String(),
+		}, {
+			Group: "engineers", Action: params.GrantGroupAccess, Access: "read", TargetTag: "cloud-aws",
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}, {
+		Error: &params.Error{Message: "permission denied", Code: params.CodeUnauthorized},
+	}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ModifyGroupAccess", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.GrantGroup("engineers", "read", coretesting.ModelTag, names.NewCloudTag("aws"))
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *groupSuite) TestRevokeGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ModifyGroupAccessRequest{
+		Changes: []params.ModifyGroupAccess{{
+			Group: "engineers", Action: params.RevokeGroupAccess, Access: "superuser", TargetTag: coretesting.ControllerTag.String(),
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ModifyGroupAccess", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RevokeGroup("engineers", "superuser", coretesting.ControllerTag)
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// AddRole adds a role allowing the specified facade methods, each given
+// as "Facade.Method" or "Facade.*".
+func (c *Client) AddRole(name string, methods ...string) error {
+	args := params.AddRoles{
+		Roles: []params.AddRole{{Name: name, Methods: methods}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(context.TODO(), "AddRoles", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// RemoveRole removes a role, revoking it from every user it was granted to.
+func (c *Client) RemoveRole(name string) error {
+	args := params.RoleNames{Names: []string{name}}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(context.TODO(), "RemoveRoles", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// Roles returns information about all the roles.
+func (c *Client) Roles() ([]params.Role, error) {
+	var results params.RolesResult
+	if err := c.facade.FacadeCall(context.TODO(), "Roles", nil, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Roles, nil
+}
+
+// GrantRole grants a role to a user on the specified models.
+func (c *Client) GrantRole(role, user string, models ...names.ModelTag) error {
+	return c.modifyRoleGrants(role, params.GrantRole, user, models)
+}
+
+// RevokeRole revokes a role from a user on the specified models.
+func (c *Client) RevokeRole(role, user string, models ...names.ModelTag) error {
+	return c.modifyRoleGrants(role, params.RevokeRole, user, models)
+}
+
+func (c *Client) modifyRoleGrants(role string, action params.RoleGrantAction, user string, models []names.ModelTag) error {
+	if !names.IsValidUser(user) {
+		return errors.Errorf("%q is not a valid username", user)
+	}
+	userTag := names.NewUserTag(user)
+	args := params.ModifyRoleGrantsRequest{
+		Changes: make([]params.ModifyRoleGrant, len(models)),
+	}
+	for i, model := range models {
+		args.Changes[i] = params.ModifyRoleGrant{
+			Role:     role,
+			Action:   action,
+			UserTag:  userTag.String(),
+			ModelTag: model.String(),
+		}
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(context.TODO(), "ModifyRoleGrants", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != len(models) {
+		return errors.Errorf("expected %d results, got %d", len(models), len(results.Results))
+	}
+	return results.Combine()
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/usermanager"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type roleSuite struct{}
+
+var _ = gc.Suite(&roleSuite{})
+
+func (s *roleSuite) TestAddRole(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AddRoles{
+		Roles: []params.AddRole{{Name: "support", Methods: []string{"Action.*", "Client.FullStatus"}}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AddRoles", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.AddRole("support", "Action.*", "Client.FullStatus")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleSuite) TestRemoveRole(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RoleNames{Names: []string{"support"}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Message: "role not found", Code: params.CodeNotFound},
+	}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveRoles", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RemoveRole("support")
+	c.Assert(err, gc.ErrorMatches, "role not found")
+}
+
+func (s *roleSuite) TestRoles(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	created := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	roles := []params.Role{{Name: "support", Methods: []string{"Action.*"}, CreatedBy: "admin", DateCreated: created}}
+	result := new(params.RolesResult)
+	results := params.RolesResult{Roles: roles}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "Roles", nil, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := client.Roles()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(obtained, jc.DeepEquals, roles)
+}
+
+func (s *roleSuite) TestGrantRole(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ModifyRoleGrantsRequest{
+		Changes: []params.ModifyRoleGrant{{
+			Role: "support", Action: params.GrantRole, UserTag: "user-bob", ModelTag: coretesting.ModelTag.String(),
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ModifyRoleGrants", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.GrantRole("support", "bob", coretesting.ModelTag)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleSuite) TestRevokeRole(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ModifyRoleGrantsRequest{
+		Changes: []params.ModifyRoleGrant{{
+			Role: "support", Action: params.RevokeRole, UserTag: "user-mary@external", ModelTag: coretesting.ModelTag.String(),
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Message: "role grant not found", Code: params.CodeNotFound},
+	}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ModifyRoleGrants", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RevokeRole("support", "mary@external", coretesting.ModelTag)
+	c.Assert(err, gc.ErrorMatches, "role grant not found")
+}
+
+func (s *roleSuite) TestGrantRoleInvalidUser(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	client := usermanager.NewClientFromCaller(mocks.NewMockFacadeCaller(ctrl))
+	err := client.GrantRole("support", "not@valid@user", coretesting.ModelTag)
+	c.Assert(err, gc.ErrorMatches, `"not@valid@user" is not a valid username`)
+}
@@ -40,6 +40,12 @@ type DebugLogParams struct {
 	ExcludeModule []string
 	// ExcludeLabel lists logging labels to exclude from the response.
 	ExcludeLabel []string
+	// IncludeMessage lists regular expressions matched against the message
+	// text. If any are set, only lines matching at least one are included.
+	IncludeMessage []string
+	// ExcludeMessage lists regular expressions matched against the message
+	// text. Lines matching any of them are excluded from the response.
+	ExcludeMessage []string
 
 	// Limit defines the maximum number of lines to return. Once this many
 	// have been sent, the socket is closed.  If zero, all filtered lines are
@@ -60,6 +66,9 @@ type DebugLogParams struct {
 	// StartTime should be a time in the past - only records with a
 	// log time on or after StartTime will be returned.
 	StartTime time.Time
+	// EndTime, if set, means only records with a log time on or before
+	// EndTime will be returned. The stream is closed once it has passed.
+	EndTime time.Time
 }
 
 func (args DebugLogParams) URLQuery() url.Values {
@@ -71,6 +80,12 @@ func (args DebugLogParams) URLQuery() url.Values {
 		"excludeModule": args.ExcludeModule,
 		"excludeLabel":  args.ExcludeLabel,
 	}
+	if len(args.IncludeMessage) > 0 {
+		attrs["includeMessage"] = args.IncludeMessage
+	}
+	if len(args.ExcludeMessage) > 0 {
+		attrs["excludeMessage"] = args.ExcludeMessage
+	}
 	if args.Replay {
 		attrs.Set("replay", fmt.Sprint(args.Replay))
 	}
@@ -89,6 +104,9 @@ func (args DebugLogParams) URLQuery() url.Values {
 	if !args.StartTime.IsZero() {
 		attrs.Set("startTime", args.StartTime.Format(time.RFC3339Nano))
 	}
+	if !args.EndTime.IsZero() {
+		attrs.Set("endTime", args.EndTime.Format(time.RFC3339Nano))
+	}
 	return attrs
 }
 
@@ -29,7 +29,8 @@ import (
 )
 
 // Login authenticates as the entity with the given name and password
-// or macaroons. Subsequent requests on the conn will act as that entity.
+// or macaroons, or with the ID token the conn was opened with.
+// Subsequent requests on the conn will act as that entity.
 // This method is usually called automatically by Open. The machine nonce
 // should be empty unless logging in as a machine agent.
 func (c *conn) Login(tag names.Tag, password, nonce string, macaroons []macaroon.Slice) error {
@@ -40,6 +41,7 @@ func (c *conn) Login(tag names.Tag, password, nonce string, macaroons []macaroon
 		Nonce:         nonce,
 		Macaroons:     macaroons,
 		BakeryVersion: bakery.LatestVersion,
+		Token:         c.token,
 		CLIArgs:       utils.CommandString(os.Args...),
 		ClientVersion: jujuversion.Current.String(),
 	}
@@ -50,7 +52,7 @@ func (c *conn) Login(tag names.Tag, password, nonce string, macaroons []macaroon
 		request.UserData = string(debug.Stack())
 	}
 
-	if password == "" {
+	if password == "" && c.token == "" {
 		// Add any macaroons from the cookie jar that might work for
 		// authenticating the login request.
 		request.Macaroons = append(request.Macaroons,
@@ -17,6 +17,7 @@ import (
 	charmscommon "github.com/juju/juju/api/common/charms"
 	apiwatcher "github.com/juju/juju/api/watcher"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/application"
 	corebase "github.com/juju/juju/core/base"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
@@ -155,6 +156,8 @@ type ProvisioningInfo struct {
 	CharmModifiedVersion int
 	CharmURL             *charm.URL
 	Trust                bool
+	MinAvailable         int
+	Autoscaling          *application.AutoscalingPolicy
 	Scale                int
 }
 
@@ -191,8 +194,17 @@ func (c *Client) ProvisioningInfo(applicationName string) (ProvisioningInfo, err
 		ImageDetails:         params.ConvertDockerImageInfo(r.ImageRepo),
 		CharmModifiedVersion: r.CharmModifiedVersion,
 		Trust:                r.Trust,
+		MinAvailable:         r.MinAvailable,
 		Scale:                r.Scale,
 	}
+	if r.Autoscaling != nil {
+		info.Autoscaling = &application.AutoscalingPolicy{
+			MinUnits:     r.Autoscaling.MinUnits,
+			MaxUnits:     r.Autoscaling.MaxUnits,
+			CPUTarget:    r.Autoscaling.CPUTarget,
+			MemoryTarget: r.Autoscaling.MemoryTarget,
+		}
+	}
 	for _, fs := range r.Filesystems {
 		f, err := filesystemFromParams(fs)
 		if err != nil {
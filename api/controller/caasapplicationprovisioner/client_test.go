@@ -14,6 +14,7 @@ import (
 
 	basetesting "github.com/juju/juju/api/base/testing"
 	"github.com/juju/juju/api/controller/caasapplicationprovisioner"
+	"github.com/juju/juju/core/application"
 	corebase "github.com/juju/juju/core/base"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/resources"
@@ -185,7 +186,13 @@ func (s *provisionerSuite) TestProvisioningInfo(c *gc.C) {
 				CharmModifiedVersion: 1,
 				CharmURL:             "ch:charm-1",
 				Trust:                true,
-				Scale:                3,
+				MinAvailable:         2,
+				Autoscaling: &params.CAASApplicationAutoscaling{
+					MinUnits:     1,
+					MaxUnits:     5,
+					MemoryTarget: 70,
+				},
+				Scale: 3,
 			}}}
 		return nil
 	})
@@ -203,7 +210,13 @@ func (s *provisionerSuite) TestProvisioningInfo(c *gc.C) {
 		CharmModifiedVersion: 1,
 		CharmURL:             &charm.URL{Schema: "ch", Name: "charm", Revision: 1},
 		Trust:                true,
-		Scale:                3,
+		MinAvailable:         2,
+		Autoscaling: &application.AutoscalingPolicy{
+			MinUnits:     1,
+			MaxUnits:     5,
+			MemoryTarget: 70,
+		},
+		Scale: 3,
 	})
 }
 
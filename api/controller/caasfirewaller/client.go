@@ -30,6 +30,7 @@ var WithTracer = base.WithTracer
 // Client allows access to the CAAS firewaller API endpoint for sidecar applications.
 type Client struct {
 	facade base.FacadeCaller
+	*common.ModelWatcher
 	*charmscommon.CharmInfoClient
 	*charmscommon.ApplicationCharmInfoClient
 }
@@ -41,6 +42,7 @@ func NewClient(caller base.APICaller, options ...Option) *Client {
 	appCharmInfoClient := charmscommon.NewApplicationCharmInfoClient(facadeCaller)
 	return &Client{
 		facade:                     facadeCaller,
+		ModelWatcher:               common.NewModelWatcher(facadeCaller),
 		CharmInfoClient:            charmInfoClient,
 		ApplicationCharmInfoClient: appCharmInfoClient,
 	}
@@ -142,6 +144,19 @@ func (c *Client) WatchApplication(appName string) (watcher.NotifyWatcher, error)
 	return common.Watch(c.facade, "Watch", appTag)
 }
 
+// WatchApplicationConfig returns a NotifyWatcher that notifies of
+// changes to the config of the application in the current model.
+func (c *Client) WatchApplicationConfig(appName string) (watcher.NotifyWatcher, error) {
+	if c.facade.BestAPIVersion() < 2 {
+		return nil, errors.NotSupportedf("watching application config on CAASFirewaller facade version 1")
+	}
+	appTag, err := applicationTag(appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return common.Watch(c.facade, "WatchApplicationConfig", appTag)
+}
+
 // Life returns the lifecycle state for the specified CAAS application
 // in the current model.
 func (c *Client) Life(appName string) (life.Value, error) {
@@ -202,6 +217,84 @@ func (c *Client) IsExposed(appName string) (bool, error) {
 	return results.Results[0].Result, nil
 }
 
+// ExposeInfo returns whether the specified CAAS application in the
+// current model is exposed, along with any endpoint-specific expose
+// settings.
+func (c *Client) ExposeInfo(appName string) (bool, map[string]params.ExposedEndpoint, error) {
+	if c.facade.BestAPIVersion() < 2 {
+		return false, nil, errors.NotSupportedf("expose info on CAASFirewaller facade version 1")
+	}
+	appTag, err := applicationTag(appName)
+	if err != nil {
+		return false, nil, errors.Trace(err)
+	}
+	args := entities(appTag)
+
+	var results params.ExposeInfoResults
+	if err := c.facade.FacadeCall(context.TODO(), "GetExposeInfo", args, &results); err != nil {
+		return false, nil, err
+	}
+	if n := len(results.Results); n != 1 {
+		return false, nil, errors.Errorf("expected 1 result, got %d", n)
+	}
+	if err := results.Results[0].Error; err != nil {
+		return false, nil, maybeNotFound(err)
+	}
+	return results.Results[0].Exposed, results.Results[0].ExposedEndpoints, nil
+}
+
+// RelatedApplications returns the names of the applications related to
+// the specified CAAS application in the current model.
+func (c *Client) RelatedApplications(appName string) ([]string, error) {
+	if c.facade.BestAPIVersion() < 2 {
+		return nil, errors.NotSupportedf("related applications on CAASFirewaller facade version 1")
+	}
+	appTag, err := applicationTag(appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	args := entities(appTag)
+
+	var results params.StringsResults
+	if err := c.facade.FacadeCall(context.TODO(), "RelatedApplications", args, &results); err != nil {
+		return nil, err
+	}
+	if n := len(results.Results); n != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", n)
+	}
+	if err := results.Results[0].Error; err != nil {
+		return nil, maybeNotFound(err)
+	}
+	return results.Results[0].Result, nil
+}
+
+// WatchRelations returns a StringsWatcher that notifies of changes to
+// the relations of the specified CAAS application in the current model.
+func (c *Client) WatchRelations(appName string) (watcher.StringsWatcher, error) {
+	if c.facade.BestAPIVersion() < 2 {
+		return nil, errors.NotSupportedf("watching relations on CAASFirewaller facade version 1")
+	}
+	appTag, err := applicationTag(appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	args := entities(appTag)
+
+	var results params.StringsWatchResults
+	if err := c.facade.FacadeCall(context.TODO(), "WatchRelations", args, &results); err != nil {
+		return nil, err
+	}
+	if n := len(results.Results); n != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", n)
+	}
+	result := results.Results[0]
+	if err := result.Error; err != nil {
+		return nil, maybeNotFound(err)
+	}
+	w := apiwatcher.NewStringsWatcher(c.facade.RawAPICaller(), result)
+	return w, nil
+}
+
 // maybeNotFound returns an error satisfying errors.IsNotFound
 // if the supplied error has a CodeNotFound error.
 func maybeNotFound(err *params.Error) error {
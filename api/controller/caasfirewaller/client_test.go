@@ -24,6 +24,10 @@ type clientCommmon interface {
 	WatchApplications() (watcher.StringsWatcher, error)
 	WatchApplication(string) (watcher.NotifyWatcher, error)
 	IsExposed(string) (bool, error)
+	ExposeInfo(string) (bool, map[string]params.ExposedEndpoint, error)
+	RelatedApplications(string) ([]string, error)
+	WatchRelations(string) (watcher.StringsWatcher, error)
+	WatchApplicationConfig(string) (watcher.NotifyWatcher, error)
 	ApplicationConfig(string) (config.ConfigAttributes, error)
 	Life(string) (life.Value, error)
 }
@@ -159,6 +163,133 @@ func (s *firewallerSuite) TestIsExposedInvalidEntityame(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `application name "" not valid`)
 }
 
+func (s *firewallerSuite) TestExposeInfo(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, s.objType)
+		c.Check(version, gc.Equals, 2)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "GetExposeInfo")
+		c.Check(arg, jc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{
+				Tag: "application-gitlab",
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ExposeInfoResults{})
+		*(result.(*params.ExposeInfoResults)) = params.ExposeInfoResults{
+			Results: []params.ExposeInfoResult{{
+				Exposed: true,
+				ExposedEndpoints: map[string]params.ExposedEndpoint{
+					"": {ExposeToCIDRs: []string{"10.0.0.0/24"}},
+				},
+			}},
+		}
+		return nil
+	}), BestVersion: 2}
+
+	client := s.newFunc(apiCaller)
+	exposed, endpoints, err := client.ExposeInfo("gitlab")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(exposed, jc.IsTrue)
+	c.Assert(endpoints, jc.DeepEquals, map[string]params.ExposedEndpoint{
+		"": {ExposeToCIDRs: []string{"10.0.0.0/24"}},
+	})
+}
+
+func (s *firewallerSuite) TestRelatedApplications(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, s.objType)
+		c.Check(version, gc.Equals, 2)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "RelatedApplications")
+		c.Check(arg, jc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{
+				Tag: "application-gitlab",
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.StringsResults{})
+		*(result.(*params.StringsResults)) = params.StringsResults{
+			Results: []params.StringsResult{{
+				Result: []string{"mysql"},
+			}},
+		}
+		return nil
+	}), BestVersion: 2}
+
+	client := s.newFunc(apiCaller)
+	related, err := client.RelatedApplications("gitlab")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(related, jc.DeepEquals, []string{"mysql"})
+}
+
+func (s *firewallerSuite) TestRelatedApplicationsError(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		*(result.(*params.StringsResults)) = params.StringsResults{
+			Results: []params.StringsResult{{Error: &params.Error{
+				Code:    params.CodeNotFound,
+				Message: "bletch",
+			}}},
+		}
+		return nil
+	}), BestVersion: 2}
+
+	client := s.newFunc(apiCaller)
+	_, err := client.RelatedApplications("gitlab")
+	c.Assert(err, gc.ErrorMatches, "bletch")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *firewallerSuite) TestWatchRelations(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, s.objType)
+		c.Check(version, gc.Equals, 2)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "WatchRelations")
+		c.Assert(arg, jc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{
+				Tag: "application-gitlab",
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.StringsWatchResults{})
+		*(result.(*params.StringsWatchResults)) = params.StringsWatchResults{
+			Results: []params.StringsWatchResult{{
+				Error: &params.Error{Message: "FAIL"},
+			}},
+		}
+		return nil
+	}), BestVersion: 2}
+
+	client := s.newFunc(apiCaller)
+	watcher, err := client.WatchRelations("gitlab")
+	c.Assert(watcher, gc.IsNil)
+	c.Assert(err, gc.ErrorMatches, "FAIL")
+}
+
+func (s *firewallerSuite) TestWatchApplicationConfig(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, s.objType)
+		c.Check(version, gc.Equals, 2)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "WatchApplicationConfig")
+		c.Assert(arg, jc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{
+				Tag: "application-gitlab",
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.NotifyWatchResults{})
+		*(result.(*params.NotifyWatchResults)) = params.NotifyWatchResults{
+			Results: []params.NotifyWatchResult{{
+				Error: &params.Error{Message: "FAIL"},
+			}},
+		}
+		return nil
+	}), BestVersion: 2}
+
+	client := s.newFunc(apiCaller)
+	watcher, err := client.WatchApplicationConfig("gitlab")
+	c.Assert(watcher, gc.IsNil)
+	c.Assert(err, gc.ErrorMatches, "FAIL")
+}
+
 func (s *firewallerSuite) TestLife(c *gc.C) {
 	tag := names.NewApplicationTag("gitlab")
 	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
@@ -281,3 +412,14 @@ func (s *firewallerSuite) TestApplicationConfig(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(cfg, jc.DeepEquals, config.ConfigAttributes{"foo": "bar"})
 }
+
+func (s *firewallerSuite) TestRelatedApplicationsNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected API call %q", request)
+		return nil
+	}), BestVersion: 1}
+
+	client := s.newFunc(apiCaller)
+	_, err := client.RelatedApplications("gitlab")
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
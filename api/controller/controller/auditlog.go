@@ -0,0 +1,23 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// AuditRecords returns the audit records matching the filter from all
+// of the controllers, oldest first.
+func (c *Client) AuditRecords(filter params.AuditRecordsArgs) (params.AuditRecordsResult, error) {
+	var result params.AuditRecordsResult
+	err := c.facade.FacadeCall(context.TODO(), "AuditRecords", filter, &result)
+	if err != nil {
+		return params.AuditRecordsResult{}, errors.Trace(err)
+	}
+	return result, nil
+}
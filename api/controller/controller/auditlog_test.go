@@ -0,0 +1,57 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apitesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/controller/controller"
+	"github.com/juju/juju/rpc/params"
+)
+
+func (s *Suite) TestAuditRecords(c *gc.C) {
+	filter := params.AuditRecordsArgs{
+		Who:     "mary",
+		Methods: []string{"Application.DestroyApplication"},
+	}
+	apiCaller := apitesting.BestVersionCaller{
+		BestVersion: 11,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "Controller")
+			c.Check(request, gc.Equals, "AuditRecords")
+			c.Check(arg, jc.DeepEquals, filter)
+			c.Assert(result, gc.FitsTypeOf, &params.AuditRecordsResult{})
+			*(result.(*params.AuditRecordsResult)) = params.AuditRecordsResult{
+				Records: []params.AuditRecord{{
+					ControllerID:   "0",
+					ConversationID: "abc",
+					Conversation:   &params.AuditConversation{Who: "mary"},
+				}},
+				Controllers: []string{"0"},
+			}
+			return nil
+		},
+	}
+	client := controller.NewClient(apiCaller)
+	result, err := client.AuditRecords(filter)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Controllers, jc.DeepEquals, []string{"0"})
+	c.Assert(result.Records, gc.HasLen, 1)
+	c.Check(result.Records[0].Conversation.Who, gc.Equals, "mary")
+}
+
+func (s *Suite) TestAuditRecordsError(c *gc.C) {
+	apiCaller := apitesting.BestVersionCaller{
+		BestVersion: 11,
+		APICallerFunc: func(string, int, string, string, interface{}, interface{}) error {
+			return errors.New("boom")
+		},
+	}
+	client := controller.NewClient(apiCaller)
+	_, err := client.AuditRecords(params.AuditRecordsArgs{})
+	c.Check(err, gc.ErrorMatches, "boom")
+}
@@ -35,7 +35,7 @@ var FacadeVersions = map[string][]int{
 	"CAASApplication":              {1},
 	"CAASApplicationProvisioner":   {1},
 	"CAASModelConfigManager":       {1},
-	"CAASFirewaller":               {1},
+	"CAASFirewaller":               {1, 2},
 	"CAASModelOperator":            {1},
 	"CAASOperatorUpgrader":         {1},
 	"CAASUnitProvisioner":          {2},
@@ -69,6 +69,7 @@ func (doer httpRequestDoer) Do(req *http.Request) (*http.Response, error) {
 		doer.c.tag,
 		doer.c.password,
 		doer.c.nonce,
+		doer.c.token,
 		doer.c.macaroons,
 	); err != nil {
 		return nil, errors.Trace(err)
@@ -85,22 +86,25 @@ func (doer httpRequestDoer) Do(req *http.Request) (*http.Response, error) {
 	})
 }
 
-// AuthHTTPRequest adds Juju auth info (username, password, nonce, macaroons)
-// to the given HTTP request, suitable for sending to a Juju API server.
+// AuthHTTPRequest adds Juju auth info (username, password, nonce, token,
+// macaroons) to the given HTTP request, suitable for sending to a Juju API
+// server.
 func AuthHTTPRequest(req *http.Request, info *Info) error {
 	var tag string
 	if info.Tag != nil {
 		tag = info.Tag.String()
 	}
-	return authHTTPRequest(req, tag, info.Password, info.Nonce, info.Macaroons)
+	return authHTTPRequest(req, tag, info.Password, info.Nonce, info.Token, info.Macaroons)
 }
 
-func authHTTPRequest(req *http.Request, tag, password, nonce string, macaroons []macaroon.Slice) error {
+func authHTTPRequest(req *http.Request, tag, password, nonce, token string, macaroons []macaroon.Slice) error {
 	if tag != "" {
 		// Note that password may be empty here; we still
 		// want to pass the tag along. An empty password
 		// indicates that we're using macaroon authentication.
 		req.SetBasicAuth(tag, password)
+	} else if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 	if nonce != "" {
 		req.Header.Set(params.MachineNonceHeader, nonce)
@@ -61,7 +61,7 @@ type Info struct {
 	// to use after connecting -- if any -- and should probably be extracted.
 
 	// SkipLogin, if true, skips the Login call on connection. It is an
-	// error to set Tag, Password, or Macaroons if SkipLogin is true.
+	// error to set Tag, Password, Macaroons or Token if SkipLogin is true.
 	SkipLogin bool `yaml:"-"`
 
 	// Tag holds the name of the entity that is connecting.
@@ -80,6 +80,11 @@ type Info struct {
 	// only by the machine agent.
 	Nonce string `yaml:",omitempty"`
 
+	// Token holds an ID token from an OpenID Connect provider trusted
+	// by the controller, used to log in instead of a password or
+	// macaroons.
+	Token string `yaml:",omitempty"`
+
 	// Proxier describes a proxier to use to for establing an API connection
 	// A nil proxier means that it will not be used.
 	Proxier proxy.Proxier
@@ -122,6 +127,9 @@ func (info *Info) Validate() error {
 		if len(info.Macaroons) > 0 {
 			return errors.NotValidf("specifying Macaroons and SkipLogin")
 		}
+		if info.Token != "" {
+			return errors.NotValidf("specifying Token and SkipLogin")
+		}
 	}
 	return nil
 }
@@ -129,21 +129,45 @@ func (a *admin) login(ctx context.Context, req params.LoginRequest, loginVersion
 		pServers[i] = hps.HostPorts()
 	}
 
+	requestRecorder := httpRequestRecorderWrapper{
+		collector: a.srv.metricsCollector,
+		modelUUID: a.root.model.UUID(),
+	}
+
 	// apiRoot is the API root exposed to the client after login.
 	var apiRoot rpc.Root
 	apiRoot, err = newAPIRoot(
 		a.root,
 		a.srv.facades,
-		httpRequestRecorderWrapper{
-			collector: a.srv.metricsCollector,
-			modelUUID: a.root.model.UUID(),
-		},
+		requestRecorder,
 		a.srv.clock,
 	)
 	if err != nil {
 		return fail, errors.Trace(err)
 	}
 
+	roleMethods, err := a.userRoleMethods(ctx, authResult)
+	if err != nil {
+		return fail, errors.Annotate(err, "fetching user roles")
+	}
+	if len(roleMethods) > 0 {
+		// The methods allowed by the user's roles are served by facades
+		// that see the user as having write access to the model.
+		roleAPIRoot, err := newAPIRoot(
+			roleAPIRootHandler{
+				apiRootHandler: a.root,
+				modelTag:       a.root.model.ModelTag(),
+			},
+			a.srv.facades,
+			requestRecorder,
+			a.srv.clock,
+		)
+		if err != nil {
+			return fail, errors.Trace(err)
+		}
+		apiRoot = newRoleRoot(apiRoot, roleAPIRoot, roleMethods)
+	}
+
 	apiRoot, err = restrictAPIRoot(
 		a.srv,
 		apiRoot,
@@ -200,7 +224,7 @@ func (a *admin) getAuditRecorder(req params.LoginRequest, authResult *authResult
 		auditlog.ConversationArgs{
 			Who:          a.root.authInfo.Entity.Tag().Id(),
 			What:         req.CLIArgs,
-			ModelName:    a.root.model.Name(),
+			ModelName:    a.root.model.Owner().Id() + "/" + a.root.model.Name(),
 			ModelUUID:    a.root.model.UUID(),
 			ConnectionID: a.root.connectionID,
 		},
@@ -218,6 +242,7 @@ type authResult struct {
 	userLogin              bool // false if anonymous user
 	controllerOnlyLogin    bool
 	controllerMachineLogin bool
+	apiTokenLogin          bool
 	userInfo               *params.AuthUserInfo
 }
 
@@ -227,7 +252,8 @@ func (a *admin) authenticate(ctx context.Context, req params.LoginRequest) (*aut
 		userLogin:           true,
 	}
 
-	logger.Debugf("request authToken: %q", req.Token)
+	// Don't log the token itself, as API tokens are long lived.
+	logger.Debugf("request has authToken: %v", req.Token != "")
 	if req.Token == "" && req.AuthTag != "" {
 		tag, err := names.ParseTag(req.AuthTag)
 		if err == nil {
@@ -306,6 +332,10 @@ func (a *admin) authenticate(ctx context.Context, req params.LoginRequest) (*aut
 			}
 
 			authenticated = true
+			_, result.apiTokenLogin = authInfo.Delegator.(*authentication.APITokenPermissionDelegator)
+			if result.userLogin {
+				authInfo = authentication.WithGroupPermissions(authInfo, a.srv.userGroups)
+			}
 			a.root.authInfo = authInfo
 			result.controllerMachineLogin = authInfo.Controller
 			break
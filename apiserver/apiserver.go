@@ -55,6 +55,7 @@ import (
 	"github.com/juju/juju/internal/servicefactory"
 	"github.com/juju/juju/rpc"
 	"github.com/juju/juju/rpc/jsoncodec"
+	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/worker/objectstore"
 	"github.com/juju/juju/worker/syslogger"
@@ -82,10 +83,15 @@ type Server struct {
 
 	localMacaroonAuthenticator macaroon.LocalMacaroonAuthenticator
 	jwtAuthenticator           jwt.Authenticator
+	oidcAuthenticator          *jwt.OIDCAuthenticator
 
 	httpAuthenticators  []authentication.HTTPAuthenticator
 	loginAuthenticators []authentication.LoginAuthenticator
 
+	// userGroups provides the access granted to the groups of
+	// authenticated users, for both logins and HTTP requests.
+	userGroups authentication.UserGroupAccessGetter
+
 	offerAuthCtxt          *crossmodel.AuthContext
 	lastConnectionID       uint64
 	newObserver            observer.ObserverFactory
@@ -151,6 +157,11 @@ type ServerConfig struct {
 	// provider.
 	JWTAuthenticator jwt.Authenticator
 
+	// OIDCAuthenticator is the request authenticator used for validating
+	// ID tokens from the OpenID Connect providers configured in the
+	// oidc-issuers controller config setting. It's nil if there are none.
+	OIDCAuthenticator *jwt.OIDCAuthenticator
+
 	// MultiwatcherFactory is used by the API server to create
 	// multiwatchers. The real factory is managed by the multiwatcher
 	// worker.
@@ -312,7 +323,8 @@ func NewServer(ctx context.Context, cfg ServerConfig) (*Server, error) {
 const readyTimeout = time.Second * 30
 
 func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
-	controllerConfigService := cfg.ServiceFactoryGetter.FactoryForModel(database.ControllerNS).ControllerConfig()
+	controllerServiceFactory := cfg.ServiceFactoryGetter.FactoryForModel(database.ControllerNS)
+	controllerConfigService := controllerServiceFactory.ControllerConfig()
 	controllerConfig, err := controllerConfigService.ControllerConfig(ctx)
 	if err != nil {
 		return nil, errors.Annotate(err, "unable to get controller config")
@@ -339,6 +351,23 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		httpAuthenticators = append([]authentication.HTTPAuthenticator{cfg.JWTAuthenticator}, httpAuthenticators...)
 		loginAuthenticators = append([]authentication.LoginAuthenticator{cfg.JWTAuthenticator}, loginAuthenticators...)
 	}
+	// The OIDC authenticator goes first, as it passes on tokens from
+	// issuers it doesn't know to the jwt authenticator.
+	if cfg.OIDCAuthenticator != nil {
+		httpAuthenticators = append([]authentication.HTTPAuthenticator{cfg.OIDCAuthenticator}, httpAuthenticators...)
+		loginAuthenticators = append([]authentication.LoginAuthenticator{cfg.OIDCAuthenticator}, loginAuthenticators...)
+	}
+	// API tokens are recognised by their prefix, so they can be checked
+	// before any of the other tokens.
+	if tokens := controllerServiceFactory.APIToken(); tokens != nil {
+		apiTokenAuthenticator := stateauthenticator.NewAPITokenAuthenticator(cfg.StatePool, tokens)
+		httpAuthenticators = append([]authentication.HTTPAuthenticator{apiTokenAuthenticator}, httpAuthenticators...)
+		loginAuthenticators = append([]authentication.LoginAuthenticator{apiTokenAuthenticator}, loginAuthenticators...)
+	}
+	var userGroups authentication.UserGroupAccessGetter
+	if groups := controllerServiceFactory.UserGroup(); groups != nil {
+		userGroups = groups
+	}
 
 	shared, err := newSharedServerContext(sharedServerConfig{
 		statePool:            cfg.StatePool,
@@ -374,8 +403,10 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		mux:                           cfg.Mux,
 		localMacaroonAuthenticator:    cfg.LocalMacaroonAuthenticator,
 		jwtAuthenticator:              cfg.JWTAuthenticator,
+		oidcAuthenticator:             cfg.OIDCAuthenticator,
 		httpAuthenticators:            httpAuthenticators,
 		loginAuthenticators:           loginAuthenticators,
+		userGroups:                    userGroups,
 		allowModelAccess:              cfg.AllowModelAccess,
 		publicDNSName_:                cfg.PublicDNSName,
 		registerIntrospectionHandlers: cfg.RegisterIntrospectionHandlers,
@@ -668,7 +699,10 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 	}
 	controllerModelUUID := systemState.ModelUUID()
 
-	httpAuthenticator := authentication.HTTPStrategicAuthenticator(srv.httpAuthenticators)
+	httpAuthenticator := authentication.GroupHTTPAuthenticator{
+		HTTPAuthenticator: authentication.HTTPStrategicAuthenticator(srv.httpAuthenticators),
+		Groups:            srv.userGroups,
+	}
 
 	addHandler := func(handler handler) {
 		methods := handler.methods
@@ -716,6 +750,7 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 	httpCtxt := httpContext{srv: srv}
 	mainAPIHandler := http.HandlerFunc(srv.apiHandler)
 	healthHandler := http.HandlerFunc(srv.healthHandler)
+	oidcLoginHandler := http.HandlerFunc(srv.oidcLoginHandler)
 	logStreamHandler := newLogStreamEndpointHandler(httpCtxt)
 	embeddedCLIHandler := newEmbeddedCLIHandler(httpCtxt)
 	debugLogHandler := newDebugLogDBHandler(
@@ -855,6 +890,9 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		objectStore:   httpCtxt.objectStoreForRequest,
 	}
 	backupHandler := &backupHandler{ctxt: httpCtxt}
+	objectStoreHandler := &objectStoreHandler{
+		objectStoreForRequest: httpCtxt.objectStoreForRequest,
+	}
 	registerHandler := &registerUserHandler{ctxt: httpCtxt}
 
 	// HTTP handler for application offer macaroon authentication.
@@ -927,6 +965,13 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		pattern:    modelRoutePrefix + "/backups",
 		handler:    backupHandler,
 		authorizer: controllerAdminAuthorizer,
+	}, {
+		// Objects are only served to other controllers, so that they can
+		// replicate the objects held in the object store.
+		pattern:    modelRoutePrefix + "/objects",
+		methods:    []string{"GET"},
+		handler:    objectStoreHandler,
+		authorizer: controllerAuthorizer{},
 	}, {
 		pattern:    "/migrate/charms",
 		handler:    migrateCharmsHTTPHandler,
@@ -970,6 +1015,12 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		handler:         healthHandler,
 		unauthenticated: true,
 		noModelUUID:     true,
+	}, {
+		pattern:         "/oidc-login",
+		methods:         []string{"GET"},
+		handler:         oidcLoginHandler,
+		unauthenticated: true,
+		noModelUUID:     true,
 	}, {
 		pattern:         "/register",
 		handler:         registerHandler,
@@ -1068,6 +1119,27 @@ func (srv *Server) healthHandler(w http.ResponseWriter, req *http.Request) {
 	fmt.Fprintf(w, "%s\n", status)
 }
 
+// oidcLoginHandler tells clients which OpenID Connect providers they
+// can log in with. The details are public, so no authentication is
+// needed.
+func (srv *Server) oidcLoginHandler(w http.ResponseWriter, req *http.Request) {
+	result := params.OIDCLoginInfo{
+		Issuers: []params.OIDCIssuerInfo{},
+	}
+	if srv.oidcAuthenticator != nil {
+		for _, issuer := range srv.oidcAuthenticator.Issuers() {
+			result.Issuers = append(result.Issuers, params.OIDCIssuerInfo{
+				URL:      issuer.URL,
+				ClientID: issuer.ClientID,
+				Scopes:   issuer.Scopes,
+			})
+		}
+	}
+	if err := sendStatusAndJSON(w, http.StatusOK, result); err != nil {
+		logger.Errorf("%v", err)
+	}
+}
+
 func (srv *Server) apiHandler(w http.ResponseWriter, req *http.Request) {
 	srv.metricsCollector.TotalConnections.Inc()
 
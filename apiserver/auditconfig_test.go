@@ -97,7 +97,7 @@ func (s *auditConfigSuite) TestLoginAddsAuditConversationEventually(c *gc.C) {
 	c.Assert(convo, mc, auditlog.Conversation{
 		Who:       user.Tag().Id(),
 		What:      "hey you guys",
-		ModelName: "controller",
+		ModelName: "admin/controller",
 		ModelUUID: s.ControllerModelUUID(),
 	})
 
@@ -0,0 +1,77 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication
+
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+)
+
+// APITokenPermissionDelegator wraps the PermissionDelegator of a user who
+// logged in with an API token, so that the user has no more access than
+// the token allows.
+type APITokenPermissionDelegator struct {
+	PermissionDelegator
+
+	// Token is the API token the user logged in with.
+	Token user.APIToken
+}
+
+// SubjectPermissions implements PermissionDelegator. The user has the
+// lesser of the access they would have without the token and the access
+// the token allows on the subject.
+func (p *APITokenPermissionDelegator) SubjectPermissions(
+	entity Entity,
+	subject names.Tag,
+) (permission.Access, error) {
+	access, err := p.PermissionDelegator.SubjectPermissions(entity, subject)
+	if err != nil {
+		return access, err
+	}
+	if p.Token.MaxAccess == permission.SuperuserAccess {
+		return access, nil
+	}
+	target, err := permission.ParseTagForID(subject)
+	if err != nil {
+		// The token allows no access to subjects it doesn't know about.
+		return permission.NoAccess, nil
+	}
+	return target.ObjectType.LowestAccess(access, p.allowedAccess(target)), nil
+}
+
+// allowedAccess returns the greatest access on the target that a token
+// allows, when it doesn't allow superuser access. Such tokens can log in
+// to the controller and, if scoped to a model, only act on that model.
+// Other tokens allow the corresponding access on offers, and admin tokens
+// allow models to be added to clouds.
+func (p *APITokenPermissionDelegator) allowedAccess(target permission.ID) permission.Access {
+	scoped := p.Token.ModelUUID != ""
+	switch target.ObjectType {
+	case permission.Controller:
+		return permission.LoginAccess
+	case permission.Model:
+		if scoped && target.Key != p.Token.ModelUUID {
+			return permission.NoAccess
+		}
+		return p.Token.MaxAccess
+	case permission.Offer:
+		if scoped {
+			return permission.NoAccess
+		}
+		switch p.Token.MaxAccess {
+		case permission.AdminAccess:
+			return permission.AdminAccess
+		case permission.WriteAccess:
+			return permission.ConsumeAccess
+		}
+		return permission.ReadAccess
+	case permission.Cloud:
+		if !scoped && p.Token.MaxAccess == permission.AdminAccess {
+			return permission.AddModelAccess
+		}
+	}
+	return permission.NoAccess
+}
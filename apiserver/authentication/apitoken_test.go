@@ -0,0 +1,87 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/testing"
+)
+
+type apiTokenDelegatorSuite struct{}
+
+var _ = gc.Suite(&apiTokenDelegatorSuite{})
+
+func (s *apiTokenDelegatorSuite) delegator(access permission.Access, token user.APIToken) *authentication.APITokenPermissionDelegator {
+	return &authentication.APITokenPermissionDelegator{
+		PermissionDelegator: stubDelegator{access: access},
+		Token:               token,
+	}
+}
+
+func (s *apiTokenDelegatorSuite) check(c *gc.C, delegator authentication.PermissionDelegator, subject names.Tag, expected permission.Access) {
+	access, err := delegator.SubjectPermissions(bob, subject)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, expected, gc.Commentf("subject %s", subject))
+}
+
+func (s *apiTokenDelegatorSuite) TestSuperuserToken(c *gc.C) {
+	token := user.APIToken{MaxAccess: permission.SuperuserAccess}
+	s.check(c, s.delegator(permission.SuperuserAccess, token), testing.ControllerTag, permission.SuperuserAccess)
+	s.check(c, s.delegator(permission.AdminAccess, token), modelTag, permission.AdminAccess)
+}
+
+func (s *apiTokenDelegatorSuite) TestTokenLimitsAccess(c *gc.C) {
+	token := user.APIToken{MaxAccess: permission.WriteAccess}
+	s.check(c, s.delegator(permission.SuperuserAccess, token), testing.ControllerTag, permission.LoginAccess)
+	s.check(c, s.delegator(permission.AdminAccess, token), modelTag, permission.WriteAccess)
+	s.check(c, s.delegator(permission.AdminAccess, token), names.NewApplicationOfferTag("hosted-mysql"), permission.ConsumeAccess)
+	s.check(c, s.delegator(permission.AdminAccess, token), names.NewCloudTag("aws"), permission.NoAccess)
+
+	// The token doesn't add to the user's own access.
+	s.check(c, s.delegator(permission.ReadAccess, token), modelTag, permission.ReadAccess)
+	s.check(c, s.delegator(permission.NoAccess, token), modelTag, permission.NoAccess)
+}
+
+func (s *apiTokenDelegatorSuite) TestAdminTokenCanAddModels(c *gc.C) {
+	token := user.APIToken{MaxAccess: permission.AdminAccess}
+	s.check(c, s.delegator(permission.AdminAccess, token), names.NewCloudTag("aws"), permission.AddModelAccess)
+	s.check(c, s.delegator(permission.AddModelAccess, token), names.NewCloudTag("aws"), permission.AddModelAccess)
+}
+
+func (s *apiTokenDelegatorSuite) TestModelScopedToken(c *gc.C) {
+	token := user.APIToken{MaxAccess: permission.AdminAccess, ModelUUID: modelTag.Id()}
+	otherModel := names.NewModelTag("deadbeef-1bad-500d-9000-4b1d0d06f00d")
+	s.check(c, s.delegator(permission.AdminAccess, token), modelTag, permission.AdminAccess)
+	s.check(c, s.delegator(permission.AdminAccess, token), otherModel, permission.NoAccess)
+	s.check(c, s.delegator(permission.SuperuserAccess, token), testing.ControllerTag, permission.LoginAccess)
+	s.check(c, s.delegator(permission.AdminAccess, token), names.NewApplicationOfferTag("hosted-mysql"), permission.NoAccess)
+	s.check(c, s.delegator(permission.AdminAccess, token), names.NewCloudTag("aws"), permission.NoAccess)
+}
+
+func (s *apiTokenDelegatorSuite) TestError(c *gc.C) {
+	delegator := &authentication.APITokenPermissionDelegator{
+		PermissionDelegator: stubDelegator{err: errors.NotFoundf("user")},
+		Token:               user.APIToken{MaxAccess: permission.ReadAccess},
+	}
+	_, err := delegator.SubjectPermissions(bob, modelTag)
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *apiTokenDelegatorSuite) TestGroupAccessLimited(c *gc.C) {
+	delegator := &authentication.APITokenPermissionDelegator{
+		PermissionDelegator: &authentication.GroupPermissionDelegator{
+			PermissionDelegator: stubDelegator{access: permission.ReadAccess},
+			Groups:              stubGroups{access: map[permission.ID]permission.Access{modelID: permission.AdminAccess}},
+		},
+		Token: user.APIToken{MaxAccess: permission.WriteAccess},
+	}
+	s.check(c, delegator, modelTag, permission.WriteAccess)
+}
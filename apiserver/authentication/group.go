@@ -0,0 +1,111 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/core/permission"
+)
+
+// UserGroupAccessGetter returns the highest access a user has been granted
+// on an entity through the groups they are a member of.
+type UserGroupAccessGetter interface {
+	UserGroupAccess(ctx context.Context, userName string, target permission.ID) (permission.Access, error)
+}
+
+// GroupPermissionDelegator wraps the PermissionDelegator of an authenticated
+// user so that access granted to the user's groups is taken into account.
+type GroupPermissionDelegator struct {
+	PermissionDelegator
+
+	// Groups provides the access granted to the user's groups.
+	Groups UserGroupAccessGetter
+}
+
+// SubjectPermissions implements PermissionDelegator. The user has the most
+// capable of the access granted to them directly and the access granted to
+// any of their groups. If the user has no direct access but a group does,
+// the group's access is returned rather than the NotFound error.
+func (p *GroupPermissionDelegator) SubjectPermissions(
+	entity Entity,
+	subject names.Tag,
+) (permission.Access, error) {
+	access, err := p.PermissionDelegator.SubjectPermissions(entity, subject)
+	if err != nil && !errors.Is(err, errors.NotFound) {
+		return access, err
+	}
+
+	userTag, ok := entity.Tag().(names.UserTag)
+	if !ok {
+		return access, err
+	}
+	target, idErr := permission.ParseTagForID(subject)
+	if idErr != nil {
+		// Groups can't be granted access on this kind of subject.
+		return access, err
+	}
+	groupAccess, groupErr := p.Groups.UserGroupAccess(context.TODO(), userTag.Id(), target)
+	if groupErr != nil {
+		return permission.NoAccess, errors.Annotatef(groupErr, "obtaining group access for %q", userTag.Id())
+	}
+
+	if err != nil {
+		if groupAccess == permission.NoAccess {
+			return access, err
+		}
+		return groupAccess, nil
+	}
+	return target.ObjectType.HighestAccess(access, groupAccess), nil
+}
+
+// WithGroupPermissions returns the AuthInfo with its delegator wrapped so
+// that access granted to the user's groups is included in the user's
+// permissions. If the user authenticated with an API token, the token
+// still limits the access they have through groups.
+func WithGroupPermissions(authInfo AuthInfo, groups UserGroupAccessGetter) AuthInfo {
+	if authInfo.Delegator == nil || groups == nil {
+		return authInfo
+	}
+	if tokenDelegator, ok := authInfo.Delegator.(*APITokenPermissionDelegator); ok {
+		authInfo.Delegator = &APITokenPermissionDelegator{
+			PermissionDelegator: &GroupPermissionDelegator{
+				PermissionDelegator: tokenDelegator.PermissionDelegator,
+				Groups:              groups,
+			},
+			Token: tokenDelegator.Token,
+		}
+		return authInfo
+	}
+	authInfo.Delegator = &GroupPermissionDelegator{
+		PermissionDelegator: authInfo.Delegator,
+		Groups:              groups,
+	}
+	return authInfo
+}
+
+// GroupHTTPAuthenticator wraps an HTTPAuthenticator so that access granted
+// to the groups of an authenticated user is included in their permissions.
+type GroupHTTPAuthenticator struct {
+	HTTPAuthenticator
+
+	// Groups provides the access granted to the user's groups.
+	Groups UserGroupAccessGetter
+}
+
+// Authenticate implements HTTPAuthenticator.
+func (a GroupHTTPAuthenticator) Authenticate(req *http.Request) (AuthInfo, error) {
+	authInfo, err := a.HTTPAuthenticator.Authenticate(req)
+	if err != nil {
+		return authInfo, err
+	}
+	if _, ok := authInfo.Entity.Tag().(names.UserTag); !ok {
+		return authInfo, nil
+	}
+	return WithGroupPermissions(authInfo, a.Groups), nil
+}
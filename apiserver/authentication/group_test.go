@@ -0,0 +1,181 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package authentication_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/testing"
+)
+
+type groupDelegatorSuite struct{}
+
+var _ = gc.Suite(&groupDelegatorSuite{})
+
+type stubEntity struct {
+	tag names.Tag
+}
+
+func (e stubEntity) Tag() names.Tag {
+	return e.tag
+}
+
+type stubDelegator struct {
+	authentication.PermissionDelegator
+	access permission.Access
+	err    error
+}
+
+func (d stubDelegator) SubjectPermissions(authentication.Entity, names.Tag) (permission.Access, error) {
+	return d.access, d.err
+}
+
+type stubGroups struct {
+	access map[permission.ID]permission.Access
+	err    error
+}
+
+func (g stubGroups) UserGroupAccess(_ context.Context, userName string, target permission.ID) (permission.Access, error) {
+	if g.err != nil {
+		return permission.NoAccess, g.err
+	}
+	if userName != "bob" {
+		return permission.NoAccess, nil
+	}
+	return g.access[target], nil
+}
+
+var (
+	bob      = stubEntity{tag: names.NewUserTag("bob")}
+	modelTag = testing.ModelTag
+	modelID  = permission.ID{ObjectType: permission.Model, Key: modelTag.Id()}
+)
+
+func (s *groupDelegatorSuite) TestGroupAccessGreater(c *gc.C) {
+	delegator := &authentication.GroupPermissionDelegator{
+		PermissionDelegator: stubDelegator{access: permission.ReadAccess},
+		Groups:              stubGroups{access: map[permission.ID]permission.Access{modelID: permission.AdminAccess}},
+	}
+	access, err := delegator.SubjectPermissions(bob, modelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.AdminAccess)
+}
+
+func (s *groupDelegatorSuite) TestDirectAccessGreater(c *gc.C) {
+	delegator := &authentication.GroupPermissionDelegator{
+		PermissionDelegator: stubDelegator{access: permission.WriteAccess},
+		Groups:              stubGroups{access: map[permission.ID]permission.Access{modelID: permission.ReadAccess}},
+	}
+	access, err := delegator.SubjectPermissions(bob, modelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.WriteAccess)
+}
+
+func (s *groupDelegatorSuite) TestGroupAccessOnly(c *gc.C) {
+	delegator := &authentication.GroupPermissionDelegator{
+		PermissionDelegator: stubDelegator{err: errors.NotFoundf("model user")},
+		Groups:              stubGroups{access: map[permission.ID]permission.Access{modelID: permission.ReadAccess}},
+	}
+	access, err := delegator.SubjectPermissions(bob, modelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.ReadAccess)
+}
+
+func (s *groupDelegatorSuite) TestNoAccess(c *gc.C) {
+	delegator := &authentication.GroupPermissionDelegator{
+		PermissionDelegator: stubDelegator{err: errors.NotFoundf("model user")},
+		Groups:              stubGroups{},
+	}
+	_, err := delegator.SubjectPermissions(bob, modelTag)
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+
+	delegator.PermissionDelegator = stubDelegator{access: permission.NoAccess}
+	access, err := delegator.SubjectPermissions(stubEntity{tag: names.NewUserTag("mary")}, modelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.NoAccess)
+}
+
+func (s *groupDelegatorSuite) TestErrors(c *gc.C) {
+	delegator := &authentication.GroupPermissionDelegator{
+		PermissionDelegator: stubDelegator{err: errors.New("boom")},
+		Groups:              stubGroups{access: map[permission.ID]permission.Access{modelID: permission.ReadAccess}},
+	}
+	_, err := delegator.SubjectPermissions(bob, modelTag)
+	c.Check(err, gc.ErrorMatches, "boom")
+
+	delegator = &authentication.GroupPermissionDelegator{
+		PermissionDelegator: stubDelegator{access: permission.ReadAccess},
+		Groups:              stubGroups{err: errors.New("boom")},
+	}
+	_, err = delegator.SubjectPermissions(bob, modelTag)
+	c.Check(err, gc.ErrorMatches, `obtaining group access for "bob": boom`)
+}
+
+type stubHTTPAuthenticator struct {
+	authInfo authentication.AuthInfo
+	err      error
+}
+
+func (a stubHTTPAuthenticator) Authenticate(*http.Request) (authentication.AuthInfo, error) {
+	return a.authInfo, a.err
+}
+
+func (s *groupDelegatorSuite) TestHTTPAuthenticator(c *gc.C) {
+	authenticator := authentication.GroupHTTPAuthenticator{
+		HTTPAuthenticator: stubHTTPAuthenticator{authInfo: authentication.AuthInfo{
+			Entity:    bob,
+			Delegator: stubDelegator{err: errors.NotFoundf("model user")},
+		}},
+		Groups: stubGroups{access: map[permission.ID]permission.Access{modelID: permission.AdminAccess}},
+	}
+	authInfo, err := authenticator.Authenticate(&http.Request{})
+	c.Assert(err, jc.ErrorIsNil)
+	access, err := authInfo.SubjectPermissions(modelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.AdminAccess)
+}
+
+func (s *groupDelegatorSuite) TestHTTPAuthenticatorAgent(c *gc.C) {
+	delegator := stubDelegator{access: permission.ReadAccess}
+	authenticator := authentication.GroupHTTPAuthenticator{
+		HTTPAuthenticator: stubHTTPAuthenticator{authInfo: authentication.AuthInfo{
+			Entity:    stubEntity{tag: names.NewMachineTag("0")},
+			Delegator: delegator,
+		}},
+		Groups: stubGroups{},
+	}
+	authInfo, err := authenticator.Authenticate(&http.Request{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(authInfo.Delegator, gc.Equals, delegator)
+}
+
+func (s *groupDelegatorSuite) TestHTTPAuthenticatorError(c *gc.C) {
+	authenticator := authentication.GroupHTTPAuthenticator{
+		HTTPAuthenticator: stubHTTPAuthenticator{err: errors.NotFoundf("credentials")},
+		Groups:            stubGroups{},
+	}
+	_, err := authenticator.Authenticate(&http.Request{})
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *groupDelegatorSuite) TestWithGroupPermissionsAPIToken(c *gc.C) {
+	authInfo := authentication.WithGroupPermissions(authentication.AuthInfo{
+		Entity: bob,
+		Delegator: &authentication.APITokenPermissionDelegator{
+			PermissionDelegator: stubDelegator{err: errors.NotFoundf("model user")},
+		},
+	}, stubGroups{access: map[permission.ID]permission.Access{modelID: permission.AdminAccess}})
+
+	tokenDelegator, ok := authInfo.Delegator.(*authentication.APITokenPermissionDelegator)
+	c.Assert(ok, jc.IsTrue)
+	c.Check(tokenDelegator.PermissionDelegator, gc.FitsTypeOf, &authentication.GroupPermissionDelegator{})
+}
@@ -0,0 +1,385 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/permission"
+)
+
+// oidcClockSkew is the leeway allowed when checking the times in an
+// ID token.
+const oidcClockSkew = time.Minute
+
+// OIDCConfig holds the configuration for an OIDCAuthenticator.
+type OIDCConfig struct {
+	// ControllerTag identifies the controller in grants.
+	ControllerTag names.ControllerTag
+
+	// Issuers are the providers whose ID tokens are accepted.
+	Issuers []controller.OIDCIssuer
+
+	// HTTPClient is used to fetch the providers' discovery documents
+	// and signing keys.
+	HTTPClient *http.Client
+
+	// Clock is used to check the validity period of ID tokens.
+	Clock clock.Clock
+}
+
+// OIDCAuthenticator authenticates users with ID tokens issued by the
+// OpenID Connect providers trusted by the controller. The user name
+// and groups come from the token's claims, and the user's access
+// from the grants configured for those groups.
+type OIDCAuthenticator struct {
+	config OIDCConfig
+	cache  *jwk.Cache
+
+	mu sync.Mutex
+	// jwksURLs holds the signing key URL for each issuer, found from
+	// its discovery document on first use.
+	jwksURLs map[string]string
+}
+
+// OIDCPermissionDelegator answers authorization questions for a user
+// authenticated with an ID token, from the grants of the token's
+// issuer. It implements authentication.PermissionDelegator.
+type OIDCPermissionDelegator struct {
+	// User is the authenticated user.
+	User names.UserTag
+
+	// Groups are the groups the user is in, from the ID token.
+	Groups set.Strings
+
+	// Grants are the grants of the ID token's issuer.
+	Grants []controller.OIDCGrant
+
+	// ControllerTag identifies the controller in grants.
+	ControllerTag names.ControllerTag
+}
+
+// NewOIDCAuthenticator returns an authenticator for the issuers in the
+// config. Providers aren't contacted until a token from them is seen,
+// so an unavailable provider doesn't stop the API server starting.
+func NewOIDCAuthenticator(ctx context.Context, config OIDCConfig) *OIDCAuthenticator {
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{
+			Timeout: 30 * time.Second,
+		}
+	}
+	if config.Clock == nil {
+		config.Clock = clock.WallClock
+	}
+	return &OIDCAuthenticator{
+		config:   config,
+		cache:    jwk.NewCache(ctx),
+		jwksURLs: make(map[string]string),
+	}
+}
+
+// Issuers returns the providers whose ID tokens are accepted.
+func (a *OIDCAuthenticator) Issuers() []controller.OIDCIssuer {
+	return a.config.Issuers
+}
+
+// Authenticate implements HTTPAuthenticator, accepting an ID token as
+// a bearer token in the Authorization header.
+func (a *OIDCAuthenticator) Authenticate(req *http.Request) (authentication.AuthInfo, error) {
+	scheme, tok, ok := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return authentication.AuthInfo{}, fmt.Errorf("oidc bearer token %w", errors.NotFound)
+	}
+	issuer, ok := a.issuerOf(tok)
+	if !ok {
+		return authentication.AuthInfo{}, fmt.Errorf("oidc bearer token %w", errors.NotFound)
+	}
+	return a.authenticate(req.Context(), issuer, tok)
+}
+
+// AuthenticateLoginRequest implements LoginAuthenticator. Tokens which
+// weren't issued by one of the trusted providers are left for the next
+// authenticator.
+func (a *OIDCAuthenticator) AuthenticateLoginRequest(
+	ctx context.Context,
+	_, _ string,
+	authParams authentication.AuthParams,
+) (authentication.AuthInfo, error) {
+	if authParams.Token == "" {
+		return authentication.AuthInfo{}, fmt.Errorf("auth token %w", errors.NotSupported)
+	}
+	issuer, ok := a.issuerOf(authParams.Token)
+	if !ok {
+		return authentication.AuthInfo{}, fmt.Errorf("oidc auth token %w", errors.NotSupported)
+	}
+	return a.authenticate(ctx, issuer, authParams.Token)
+}
+
+func (a *OIDCAuthenticator) authenticate(ctx context.Context, issuer controller.OIDCIssuer, tok string) (authentication.AuthInfo, error) {
+	token, entity, err := a.parse(ctx, issuer, tok)
+	if err != nil {
+		return authentication.AuthInfo{}, errors.Unauthorizedf("invalid OIDC ID token: %v", err)
+	}
+	groups, err := groupsFromToken(token, issuer.GroupsClaim)
+	if err != nil {
+		return authentication.AuthInfo{}, errors.Unauthorizedf("invalid OIDC ID token: %v", err)
+	}
+	return authentication.AuthInfo{
+		Entity: entity,
+		Delegator: &OIDCPermissionDelegator{
+			User:          entity.User,
+			Groups:        groups,
+			Grants:        issuer.Grants,
+			ControllerTag: a.config.ControllerTag,
+		},
+	}, nil
+}
+
+// Parse implements TokenParser. The token must have been issued by one
+// of the trusted providers.
+func (a *OIDCAuthenticator) Parse(ctx context.Context, tok string) (jwt.Token, authentication.Entity, error) {
+	issuer, ok := a.issuerOf(tok)
+	if !ok {
+		return nil, nil, errors.NotValidf("oidc token issuer")
+	}
+	token, entity, err := a.parse(ctx, issuer, tok)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return token, entity, nil
+}
+
+func (a *OIDCAuthenticator) parse(ctx context.Context, issuer controller.OIDCIssuer, tok string) (jwt.Token, TokenEntity, error) {
+	keySet, err := a.keySet(ctx, issuer.URL)
+	if err != nil {
+		return nil, TokenEntity{}, errors.Annotatef(err, "fetching keys for %q", issuer.URL)
+	}
+	token, err := jwt.Parse(
+		[]byte(tok),
+		jwt.WithKeySet(keySet),
+		jwt.WithIssuer(issuer.URL),
+		jwt.WithAudience(issuer.ClientID),
+		jwt.WithClock(jwt.ClockFunc(a.config.Clock.Now)),
+		jwt.WithAcceptableSkew(oidcClockSkew),
+	)
+	if err != nil {
+		return nil, TokenEntity{}, errors.Trace(err)
+	}
+	user, err := userFromOIDCToken(token, issuer)
+	if err != nil {
+		return nil, TokenEntity{}, errors.Trace(err)
+	}
+	return token, TokenEntity{User: user}, nil
+}
+
+// issuerOf returns the trusted issuer of the token, without checking
+// its signature.
+func (a *OIDCAuthenticator) issuerOf(tok string) (controller.OIDCIssuer, bool) {
+	token, err := jwt.ParseInsecure([]byte(tok))
+	if err != nil {
+		return controller.OIDCIssuer{}, false
+	}
+	for _, issuer := range a.config.Issuers {
+		if issuer.URL == token.Issuer() {
+			return issuer, true
+		}
+	}
+	return controller.OIDCIssuer{}, false
+}
+
+// keySet returns the signing keys of the issuer, finding where they're
+// published from its discovery document the first time.
+func (a *OIDCAuthenticator) keySet(ctx context.Context, issuerURL string) (jwk.Set, error) {
+	a.mu.Lock()
+	jwksURL, ok := a.jwksURLs[issuerURL]
+	if !ok {
+		var err error
+		if jwksURL, err = a.discover(ctx, issuerURL); err != nil {
+			a.mu.Unlock()
+			return nil, errors.Trace(err)
+		}
+		if err := a.cache.Register(jwksURL, jwk.WithHTTPClient(a.config.HTTPClient)); err != nil {
+			a.mu.Unlock()
+			return nil, errors.Annotatef(err, "registering jwk cache with url %q", jwksURL)
+		}
+		a.jwksURLs[issuerURL] = jwksURL
+	}
+	a.mu.Unlock()
+	return a.cache.Get(ctx, jwksURL)
+}
+
+// discover returns the signing key URL from the issuer's discovery
+// document.
+func (a *OIDCAuthenticator) discover(ctx context.Context, issuerURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, DiscoveryURL(issuerURL), nil)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	resp, err := a.config.HTTPClient.Do(req)
+	if err != nil {
+		return "", errors.Annotate(err, "fetching discovery document")
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("fetching discovery document: %s", resp.Status)
+	}
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", errors.Annotate(err, "decoding discovery document")
+	}
+	// The issuer in the document must match exactly, so a provider
+	// can't speak for another.
+	if doc.Issuer != issuerURL {
+		return "", errors.NotValidf("discovery document issuer %q", doc.Issuer)
+	}
+	if doc.JWKSURI == "" {
+		return "", errors.NotValidf("discovery document without jwks_uri")
+	}
+	return doc.JWKSURI, nil
+}
+
+// DiscoveryURL returns the URL of the OpenID Connect discovery document
+// for the issuer.
+func DiscoveryURL(issuerURL string) string {
+	return strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+}
+
+// userFromOIDCToken returns the user named by the issuer's username
+// claim, in the issuer's user domain. The claim is often chosen by the
+// user, so it never decides the domain; otherwise a token could name a
+// local user, or a user from another issuer. A domain in the claim,
+// such as that of an email address, is kept as part of the name.
+func userFromOIDCToken(token jwt.Token, issuer controller.OIDCIssuer) (names.UserTag, error) {
+	value, _ := token.Get(issuer.UsernameClaim)
+	name, _ := value.(string)
+	if name == "" {
+		return names.UserTag{}, errors.NotValidf("token without %q claim", issuer.UsernameClaim)
+	}
+	if issuer.UsernameClaim == "email" {
+		// Providers which let users set their email address mark
+		// whether it has been verified.
+		if verified, ok := token.Get("email_verified"); ok && verified != true {
+			return names.UserTag{}, errors.NotValidf("unverified email %q", name)
+		}
+	}
+	userName := strings.ReplaceAll(name, "@", "+") + "@" + issuer.UserDomain
+	if !names.IsValidUser(userName) {
+		return names.UserTag{}, errors.NotValidf("user name %q", name)
+	}
+	return names.NewUserTag(userName), nil
+}
+
+// groupsFromToken returns the groups in the claim, which may be a
+// list or a single group.
+func groupsFromToken(token jwt.Token, claim string) (set.Strings, error) {
+	groups := set.NewStrings()
+	value, ok := token.Get(claim)
+	if !ok {
+		return groups, nil
+	}
+	switch value := value.(type) {
+	case string:
+		groups.Add(value)
+	case []interface{}:
+		for _, v := range value {
+			group, ok := v.(string)
+			if !ok {
+				return nil, errors.NotValidf("%q claim %v", claim, value)
+			}
+			groups.Add(group)
+		}
+	default:
+		return nil, errors.NotValidf("%q claim %v", claim, value)
+	}
+	return groups, nil
+}
+
+// SubjectPermissions implements PermissionDelegator. The user has the
+// highest access granted on the subject to any of their groups.
+func (p *OIDCPermissionDelegator) SubjectPermissions(
+	e authentication.Entity,
+	subject names.Tag,
+) (permission.Access, error) {
+	if e.Tag().Id() == common.EveryoneTagName {
+		// Grants are made to groups from the token, never to the
+		// everyone@external group.
+		return permission.NoAccess, nil
+	}
+	// We need to make very sure that the entity the request pertains to
+	// is the same entity this function was seeded with.
+	if e.Tag().String() != p.User.String() {
+		err := fmt.Errorf(
+			"%w to use token permissions for one entity on another",
+			apiservererrors.ErrPerm,
+		)
+		return permission.NoAccess, errors.WithType(err, authentication.ErrorEntityMissingPermission)
+	}
+
+	var greater func(a, b permission.Access) bool
+	switch subject.Kind() {
+	case names.ControllerTagKind:
+		greater = permission.Access.GreaterControllerAccessThan
+	case names.ModelTagKind:
+		greater = permission.Access.GreaterModelAccessThan
+	case names.CloudTagKind:
+		greater = func(a, b permission.Access) bool {
+			return !b.EqualOrGreaterCloudAccessThan(a)
+		}
+	default:
+		return permission.NoAccess, nil
+	}
+
+	access := permission.NoAccess
+	for _, grant := range p.Grants {
+		if grant.Group != "" && !p.Groups.Contains(grant.Group) {
+			continue
+		}
+		if !p.grantApplies(grant, subject) {
+			continue
+		}
+		if greater(grant.Access, access) {
+			access = grant.Access
+		}
+	}
+	return access, nil
+}
+
+func (p *OIDCPermissionDelegator) grantApplies(grant controller.OIDCGrant, subject names.Tag) bool {
+	if grant.Target == controller.OIDCControllerTarget {
+		return subject.String() == p.ControllerTag.String()
+	}
+	return subject.String() == grant.Target
+}
+
+// PermissionError implements PermissionDelegator.
+func (p *OIDCPermissionDelegator) PermissionError(
+	subject names.Tag,
+	perm permission.Access,
+) error {
+	return &apiservererrors.AccessRequiredError{
+		RequiredAccess: map[names.Tag]permission.Access{
+			subject: perm,
+		},
+	}
+}
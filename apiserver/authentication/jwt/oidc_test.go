@@ -0,0 +1,240 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jwt_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/authentication/jwt"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/testing"
+)
+
+type oidcSuite struct {
+	issuer        *apiservertesting.OIDCIssuer
+	authenticator *jwt.OIDCAuthenticator
+	modelTag      names.ModelTag
+}
+
+var _ = gc.Suite(&oidcSuite{})
+
+func (s *oidcSuite) SetUpTest(c *gc.C) {
+	issuer, err := apiservertesting.NewOIDCIssuer("juju")
+	c.Assert(err, jc.ErrorIsNil)
+	s.issuer = issuer
+	s.modelTag = names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00d")
+
+	issuers, err := controller.ParseOIDCIssuers(`
+- url: ` + issuer.URL + `
+  client-id: juju
+  grants:
+  - target: controller
+    access: login
+  - group: juju-admins
+    target: controller
+    access: superuser
+  - group: prod-operators
+    target: ` + s.modelTag.String() + `
+    access: write
+  - group: prod-readers
+    target: ` + s.modelTag.String() + `
+    access: read
+`)
+	c.Assert(err, jc.ErrorIsNil)
+	s.authenticator = jwt.NewOIDCAuthenticator(context.Background(), jwt.OIDCConfig{
+		ControllerTag: testing.ControllerTag,
+		HTTPClient:    s.issuer.Client(),
+		Issuers:       issuers,
+		Clock:         clock.WallClock,
+	})
+}
+
+func (s *oidcSuite) TearDownTest(_ *gc.C) {
+	s.issuer.Close()
+}
+
+func (s *oidcSuite) login(c *gc.C, claims map[string]interface{}) (authentication.AuthInfo, error) {
+	token, err := s.issuer.IDToken(claims)
+	c.Assert(err, jc.ErrorIsNil)
+	return s.authenticator.AuthenticateLoginRequest(context.Background(), "", "", authentication.AuthParams{Token: token})
+}
+
+func (s *oidcSuite) TestLoginMapsClaims(c *gc.C) {
+	authInfo, err := s.login(c, map[string]interface{}{
+		"email":          "mary@example.com",
+		"email_verified": true,
+		"groups":         []string{"prod-operators", "prod-readers"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(authInfo.Entity.Tag(), gc.Equals, names.NewUserTag("mary+example.com@external"))
+
+	access, err := authInfo.SubjectPermissions(testing.ControllerTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.LoginAccess)
+
+	// The highest of the grants to mary's groups applies.
+	access, err = authInfo.SubjectPermissions(s.modelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.WriteAccess)
+
+	access, err = authInfo.SubjectPermissions(names.NewModelTag("cafebabe-0bad-400d-8000-4b1d0d06f00d"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.NoAccess)
+}
+
+func (s *oidcSuite) TestLoginSuperuserGroup(c *gc.C) {
+	authInfo, err := s.login(c, map[string]interface{}{
+		"email":  "bob@example.com",
+		"groups": "juju-admins",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	access, err := authInfo.SubjectPermissions(testing.ControllerTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.SuperuserAccess)
+}
+
+func (s *oidcSuite) TestLoginAddsUserDomain(c *gc.C) {
+	s.authenticator = jwt.NewOIDCAuthenticator(context.Background(), jwt.OIDCConfig{
+		ControllerTag: testing.ControllerTag,
+		HTTPClient:    s.issuer.Client(),
+		Issuers: []controller.OIDCIssuer{{
+			URL:           s.issuer.URL,
+			ClientID:      "juju",
+			UsernameClaim: "preferred_username",
+			GroupsClaim:   "groups",
+			UserDomain:    "sso",
+		}},
+	})
+	authInfo, err := s.login(c, map[string]interface{}{
+		"preferred_username": "mary",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(authInfo.Entity.Tag(), gc.Equals, names.NewUserTag("mary@sso"))
+}
+
+func (s *oidcSuite) TestLoginClaimCannotChooseDomain(c *gc.C) {
+	s.authenticator = jwt.NewOIDCAuthenticator(context.Background(), jwt.OIDCConfig{
+		ControllerTag: testing.ControllerTag,
+		HTTPClient:    s.issuer.Client(),
+		Issuers: []controller.OIDCIssuer{{
+			URL:           s.issuer.URL,
+			ClientID:      "juju",
+			UsernameClaim: "preferred_username",
+			GroupsClaim:   "groups",
+			UserDomain:    "sso",
+		}},
+	})
+	// Users can often choose their own preferred_username, so it must
+	// not let them log in as a local user or a user from elsewhere.
+	for claim, expect := range map[string]string{
+		"admin@local":  "admin+local@sso",
+		"bob@external": "bob+external@sso",
+		"bob@sso":      "bob+sso@sso",
+	} {
+		authInfo, err := s.login(c, map[string]interface{}{
+			"preferred_username": claim,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(authInfo.Entity.Tag(), gc.Equals, names.NewUserTag(expect))
+	}
+}
+
+func (s *oidcSuite) TestLoginRejectsInvalidTokens(c *gc.C) {
+	for _, test := range []struct {
+		about  string
+		claims map[string]interface{}
+		err    string
+	}{{
+		about:  "wrong audience",
+		claims: map[string]interface{}{"email": "mary@example.com", "aud": "other"},
+		err:    `invalid OIDC ID token: .*"aud" not satisfied`,
+	}, {
+		about:  "expired",
+		claims: map[string]interface{}{"email": "mary@example.com", "exp": time.Now().Add(-time.Hour)},
+		err:    `invalid OIDC ID token: .*"exp" not satisfied`,
+	}, {
+		about:  "no user name",
+		claims: map[string]interface{}{"sub": "1234"},
+		err:    `invalid OIDC ID token: token without "email" claim not valid`,
+	}, {
+		about:  "unverified email",
+		claims: map[string]interface{}{"email": "mary@example.com", "email_verified": false},
+		err:    `invalid OIDC ID token: unverified email "mary@example.com" not valid`,
+	}, {
+		about:  "invalid user name",
+		claims: map[string]interface{}{"email": "mary smith@example.com"},
+		err:    `invalid OIDC ID token: user name "mary smith@example.com" not valid`,
+	}, {
+		about:  "bad groups",
+		claims: map[string]interface{}{"email": "mary@example.com", "groups": 42},
+		err:    `invalid OIDC ID token: "groups" claim 42 not valid`,
+	}} {
+		c.Logf("test: %s", test.about)
+		_, err := s.login(c, test.claims)
+		c.Check(err, gc.ErrorMatches, test.err)
+		c.Check(err, jc.ErrorIs, errors.Unauthorized)
+	}
+}
+
+func (s *oidcSuite) TestLoginOtherIssuerNotSupported(c *gc.C) {
+	other, err := apiservertesting.NewOIDCIssuer("juju")
+	c.Assert(err, jc.ErrorIsNil)
+	defer other.Close()
+	token, err := other.IDToken(map[string]interface{}{"email": "mary@example.com"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.authenticator.AuthenticateLoginRequest(context.Background(), "", "", authentication.AuthParams{Token: token})
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+
+	_, err = s.authenticator.AuthenticateLoginRequest(context.Background(), "", "", authentication.AuthParams{})
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *oidcSuite) TestLoginForgedSignature(c *gc.C) {
+	// A token from another provider claiming to be from ours doesn't
+	// match our provider's keys.
+	forger, err := apiservertesting.NewOIDCIssuer("juju")
+	c.Assert(err, jc.ErrorIsNil)
+	defer forger.Close()
+	forger.URL = s.issuer.URL
+	token, err := forger.IDToken(map[string]interface{}{"email": "mary@example.com"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.authenticator.AuthenticateLoginRequest(context.Background(), "", "", authentication.AuthParams{Token: token})
+	c.Check(err, gc.ErrorMatches, `invalid OIDC ID token: .*`)
+}
+
+func (s *oidcSuite) TestAuthenticateBearerToken(c *gc.C) {
+	token, err := s.issuer.IDToken(map[string]interface{}{"email": "mary@example.com"})
+	c.Assert(err, jc.ErrorIsNil)
+	req, err := http.NewRequest(http.MethodGet, "/", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.authenticator.Authenticate(req)
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	authInfo, err := s.authenticator.Authenticate(req)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(authInfo.Entity.Tag(), gc.Equals, names.NewUserTag("mary+example.com@external"))
+}
+
+func (s *oidcSuite) TestPermissionsForOtherEntity(c *gc.C) {
+	authInfo, err := s.login(c, map[string]interface{}{"email": "mary@example.com"})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = authInfo.Delegator.SubjectPermissions(
+		jwt.TokenEntity{User: names.NewUserTag("bob+example.com@external")}, testing.ControllerTag)
+	c.Check(err, jc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
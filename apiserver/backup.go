@@ -4,11 +4,21 @@
 package apiserver
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/juju/errors"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/backups"
+	"github.com/juju/juju/rpc/params"
 )
 
 // backupHandler handles backup requests.
@@ -45,13 +55,87 @@ func (h *backupHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 			return
 		}
 
-		// TODO (manadart 2023-10-11): Implement when we have a solution for Dqlite.
-		h.sendError(resp, errors.Errorf("not backups in directory %q", modelConfig.BackupDir()))
+		backupDir := modelConfig.BackupDir()
+		if backupDir == "" {
+			backupDir = os.TempDir()
+		}
+		filename, err := h.download(backupDir, resp, req)
+		if err != nil {
+			h.sendError(resp, err)
+			return
+		}
+		logger.Infof("backups download request successful for %q", filename)
+
+		// The archive is only kept on the controller until it is downloaded.
+		if err := os.Remove(filename); err != nil {
+			logger.Warningf("removing downloaded backup %q: %v", filename, err)
+		}
 	default:
 		h.sendError(resp, errors.MethodNotAllowedf("unsupported method: %q", req.Method))
 	}
 }
 
+func (h *backupHandler) download(backupDir string, resp http.ResponseWriter, req *http.Request) (string, error) {
+	args, err := h.parseGETArgs(req)
+	if err != nil {
+		return "", err
+	}
+	logger.Infof("backups download request for %q", args.ID)
+
+	// Only archives created by the backups facade may be downloaded.
+	filename := filepath.Clean(args.ID)
+	if filepath.Dir(filename) != filepath.Clean(backupDir) ||
+		!strings.HasPrefix(filepath.Base(filename), backups.FilenamePrefix) {
+		return "", errors.NotFoundf("backup %q", args.ID)
+	}
+
+	archive, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return "", errors.NotFoundf("backup %q", args.ID)
+	} else if err != nil {
+		return "", errors.Trace(err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	// The digest is sent in a header before the archive, so the archive
+	// is read through once to hash it first.
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, archive); err != nil {
+		return "", errors.Annotate(err, "reading backup archive")
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return "", errors.Trace(err)
+	}
+	checksum := base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+
+	return filename, h.sendFile(archive, checksum, resp)
+}
+
+func (h *backupHandler) parseGETArgs(req *http.Request) (*params.BackupsDownloadArgs, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, errors.Annotate(err, "while reading request body")
+	}
+	var args params.BackupsDownloadArgs
+	if err := json.Unmarshal(body, &args); err != nil {
+		return nil, errors.Annotate(err, "while de-serializing args")
+	}
+	return &args, nil
+}
+
+// sendFile streams the file in the response. The checksum is the base64
+// encoded SHA-256 hash of the file, as the Digest header requires.
+func (h *backupHandler) sendFile(file io.Reader, checksum string, resp http.ResponseWriter) error {
+	// We don't set the Content-Length header, leaving it at -1.
+	resp.Header().Set("Content-Type", params.ContentTypeRaw)
+	resp.Header().Set("Digest", fmt.Sprintf("%s=%s", params.DigestSHA256, checksum))
+	resp.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(resp, file); err != nil {
+		return errors.Annotate(err, "while streaming archive")
+	}
+	return nil
+}
+
 // sendError sends a JSON-encoded error response.
 // Note the difference from the error response sent by
 // the sendError function - the error is encoded directly
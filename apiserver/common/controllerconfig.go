@@ -54,14 +54,16 @@ func NewControllerConfigAPI(
 	}
 }
 
-// ControllerConfig returns the controller's configuration.
+// ControllerConfig returns the controller's configuration. Attributes that
+// hold credentials are only used by the controller itself, so they are
+// never returned.
 func (s *ControllerConfigAPI) ControllerConfig(ctx context.Context) (params.ControllerConfigResult, error) {
 	result := params.ControllerConfigResult{}
 	config, err := s.controllerConfigService.ControllerConfig(ctx)
 	if err != nil {
 		return result, err
 	}
-	result.Config = params.ControllerConfig(config)
+	result.Config = params.ControllerConfig(config.Redacted())
 	return result, nil
 }
 
@@ -70,6 +70,29 @@ func (s *controllerConfigSuite) TestControllerConfigSuccess(c *gc.C) {
 	})
 }
 
+func (s *controllerConfigSuite) TestControllerConfigRedactsCredentials(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(
+		map[string]interface{}{
+			controller.ControllerUUIDKey:          testing.ControllerTag.Id(),
+			controller.ObjectStoreType:            "s3",
+			controller.ObjectStoreS3StaticKey:     "access-key",
+			controller.ObjectStoreS3StaticSecret:  "secret-key",
+			controller.ObjectStoreS3StaticSession: "session-token",
+		},
+		nil,
+	)
+
+	result, err := s.ctrlConfigAPI.ControllerConfig(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(map[string]interface{}(result.Config), jc.DeepEquals, map[string]interface{}{
+		"controller-uuid":            "deadbeef-1bad-500d-9000-4b1d0d06f00d",
+		"object-store-type":          "s3",
+		"object-store-s3-static-key": "access-key",
+	})
+}
+
 func (s *controllerConfigSuite) TestControllerConfigFetchError(c *gc.C) {
 	defer s.setup(c).Finish()
 
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"syscall"
 	"time"
@@ -78,6 +79,11 @@ func newDebugLogHandler(
 //	excludeEntity -> []string - lists entity tags to exclude from the response
 //	   - as with include, it may finish with a '*'
 //	excludeModule -> []string - lists logging modules to exclude from the response
+//	includeMessage -> []string - regular expressions matched against the message text
+//	   - a line is included if any of them match
+//	   - if none are set, then all lines are considered included
+//	excludeMessage -> []string - regular expressions matched against the message text
+//	   - a line is excluded if any of them match
 //	limit -> uint - show *at most* this many lines
 //	backlog -> uint
 //	   - go back this many lines from the end before starting to filter
@@ -86,6 +92,9 @@ func newDebugLogHandler(
 //	replay -> string - one of [true, false], if true, start the file from the start
 //	noTail -> string - one of [true, false], if true, existing logs are sent back,
 //	   - but the command does not wait for new ones.
+//	startTime -> string - RFC3339 time, only lines logged at or after this time are sent
+//	endTime -> string - RFC3339 time, only lines logged at or before this time are sent
+//	   - once this time has passed the stream is closed
 func (h *debugLogHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	handler := func(conn *websocket.Conn) {
 		socket := &debugLogSocketImpl{conn}
@@ -181,18 +190,21 @@ func (s *debugLogSocketImpl) sendLogRecord(record *params.LogMessage) error {
 
 // debugLogParams contains the parsed debuglog API request parameters.
 type debugLogParams struct {
-	startTime     time.Time
-	maxLines      uint
-	fromTheStart  bool
-	noTail        bool
-	backlog       uint
-	filterLevel   loggo.Level
-	includeEntity []string
-	excludeEntity []string
-	includeModule []string
-	excludeModule []string
-	includeLabel  []string
-	excludeLabel  []string
+	startTime      time.Time
+	endTime        time.Time
+	maxLines       uint
+	fromTheStart   bool
+	noTail         bool
+	backlog        uint
+	filterLevel    loggo.Level
+	includeEntity  []string
+	excludeEntity  []string
+	includeModule  []string
+	excludeModule  []string
+	includeLabel   []string
+	excludeLabel   []string
+	includeMessage []string
+	excludeMessage []string
 }
 
 func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
@@ -248,6 +260,18 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		params.startTime = startTime
 	}
 
+	if value := queryMap.Get("endTime"); value != "" {
+		endTime, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return params, errors.Errorf("end time %q is not a valid time in RFC3339 format", value)
+		}
+		if !params.startTime.IsZero() && endTime.Before(params.startTime) {
+			return params, errors.Errorf("end time %q is before start time %q",
+				value, params.startTime.Format(time.RFC3339Nano))
+		}
+		params.endTime = endTime
+	}
+
 	params.includeEntity = queryMap["includeEntity"]
 	params.excludeEntity = queryMap["excludeEntity"]
 	params.includeModule = queryMap["includeModule"]
@@ -260,5 +284,18 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		params.excludeLabel = label
 	}
 
+	// The message patterns are matched by the log tailer, but check them
+	// here so that a bad pattern is reported back to the user rather
+	// than failing the tailer.
+	for _, key := range []string{"includeMessage", "excludeMessage"} {
+		for _, pattern := range queryMap[key] {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return params, errors.Errorf("%s value %q is not a valid regular expression: %v", key, pattern, err)
+			}
+		}
+	}
+	params.includeMessage = queryMap["includeMessage"]
+	params.excludeMessage = queryMap["excludeMessage"]
+
 	return params, nil
 }
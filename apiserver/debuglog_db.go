@@ -33,6 +33,18 @@ func handleDebugLogDBRequest(
 	stop <-chan struct{},
 ) error {
 	tailerParams := makeLogTailerParams(reqParams)
+
+	// There is nothing to wait for if the requested window has already
+	// closed; otherwise stop streaming once it does.
+	var endOfWindow <-chan time.Time
+	if !reqParams.endTime.IsZero() {
+		if untilEnd := reqParams.endTime.Sub(clock.Now()); untilEnd > 0 {
+			endOfWindow = clock.After(untilEnd)
+		} else {
+			tailerParams.NoTail = true
+		}
+	}
+
 	tailer, err := newLogTailer(st, tailerParams)
 	if err != nil {
 		return errors.Trace(err)
@@ -51,6 +63,8 @@ func handleDebugLogDBRequest(
 			return nil
 		case <-timeout:
 			return nil
+		case <-endOfWindow:
+			return nil
 		case rec, ok := <-tailer.Logs():
 			if !ok {
 				return errors.Annotate(tailer.Err(), "tailer stopped")
@@ -70,16 +84,19 @@ func handleDebugLogDBRequest(
 
 func makeLogTailerParams(reqParams debugLogParams) corelogger.LogTailerParams {
 	tailerParams := corelogger.LogTailerParams{
-		MinLevel:      reqParams.filterLevel,
-		NoTail:        reqParams.noTail,
-		StartTime:     reqParams.startTime,
-		InitialLines:  int(reqParams.backlog),
-		IncludeEntity: reqParams.includeEntity,
-		ExcludeEntity: reqParams.excludeEntity,
-		IncludeModule: reqParams.includeModule,
-		ExcludeModule: reqParams.excludeModule,
-		IncludeLabel:  reqParams.includeLabel,
-		ExcludeLabel:  reqParams.excludeLabel,
+		MinLevel:       reqParams.filterLevel,
+		NoTail:         reqParams.noTail,
+		StartTime:      reqParams.startTime,
+		EndTime:        reqParams.endTime,
+		InitialLines:   int(reqParams.backlog),
+		IncludeEntity:  reqParams.includeEntity,
+		ExcludeEntity:  reqParams.excludeEntity,
+		IncludeModule:  reqParams.includeModule,
+		ExcludeModule:  reqParams.excludeModule,
+		IncludeLabel:   reqParams.includeLabel,
+		ExcludeLabel:   reqParams.excludeLabel,
+		IncludeMessage: reqParams.includeMessage,
+		ExcludeMessage: reqParams.excludeMessage,
 	}
 	if reqParams.fromTheStart {
 		tailerParams.InitialLines = 0
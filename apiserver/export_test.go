@@ -190,6 +190,29 @@ func TestingAnonymousRoot() rpc.Root {
 	return restrictRoot(r, anonymousFacadesOnly)
 }
 
+// TestingAPITokenRoot returns a restricted srvRoot as if logged in
+// with an API token.
+func TestingAPITokenRoot() rpc.Root {
+	r := TestingAPIRoot(AllFacades())
+	return restrictRoot(r, apiTokenMethodsOnly)
+}
+
+// TestingRoleRoot returns a root that dispatches the methods allowed
+// by roles to elevated and all other methods to root.
+func TestingRoleRoot(root, elevated rpc.Root, methods []string) rpc.Root {
+	return newRoleRoot(root, elevated, methods)
+}
+
+// NewRoleAuthorizerForTest returns an authorizer that grants the
+// authenticated user write access to the model, in addition to the access
+// granted by authorizer.
+func NewRoleAuthorizerForTest(authorizer facade.Authorizer, modelTag names.ModelTag) facade.Authorizer {
+	return roleAuthorizer{
+		Authorizer: authorizer,
+		modelTag:   modelTag,
+	}
+}
+
 // TestingControllerOnlyRoot returns a restricted srvRoot as if
 // logged in to the root of the API path.
 func TestingControllerOnlyRoot() rpc.Root {
@@ -4,6 +4,7 @@
 package facadetest
 
 import (
+	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/names/v4"
 
@@ -40,6 +41,7 @@ type Context struct {
 	CharmhubHTTPClient_ facade.HTTPClient
 	ServiceFactory_     servicefactory.ServiceFactory
 	ControllerDB_       changestream.WatchableDB
+	ModelDBs_           map[string]changestream.WatchableDB
 	ObjectStore_        objectstore.ObjectStore
 	Logger_             loggo.Logger
 
@@ -177,6 +179,15 @@ func (context Context) ControllerDB() (changestream.WatchableDB, error) {
 	return context.ControllerDB_, nil
 }
 
+// ModelDB implements facade.Context.
+func (context Context) ModelDB(modelUUID string) (changestream.WatchableDB, error) {
+	db, ok := context.ModelDBs_[modelUUID]
+	if !ok {
+		return nil, errors.NotFoundf("database for model %q", modelUUID)
+	}
+	return db, nil
+}
+
 // MachineTag returns the current machine tag.
 func (context Context) MachineTag() names.Tag {
 	return context.MachineTag_
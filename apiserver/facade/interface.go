@@ -68,6 +68,7 @@ type Context interface {
 	// types/objects.
 	LeadershipContext
 	ControllerDBGetter
+	ModelDBGetter
 	ServiceFactory
 	ObjectStoreFactory
 	Logger
@@ -131,7 +132,8 @@ type Context interface {
 	Presence() Presence
 
 	// Hub returns the central hub that the API server holds.
-	// At least at this stage, facades only need to publish events.
+	// Facades mostly publish events; subscriptions are only used to
+	// collect responses to requests the facade has published.
 	Hub() Hub
 
 	// ID returns a string that should almost always be "", unless
@@ -164,6 +166,15 @@ type ControllerDBGetter interface {
 	ControllerDB() (changestream.WatchableDB, error)
 }
 
+// ModelDBGetter defines an interface for getting model DBs.
+type ModelDBGetter interface {
+	// ModelDB returns a transaction runner for the database of the model
+	// with the input UUID.
+	// Deprecated: Use this for only controller backups otherwise use the
+	// service factory.
+	ModelDB(modelUUID string) (changestream.WatchableDB, error)
+}
+
 // ServiceFactory defines an interface for accessing all the services.
 type ServiceFactory interface {
 	// ServiceFactory returns the services factory for the current model.
@@ -254,6 +265,7 @@ type ModelPresence interface {
 // Hub represents the central hub that the API server has.
 type Hub interface {
 	Publish(topic string, data interface{}) (func(), error)
+	Subscribe(topic string, handler interface{}) (func(), error)
 }
 
 // HTTPClient represents an HTTP client, for example, an *http.Client.
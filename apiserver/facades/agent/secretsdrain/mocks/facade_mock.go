@@ -272,6 +272,21 @@ func (mr *MockContextMockRecorder) MachineTag() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MachineTag", reflect.TypeOf((*MockContext)(nil).MachineTag))
 }
 
+// ModelDB mocks base method.
+func (m *MockContext) ModelDB(arg0 string) (changestream.WatchableDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelDB", arg0)
+	ret0, _ := ret[0].(changestream.WatchableDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelDB indicates an expected call of ModelDB.
+func (mr *MockContextMockRecorder) ModelDB(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelDB", reflect.TypeOf((*MockContext)(nil).ModelDB), arg0)
+}
+
 // MultiwatcherFactory mocks base method.
 func (m *MockContext) MultiwatcherFactory() multiwatcher.Factory {
 	m.ctrl.T.Helper()
@@ -348,7 +348,23 @@ func (api *APIBase) Deploy(ctx context.Context, args params.ApplicationsDeploy)
 
 // ConfigSchema returns the config schema and defaults for an application.
 func ConfigSchema() (environschema.Fields, schema.Defaults, error) {
-	return trustFields, trustDefaults, nil
+	fields := make(environschema.Fields)
+	for name, field := range trustFields {
+		fields[name] = field
+	}
+	for name, field := range ingressFields {
+		fields[name] = field
+	}
+	for name, field := range networkPolicyFields {
+		fields[name] = field
+	}
+	for name, field := range availabilityFields {
+		fields[name] = field
+	}
+	for name, field := range autoscalingFields {
+		fields[name] = field
+	}
+	return fields, trustDefaults, nil
 }
 
 func splitApplicationAndCharmConfig(inConfig map[string]string) (
@@ -906,6 +922,9 @@ func (api *APIBase) setConfig(app Application, generation, settingsYAML string,
 		configChanged = true
 	}
 	if cfgAttrs := appConfig.Attributes(); len(cfgAttrs) > 0 {
+		if err := validateApplicationAutoscaling(app, cfgAttrs); err != nil {
+			return errors.Trace(err)
+		}
 		if err = app.UpdateApplicationConfig(cfgAttrs, nil, appConfigSchema, defaults); err != nil {
 			return errors.Annotate(err, "updating application config settings")
 		}
@@ -1165,6 +1184,12 @@ func (api *APIBase) applicationSetCharm(
 		return errors.New("cannot downgrade from v2 charm format to v1")
 	}
 
+	if attr := appConfig.Attributes(); len(attr) > 0 {
+		if err := validateApplicationAutoscaling(params.Application, attr); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	// TODO(wallyworld) - do in a single transaction
 	if err := params.Application.SetCharm(cfg, api.store); err != nil {
 		return errors.Annotate(err, "updating charm config")
@@ -1767,6 +1792,10 @@ func (api *APIBase) ScaleApplications(ctx context.Context, args params.ScaleAppl
 			}
 		}
 
+		if err := checkNotAutoscaled(app); err != nil {
+			return nil, errors.Trace(err)
+		}
+
 		var info params.ScaleApplicationInfo
 		if arg.ScaleChange != 0 {
 			newScale, err := app.ChangeScale(arg.ScaleChange)
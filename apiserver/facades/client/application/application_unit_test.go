@@ -298,6 +298,7 @@ func (s *ApplicationSuite) expectUpdateApplicationConfig(c *gc.C, app *mocks.Moc
 		"trust": "true",
 	}, appCfgSchema, nil)
 	c.Assert(err, jc.ErrorIsNil)
+	app.EXPECT().ApplicationConfig().Return(coreconfig.ConfigAttributes{}, nil)
 	app.EXPECT().UpdateApplicationConfig(appCfg.Attributes(), []string(nil), appCfgSchema, defaults).Return(nil)
 }
 
@@ -335,6 +336,7 @@ func (s *ApplicationSuite) TestSetCharmEverything(c *gc.C) {
 		CharmOrigin:    createStateCharmOriginFromURL(curl),
 		ConfigSettings: charm.Settings{"stringOption": "foo", "intOption": int64(666)},
 	}
+	app.EXPECT().ApplicationConfig().Return(coreconfig.ConfigAttributes{}, nil)
 	app.EXPECT().SetCharm(setCharmConfigMatcher{c: c, expected: cfg}, s.store)
 
 	schemaFields, defaults, err := application.ConfigSchema()
@@ -2075,6 +2077,7 @@ func (s *ApplicationSuite) TestScaleApplicationsCAASModel(c *gc.C) {
 	defer ctrl.Finish()
 
 	app := s.expectDefaultApplication(ctrl)
+	app.EXPECT().ApplicationConfig().Return(coreconfig.ConfigAttributes{}, nil)
 	app.EXPECT().SetScale(5, int64(0), true).Return(nil)
 	s.backend.EXPECT().Application("postgresql").Return(app, nil)
 
@@ -2166,6 +2169,7 @@ func (s *ApplicationSuite) TestScaleApplicationsCAASModelScaleChange(c *gc.C) {
 	defer ctrl.Finish()
 
 	app := s.expectDefaultApplication(ctrl)
+	app.EXPECT().ApplicationConfig().Return(coreconfig.ConfigAttributes{}, nil)
 	app.EXPECT().ChangeScale(5).Return(7, nil)
 	s.backend.EXPECT().Application("postgresql").Return(app, nil)
 
@@ -2183,6 +2187,27 @@ func (s *ApplicationSuite) TestScaleApplicationsCAASModelScaleChange(c *gc.C) {
 	})
 }
 
+func (s *ApplicationSuite) TestScaleApplicationsAutoscaled(c *gc.C) {
+	s.modelType = state.ModelTypeCAAS
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	app := s.expectDefaultApplication(ctrl)
+	app.EXPECT().ApplicationConfig().Return(coreconfig.ConfigAttributes{
+		"kubernetes-autoscale-max-units": 5,
+	}, nil)
+	s.backend.EXPECT().Application("postgresql").Return(app, nil)
+
+	results, err := s.api.ScaleApplications(context.Background(), params.ScaleApplicationsParams{
+		Applications: []params.ScaleApplicationParams{{
+			ApplicationTag: "application-postgresql",
+			Scale:          5,
+		}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `scaling autoscaled application "postgresql"; unset "kubernetes-autoscale-max-units" to scale it manually`)
+}
+
 func (s *ApplicationSuite) TestScaleApplicationsCAASModelScaleArgCheckScaleAndScaleChange(c *gc.C) {
 	s.modelType = state.ModelTypeCAAS
 	defer s.setup(c).Finish()
@@ -2654,6 +2679,28 @@ func (s *ApplicationSuite) TestSetConfigBranch(c *gc.C) {
 	c.Assert(result.OneError(), jc.ErrorIsNil)
 }
 
+func (s *ApplicationSuite) TestSetConfigAutoscalingNotValid(c *gc.C) {
+	s.modelType = state.ModelTypeCAAS
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	app := s.expectDefaultApplication(ctrl)
+	app.EXPECT().ApplicationConfig().Return(coreconfig.ConfigAttributes{
+		"kubernetes-autoscale-min-units": 3,
+	}, nil)
+	s.backend.EXPECT().Application("postgresql").Return(app, nil)
+
+	result, err := s.api.SetConfigs(context.Background(), params.ConfigSetArgs{
+		Args: []params.ConfigSet{{
+			ApplicationName: "postgresql",
+			Config: map[string]string{
+				"kubernetes-autoscale-max-units": "2",
+			},
+		}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.OneError(), gc.ErrorMatches, `autoscale max units 2 less than min units 3 not valid`)
+}
+
 func (s *ApplicationSuite) TestSetEmptyConfigMasterBranch(c *gc.C) {
 	s.modelType = state.ModelTypeCAAS
 	ctrl := s.setup(c)
@@ -0,0 +1,81 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/environschema.v1"
+
+	"github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/config"
+)
+
+var autoscalingFields = environschema.Fields{
+	application.AutoscaleMinUnitsConfigOptionName: {
+		Description: "The fewest units an autoscaled Kubernetes application is scaled down to",
+		Type:        environschema.Tint,
+		Group:       environschema.JujuGroup,
+	},
+	application.AutoscaleMaxUnitsConfigOptionName: {
+		Description: "The most units an autoscaled Kubernetes application is scaled up to; setting it enables autoscaling",
+		Type:        environschema.Tint,
+		Group:       environschema.JujuGroup,
+	},
+	application.AutoscaleCPUTargetConfigOptionName: {
+		Description: "The average CPU utilisation, as a percentage of requested CPU, targeted by the autoscaler",
+		Type:        environschema.Tint,
+		Group:       environschema.JujuGroup,
+	},
+	application.AutoscaleMemoryTargetConfigOptionName: {
+		Description: "The average memory utilisation, as a percentage of requested memory, targeted by the autoscaler",
+		Type:        environschema.Tint,
+		Group:       environschema.JujuGroup,
+	},
+}
+
+// validateAutoscaling returns an error if the application config resulting
+// from applying the changes to the current config holds an autoscaling
+// policy which is not valid.
+func validateAutoscaling(current, changes config.ConfigAttributes) error {
+	cfg := make(config.ConfigAttributes)
+	for k, v := range current {
+		cfg[k] = v
+	}
+	for k, v := range changes {
+		cfg[k] = v
+	}
+	policy := application.AutoscalingPolicyFromConfig(cfg.GetInt)
+	if policy == nil {
+		return nil
+	}
+	return errors.Trace(policy.Validate())
+}
+
+// validateApplicationAutoscaling returns an error if the config of the
+// application would hold an autoscaling policy which is not valid once
+// the changes are applied. Unsetting autoscaling config can't make a
+// policy invalid, as the defaults always are.
+func validateApplicationAutoscaling(app Application, changes config.ConfigAttributes) error {
+	current, err := app.ApplicationConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return validateAutoscaling(current, changes)
+}
+
+// checkNotAutoscaled returns an error if the application's scale is
+// managed by an autoscaler, and so can't be set directly.
+func checkNotAutoscaled(app Application) error {
+	cfg, err := app.ApplicationConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if application.AutoscalingPolicyFromConfig(cfg.GetInt) != nil {
+		return errors.Forbiddenf(
+			"scaling autoscaled application %q; unset %q to scale it manually",
+			app.Name(), application.AutoscaleMaxUnitsConfigOptionName,
+		)
+	}
+	return nil
+}
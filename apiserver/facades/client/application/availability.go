@@ -0,0 +1,18 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"gopkg.in/juju/environschema.v1"
+
+	"github.com/juju/juju/core/application"
+)
+
+var availabilityFields = environschema.Fields{
+	application.MinAvailableConfigOptionName: {
+		Description: "The number of units of a Kubernetes application which must remain available during node drains",
+		Type:        environschema.Tint,
+		Group:       environschema.JujuGroup,
+	},
+}
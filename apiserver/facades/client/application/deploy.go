@@ -103,6 +103,12 @@ func DeployApplication(
 		}
 	}
 
+	if args.ApplicationConfig != nil {
+		if err := validateAutoscaling(nil, args.ApplicationConfig.Attributes()); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
 	// TODO(fwereade): transactional State.AddApplication including settings, constraints
 	// (minimumUnitCount, initialMachineIds?).
 
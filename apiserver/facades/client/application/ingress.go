@@ -0,0 +1,33 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"gopkg.in/juju/environschema.v1"
+
+	"github.com/juju/juju/core/application"
+)
+
+var ingressFields = environschema.Fields{
+	application.IngressHostConfigOptionName: {
+		Description: "The host routed to the application by an ingress when it is exposed on Kubernetes",
+		Type:        environschema.Tstring,
+		Group:       environschema.JujuGroup,
+	},
+	application.IngressPathConfigOptionName: {
+		Description: "The HTTP path prefix routed to the application by its ingress, \"/\" if unset",
+		Type:        environschema.Tstring,
+		Group:       environschema.JujuGroup,
+	},
+	application.IngressTLSSecretConfigOptionName: {
+		Description: "The secret holding the certificate used to terminate TLS at the application's ingress",
+		Type:        environschema.Tstring,
+		Group:       environschema.JujuGroup,
+	},
+	application.IngressEndpointConfigOptionName: {
+		Description: "The endpoint whose opened port the application's ingress routes to",
+		Type:        environschema.Tstring,
+		Group:       environschema.JujuGroup,
+	},
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"gopkg.in/juju/environschema.v1"
+
+	"github.com/juju/juju/core/application"
+)
+
+var networkPolicyFields = environschema.Fields{
+	application.NetworkPolicyConfigOptionName: {
+		Description: "Whether a Kubernetes application's pods only accept traffic from related applications in the model and from the CIDRs it is exposed to",
+		Type:        environschema.Tbool,
+		Group:       environschema.JujuGroup,
+	},
+}
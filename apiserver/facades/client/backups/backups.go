@@ -12,6 +12,9 @@ import (
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/controller"
 	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/domain/model"
+	"github.com/juju/juju/environs/config"
 )
 
 // ControllerConfigService is an interface that provides the controller config.
@@ -20,9 +23,44 @@ type ControllerConfigService interface {
 	ControllerConfig(context.Context) (controller.Config, error)
 }
 
+// ModelService provides the models known to the controller.
+type ModelService interface {
+	// ModelList returns the UUIDs of all models with a database.
+	ModelList(context.Context) ([]model.UUID, error)
+}
+
+// DBGetter provides access to the controller and model databases.
+type DBGetter interface {
+	// ControllerDB returns a transaction runner for the controller database.
+	ControllerDB() (changestream.WatchableDB, error)
+
+	// ModelDB returns a transaction runner for the database of the model
+	// with the input UUID.
+	ModelDB(modelUUID string) (changestream.WatchableDB, error)
+}
+
+// Backend describes the controller model state required for backups.
+type Backend interface {
+	// ControllerModelUUID returns the UUID of the controller model.
+	ControllerModelUUID() string
+
+	// ModelConfig returns the configuration of the controller model.
+	ModelConfig(context.Context) (*config.Config, error)
+
+	// ControllerIds returns the IDs of the controller machines.
+	ControllerIds() ([]string, error)
+
+	// MachineInstanceId returns the cloud instance ID of the machine with
+	// the input ID.
+	MachineInstanceId(machineID string) (string, error)
+}
+
 // API provides backup-specific API methods.
 type API struct {
 	controllerConfigService ControllerConfigService
+	modelService            ModelService
+	dbGetter                DBGetter
+	backend                 Backend
 	paths                   *corebackups.Paths
 
 	// machineID is the ID of the machine where the API server is running.
@@ -32,6 +70,9 @@ type API struct {
 // NewAPI creates a new instance of the Backups API facade.
 func NewAPI(
 	controllerConfigService ControllerConfigService,
+	modelService ModelService,
+	dbGetter DBGetter,
+	backend Backend,
 	authorizer facade.Authorizer,
 	machineTag names.Tag,
 	dataDir, logDir string,
@@ -51,6 +92,9 @@ func NewAPI(
 
 	b := API{
 		controllerConfigService: controllerConfigService,
+		modelService:            modelService,
+		dbGetter:                dbGetter,
+		backend:                 backend,
 		paths:                   &paths,
 		machineID:               machineTag.Id(),
 	}
@@ -0,0 +1,141 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/domain/model"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	databasetesting "github.com/juju/juju/internal/database/testing"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type backupsSuite struct {
+	schematesting.ControllerModelSuite
+
+	controllerConfigService *MockControllerConfigService
+	modelService            *MockModelService
+	dbGetter                *MockDBGetter
+	backend                 *MockBackend
+	authorizer              *facademocks.MockAuthorizer
+
+	backupDir string
+}
+
+var _ = gc.Suite(&backupsSuite{})
+
+func (s *backupsSuite) SetUpTest(c *gc.C) {
+	s.ControllerModelSuite.SetUpTest(c)
+	s.backupDir = c.MkDir()
+}
+
+func (s *backupsSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.modelService = NewMockModelService(ctrl)
+	s.dbGetter = NewMockDBGetter(ctrl)
+	s.backend = NewMockBackend(ctrl)
+	s.authorizer = facademocks.NewMockAuthorizer(ctrl)
+
+	return ctrl
+}
+
+func (s *backupsSuite) newAPI(c *gc.C) *API {
+	s.authorizer.EXPECT().AuthClient().Return(true)
+
+	api, err := NewAPI(
+		s.controllerConfigService,
+		s.modelService,
+		s.dbGetter,
+		s.backend,
+		s.authorizer,
+		names.NewMachineTag("0"),
+		c.MkDir(),
+		c.MkDir(),
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	return api
+}
+
+func (s *backupsSuite) TestNewAPINotClient(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(false)
+
+	_, err := NewAPI(
+		s.controllerConfigService,
+		s.modelService,
+		s.dbGetter,
+		s.backend,
+		s.authorizer,
+		names.NewMachineTag("0"),
+		c.MkDir(),
+		c.MkDir(),
+	)
+	c.Assert(err, gc.Equals, apiservererrors.ErrPerm)
+}
+
+func (s *backupsSuite) TestCreate(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := model.UUID(coretesting.ModelTag.Id())
+	modelDB := databasetesting.ConstFactory(s.ModelTxnRunner(c, modelUUID.String()))
+	controllerDB := databasetesting.ConstFactory(s.ControllerTxnRunner())
+
+	s.backend.EXPECT().ModelConfig(gomock.Any()).Return(
+		coretesting.CustomModelConfig(c, coretesting.Attrs{"backup-dir": s.backupDir}), nil)
+	s.backend.EXPECT().ControllerModelUUID().Return(modelUUID.String())
+	s.backend.EXPECT().ControllerIds().Return([]string{"0", "1", "2"}, nil)
+	s.backend.EXPECT().MachineInstanceId("0").Return("inst-0", nil)
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(coretesting.FakeControllerConfig(), nil)
+	s.modelService.EXPECT().ModelList(gomock.Any()).Return([]model.UUID{modelUUID}, nil)
+	s.dbGetter.EXPECT().ControllerDB().DoAndReturn(controllerDB)
+	s.dbGetter.EXPECT().ModelDB(modelUUID.String()).DoAndReturn(func(string) (changestream.WatchableDB, error) {
+		return modelDB()
+	})
+
+	result, err := s.newAPI(c).Create(context.Background(), params.BackupsCreateArgs{Notes: "nightly"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(filepath.Dir(result.Filename), gc.Equals, s.backupDir)
+	c.Check(result.Notes, gc.Equals, "nightly")
+	c.Check(result.Model, gc.Equals, modelUUID.String())
+	c.Check(result.Machine, gc.Equals, "0")
+	c.Check(result.ControllerUUID, gc.Equals, coretesting.ControllerTag.Id())
+	c.Check(result.ControllerMachineInstanceID, gc.Equals, "inst-0")
+	c.Check(result.HANodes, gc.Equals, int64(3))
+	c.Check(result.Size, gc.Not(gc.Equals), int64(0))
+	c.Check(result.Checksum, gc.Not(gc.Equals), "")
+
+	archive, err := os.Open(result.Filename)
+	c.Assert(err, jc.ErrorIsNil)
+	defer archive.Close()
+
+	ws, err := corebackups.NewArchiveWorkspaceReader(archive)
+	c.Assert(err, jc.ErrorIsNil)
+	defer ws.Close()
+
+	controllerDump, err := os.ReadFile(filepath.Join(ws.DBDumpDir, corebackups.DBDumpFileName("controller")))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(strings.Contains(string(controllerDump), "CREATE TABLE model_list"), jc.IsTrue)
+
+	modelDump, err := os.ReadFile(filepath.Join(ws.DBDumpDir, corebackups.DBDumpFileName(modelUUID.String())))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(strings.Contains(string(modelDump), "CREATE TABLE model_config"), jc.IsTrue)
+}
@@ -5,15 +5,82 @@ package backups
 
 import (
 	"context"
+	"os"
 
 	"github.com/juju/errors"
 
+	corebackups "github.com/juju/juju/core/backups"
 	"github.com/juju/juju/rpc/params"
+	jujuversion "github.com/juju/juju/version"
 )
 
 // Create is the API method that requests juju to create a new backup
 // of its state.
-func (a *API) Create(context.Context, params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
+func (a *API) Create(ctx context.Context, args params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
 	result := params.BackupsMetadataResult{}
-	return result, errors.NotImplementedf("Dqlite-based backups")
+
+	modelConfig, err := a.backend.ModelConfig(ctx)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	paths := *a.paths
+	paths.BackupDir = modelConfig.BackupDir()
+
+	meta, err := a.newMetadata(ctx)
+	if err != nil {
+		return result, errors.Annotate(err, "creating backup metadata")
+	}
+	meta.Notes = args.Notes
+
+	files, err := corebackups.FilesToBackUp(paths, a.machineID)
+	if err != nil {
+		return result, errors.Annotate(err, "listing files to back up")
+	}
+
+	dumper := dbDumper{
+		modelService: a.modelService,
+		dbGetter:     a.dbGetter,
+	}
+	filename, err := corebackups.Create(ctx, meta, paths, dumper, files)
+	if err != nil {
+		return result, errors.Annotate(err, "creating backup")
+	}
+	return params.CreateResult(meta, filename), nil
+}
+
+func (a *API) newMetadata(ctx context.Context) (*corebackups.Metadata, error) {
+	controllerConfig, err := a.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	controllerIDs, err := a.backend.ControllerIds()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	instanceID, err := a.backend.MachineInstanceId(a.machineID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	meta := corebackups.NewMetadata()
+	meta.Origin = corebackups.Origin{
+		Model:    a.backend.ControllerModelUUID(),
+		Machine:  a.machineID,
+		Hostname: hostname,
+		Version:  jujuversion.Current,
+	}
+	meta.Controller = corebackups.ControllerMetadata{
+		UUID:              controllerConfig.ControllerUUID(),
+		MachineID:         a.machineID,
+		MachineInstanceID: instanceID,
+		HANodes:           int64(len(controllerIDs)),
+	}
+	return meta, nil
 }
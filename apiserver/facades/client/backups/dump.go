@@ -0,0 +1,63 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+
+	corebackups "github.com/juju/juju/core/backups"
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/internal/database"
+)
+
+// dbDumper writes snapshots of the controller database and the database of
+// every model known to the controller. Each database is captured within its
+// own transaction, so every snapshot is internally consistent.
+type dbDumper struct {
+	modelService ModelService
+	dbGetter     DBGetter
+}
+
+// DumpDatabases is part of the corebackups.DBDumper interface.
+func (d dbDumper) DumpDatabases(ctx context.Context, dumpDir string) error {
+	controllerDB, err := d.dbGetter.ControllerDB()
+	if err != nil {
+		return errors.Annotate(err, "getting controller database")
+	}
+	if err := dumpDB(ctx, controllerDB, dumpDir, coredatabase.ControllerNS); err != nil {
+		return errors.Annotate(err, "dumping controller database")
+	}
+
+	modelUUIDs, err := d.modelService.ModelList(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, modelUUID := range modelUUIDs {
+		namespace := modelUUID.String()
+		modelDB, err := d.dbGetter.ModelDB(namespace)
+		if err != nil {
+			return errors.Annotatef(err, "getting database for model %q", namespace)
+		}
+		if err := dumpDB(ctx, modelDB, dumpDir, namespace); err != nil {
+			return errors.Annotatef(err, "dumping database for model %q", namespace)
+		}
+	}
+	return nil
+}
+
+func dumpDB(ctx context.Context, runner coredatabase.TxnRunner, dumpDir, namespace string) error {
+	f, err := os.Create(filepath.Join(dumpDir, corebackups.DBDumpFileName(namespace)))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = database.Dump(ctx, runner, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return errors.Trace(err)
+}
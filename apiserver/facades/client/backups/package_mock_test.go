@@ -0,0 +1,227 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/backups (interfaces: ControllerConfigService,ModelService,DBGetter,Backend)
+
+// Package backups is a generated GoMock package.
+package backups
+
+import (
+	context "context"
+	reflect "reflect"
+
+	controller "github.com/juju/juju/controller"
+	changestream "github.com/juju/juju/core/changestream"
+	model "github.com/juju/juju/domain/model"
+	config "github.com/juju/juju/environs/config"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock *MockControllerConfigService
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig", arg0)
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).ControllerConfig), arg0)
+}
+
+// MockModelService is a mock of ModelService interface.
+type MockModelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelServiceMockRecorder
+}
+
+// MockModelServiceMockRecorder is the mock recorder for MockModelService.
+type MockModelServiceMockRecorder struct {
+	mock *MockModelService
+}
+
+// NewMockModelService creates a new mock instance.
+func NewMockModelService(ctrl *gomock.Controller) *MockModelService {
+	mock := &MockModelService{ctrl: ctrl}
+	mock.recorder = &MockModelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelService) EXPECT() *MockModelServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelList mocks base method.
+func (m *MockModelService) ModelList(arg0 context.Context) ([]model.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelList", arg0)
+	ret0, _ := ret[0].([]model.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelList indicates an expected call of ModelList.
+func (mr *MockModelServiceMockRecorder) ModelList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelList", reflect.TypeOf((*MockModelService)(nil).ModelList), arg0)
+}
+
+// MockDBGetter is a mock of DBGetter interface.
+type MockDBGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBGetterMockRecorder
+}
+
+// MockDBGetterMockRecorder is the mock recorder for MockDBGetter.
+type MockDBGetterMockRecorder struct {
+	mock *MockDBGetter
+}
+
+// NewMockDBGetter creates a new mock instance.
+func NewMockDBGetter(ctrl *gomock.Controller) *MockDBGetter {
+	mock := &MockDBGetter{ctrl: ctrl}
+	mock.recorder = &MockDBGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBGetter) EXPECT() *MockDBGetterMockRecorder {
+	return m.recorder
+}
+
+// ControllerDB mocks base method.
+func (m *MockDBGetter) ControllerDB() (changestream.WatchableDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerDB")
+	ret0, _ := ret[0].(changestream.WatchableDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerDB indicates an expected call of ControllerDB.
+func (mr *MockDBGetterMockRecorder) ControllerDB() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerDB", reflect.TypeOf((*MockDBGetter)(nil).ControllerDB))
+}
+
+// ModelDB mocks base method.
+func (m *MockDBGetter) ModelDB(arg0 string) (changestream.WatchableDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelDB", arg0)
+	ret0, _ := ret[0].(changestream.WatchableDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelDB indicates an expected call of ModelDB.
+func (mr *MockDBGetterMockRecorder) ModelDB(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelDB", reflect.TypeOf((*MockDBGetter)(nil).ModelDB), arg0)
+}
+
+// MockBackend is a mock of Backend interface.
+type MockBackend struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackendMockRecorder
+}
+
+// MockBackendMockRecorder is the mock recorder for MockBackend.
+type MockBackendMockRecorder struct {
+	mock *MockBackend
+}
+
+// NewMockBackend creates a new mock instance.
+func NewMockBackend(ctrl *gomock.Controller) *MockBackend {
+	mock := &MockBackend{ctrl: ctrl}
+	mock.recorder = &MockBackendMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
+	return m.recorder
+}
+
+// ControllerIds mocks base method.
+func (m *MockBackend) ControllerIds() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerIds")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerIds indicates an expected call of ControllerIds.
+func (mr *MockBackendMockRecorder) ControllerIds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerIds", reflect.TypeOf((*MockBackend)(nil).ControllerIds))
+}
+
+// ControllerModelUUID mocks base method.
+func (m *MockBackend) ControllerModelUUID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerModelUUID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ControllerModelUUID indicates an expected call of ControllerModelUUID.
+func (mr *MockBackendMockRecorder) ControllerModelUUID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerModelUUID", reflect.TypeOf((*MockBackend)(nil).ControllerModelUUID))
+}
+
+// MachineInstanceId mocks base method.
+func (m *MockBackend) MachineInstanceId(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MachineInstanceId", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MachineInstanceId indicates an expected call of MachineInstanceId.
+func (mr *MockBackendMockRecorder) MachineInstanceId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MachineInstanceId", reflect.TypeOf((*MockBackend)(nil).MachineInstanceId), arg0)
+}
+
+// ModelConfig mocks base method.
+func (m *MockBackend) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockBackendMockRecorder) ModelConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockBackend)(nil).ModelConfig), arg0)
+}
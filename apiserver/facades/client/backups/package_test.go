@@ -0,0 +1,16 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package backups -destination package_mock_test.go github.com/juju/juju/apiserver/facades/client/backups ControllerConfigService,ModelService,DBGetter,Backend
+
+func TestPackage(t *testing.T) {
+	gc.TestingT(t)
+}
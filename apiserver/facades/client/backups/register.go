@@ -6,6 +6,8 @@ package backups
 import (
 	"reflect"
 
+	"github.com/juju/errors"
+
 	"github.com/juju/juju/apiserver/facade"
 )
 
@@ -18,8 +20,21 @@ func Register(registry facade.FacadeRegistry) {
 
 // newFacade provides the required signature for facade registration.
 func newFacade(ctx facade.Context) (*API, error) {
+	st := ctx.State()
+	if !st.IsController() {
+		return nil, errors.New("backups are only supported from the controller model")
+	}
+	model, err := st.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	serviceFactory := ctx.ServiceFactory()
 	return NewAPI(
-		ctx.ServiceFactory().ControllerConfig(),
+		serviceFactory.ControllerConfig(),
+		serviceFactory.ModelManager(),
+		ctx,
+		stateShim{st: st, model: model},
 		ctx.Auth(),
 		ctx.MachineTag(),
 		ctx.DataDir(),
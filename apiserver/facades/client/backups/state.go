@@ -0,0 +1,49 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/state"
+)
+
+// stateShim adapts the controller model state to the Backend interface.
+type stateShim struct {
+	st    *state.State
+	model *state.Model
+}
+
+// ControllerModelUUID is part of the Backend interface.
+func (s stateShim) ControllerModelUUID() string {
+	return s.st.ControllerModelUUID()
+}
+
+// ModelConfig is part of the Backend interface.
+func (s stateShim) ModelConfig(ctx context.Context) (*config.Config, error) {
+	cfg, err := s.model.ModelConfig(ctx)
+	return cfg, errors.Trace(err)
+}
+
+// ControllerIds is part of the Backend interface.
+func (s stateShim) ControllerIds() ([]string, error) {
+	ids, err := s.st.ControllerIds()
+	return ids, errors.Trace(err)
+}
+
+// MachineInstanceId is part of the Backend interface.
+func (s stateShim) MachineInstanceId(machineID string) (string, error) {
+	m, err := s.st.Machine(machineID)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	instanceID, err := m.InstanceId()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(instanceID), nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/utils/v3"
+
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/internal/pubsub/apiserver"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	// defaultAuditRecordsLimit is used when no limit is requested.
+	defaultAuditRecordsLimit = 1000
+
+	// maxAuditRecordsLimit stops a single call returning an unbounded
+	// amount of data; larger queries should be split by time.
+	maxAuditRecordsLimit = 10000
+)
+
+// auditQueryTimeout is how long to wait for the controllers to respond
+// to an audit log query.
+var auditQueryTimeout = 30 * time.Second
+
+// AuditRecords returns the audit records matching the filter from the
+// audit logs on every controller machine, oldest first. Records from
+// different controllers are merged by time; each one carries the ID of
+// its conversation, which is unique across controllers.
+func (c *ControllerAPI) AuditRecords(ctx context.Context, args params.AuditRecordsArgs) (params.AuditRecordsResult, error) {
+	if err := c.checkIsSuperUser(); err != nil {
+		return params.AuditRecordsResult{}, errors.Trace(err)
+	}
+
+	filter := auditlog.Filter{
+		Who:            args.Who,
+		Model:          args.Model,
+		ConversationID: args.ConversationID,
+		Methods:        args.Methods,
+		Limit:          args.Limit,
+	}
+	if args.From != nil {
+		filter.From = *args.From
+	}
+	if args.To != nil {
+		filter.To = *args.To
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return params.AuditRecordsResult{}, errors.NotValidf("to before from")
+	}
+	switch {
+	case filter.Limit < 0:
+		return params.AuditRecordsResult{}, errors.NotValidf("negative limit")
+	case filter.Limit == 0:
+		filter.Limit = defaultAuditRecordsLimit
+	case filter.Limit > maxAuditRecordsLimit:
+		filter.Limit = maxAuditRecordsLimit
+	}
+
+	controllerIDs, err := c.state.ControllerIds()
+	if err != nil {
+		return params.AuditRecordsResult{}, errors.Trace(err)
+	}
+
+	responses, err := c.queryAuditLogs(ctx, filter, len(controllerIDs))
+	if err != nil {
+		return params.AuditRecordsResult{}, errors.Trace(err)
+	}
+
+	result := params.AuditRecordsResult{
+		Controllers: []string{},
+		Missing:     len(controllerIDs),
+	}
+	var records []originRecord
+	for _, response := range responses {
+		controllerID := controllerIDFromOrigin(response.Origin)
+		if response.Error != "" {
+			c.logger.Warningf("controller %q couldn't read its audit log: %s", controllerID, response.Error)
+			continue
+		}
+		result.Controllers = append(result.Controllers, controllerID)
+		result.Missing--
+		result.Truncated = result.Truncated || response.Truncated
+		for _, r := range response.Records {
+			when, _ := auditlog.RecordTime(r)
+			records = append(records, originRecord{
+				Record:       r,
+				controllerID: controllerID,
+				when:         when,
+			})
+		}
+	}
+
+	// Each controller's records are already in order, and a stable
+	// sort keeps them that way when they have the same time.
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].when.Before(records[j].when)
+	})
+	if len(records) > filter.Limit {
+		records = records[:filter.Limit]
+		result.Truncated = true
+	}
+	result.Records = make([]params.AuditRecord, len(records))
+	for i, r := range records {
+		result.Records[i] = toParamsAuditRecord(r.Record, r.controllerID)
+	}
+	return result, nil
+}
+
+// queryAuditLogs asks every API server for its matching audit records,
+// and waits for the expected number of responses or the timeout.
+func (c *ControllerAPI) queryAuditLogs(ctx context.Context, filter auditlog.Filter, expected int) ([]apiserver.AuditLogRecords, error) {
+	requestID := utils.MustNewUUID().String()
+	received := make(chan apiserver.AuditLogRecords, expected)
+	unsubscribe, err := c.hub.Subscribe(apiserver.AuditLogRecordsTopic, func(topic string, data apiserver.AuditLogRecords, err error) {
+		if err != nil {
+			c.logger.Errorf("programming error in %s message data: %v", topic, err)
+			return
+		}
+		if data.RequestID != requestID {
+			return
+		}
+		select {
+		case received <- data:
+		default:
+			// More responses than controllers; the controller
+			// set changed while we were asking.
+		}
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer unsubscribe()
+
+	if _, err := c.hub.Publish(apiserver.AuditLogQueryTopic, apiserver.AuditLogQuery{
+		RequestID: requestID,
+		Filter:    filter,
+	}); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	timeout := time.After(auditQueryTimeout)
+	var responses []apiserver.AuditLogRecords
+	for len(responses) < expected {
+		select {
+		case response := <-received:
+			responses = append(responses, response)
+		case <-timeout:
+			c.logger.Warningf("timed out waiting for audit records, %d of %d controllers responded", len(responses), expected)
+			return responses, nil
+		case <-ctx.Done():
+			return nil, errors.Trace(ctx.Err())
+		}
+	}
+	return responses, nil
+}
+
+// controllerIDFromOrigin returns the controller ID from the origin of a
+// hub message, which is the tag of the controller agent.
+func controllerIDFromOrigin(origin string) string {
+	tag, err := names.ParseTag(origin)
+	if err != nil {
+		return origin
+	}
+	return tag.Id()
+}
+
+// originRecord is an audit record and the controller it came from.
+type originRecord struct {
+	auditlog.Record
+	controllerID string
+	when         time.Time
+}
+
+func toParamsAuditRecord(r auditlog.Record, controllerID string) params.AuditRecord {
+	result := params.AuditRecord{
+		ControllerID:   controllerID,
+		ConversationID: r.ConversationID(),
+	}
+	switch {
+	case r.Conversation != nil:
+		result.Conversation = &params.AuditConversation{
+			Who:          r.Conversation.Who,
+			What:         r.Conversation.What,
+			When:         r.Conversation.When,
+			ModelName:    r.Conversation.ModelName,
+			ModelUUID:    r.Conversation.ModelUUID,
+			ConnectionID: r.Conversation.ConnectionID,
+		}
+	case r.Request != nil:
+		result.Request = &params.AuditRequest{
+			RequestID: r.Request.RequestID,
+			When:      r.Request.When,
+			Facade:    r.Request.Facade,
+			Method:    r.Request.Method,
+			Version:   r.Request.Version,
+			Args:      r.Request.Args,
+		}
+	case r.Errors != nil:
+		errs := make([]params.AuditError, 0, len(r.Errors.Errors))
+		for _, e := range r.Errors.Errors {
+			if e == nil {
+				continue
+			}
+			errs = append(errs, params.AuditError{Message: e.Message, Code: e.Code})
+		}
+		result.Errors = &params.AuditResponseErrors{
+			RequestID: r.Errors.RequestID,
+			When:      r.Errors.When,
+			Errors:    errs,
+		}
+	}
+	return result
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/names/v4"
+	"github.com/juju/pubsub/v2"
+	jtesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/internal/pubsub/apiserver"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type auditRecordsSuite struct {
+	jtesting.IsolationSuite
+
+	hub     *pubsub.StructuredHub
+	backend *fakeAuditBackend
+	api     *ControllerAPI
+}
+
+var _ = gc.Suite(&auditRecordsSuite{})
+
+func (s *auditRecordsSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.hub = pubsub.NewStructuredHub(nil)
+	s.backend = &fakeAuditBackend{ids: []string{"0", "1"}}
+	admin := names.NewUserTag("admin")
+	s.api = &ControllerAPI{
+		state:      s.backend,
+		authorizer: apiservertesting.FakeAuthorizer{Tag: admin, AdminTag: admin},
+		hub:        s.hub,
+		logger:     loggo.GetLogger("test"),
+	}
+}
+
+// respond answers audit log queries as the controller with the given
+// origin, returning the records which pass the filter's time bounds.
+func (s *auditRecordsSuite) respond(c *gc.C, origin string, truncated bool, records ...auditlog.Record) {
+	unsubscribe, err := s.hub.Subscribe(apiserver.AuditLogQueryTopic, func(topic string, query apiserver.AuditLogQuery, err error) {
+		c.Check(err, jc.ErrorIsNil)
+		var matched []auditlog.Record
+		for _, r := range records {
+			t, _ := auditlog.RecordTime(r)
+			if !query.Filter.From.IsZero() && t.Before(query.Filter.From) {
+				continue
+			}
+			matched = append(matched, r)
+		}
+		_, err = s.hub.Publish(apiserver.AuditLogRecordsTopic, apiserver.AuditLogRecords{
+			Origin:    origin,
+			RequestID: query.RequestID,
+			Records:   matched,
+			Truncated: truncated,
+		})
+		c.Check(err, jc.ErrorIsNil)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.AddCleanup(func(*gc.C) { unsubscribe() })
+}
+
+func (s *auditRecordsSuite) TestMergesControllers(c *gc.C) {
+	s.respond(c, "machine-0", false,
+		auditlog.Record{Conversation: &auditlog.Conversation{ConversationID: "aa", Who: "mary", When: "2026-10-13T10:00:00Z"}},
+		auditlog.Record{Request: &auditlog.Request{ConversationID: "aa", RequestID: 1, Facade: "Application", Method: "DestroyApplication", When: "2026-10-13T10:00:05Z"}},
+	)
+	s.respond(c, "machine-1", false,
+		auditlog.Record{Conversation: &auditlog.Conversation{ConversationID: "bb", Who: "bob", When: "2026-10-13T10:00:01Z"}},
+		auditlog.Record{Errors: &auditlog.ResponseErrors{ConversationID: "bb", RequestID: 3, When: "2026-10-13T10:00:07Z", Errors: []*auditlog.Error{{Message: "boom", Code: "oops"}}}},
+	)
+
+	result, err := s.api.AuditRecords(context.Background(), params.AuditRecordsArgs{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Controllers, jc.SameContents, []string{"0", "1"})
+	c.Check(result.Missing, gc.Equals, 0)
+	c.Check(result.Truncated, jc.IsFalse)
+	c.Check(result.Records, jc.DeepEquals, []params.AuditRecord{{
+		ControllerID:   "0",
+		ConversationID: "aa",
+		Conversation:   &params.AuditConversation{Who: "mary", When: "2026-10-13T10:00:00Z"},
+	}, {
+		ControllerID:   "1",
+		ConversationID: "bb",
+		Conversation:   &params.AuditConversation{Who: "bob", When: "2026-10-13T10:00:01Z"},
+	}, {
+		ControllerID:   "0",
+		ConversationID: "aa",
+		Request:        &params.AuditRequest{RequestID: 1, Facade: "Application", Method: "DestroyApplication", When: "2026-10-13T10:00:05Z"},
+	}, {
+		ControllerID:   "1",
+		ConversationID: "bb",
+		Errors: &params.AuditResponseErrors{
+			RequestID: 3,
+			When:      "2026-10-13T10:00:07Z",
+			Errors:    []params.AuditError{{Message: "boom", Code: "oops"}},
+		},
+	}})
+}
+
+func (s *auditRecordsSuite) TestPassesFilter(c *gc.C) {
+	s.backend.ids = []string{"0"}
+	s.respond(c, "machine-0", false,
+		auditlog.Record{Conversation: &auditlog.Conversation{ConversationID: "aa", When: "2026-10-13T10:00:00Z"}},
+		auditlog.Record{Conversation: &auditlog.Conversation{ConversationID: "bb", When: "2026-10-14T10:00:00Z"}},
+	)
+
+	from := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	result, err := s.api.AuditRecords(context.Background(), params.AuditRecordsArgs{From: &from})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Records, gc.HasLen, 1)
+	c.Check(result.Records[0].ConversationID, gc.Equals, "bb")
+}
+
+func (s *auditRecordsSuite) TestLimit(c *gc.C) {
+	s.respond(c, "machine-0", false,
+		auditlog.Record{Conversation: &auditlog.Conversation{ConversationID: "aa", When: "2026-10-13T10:00:00Z"}},
+	)
+	s.respond(c, "machine-1", true,
+		auditlog.Record{Conversation: &auditlog.Conversation{ConversationID: "bb", When: "2026-10-13T10:00:01Z"}},
+	)
+
+	result, err := s.api.AuditRecords(context.Background(), params.AuditRecordsArgs{Limit: 1})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Truncated, jc.IsTrue)
+	c.Assert(result.Records, gc.HasLen, 1)
+	c.Check(result.Records[0].ConversationID, gc.Equals, "aa")
+}
+
+func (s *auditRecordsSuite) TestMissingController(c *gc.C) {
+	s.PatchValue(&auditQueryTimeout, coretesting.ShortWait)
+	s.respond(c, "machine-0", false)
+
+	result, err := s.api.AuditRecords(context.Background(), params.AuditRecordsArgs{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Controllers, jc.DeepEquals, []string{"0"})
+	c.Check(result.Missing, gc.Equals, 1)
+}
+
+func (s *auditRecordsSuite) TestInvalidArgs(c *gc.C) {
+	from := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	_, err := s.api.AuditRecords(context.Background(), params.AuditRecordsArgs{From: &from, To: &to})
+	c.Check(err, gc.ErrorMatches, "to before from not valid")
+
+	_, err = s.api.AuditRecords(context.Background(), params.AuditRecordsArgs{Limit: -1})
+	c.Check(err, gc.ErrorMatches, "negative limit not valid")
+}
+
+func (s *auditRecordsSuite) TestNotSuperuser(c *gc.C) {
+	s.api.authorizer = apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("bob")}
+	_, err := s.api.AuditRecords(context.Background(), params.AuditRecordsArgs{})
+	c.Check(err, gc.ErrorMatches, "permission denied")
+}
+
+type fakeAuditBackend struct {
+	Backend
+	ids []string
+}
+
+func (b *fakeAuditBackend) ControllerTag() names.ControllerTag {
+	return coretesting.ControllerTag
+}
+
+func (b *fakeAuditBackend) ControllerIds() ([]string, error) {
+	if b.ids == nil {
+		return nil, errors.New("no controllers")
+	}
+	return b.ids, nil
+}
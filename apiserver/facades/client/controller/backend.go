@@ -38,6 +38,7 @@ type Backend interface {
 	RemoveAllBlocksForController() error
 	ModelExists(uuid string) (bool, error)
 	ControllerConfig() (jujucontroller.Config, error)
+	ControllerIds() ([]string, error)
 	UpdateControllerConfig(updateAttrs map[string]interface{}, removeAttrs []string) error
 }
 
@@ -114,6 +114,21 @@ func (mr *MockBackendMockRecorder) ControllerConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockBackend)(nil).ControllerConfig))
 }
 
+// ControllerIds mocks base method.
+func (m *MockBackend) ControllerIds() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerIds")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerIds indicates an expected call of ControllerIds.
+func (mr *MockBackendMockRecorder) ControllerIds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerIds", reflect.TypeOf((*MockBackend)(nil).ControllerIds))
+}
+
 // ControllerInfo mocks base method.
 func (m *MockBackend) ControllerInfo() (*state.ControllerInfo, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,148 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// TokenService manages the API tokens that users can log in with.
+type TokenService interface {
+	AddAPIToken(ctx context.Context, token user.APIToken) (string, error)
+	ListAPITokens(ctx context.Context, userName string) ([]user.APIToken, error)
+	RemoveAPIToken(ctx context.Context, userName, name string) error
+	RemoveUserAPITokens(ctx context.Context, userName string) error
+}
+
+// AddAPITokens mints API tokens for the logged in user, returning their
+// secrets. The secrets can't be retrieved later.
+func (api *UserManagerAPI) AddAPITokens(ctx context.Context, args params.AddAPITokens) (params.AddAPITokenResults, error) {
+	result := params.AddAPITokenResults{
+		Results: make([]params.AddAPITokenResult, len(args.Tokens)),
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Tokens {
+		secret, err := api.addAPIToken(ctx, arg)
+		result.Results[i] = params.AddAPITokenResult{
+			Token: secret,
+			Error: apiservererrors.ServerError(tokenError(err)),
+		}
+	}
+	return result, nil
+}
+
+func (api *UserManagerAPI) addAPIToken(ctx context.Context, arg params.AddAPIToken) (string, error) {
+	token := user.APIToken{
+		Name:      arg.Name,
+		UserName:  api.apiUser.Id(),
+		MaxAccess: permission.Access(arg.MaxAccess),
+		ExpiresAt: arg.Expires,
+	}
+	if arg.ModelTag != "" {
+		modelTag, err := names.ParseModelTag(arg.ModelTag)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		token.ModelUUID = modelTag.Id()
+	}
+	return api.tokens.AddAPIToken(ctx, token)
+}
+
+// APITokens returns information about the API tokens of the user with the
+// given tag, including those that have expired. If the tag is empty the
+// logged in user's tokens are returned. Only controller superusers can see
+// other users' tokens.
+func (api *UserManagerAPI) APITokens(ctx context.Context, arg params.Entity) (params.APITokensResult, error) {
+	result := params.APITokensResult{
+		Tokens: []params.APIToken{},
+	}
+	userName, err := api.tokenOwner(arg.Tag)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	tokens, err := api.tokens.ListAPITokens(ctx, userName)
+	if err != nil {
+		return result, errors.Trace(tokenError(err))
+	}
+	for _, token := range tokens {
+		info := params.APIToken{
+			Name:        token.Name,
+			MaxAccess:   string(token.MaxAccess),
+			DateCreated: token.CreatedAt,
+			Expires:     token.ExpiresAt,
+		}
+		if token.ModelUUID != "" {
+			info.ModelTag = names.NewModelTag(token.ModelUUID).String()
+		}
+		result.Tokens = append(result.Tokens, info)
+	}
+	return result, nil
+}
+
+// RemoveAPITokens removes the API tokens with the given names, so they can
+// no longer be used to log in. The tokens belong to the user with the given
+// tag, or to the logged in user if it is empty. Only controller superusers
+// can remove other users' tokens.
+func (api *UserManagerAPI) RemoveAPITokens(ctx context.Context, args params.APITokenNames) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	if err := api.check.RemoveAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	userName, err := api.tokenOwner(args.UserTag)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, name := range args.Names {
+		err := api.tokens.RemoveAPIToken(ctx, userName, name)
+		result.Results[i].Error = apiservererrors.ServerError(tokenError(err))
+	}
+	return result, nil
+}
+
+// tokenOwner returns the name of the user whose tokens are managed, given
+// the user tag passed by the client. Only controller superusers can manage
+// the tokens of users other than themselves.
+func (api *UserManagerAPI) tokenOwner(userTag string) (string, error) {
+	if userTag == "" {
+		return api.apiUser.Id(), nil
+	}
+	tag, err := names.ParseUserTag(userTag)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if tag.Id() != api.apiUser.Id() && !api.isAdmin {
+		return "", apiservererrors.ErrPerm
+	}
+	return tag.Id(), nil
+}
+
+// tokenError converts errors from the token service into errors that the
+// API client recognises.
+func tokenError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, usererrors.APITokenNotFound):
+		return errors.NewNotFound(err, "")
+	case errors.Is(err, usererrors.APITokenAlreadyExists):
+		return errors.NewAlreadyExists(err, "")
+	case errors.Is(err, usererrors.APITokenNameNotValid),
+		errors.Is(err, usererrors.UsernameNotValid):
+		return errors.NewNotValid(err, "")
+	}
+	return err
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/usermanager (interfaces: TokenService)
+
+// Package usermanager_test is a generated GoMock package.
+package usermanager_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/juju/juju/core/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTokenService is a mock of TokenService interface.
+type MockTokenService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenServiceMockRecorder
+}
+
+// MockTokenServiceMockRecorder is the mock recorder for MockTokenService.
+type MockTokenServiceMockRecorder struct {
+	mock *MockTokenService
+}
+
+// NewMockTokenService creates a new mock instance.
+func NewMockTokenService(ctrl *gomock.Controller) *MockTokenService {
+	mock := &MockTokenService{ctrl: ctrl}
+	mock.recorder = &MockTokenServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenService) EXPECT() *MockTokenServiceMockRecorder {
+	return m.recorder
+}
+
+// AddAPIToken mocks base method.
+func (m *MockTokenService) AddAPIToken(arg0 context.Context, arg1 user.APIToken) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAPIToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAPIToken indicates an expected call of AddAPIToken.
+func (mr *MockTokenServiceMockRecorder) AddAPIToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAPIToken", reflect.TypeOf((*MockTokenService)(nil).AddAPIToken), arg0, arg1)
+}
+
+// ListAPITokens mocks base method.
+func (m *MockTokenService) ListAPITokens(arg0 context.Context, arg1 string) ([]user.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokens", arg0, arg1)
+	ret0, _ := ret[0].([]user.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokens indicates an expected call of ListAPITokens.
+func (mr *MockTokenServiceMockRecorder) ListAPITokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokens", reflect.TypeOf((*MockTokenService)(nil).ListAPITokens), arg0, arg1)
+}
+
+// RemoveAPIToken mocks base method.
+func (m *MockTokenService) RemoveAPIToken(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAPIToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAPIToken indicates an expected call of RemoveAPIToken.
+func (mr *MockTokenServiceMockRecorder) RemoveAPIToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAPIToken", reflect.TypeOf((*MockTokenService)(nil).RemoveAPIToken), arg0, arg1, arg2)
+}
+
+// RemoveUserAPITokens mocks base method.
+func (m *MockTokenService) RemoveUserAPITokens(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserAPITokens", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserAPITokens indicates an expected call of RemoveUserAPITokens.
+func (mr *MockTokenServiceMockRecorder) RemoveUserAPITokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserAPITokens", reflect.TypeOf((*MockTokenService)(nil).RemoveUserAPITokens), arg0, arg1)
+}
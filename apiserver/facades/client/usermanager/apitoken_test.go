@@ -0,0 +1,191 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/usermanager"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type apiTokenSuite struct {
+	tokens *MockTokenService
+}
+
+var _ = gc.Suite(&apiTokenSuite{})
+
+func (s *apiTokenSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.tokens = NewMockTokenService(ctrl)
+	return ctrl
+}
+
+func (s *apiTokenSuite) api(check stubBlockChecker) *usermanager.UserManagerAPI {
+	authorizer := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("bob")}
+	return usermanager.NewAPITokenAPIForTest(s.tokens, check, authorizer, false)
+}
+
+func (s *apiTokenSuite) adminAPI() *usermanager.UserManagerAPI {
+	authorizer := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("admin")}
+	return usermanager.NewAPITokenAPIForTest(s.tokens, stubBlockChecker{}, authorizer, true)
+}
+
+func (s *apiTokenSuite) TestAddAPITokens(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expires := time.Date(2026, 11, 18, 12, 0, 0, 0, time.UTC)
+	s.tokens.EXPECT().AddAPIToken(gomock.Any(), user.APIToken{
+		Name:      "ci",
+		UserName:  "bob",
+		ModelUUID: modelTag.Id(),
+		MaxAccess: permission.WriteAccess,
+		ExpiresAt: expires,
+	}).Return("secret", nil)
+	s.tokens.EXPECT().AddAPIToken(gomock.Any(), user.APIToken{
+		Name:      "ci",
+		UserName:  "bob",
+		MaxAccess: permission.ReadAccess,
+		ExpiresAt: expires,
+	}).Return("", usererrors.APITokenAlreadyExists)
+
+	result, err := s.api(stubBlockChecker{}).AddAPITokens(context.Background(), params.AddAPITokens{
+		Tokens: []params.AddAPIToken{
+			{Name: "ci", ModelTag: modelTag.String(), MaxAccess: "write", Expires: expires},
+			{Name: "ci", MaxAccess: "read", Expires: expires},
+			{Name: "ci", ModelTag: "machine-0", MaxAccess: "read", Expires: expires},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0], jc.DeepEquals, params.AddAPITokenResult{Token: "secret"})
+	c.Check(result.Results[1].Error.Code, gc.Equals, params.CodeAlreadyExists)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, `"machine-0" is not a valid model tag`)
+}
+
+func (s *apiTokenSuite) TestAddAPITokensBlocked(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.api(stubBlockChecker{err: errors.New("blocked")}).AddAPITokens(context.Background(), params.AddAPITokens{
+		Tokens: []params.AddAPIToken{{Name: "ci", MaxAccess: "read"}},
+	})
+	c.Check(err, gc.ErrorMatches, "blocked")
+}
+
+func (s *apiTokenSuite) TestAPITokens(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	created := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s.tokens.EXPECT().ListAPITokens(gomock.Any(), "bob").Return([]user.APIToken{{
+		Name:      "ci",
+		UserName:  "bob",
+		MaxAccess: permission.SuperuserAccess,
+		CreatedAt: created,
+		ExpiresAt: created.Add(time.Hour),
+	}, {
+		Name:      "deploy",
+		UserName:  "bob",
+		ModelUUID: modelTag.Id(),
+		MaxAccess: permission.WriteAccess,
+		CreatedAt: created,
+		ExpiresAt: created.Add(time.Hour),
+	}}, nil)
+
+	result, err := s.api(stubBlockChecker{}).APITokens(context.Background(), params.Entity{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Tokens, jc.DeepEquals, []params.APIToken{{
+		Name:        "ci",
+		MaxAccess:   "superuser",
+		DateCreated: created,
+		Expires:     created.Add(time.Hour),
+	}, {
+		Name:        "deploy",
+		ModelTag:    modelTag.String(),
+		MaxAccess:   "write",
+		DateCreated: created,
+		Expires:     created.Add(time.Hour),
+	}})
+}
+
+func (s *apiTokenSuite) TestRemoveAPITokens(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.tokens.EXPECT().RemoveAPIToken(gomock.Any(), "bob", "ci").Return(nil)
+	s.tokens.EXPECT().RemoveAPIToken(gomock.Any(), "bob", "deploy").Return(usererrors.APITokenNotFound)
+
+	result, err := s.api(stubBlockChecker{}).RemoveAPITokens(context.Background(), params.APITokenNames{
+		Names: []string{"ci", "deploy"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error.Code, gc.Equals, params.CodeNotFound)
+}
+
+func (s *apiTokenSuite) TestAPITokensForUser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.tokens.EXPECT().ListAPITokens(gomock.Any(), "bob").Return([]user.APIToken{{
+		Name:      "ci",
+		UserName:  "bob",
+		MaxAccess: permission.ReadAccess,
+	}}, nil)
+
+	result, err := s.adminAPI().APITokens(context.Background(), params.Entity{
+		Tag: names.NewUserTag("bob").String(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Tokens, jc.DeepEquals, []params.APIToken{{Name: "ci", MaxAccess: "read"}})
+}
+
+func (s *apiTokenSuite) TestAPITokensForOtherUserNotAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.api(stubBlockChecker{}).APITokens(context.Background(), params.Entity{
+		Tag: names.NewUserTag("mary").String(),
+	})
+	c.Check(err, gc.ErrorMatches, "permission denied")
+
+	// Users can name themselves.
+	s.tokens.EXPECT().ListAPITokens(gomock.Any(), "bob").Return(nil, nil)
+	_, err = s.api(stubBlockChecker{}).APITokens(context.Background(), params.Entity{
+		Tag: names.NewUserTag("bob").String(),
+	})
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *apiTokenSuite) TestRemoveAPITokensForUser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.tokens.EXPECT().RemoveAPIToken(gomock.Any(), "bob", "ci").Return(nil)
+
+	result, err := s.adminAPI().RemoveAPITokens(context.Background(), params.APITokenNames{
+		UserTag: names.NewUserTag("bob").String(),
+		Names:   []string{"ci"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Check(result.Results[0].Error, gc.IsNil)
+}
+
+func (s *apiTokenSuite) TestRemoveAPITokensForOtherUserNotAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.api(stubBlockChecker{}).RemoveAPITokens(context.Background(), params.APITokenNames{
+		UserTag: names.NewUserTag("mary").String(),
+		Names:   []string{"ci"},
+	})
+	c.Check(err, gc.ErrorMatches, "permission denied")
+}
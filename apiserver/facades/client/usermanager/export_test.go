@@ -3,6 +3,71 @@
 
 package usermanager
 
+import (
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facade"
+)
+
 var (
 	NewUserManagerAPI = newUserManagerAPI
 )
+
+// NewGroupAPIForTest returns a UserManagerAPI that can only be used to
+// manage user groups.
+func NewGroupAPIForTest(
+	groups GroupService,
+	check common.BlockCheckerInterface,
+	authorizer facade.Authorizer,
+	controllerTag names.ControllerTag,
+	isAdmin bool,
+) *UserManagerAPI {
+	apiUser, _ := authorizer.GetAuthTag().(names.UserTag)
+	return &UserManagerAPI{
+		groups:        groups,
+		authorizer:    authorizer,
+		check:         check,
+		controllerTag: controllerTag,
+		apiUser:       apiUser,
+		isAdmin:       isAdmin,
+	}
+}
+
+// NewAPITokenAPIForTest returns a UserManagerAPI that can only be used to
+// manage API tokens.
+func NewAPITokenAPIForTest(
+	tokens TokenService,
+	check common.BlockCheckerInterface,
+	authorizer facade.Authorizer,
+	isAdmin bool,
+) *UserManagerAPI {
+	apiUser, _ := authorizer.GetAuthTag().(names.UserTag)
+	return &UserManagerAPI{
+		tokens:     tokens,
+		authorizer: authorizer,
+		check:      check,
+		apiUser:    apiUser,
+		isAdmin:    isAdmin,
+	}
+}
+
+// NewRoleAPIForTest returns a UserManagerAPI that can only be used to
+// manage roles.
+func NewRoleAPIForTest(
+	roles RoleService,
+	check common.BlockCheckerInterface,
+	authorizer facade.Authorizer,
+	controllerTag names.ControllerTag,
+	isAdmin bool,
+) *UserManagerAPI {
+	apiUser, _ := authorizer.GetAuthTag().(names.UserTag)
+	return &UserManagerAPI{
+		roles:         roles,
+		authorizer:    authorizer,
+		check:         check,
+		controllerTag: controllerTag,
+		apiUser:       apiUser,
+		isAdmin:       isAdmin,
+	}
+}
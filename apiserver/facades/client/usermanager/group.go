@@ -0,0 +1,241 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// GroupService manages user groups and the access granted to them.
+type GroupService interface {
+	AddGroup(ctx context.Context, name, creatorName string, members ...string) error
+	RemoveGroup(ctx context.Context, name string) error
+	GetGroup(ctx context.Context, name string) (user.Group, error)
+	ListGroups(ctx context.Context) ([]user.Group, error)
+	AddGroupMembers(ctx context.Context, name string, members ...string) error
+	RemoveGroupMembers(ctx context.Context, name string, members ...string) error
+	GrantGroupAccess(ctx context.Context, name string, target permission.ID, access permission.Access) error
+	RevokeGroupAccess(ctx context.Context, name string, target permission.ID, access permission.Access) error
+	RemoveUserMemberships(ctx context.Context, userName string) error
+}
+
+// AddGroups adds the user groups, with their initial members. Only
+// controller superusers can add groups.
+func (api *UserManagerAPI) AddGroups(ctx context.Context, args params.AddGroups) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Groups)),
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	if !api.isAdmin {
+		return result, apiservererrors.ErrPerm
+	}
+	for i, arg := range args.Groups {
+		err := api.groups.AddGroup(ctx, arg.Name, api.apiUser.Id(), arg.Members...)
+		result.Results[i].Error = apiservererrors.ServerError(groupError(err))
+	}
+	return result, nil
+}
+
+// RemoveGroups removes the user groups, along with all the access granted
+// to them. Only controller superusers can remove groups.
+func (api *UserManagerAPI) RemoveGroups(ctx context.Context, args params.GroupNames) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	if err := api.check.RemoveAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	if !api.isAdmin {
+		return result, apiservererrors.ErrPerm
+	}
+	for i, name := range args.Names {
+		err := api.groups.RemoveGroup(ctx, name)
+		result.Results[i].Error = apiservererrors.ServerError(groupError(err))
+	}
+	return result, nil
+}
+
+// ModifyGroupMembers adds users to, or removes users from, user groups. Only
+// controller superusers can change group membership.
+func (api *UserManagerAPI) ModifyGroupMembers(ctx context.Context, args params.ModifyGroupMembersRequest) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Changes)),
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	if !api.isAdmin {
+		return result, apiservererrors.ErrPerm
+	}
+	for i, arg := range args.Changes {
+		var err error
+		switch arg.Action {
+		case params.AddGroupMembers:
+			err = api.groups.AddGroupMembers(ctx, arg.Group, arg.Members...)
+		case params.RemoveGroupMembers:
+			err = api.groups.RemoveGroupMembers(ctx, arg.Group, arg.Members...)
+		default:
+			err = errors.NotValidf("group members action %q", arg.Action)
+		}
+		result.Results[i].Error = apiservererrors.ServerError(groupError(err))
+	}
+	return result, nil
+}
+
+// UserGroups returns information about the named user groups, or about all
+// groups if no names are given. Users who are not controller superusers
+// only see the groups they are members of.
+func (api *UserManagerAPI) UserGroups(ctx context.Context, args params.GroupNames) (params.UserGroupResults, error) {
+	var result params.UserGroupResults
+	if len(args.Names) == 0 {
+		groups, err := api.groups.ListGroups(ctx)
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		for _, group := range groups {
+			if api.canSeeGroup(group) {
+				result.Results = append(result.Results, params.UserGroupResult{Result: groupInfo(group)})
+			}
+		}
+		return result, nil
+	}
+
+	result.Results = make([]params.UserGroupResult, len(args.Names))
+	for i, name := range args.Names {
+		group, err := api.groups.GetGroup(ctx, name)
+		if err == nil && !api.canSeeGroup(group) {
+			err = apiservererrors.ErrPerm
+		}
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(groupError(err))
+			continue
+		}
+		result.Results[i].Result = groupInfo(group)
+	}
+	return result, nil
+}
+
+// ModifyGroupAccess grants access to, or revokes access from, user groups.
+// Controller superusers can change access to anything; model and cloud
+// admins can change access to their models and clouds.
+func (api *UserManagerAPI) ModifyGroupAccess(ctx context.Context, args params.ModifyGroupAccessRequest) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Changes)),
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Changes {
+		err := api.modifyGroupAccess(ctx, arg)
+		result.Results[i].Error = apiservererrors.ServerError(groupError(err))
+	}
+	return result, nil
+}
+
+func (api *UserManagerAPI) modifyGroupAccess(ctx context.Context, arg params.ModifyGroupAccess) error {
+	targetTag, err := names.ParseTag(arg.TargetTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	target, err := permission.ParseTagForID(targetTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if target.ObjectType == permission.Offer {
+		return errors.NotSupportedf("granting groups access to offers")
+	}
+	if err := api.checkCanModifyAccess(targetTag); err != nil {
+		return errors.Trace(err)
+	}
+
+	access := permission.Access(arg.Access)
+	switch arg.Action {
+	case params.GrantGroupAccess:
+		return api.groups.GrantGroupAccess(ctx, arg.Group, target, access)
+	case params.RevokeGroupAccess:
+		return api.groups.RevokeGroupAccess(ctx, arg.Group, target, access)
+	}
+	return errors.NotValidf("group access action %q", arg.Action)
+}
+
+// checkCanModifyAccess returns an error if the API user can't change the
+// access that groups have to the target.
+func (api *UserManagerAPI) checkCanModifyAccess(target names.Tag) error {
+	if api.isAdmin {
+		return nil
+	}
+	switch target := target.(type) {
+	case names.ModelTag:
+		isAdmin, err := common.HasModelAdmin(api.authorizer, api.controllerTag, target)
+		if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+			return errors.Trace(err)
+		}
+		if isAdmin {
+			return nil
+		}
+	case names.CloudTag:
+		err := api.authorizer.HasPermission(permission.AdminAccess, target)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+			return errors.Trace(err)
+		}
+	}
+	return apiservererrors.ErrPerm
+}
+
+// canSeeGroup reports whether the API user can see the group's details.
+func (api *UserManagerAPI) canSeeGroup(group user.Group) bool {
+	if api.isAdmin {
+		return true
+	}
+	for _, member := range group.Members {
+		if member == api.apiUser.Id() {
+			return true
+		}
+	}
+	return false
+}
+
+func groupInfo(group user.Group) *params.UserGroup {
+	return &params.UserGroup{
+		Name:        group.Name,
+		Members:     group.Members,
+		CreatedBy:   group.CreatorName,
+		DateCreated: group.CreatedAt,
+	}
+}
+
+// groupError converts errors from the group service into errors that the
+// API client recognises.
+func groupError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, usererrors.GroupNotFound),
+		errors.Is(err, usererrors.GroupAccessNotFound):
+		return errors.NewNotFound(err, "")
+	case errors.Is(err, usererrors.GroupAlreadyExists),
+		errors.Is(err, usererrors.GroupAccessAlreadyGranted):
+		return errors.NewAlreadyExists(err, "")
+	case errors.Is(err, usererrors.GroupNameNotValid),
+		errors.Is(err, usererrors.UsernameNotValid):
+		return errors.NewNotValid(err, "")
+	}
+	return err
+}
@@ -0,0 +1,180 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/usermanager (interfaces: GroupService)
+
+// Package usermanager_test is a generated GoMock package.
+package usermanager_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	permission "github.com/juju/juju/core/permission"
+	user "github.com/juju/juju/core/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGroupService is a mock of GroupService interface.
+type MockGroupService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupServiceMockRecorder
+}
+
+// MockGroupServiceMockRecorder is the mock recorder for MockGroupService.
+type MockGroupServiceMockRecorder struct {
+	mock *MockGroupService
+}
+
+// NewMockGroupService creates a new mock instance.
+func NewMockGroupService(ctrl *gomock.Controller) *MockGroupService {
+	mock := &MockGroupService{ctrl: ctrl}
+	mock.recorder = &MockGroupServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupService) EXPECT() *MockGroupServiceMockRecorder {
+	return m.recorder
+}
+
+// AddGroup mocks base method.
+func (m *MockGroupService) AddGroup(arg0 context.Context, arg1, arg2 string, arg3 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddGroup", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGroup indicates an expected call of AddGroup.
+func (mr *MockGroupServiceMockRecorder) AddGroup(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroup", reflect.TypeOf((*MockGroupService)(nil).AddGroup), varargs...)
+}
+
+// AddGroupMembers mocks base method.
+func (m *MockGroupService) AddGroupMembers(arg0 context.Context, arg1 string, arg2 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddGroupMembers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGroupMembers indicates an expected call of AddGroupMembers.
+func (mr *MockGroupServiceMockRecorder) AddGroupMembers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupMembers", reflect.TypeOf((*MockGroupService)(nil).AddGroupMembers), varargs...)
+}
+
+// GetGroup mocks base method.
+func (m *MockGroupService) GetGroup(arg0 context.Context, arg1 string) (user.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", arg0, arg1)
+	ret0, _ := ret[0].(user.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *MockGroupServiceMockRecorder) GetGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockGroupService)(nil).GetGroup), arg0, arg1)
+}
+
+// GrantGroupAccess mocks base method.
+func (m *MockGroupService) GrantGroupAccess(arg0 context.Context, arg1 string, arg2 permission.ID, arg3 permission.Access) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantGroupAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantGroupAccess indicates an expected call of GrantGroupAccess.
+func (mr *MockGroupServiceMockRecorder) GrantGroupAccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantGroupAccess", reflect.TypeOf((*MockGroupService)(nil).GrantGroupAccess), arg0, arg1, arg2, arg3)
+}
+
+// ListGroups mocks base method.
+func (m *MockGroupService) ListGroups(arg0 context.Context) ([]user.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", arg0)
+	ret0, _ := ret[0].([]user.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockGroupServiceMockRecorder) ListGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockGroupService)(nil).ListGroups), arg0)
+}
+
+// RemoveGroup mocks base method.
+func (m *MockGroupService) RemoveGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGroup indicates an expected call of RemoveGroup.
+func (mr *MockGroupServiceMockRecorder) RemoveGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroup", reflect.TypeOf((*MockGroupService)(nil).RemoveGroup), arg0, arg1)
+}
+
+// RemoveGroupMembers mocks base method.
+func (m *MockGroupService) RemoveGroupMembers(arg0 context.Context, arg1 string, arg2 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveGroupMembers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGroupMembers indicates an expected call of RemoveGroupMembers.
+func (mr *MockGroupServiceMockRecorder) RemoveGroupMembers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMembers", reflect.TypeOf((*MockGroupService)(nil).RemoveGroupMembers), varargs...)
+}
+
+// RemoveUserMemberships mocks base method.
+func (m *MockGroupService) RemoveUserMemberships(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserMemberships", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserMemberships indicates an expected call of RemoveUserMemberships.
+func (mr *MockGroupServiceMockRecorder) RemoveUserMemberships(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserMemberships", reflect.TypeOf((*MockGroupService)(nil).RemoveUserMemberships), arg0, arg1)
+}
+
+// RevokeGroupAccess mocks base method.
+func (m *MockGroupService) RevokeGroupAccess(arg0 context.Context, arg1 string, arg2 permission.ID, arg3 permission.Access) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeGroupAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeGroupAccess indicates an expected call of RevokeGroupAccess.
+func (mr *MockGroupServiceMockRecorder) RevokeGroupAccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeGroupAccess", reflect.TypeOf((*MockGroupService)(nil).RevokeGroupAccess), arg0, arg1, arg2, arg3)
+}
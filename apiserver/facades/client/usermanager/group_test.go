@@ -0,0 +1,231 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/usermanager"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type groupSuite struct {
+	groups *MockGroupService
+}
+
+var _ = gc.Suite(&groupSuite{})
+
+type stubBlockChecker struct {
+	err error
+}
+
+func (c stubBlockChecker) ChangeAllowed(context.Context) error  { return c.err }
+func (c stubBlockChecker) RemoveAllowed(context.Context) error  { return c.err }
+func (c stubBlockChecker) DestroyAllowed(context.Context) error { return c.err }
+
+var (
+	modelTag = coretesting.ModelTag
+	modelID  = permission.ID{ObjectType: permission.Model, Key: modelTag.Id()}
+)
+
+func (s *groupSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.groups = NewMockGroupService(ctrl)
+	return ctrl
+}
+
+func (s *groupSuite) api(userName string, isAdmin bool) *usermanager.UserManagerAPI {
+	authorizer := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag(userName)}
+	return usermanager.NewGroupAPIForTest(s.groups, stubBlockChecker{}, authorizer, coretesting.ControllerTag, isAdmin)
+}
+
+func (s *groupSuite) TestAddGroups(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.groups.EXPECT().AddGroup(gomock.Any(), "engineers", "admin", "bob", "mary@external").Return(nil)
+	s.groups.EXPECT().AddGroup(gomock.Any(), "operators", "admin").Return(usererrors.GroupAlreadyExists)
+
+	result, err := s.api("admin", true).AddGroups(context.Background(), params.AddGroups{
+		Groups: []params.AddGroup{
+			{Name: "engineers", Members: []string{"bob", "mary@external"}},
+			{Name: "operators"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, gc.ErrorMatches, "group already exists")
+	c.Check(result.Results[1].Error.Code, gc.Equals, params.CodeAlreadyExists)
+}
+
+func (s *groupSuite) TestAddGroupsNotAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.api("bob", false).AddGroups(context.Background(), params.AddGroups{
+		Groups: []params.AddGroup{{Name: "engineers"}},
+	})
+	c.Check(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *groupSuite) TestAddGroupsBlocked(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	api := usermanager.NewGroupAPIForTest(
+		s.groups, stubBlockChecker{err: errors.New("blocked")},
+		apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("admin")}, coretesting.ControllerTag, true)
+	_, err := api.AddGroups(context.Background(), params.AddGroups{
+		Groups: []params.AddGroup{{Name: "engineers"}},
+	})
+	c.Check(err, gc.ErrorMatches, "blocked")
+}
+
+func (s *groupSuite) TestRemoveGroups(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.groups.EXPECT().RemoveGroup(gomock.Any(), "engineers").Return(nil)
+	s.groups.EXPECT().RemoveGroup(gomock.Any(), "operators").Return(usererrors.GroupNotFound)
+
+	result, err := s.api("admin", true).RemoveGroups(context.Background(), params.GroupNames{
+		Names: []string{"engineers", "operators"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error.Code, gc.Equals, params.CodeNotFound)
+}
+
+func (s *groupSuite) TestModifyGroupMembers(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.groups.EXPECT().AddGroupMembers(gomock.Any(), "engineers", "bob", "jim").Return(nil)
+	s.groups.EXPECT().RemoveGroupMembers(gomock.Any(), "engineers", "mary@external").Return(nil)
+
+	result, err := s.api("admin", true).ModifyGroupMembers(context.Background(), params.ModifyGroupMembersRequest{
+		Changes: []params.ModifyGroupMembers{
+			{Group: "engineers", Action: params.AddGroupMembers, Members: []string{"bob", "jim"}},
+			{Group: "engineers", Action: params.RemoveGroupMembers, Members: []string{"mary@external"}},
+			{Group: "engineers", Action: "replace", Members: []string{"sam"}},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, gc.IsNil)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, `group members action "replace" not valid`)
+}
+
+func (s *groupSuite) TestUserGroups(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	created := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	groups := []user.Group{
+		{Name: "engineers", Members: []string{"bob"}, CreatorName: "admin", CreatedAt: created},
+		{Name: "operators", Members: []string{"jim"}, CreatorName: "admin", CreatedAt: created},
+	}
+	s.groups.EXPECT().ListGroups(gomock.Any()).Return(groups, nil).Times(2)
+
+	result, err := s.api("admin", true).UserGroups(context.Background(), params.GroupNames{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Results, jc.DeepEquals, []params.UserGroupResult{{
+		Result: &params.UserGroup{Name: "engineers", Members: []string{"bob"}, CreatedBy: "admin", DateCreated: created},
+	}, {
+		Result: &params.UserGroup{Name: "operators", Members: []string{"jim"}, CreatedBy: "admin", DateCreated: created},
+	}})
+
+	// Other users only see the groups they're members of.
+	result, err = s.api("bob", false).UserGroups(context.Background(), params.GroupNames{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Check(result.Results[0].Result.Name, gc.Equals, "engineers")
+}
+
+func (s *groupSuite) TestUserGroupsByName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.groups.EXPECT().GetGroup(gomock.Any(), "engineers").Return(user.Group{Name: "engineers", Members: []string{"bob"}}, nil)
+	s.groups.EXPECT().GetGroup(gomock.Any(), "operators").Return(user.Group{Name: "operators", Members: []string{"jim"}}, nil)
+	s.groups.EXPECT().GetGroup(gomock.Any(), "admins").Return(user.Group{}, usererrors.GroupNotFound)
+
+	result, err := s.api("bob", false).UserGroups(context.Background(), params.GroupNames{
+		Names: []string{"engineers", "operators", "admins"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0].Result.Name, gc.Equals, "engineers")
+	c.Check(result.Results[1].Error, gc.ErrorMatches, "permission denied")
+	c.Check(result.Results[2].Error.Code, gc.Equals, params.CodeNotFound)
+}
+
+func (s *groupSuite) TestModifyGroupAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	controllerID := permission.ID{ObjectType: permission.Controller, Key: coretesting.ControllerTag.Id()}
+	s.groups.EXPECT().GrantGroupAccess(gomock.Any(), "engineers", modelID, permission.WriteAccess).Return(nil)
+	s.groups.EXPECT().RevokeGroupAccess(gomock.Any(), "engineers", controllerID, permission.SuperuserAccess).Return(nil)
+
+	result, err := s.api("admin", true).ModifyGroupAccess(context.Background(), params.ModifyGroupAccessRequest{
+		Changes: []params.ModifyGroupAccess{{
+			Group: "engineers", Action: params.GrantGroupAccess, Access: "write", TargetTag: modelTag.String(),
+		}, {
+			Group: "engineers", Action: params.RevokeGroupAccess, Access: "superuser", TargetTag: coretesting.ControllerTag.String(),
+		}, {
+			Group: "engineers", Action: params.GrantGroupAccess, Access: "consume", TargetTag: "applicationoffer-hosted-mysql",
+		}, {
+			Group: "engineers", Action: params.GrantGroupAccess, Access: "read", TargetTag: "machine-0",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 4)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, gc.IsNil)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, "granting groups access to offers not supported")
+	c.Check(result.Results[3].Error, gc.ErrorMatches, `granting access on "machine" not valid`)
+}
+
+func (s *groupSuite) TestModifyGroupAccessModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.groups.EXPECT().GrantGroupAccess(gomock.Any(), "engineers", modelID, permission.ReadAccess).Return(nil)
+
+	// The user is an admin of the model but not of the controller.
+	result, err := s.api("admin-"+modelTag.String(), false).ModifyGroupAccess(context.Background(), params.ModifyGroupAccessRequest{
+		Changes: []params.ModifyGroupAccess{{
+			Group: "engineers", Action: params.GrantGroupAccess, Access: "read", TargetTag: modelTag.String(),
+		}, {
+			Group: "engineers", Action: params.GrantGroupAccess, Access: "login", TargetTag: coretesting.ControllerTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, gc.ErrorMatches, "permission denied")
+}
+
+func (s *groupSuite) TestModifyGroupAccessNotPermitted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	result, err := s.api("read-"+modelTag.String(), false).ModifyGroupAccess(context.Background(), params.ModifyGroupAccessRequest{
+		Changes: []params.ModifyGroupAccess{{
+			Group: "engineers", Action: params.GrantGroupAccess, Access: "read", TargetTag: modelTag.String(),
+		}, {
+			Group: "engineers", Action: params.GrantGroupAccess, Access: "add-model", TargetTag: "cloud-dummy",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.ErrorMatches, "permission denied")
+	c.Check(result.Results[1].Error, gc.ErrorMatches, "permission denied")
+}
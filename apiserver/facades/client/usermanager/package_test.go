@@ -9,6 +9,10 @@ import (
 	"github.com/juju/juju/testing"
 )
 
+//go:generate go run go.uber.org/mock/mockgen -package usermanager_test -destination group_mock_test.go github.com/juju/juju/apiserver/facades/client/usermanager GroupService
+//go:generate go run go.uber.org/mock/mockgen -package usermanager_test -destination apitoken_mock_test.go github.com/juju/juju/apiserver/facades/client/usermanager TokenService
+//go:generate go run go.uber.org/mock/mockgen -package usermanager_test -destination role_mock_test.go github.com/juju/juju/apiserver/facades/client/usermanager RoleService
+
 func TestAll(t *stdtesting.T) {
 	testing.MgoTestPackage(t)
 }
@@ -43,12 +43,16 @@ func newUserManagerAPI(ctx facade.Context) (*UserManagerAPI, error) {
 	isAdmin := err == nil
 
 	return &UserManagerAPI{
-		state:      st,
-		pool:       ctx.StatePool(),
-		authorizer: authorizer,
-		check:      common.NewBlockChecker(st),
-		apiUser:    apiUser,
-		isAdmin:    isAdmin,
-		logger:     ctx.Logger().Child("usermanager"),
+		state:         st,
+		pool:          ctx.StatePool(),
+		groups:        ctx.ServiceFactory().UserGroup(),
+		tokens:        ctx.ServiceFactory().APIToken(),
+		roles:         ctx.ServiceFactory().Role(),
+		authorizer:    authorizer,
+		check:         common.NewBlockChecker(st),
+		controllerTag: st.ControllerTag(),
+		apiUser:       apiUser,
+		isAdmin:       isAdmin,
+		logger:        ctx.Logger().Child("usermanager"),
 	}, nil
 }
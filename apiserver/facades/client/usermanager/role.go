@@ -0,0 +1,148 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// RoleService manages roles and their grants to users.
+type RoleService interface {
+	AddRole(ctx context.Context, name, creatorName string, methods ...string) error
+	RemoveRole(ctx context.Context, name string) error
+	ListRoles(ctx context.Context) ([]user.Role, error)
+	GrantRole(ctx context.Context, name, userName, modelUUID string) error
+	RevokeRole(ctx context.Context, name, userName, modelUUID string) error
+	RemoveUserRoleGrants(ctx context.Context, userName string) error
+}
+
+// AddRoles adds the roles. Only controller superusers can add roles.
+func (api *UserManagerAPI) AddRoles(ctx context.Context, args params.AddRoles) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Roles)),
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	if !api.isAdmin {
+		return result, apiservererrors.ErrPerm
+	}
+	for i, arg := range args.Roles {
+		err := api.roles.AddRole(ctx, arg.Name, api.apiUser.Id(), arg.Methods...)
+		result.Results[i].Error = apiservererrors.ServerError(roleError(err))
+	}
+	return result, nil
+}
+
+// RemoveRoles removes the roles, revoking them from every user they were
+// granted to. Only controller superusers can remove roles.
+func (api *UserManagerAPI) RemoveRoles(ctx context.Context, args params.RoleNames) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	if err := api.check.RemoveAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	if !api.isAdmin {
+		return result, apiservererrors.ErrPerm
+	}
+	for i, name := range args.Names {
+		err := api.roles.RemoveRole(ctx, name)
+		result.Results[i].Error = apiservererrors.ServerError(roleError(err))
+	}
+	return result, nil
+}
+
+// Roles returns information about all the roles. Any user can see the
+// roles, so that they know what the roles granted to them allow.
+func (api *UserManagerAPI) Roles(ctx context.Context) (params.RolesResult, error) {
+	result := params.RolesResult{Roles: []params.Role{}}
+	roles, err := api.roles.ListRoles(ctx)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for _, role := range roles {
+		result.Roles = append(result.Roles, params.Role{
+			Name:        role.Name,
+			Methods:     role.Methods,
+			CreatedBy:   role.CreatorName,
+			DateCreated: role.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+// ModifyRoleGrants grants roles to, or revokes roles from, users on
+// models. Controller superusers and model admins can change the roles
+// granted on a model.
+func (api *UserManagerAPI) ModifyRoleGrants(ctx context.Context, args params.ModifyRoleGrantsRequest) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Changes)),
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Changes {
+		err := api.modifyRoleGrant(ctx, arg)
+		result.Results[i].Error = apiservererrors.ServerError(roleError(err))
+	}
+	return result, nil
+}
+
+func (api *UserManagerAPI) modifyRoleGrant(ctx context.Context, arg params.ModifyRoleGrant) error {
+	userTag, err := names.ParseUserTag(arg.UserTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	modelTag, err := names.ParseModelTag(arg.ModelTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !api.isAdmin {
+		isAdmin, err := common.HasModelAdmin(api.authorizer, api.controllerTag, modelTag)
+		if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+			return errors.Trace(err)
+		}
+		if !isAdmin {
+			return apiservererrors.ErrPerm
+		}
+	}
+
+	switch arg.Action {
+	case params.GrantRole:
+		return api.roles.GrantRole(ctx, arg.Role, userTag.Id(), modelTag.Id())
+	case params.RevokeRole:
+		return api.roles.RevokeRole(ctx, arg.Role, userTag.Id(), modelTag.Id())
+	}
+	return errors.NotValidf("role grant action %q", arg.Action)
+}
+
+// roleError converts errors from the role service into errors that the API
+// client recognises.
+func roleError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, usererrors.RoleNotFound),
+		errors.Is(err, usererrors.RoleGrantNotFound):
+		return errors.NewNotFound(err, "")
+	case errors.Is(err, usererrors.RoleAlreadyExists):
+		return errors.NewAlreadyExists(err, "")
+	case errors.Is(err, usererrors.RoleNameNotValid),
+		errors.Is(err, usererrors.RoleMethodNotValid),
+		errors.Is(err, usererrors.UsernameNotValid):
+		return errors.NewNotValid(err, "")
+	}
+	return err
+}
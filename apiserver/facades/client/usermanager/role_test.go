@@ -0,0 +1,146 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usermanager_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/client/usermanager"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type roleSuite struct {
+	roles *MockRoleService
+}
+
+var _ = gc.Suite(&roleSuite{})
+
+func (s *roleSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.roles = NewMockRoleService(ctrl)
+	return ctrl
+}
+
+func (s *roleSuite) api(userName string, isAdmin bool) *usermanager.UserManagerAPI {
+	authorizer := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag(userName)}
+	return usermanager.NewRoleAPIForTest(s.roles, stubBlockChecker{}, authorizer, coretesting.ControllerTag, isAdmin)
+}
+
+func (s *roleSuite) TestAddRoles(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.roles.EXPECT().AddRole(gomock.Any(), "support", "admin", "Action.EnqueueOperation", "Client.FullStatus").Return(nil)
+	s.roles.EXPECT().AddRole(gomock.Any(), "write", "admin", "Action.*").Return(usererrors.RoleNameNotValid)
+
+	result, err := s.api("admin", true).AddRoles(context.Background(), params.AddRoles{
+		Roles: []params.AddRole{
+			{Name: "support", Methods: []string{"Action.EnqueueOperation", "Client.FullStatus"}},
+			{Name: "write", Methods: []string{"Action.*"}},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error.Code, gc.Equals, params.CodeNotValid)
+}
+
+func (s *roleSuite) TestAddRolesNotAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.api("bob", false).AddRoles(context.Background(), params.AddRoles{
+		Roles: []params.AddRole{{Name: "support", Methods: []string{"Action.*"}}},
+	})
+	c.Check(err, gc.ErrorMatches, "permission denied")
+
+	_, err = s.api("bob", false).RemoveRoles(context.Background(), params.RoleNames{Names: []string{"support"}})
+	c.Check(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *roleSuite) TestRemoveRoles(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.roles.EXPECT().RemoveRole(gomock.Any(), "support").Return(nil)
+	s.roles.EXPECT().RemoveRole(gomock.Any(), "auditor").Return(usererrors.RoleNotFound)
+
+	result, err := s.api("admin", true).RemoveRoles(context.Background(), params.RoleNames{
+		Names: []string{"support", "auditor"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error.Code, gc.Equals, params.CodeNotFound)
+}
+
+func (s *roleSuite) TestRoles(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	created := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s.roles.EXPECT().ListRoles(gomock.Any()).Return([]user.Role{{
+		Name: "support", Methods: []string{"Action.*"}, CreatorName: "admin", CreatedAt: created,
+	}}, nil)
+
+	result, err := s.api("bob", false).Roles(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Roles, jc.DeepEquals, []params.Role{{
+		Name: "support", Methods: []string{"Action.*"}, CreatedBy: "admin", DateCreated: created,
+	}})
+}
+
+func (s *roleSuite) TestModifyRoleGrants(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.roles.EXPECT().GrantRole(gomock.Any(), "support", "bob", modelTag.Id()).Return(nil)
+	s.roles.EXPECT().RevokeRole(gomock.Any(), "support", "mary@external", modelTag.Id()).Return(usererrors.RoleGrantNotFound)
+
+	result, err := s.api("admin", true).ModifyRoleGrants(context.Background(), params.ModifyRoleGrantsRequest{
+		Changes: []params.ModifyRoleGrant{{
+			Role: "support", Action: params.GrantRole, UserTag: "user-bob", ModelTag: modelTag.String(),
+		}, {
+			Role: "support", Action: params.RevokeRole, UserTag: "user-mary@external", ModelTag: modelTag.String(),
+		}, {
+			Role: "support", Action: "replace", UserTag: "user-bob", ModelTag: modelTag.String(),
+		}, {
+			Role: "support", Action: params.GrantRole, UserTag: "user-bob", ModelTag: coretesting.ControllerTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 4)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error.Code, gc.Equals, params.CodeNotFound)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, `role grant action "replace" not valid`)
+	c.Check(result.Results[3].Error, gc.ErrorMatches, `"controller-.*" is not a valid model tag`)
+}
+
+func (s *roleSuite) TestModifyRoleGrantsModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.roles.EXPECT().GrantRole(gomock.Any(), "support", "bob", modelTag.Id()).Return(nil)
+
+	// The user is an admin of the model but not of the controller.
+	result, err := s.api("admin-"+modelTag.String(), false).ModifyRoleGrants(context.Background(), params.ModifyRoleGrantsRequest{
+		Changes: []params.ModifyRoleGrant{{
+			Role: "support", Action: params.GrantRole, UserTag: "user-bob", ModelTag: modelTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Results[0].Error, gc.IsNil)
+
+	result, err = s.api("write-"+modelTag.String(), false).ModifyRoleGrants(context.Background(), params.ModifyRoleGrantsRequest{
+		Changes: []params.ModifyRoleGrant{{
+			Role: "support", Action: params.GrantRole, UserTag: "user-bob", ModelTag: modelTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Results[0].Error, gc.ErrorMatches, "permission denied")
+}
@@ -24,13 +24,17 @@ import (
 // UserManagerAPI implements the user manager interface and is the concrete
 // implementation of the api end point.
 type UserManagerAPI struct {
-	state      *state.State
-	pool       *state.StatePool
-	authorizer facade.Authorizer
-	check      *common.BlockChecker
-	apiUser    names.UserTag
-	isAdmin    bool
-	logger     loggo.Logger
+	state         *state.State
+	pool          *state.StatePool
+	groups        GroupService
+	tokens        TokenService
+	roles         RoleService
+	authorizer    facade.Authorizer
+	check         common.BlockCheckerInterface
+	controllerTag names.ControllerTag
+	apiUser       names.UserTag
+	isAdmin       bool
+	logger        loggo.Logger
 }
 
 func (api *UserManagerAPI) hasControllerAdminAccess() (bool, error) {
@@ -132,6 +136,25 @@ func (api *UserManagerAPI) RemoveUser(ctx context.Context, entities params.Entit
 			}
 			continue
 		}
+		// Group members are recorded by name, so a user added later with
+		// the same name would otherwise inherit the removed user's groups.
+		if err := api.groups.RemoveUserMemberships(ctx, user.Id()); err != nil {
+			deletions.Results[i].Error = apiservererrors.ServerError(
+				errors.Annotatef(err, "failed to remove user %q from groups", user.Name()))
+			continue
+		}
+		// Likewise tokens, which would otherwise let the new user log in
+		// with credentials issued to the removed one, and role grants.
+		if err := api.tokens.RemoveUserAPITokens(ctx, user.Id()); err != nil {
+			deletions.Results[i].Error = apiservererrors.ServerError(
+				errors.Annotatef(err, "failed to remove API tokens for user %q", user.Name()))
+			continue
+		}
+		if err := api.roles.RemoveUserRoleGrants(ctx, user.Id()); err != nil {
+			deletions.Results[i].Error = apiservererrors.ServerError(
+				errors.Annotatef(err, "failed to revoke roles from user %q", user.Name()))
+			continue
+		}
 		deletions.Results[i].Error = nil
 	}
 	return deletions, nil
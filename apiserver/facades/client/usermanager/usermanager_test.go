@@ -22,6 +22,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/usermanager"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
 	jujutesting "github.com/juju/juju/juju/testing"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
@@ -52,10 +53,11 @@ func (s *userManagerSuite) SetUpTest(c *gc.C) {
 	}
 	var err error
 	s.usermanager, err = usermanager.NewUserManagerAPI(facadetest.Context{
-		StatePool_: s.StatePool(),
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      s.authorizer,
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		StatePool_:      s.StatePool(),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           s.authorizer,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -67,9 +69,10 @@ func (s *userManagerSuite) TestNewUserManagerAPIRefusesNonClient(c *gc.C) {
 	anAuthoriser := s.authorizer
 	anAuthoriser.Tag = names.NewMachineTag("1")
 	endPoint, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      anAuthoriser,
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           anAuthoriser,
 	})
 	c.Assert(endPoint, gc.IsNil)
 	c.Assert(err, gc.ErrorMatches, "permission denied")
@@ -165,9 +168,10 @@ func (s *userManagerSuite) TestAddUserAsNormalUser(c *gc.C) {
 	alex := f.MakeUser(c, &factory.UserParams{Name: "alex", NoModelUser: true})
 	st := s.ControllerModel(c).State()
 	usermanager, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     st,
-		Resources_: s.resources,
-		Auth_:      apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          st,
+		Resources_:      s.resources,
+		Auth_:           apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -330,9 +334,10 @@ func (s *userManagerSuite) TestDisableUserAsNormalUser(c *gc.C) {
 	defer release()
 	alex := f.MakeUser(c, &factory.UserParams{Name: "alex", NoModelUser: true})
 	usermanager, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -354,9 +359,10 @@ func (s *userManagerSuite) TestEnableUserAsNormalUser(c *gc.C) {
 	defer release()
 	alex := f.MakeUser(c, &factory.UserParams{Name: "alex", NoModelUser: true})
 	usermanager, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -524,9 +530,10 @@ func (s *userManagerSuite) TestUserInfoNonControllerAdmin(c *gc.C) {
 		Tag: userAardvark.Tag(),
 	}
 	usermanager, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      authorizer,
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           authorizer,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -753,9 +760,10 @@ func (s *userManagerSuite) TestSetPasswordForSelf(c *gc.C) {
 	defer release()
 	alex := f.MakeUser(c, &factory.UserParams{Name: "alex", NoModelUser: true})
 	usermanager, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -781,9 +789,10 @@ func (s *userManagerSuite) TestSetPasswordForOther(c *gc.C) {
 	alex := f.MakeUser(c, &factory.UserParams{Name: "alex", NoModelUser: true})
 	barb := f.MakeUser(c, &factory.UserParams{Name: "barb", NoModelUser: true})
 	usermanager, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -862,6 +871,79 @@ func (s *userManagerSuite) TestRemoveUser(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *userManagerSuite) TestRemoveUserGroupMemberships(c *gc.C) {
+	f, release := s.NewFactory(c, s.ControllerModelUUID())
+	defer release()
+	jjam := f.MakeUser(c, &factory.UserParams{Name: "jimmyjam"})
+
+	groups := s.ControllerServiceFactory(c).UserGroup()
+	err := groups.AddGroup(context.Background(), "engineers", "admin", jjam.Name(), "bob")
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err := s.usermanager.RemoveUser(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: jjam.Tag().String()}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got.Results, gc.HasLen, 1)
+	c.Check(got.Results[0].Error, gc.IsNil)
+
+	group, err := groups.GetGroup(context.Background(), "engineers")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(group.Members, jc.DeepEquals, []string{"bob"})
+}
+
+func (s *userManagerSuite) TestRemoveUserAPITokens(c *gc.C) {
+	f, release := s.NewFactory(c, s.ControllerModelUUID())
+	defer release()
+	jjam := f.MakeUser(c, &factory.UserParams{Name: "jimmyjam"})
+
+	tokens := s.ControllerServiceFactory(c).APIToken()
+	_, err := tokens.AddAPIToken(context.Background(), user.APIToken{
+		Name:      "ci",
+		UserName:  jjam.Name(),
+		MaxAccess: permission.ReadAccess,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err := s.usermanager.RemoveUser(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: jjam.Tag().String()}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got.Results, gc.HasLen, 1)
+	c.Check(got.Results[0].Error, gc.IsNil)
+
+	// A user added again with the same name doesn't get the removed
+	// user's tokens.
+	f.MakeUser(c, &factory.UserParams{Name: "jimmyjam"})
+	list, err := tokens.ListAPITokens(context.Background(), jjam.Name())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(list, gc.HasLen, 0)
+}
+
+func (s *userManagerSuite) TestRemoveUserRoleGrants(c *gc.C) {
+	f, release := s.NewFactory(c, s.ControllerModelUUID())
+	defer release()
+	jjam := f.MakeUser(c, &factory.UserParams{Name: "jimmyjam"})
+
+	roles := s.ControllerServiceFactory(c).Role()
+	err := roles.AddRole(context.Background(), "support", "admin", "Client.FullStatus")
+	c.Assert(err, jc.ErrorIsNil)
+	err = roles.GrantRole(context.Background(), "support", jjam.Name(), s.ControllerModelUUID())
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err := s.usermanager.RemoveUser(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: jjam.Tag().String()}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got.Results, gc.HasLen, 1)
+	c.Check(got.Results[0].Error, gc.IsNil)
+
+	// A user added again with the same name doesn't get the removed
+	// user's roles.
+	f.MakeUser(c, &factory.UserParams{Name: "jimmyjam"})
+	methods, err := roles.UserRoleMethods(context.Background(), jjam.Name(), s.ControllerModelUUID())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(methods, gc.HasLen, 0)
+}
+
 func (s *userManagerSuite) TestRemoveUserAsNormalUser(c *gc.C) {
 	f, release := s.NewFactory(c, s.ControllerModelUUID())
 	defer release()
@@ -875,9 +957,10 @@ func (s *userManagerSuite) TestRemoveUserAsNormalUser(c *gc.C) {
 
 	// Authenticate as chuck.
 	usermanager, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      apiservertesting.FakeAuthorizer{Tag: chuck.Tag()},
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           apiservertesting.FakeAuthorizer{Tag: chuck.Tag()},
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -908,9 +991,10 @@ func (s *userManagerSuite) TestRemoveUserSelfAsNormalUser(c *gc.C) {
 		NoModelUser: true,
 	})
 	usermanager, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      apiservertesting.FakeAuthorizer{Tag: jjam.Tag()},
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           apiservertesting.FakeAuthorizer{Tag: jjam.Tag()},
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -1102,9 +1186,10 @@ func (s *userManagerSuite) TestResetPasswordNotControllerAdmin(c *gc.C) {
 	barb := f.MakeUser(c, &factory.UserParams{Name: "barb", NoModelUser: true})
 	c.Assert(barb.PasswordValid("password"), jc.IsTrue)
 	usermanager, err := usermanager.NewUserManagerAPI(facadetest.Context{
-		State_:     s.ControllerModel(c).State(),
-		Resources_: s.resources,
-		Auth_:      apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
+		ServiceFactory_: s.ControllerServiceFactory(c),
+		State_:          s.ControllerModel(c).State(),
+		Resources_:      s.resources,
+		Auth_:           apiservertesting.FakeAuthorizer{Tag: alex.Tag()},
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
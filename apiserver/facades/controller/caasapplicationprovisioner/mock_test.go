@@ -233,6 +233,7 @@ type mockApplication struct {
 	unitsWatcher         *statetesting.MockStringsWatcher
 	unitsChanges         chan []string
 	watcher              *statetesting.MockNotifyWatcher
+	configWatcher        *statetesting.MockNotifyWatcher
 	charmPending         bool
 	provisioningState    *state.ApplicationProvisioningState
 }
@@ -366,6 +367,11 @@ func (a *mockApplication) Watch() state.NotifyWatcher {
 	return a.watcher
 }
 
+func (a *mockApplication) WatchApplicationConfig() state.NotifyWatcher {
+	a.MethodCall(a, "WatchApplicationConfig")
+	return a.configWatcher
+}
+
 func (a *mockApplication) SetProvisioningState(ps state.ApplicationProvisioningState) error {
 	a.MethodCall(a, "SetProvisioningState", ps)
 	err := a.NextErr()
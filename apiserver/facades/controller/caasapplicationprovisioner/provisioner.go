@@ -246,11 +246,12 @@ func (a *API) watchProvisioningInfo(appName names.ApplicationTag) (params.Notify
 	}
 
 	appWatcher := app.Watch()
+	appConfigWatcher := app.WatchApplicationConfig()
 	controllerConfigWatcher := a.ctrlSt.WatchControllerConfig()
 	controllerAPIHostPortsWatcher := a.ctrlSt.WatchAPIHostPortsForAgents()
 	modelConfigWatcher := model.WatchForModelConfigChanges()
 
-	multiWatcher := common.NewMultiNotifyWatcher(appWatcher, controllerConfigWatcher, controllerAPIHostPortsWatcher, modelConfigWatcher)
+	multiWatcher := common.NewMultiNotifyWatcher(appWatcher, appConfigWatcher, controllerConfigWatcher, controllerAPIHostPortsWatcher, modelConfigWatcher)
 
 	if _, ok := <-multiWatcher.Changes(); ok {
 		result.NotifyWatcherId = a.resources.Register(multiWatcher)
@@ -366,6 +367,15 @@ func (a *API) provisioningInfo(ctx context.Context, appName names.ApplicationTag
 	if err != nil {
 		return nil, errors.Annotatef(err, "parsing %s", controller.CAASImageRepo)
 	}
+	var autoscaling *params.CAASApplicationAutoscaling
+	if policy := coreapplication.AutoscalingPolicyFromConfig(appConfig.GetInt); policy != nil {
+		autoscaling = &params.CAASApplicationAutoscaling{
+			MinUnits:     policy.MinUnits,
+			MaxUnits:     policy.MaxUnits,
+			CPUTarget:    policy.CPUTarget,
+			MemoryTarget: policy.MemoryTarget,
+		}
+	}
 	return &params.CAASApplicationProvisioningInfo{
 		Version:              vers,
 		APIAddresses:         addrs,
@@ -379,6 +389,8 @@ func (a *API) provisioningInfo(ctx context.Context, appName names.ApplicationTag
 		CharmModifiedVersion: app.CharmModifiedVersion(),
 		CharmURL:             *charmURL,
 		Trust:                appConfig.GetBool(coreapplication.TrustConfigOptionName, false),
+		MinAvailable:         appConfig.GetInt(coreapplication.MinAvailableConfigOptionName, 0),
+		Autoscaling:          autoscaling,
 		Scale:                app.GetScale(),
 	}, nil
 }
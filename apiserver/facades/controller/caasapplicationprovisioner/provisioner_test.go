@@ -98,7 +98,9 @@ func (s *CAASApplicationProvisionerSuite) TestProvisioningInfo(c *gc.C) {
 		charmModifiedVersion: 10,
 		scale:                3,
 		config: config.ConfigAttributes{
-			"trust": true,
+			"trust":                          true,
+			"kubernetes-min-available":       2,
+			"kubernetes-autoscale-max-units": 5,
 		},
 	}
 	result, err := s.api.ProvisioningInfo(context.Background(), params.Entities{Entities: []params.Entity{{"application-gitlab"}}})
@@ -119,6 +121,12 @@ func (s *CAASApplicationProvisionerSuite) TestProvisioningInfo(c *gc.C) {
 			CharmModifiedVersion: 10,
 			Scale:                3,
 			Trust:                true,
+			MinAvailable:         2,
+			Autoscaling: &params.CAASApplicationAutoscaling{
+				MinUnits:  1,
+				MaxUnits:  5,
+				CPUTarget: 80,
+			},
 		}},
 	})
 }
@@ -139,6 +147,7 @@ func (s *CAASApplicationProvisionerSuite) TestProvisioningInfoPendingCharmError(
 
 func (s *CAASApplicationProvisionerSuite) TestWatchProvisioningInfo(c *gc.C) {
 	appChanged := make(chan struct{}, 1)
+	appConfigChanged := make(chan struct{}, 1)
 	portsChanged := make(chan struct{}, 1)
 	modelConfigChanged := make(chan struct{}, 1)
 	controllerConfigChanged := make(chan struct{}, 1)
@@ -151,9 +160,11 @@ func (s *CAASApplicationProvisionerSuite) TestWatchProvisioningInfo(c *gc.C) {
 			meta: &charm.Meta{},
 			url:  "cs:gitlab",
 		},
-		watcher: statetesting.NewMockNotifyWatcher(appChanged),
+		watcher:       statetesting.NewMockNotifyWatcher(appChanged),
+		configWatcher: statetesting.NewMockNotifyWatcher(appConfigChanged),
 	}
 	appChanged <- struct{}{}
+	appConfigChanged <- struct{}{}
 	portsChanged <- struct{}{}
 	modelConfigChanged <- struct{}{}
 	controllerConfigChanged <- struct{}{}
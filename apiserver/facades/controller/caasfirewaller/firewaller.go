@@ -16,12 +16,21 @@ import (
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/watcher"
 )
 
+// FacadeV1 provides access to the CAASFirewaller API facade
+// version 1, which doesn't support network policies.
+type FacadeV1 struct {
+	*Facade
+}
+
+// Facade provides access to the CAASFirewaller API facade.
 type Facade struct {
 	*common.LifeGetter
 	*common.AgentEntityWatcher
+	*common.ModelWatcher
 	resources       facade.Resources
 	state           CAASFirewallerState
 	charmInfoAPI    *charmscommon.CharmInfoAPI
@@ -67,6 +76,150 @@ func (f *Facade) isExposed(backend CAASFirewallerState, tagString string) (bool,
 	return app.IsExposed(), nil
 }
 
+// GetExposeInfo isn't on the V1 API.
+func (*FacadeV1) GetExposeInfo(ctx context.Context, _ struct{}) {}
+
+// GetExposeInfo returns the expose flag and per-endpoint expose settings
+// for the specified applications.
+func (f *Facade) GetExposeInfo(ctx context.Context, args params.Entities) (params.ExposeInfoResults, error) {
+	result := params.ExposeInfoResults{
+		Results: make([]params.ExposeInfoResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		app, err := f.application(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if !app.IsExposed() {
+			continue
+		}
+
+		result.Results[i].Exposed = true
+		if exposedEndpoints := app.ExposedEndpoints(); len(exposedEndpoints) != 0 {
+			mappedEndpoints := make(map[string]params.ExposedEndpoint)
+			for endpoint, exposeDetails := range exposedEndpoints {
+				mappedEndpoints[endpoint] = params.ExposedEndpoint{
+					ExposeToSpaces: exposeDetails.ExposeToSpaceIDs,
+					ExposeToCIDRs:  exposeDetails.ExposeToCIDRs,
+				}
+			}
+			result.Results[i].ExposedEndpoints = mappedEndpoints
+		}
+	}
+	return result, nil
+}
+
+// RelatedApplications isn't on the V1 API.
+func (*FacadeV1) RelatedApplications(ctx context.Context, _ struct{}) {}
+
+// RelatedApplications returns the names of the applications in the model
+// related to each of the specified applications. Applications offered by
+// other models are left out, as their units don't run in the model.
+func (f *Facade) RelatedApplications(ctx context.Context, args params.Entities) (params.StringsResults, error) {
+	result := params.StringsResults{
+		Results: make([]params.StringsResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		related, err := f.relatedApplications(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].Result = related
+	}
+	return result, nil
+}
+
+func (f *Facade) relatedApplications(tagString string) ([]string, error) {
+	app, err := f.application(tagString)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	related, err := app.RelatedApplications()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var local []string
+	for _, name := range related {
+		remote, err := f.state.IsRemoteApplication(name)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !remote {
+			local = append(local, name)
+		}
+	}
+	return local, nil
+}
+
+// WatchRelations isn't on the V1 API.
+func (*FacadeV1) WatchRelations(ctx context.Context, _ struct{}) {}
+
+// WatchRelations returns a StringsWatcher for each of the specified
+// applications, notifying of changes to the relations they are in.
+func (f *Facade) WatchRelations(ctx context.Context, args params.Entities) (params.StringsWatchResults, error) {
+	result := params.StringsWatchResults{
+		Results: make([]params.StringsWatchResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		app, err := f.application(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		watch := app.WatchRelations()
+		// Consume the initial event and forward it to the result.
+		if changes, ok := <-watch.Changes(); ok {
+			result.Results[i].StringsWatcherId = f.resources.Register(watch)
+			result.Results[i].Changes = changes
+		} else {
+			result.Results[i].Error = apiservererrors.ServerError(watcher.EnsureErr(watch))
+		}
+	}
+	return result, nil
+}
+
+// WatchApplicationConfig isn't on the V1 API.
+func (*FacadeV1) WatchApplicationConfig(ctx context.Context, _ struct{}) {}
+
+// WatchForModelConfigChanges isn't on the V1 API.
+func (*FacadeV1) WatchForModelConfigChanges(ctx context.Context, _ struct{}) {}
+
+// ModelConfig isn't on the V1 API.
+func (*FacadeV1) ModelConfig(ctx context.Context, _ struct{}) {}
+
+// WatchApplicationConfig returns a NotifyWatcher for each of the specified
+// applications, notifying of changes to their config.
+func (f *Facade) WatchApplicationConfig(ctx context.Context, args params.Entities) (params.NotifyWatchResults, error) {
+	result := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		app, err := f.application(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		watch := app.WatchApplicationConfig()
+		// Consume the initial event.
+		if _, ok := <-watch.Changes(); ok {
+			result.Results[i].NotifyWatcherId = f.resources.Register(watch)
+		} else {
+			result.Results[i].Error = apiservererrors.ServerError(watcher.EnsureErr(watch))
+		}
+	}
+	return result, nil
+}
+
+func (f *Facade) application(tagString string) (Application, error) {
+	tag, err := names.ParseApplicationTag(tagString)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return f.state.Application(tag.Id())
+}
+
 // ApplicationsConfig returns the config for the specified applications.
 func (f *Facade) ApplicationsConfig(ctx context.Context, args params.Entities) (params.ApplicationGetConfigResults, error) {
 	results := params.ApplicationGetConfigResults{
@@ -110,6 +263,7 @@ func newFacade(
 	resources facade.Resources,
 	authorizer facade.Authorizer,
 	st CAASFirewallerState,
+	model state.ModelAccessor,
 	commonCharmsAPI *charmscommon.CharmInfoAPI,
 	appCharmInfoAPI *charmscommon.ApplicationCharmInfoAPI,
 ) (*Facade, error) {
@@ -131,6 +285,7 @@ func newFacade(
 			resources,
 			accessApplication,
 		),
+		ModelWatcher:    common.NewModelWatcher(model, resources, authorizer),
 		resources:       resources,
 		state:           st,
 		charmInfoAPI:    commonCharmsAPI,
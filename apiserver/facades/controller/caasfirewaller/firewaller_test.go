@@ -19,6 +19,7 @@ import (
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/network"
+	environsconfig "github.com/juju/juju/environs/config"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 	statetesting "github.com/juju/juju/state/testing"
@@ -32,6 +33,9 @@ type firewallerSuite struct {
 	applicationsChanges chan []string
 	openPortsChanges    chan []string
 	appExposedChanges   chan struct{}
+	relationsChanges    chan []string
+	appConfigChanges    chan struct{}
+	modelConfigChanges  chan struct{}
 
 	resources  *common.Resources
 	authorizer *apiservertesting.FakeAuthorizer
@@ -58,6 +62,7 @@ var _ = gc.Suite(&firewallerSuite{
 			resources,
 			authorizer,
 			st,
+			st,
 			commonCharmsAPI,
 			appCharmInfoAPI,
 		)
@@ -70,11 +75,18 @@ func (s *firewallerSuite) SetUpTest(c *gc.C) {
 	s.applicationsChanges = make(chan []string, 1)
 	s.appExposedChanges = make(chan struct{}, 1)
 	s.openPortsChanges = make(chan []string, 1)
+	s.relationsChanges = make(chan []string, 1)
+	s.appConfigChanges = make(chan struct{}, 1)
+	s.modelConfigChanges = make(chan struct{}, 1)
+	modelConfig, err := environsconfig.New(environsconfig.UseDefaults, coretesting.FakeConfig())
+	c.Assert(err, jc.ErrorIsNil)
 	appExposedWatcher := statetesting.NewMockNotifyWatcher(s.appExposedChanges)
 	s.st = &mockState{
 		application: mockApplication{
-			life:    state.Alive,
-			watcher: appExposedWatcher,
+			life:             state.Alive,
+			watcher:          appExposedWatcher,
+			relationsWatcher: statetesting.NewMockStringsWatcher(s.relationsChanges),
+			configWatcher:    statetesting.NewMockNotifyWatcher(s.appConfigChanges),
 			charm: mockCharm{
 				meta: &charm.Meta{
 					Deployment: &charm.Deployment{},
@@ -86,10 +98,15 @@ func (s *firewallerSuite) SetUpTest(c *gc.C) {
 		applicationsWatcher: statetesting.NewMockStringsWatcher(s.applicationsChanges),
 		openPortsWatcher:    statetesting.NewMockStringsWatcher(s.openPortsChanges),
 		appExposedWatcher:   appExposedWatcher,
+		modelConfig:         modelConfig,
+		modelConfigWatcher:  statetesting.NewMockNotifyWatcher(s.modelConfigChanges),
 	}
 	s.AddCleanup(func(c *gc.C) { workertest.DirtyKill(c, s.st.applicationsWatcher) })
 	s.AddCleanup(func(c *gc.C) { workertest.DirtyKill(c, s.st.openPortsWatcher) })
 	s.AddCleanup(func(c *gc.C) { workertest.DirtyKill(c, s.st.appExposedWatcher) })
+	s.AddCleanup(func(c *gc.C) { workertest.DirtyKill(c, s.st.application.relationsWatcher) })
+	s.AddCleanup(func(c *gc.C) { workertest.DirtyKill(c, s.st.application.configWatcher) })
+	s.AddCleanup(func(c *gc.C) { workertest.DirtyKill(c, s.st.modelConfigWatcher) })
 
 	s.resources = common.NewResources()
 	s.authorizer = &apiservertesting.FakeAuthorizer{
@@ -177,6 +194,12 @@ type facadeSidecar interface {
 	ApplicationCharmInfo(ctx context.Context, args params.Entity) (params.Charm, error)
 	WatchOpenedPorts(ctx context.Context, args params.Entities) (params.StringsWatchResults, error)
 	GetOpenedPorts(ctx context.Context, arg params.Entity) (params.ApplicationOpenedPortsResults, error)
+	GetExposeInfo(ctx context.Context, args params.Entities) (params.ExposeInfoResults, error)
+	RelatedApplications(ctx context.Context, args params.Entities) (params.StringsResults, error)
+	WatchRelations(ctx context.Context, args params.Entities) (params.StringsWatchResults, error)
+	WatchApplicationConfig(ctx context.Context, args params.Entities) (params.NotifyWatchResults, error)
+	WatchForModelConfigChanges(ctx context.Context) (params.NotifyWatchResult, error)
+	ModelConfig(ctx context.Context) (params.ModelConfigResult, error)
 }
 
 func (s *firewallerSuite) TestPermission(c *gc.C) {
@@ -239,6 +262,122 @@ func (s *firewallerSuite) TestIsExposed(c *gc.C) {
 	})
 }
 
+func (s *firewallerSuite) TestGetExposeInfo(c *gc.C) {
+	s.st.application.exposed = true
+	s.st.application.exposedEndpoints = map[string]state.ExposedEndpoint{
+		"": {ExposeToCIDRs: []string{"10.0.0.0/24"}},
+	}
+	results, err := s.facade.GetExposeInfo(context.Background(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: "application-gitlab"},
+			{Tag: "unit-gitlab-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ExposeInfoResults{
+		Results: []params.ExposeInfoResult{{
+			Exposed: true,
+			ExposedEndpoints: map[string]params.ExposedEndpoint{
+				"": {ExposeToCIDRs: []string{"10.0.0.0/24"}},
+			},
+		}, {
+			Error: &params.Error{
+				Message: `"unit-gitlab-0" is not a valid application tag`,
+			},
+		}},
+	})
+
+	s.st.application.exposed = false
+	results, err = s.facade.GetExposeInfo(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: "application-gitlab"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ExposeInfoResults{
+		Results: []params.ExposeInfoResult{{}},
+	})
+}
+
+func (s *firewallerSuite) TestRelatedApplications(c *gc.C) {
+	s.st.application.relations = []string{"mysql", "nginx"}
+	results, err := s.facade.RelatedApplications(context.Background(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: "application-gitlab"},
+			{Tag: "unit-gitlab-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{{
+			Result: []string{"mysql", "nginx"},
+		}, {
+			Error: &params.Error{
+				Message: `"unit-gitlab-0" is not a valid application tag`,
+			},
+		}},
+	})
+}
+
+func (s *firewallerSuite) TestRelatedApplicationsSkipsRemote(c *gc.C) {
+	s.st.application.relations = []string{"mysql", "nginx"}
+	s.st.remoteApplications = []string{"mysql"}
+	results, err := s.facade.RelatedApplications(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: "application-gitlab"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{{
+			Result: []string{"nginx"},
+		}},
+	})
+}
+
+func (s *firewallerSuite) TestWatchRelations(c *gc.C) {
+	s.relationsChanges <- []string{"gitlab:db mysql:server"}
+
+	results, err := s.facade.WatchRelations(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: "application-gitlab"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	result := results.Results[0]
+	c.Assert(result.Error, gc.IsNil)
+	c.Assert(result.StringsWatcherId, gc.Equals, "1")
+	c.Assert(result.Changes, jc.DeepEquals, []string{"gitlab:db mysql:server"})
+	c.Assert(s.resources.Get("1"), gc.Equals, s.st.application.relationsWatcher)
+}
+
+func (s *firewallerSuite) TestWatchApplicationConfig(c *gc.C) {
+	s.appConfigChanges <- struct{}{}
+
+	results, err := s.facade.WatchApplicationConfig(context.Background(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: "application-gitlab"},
+			{Tag: "unit-gitlab-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].NotifyWatcherId, gc.Equals, "1")
+	c.Assert(s.resources.Get("1"), gc.Equals, s.st.application.configWatcher)
+	c.Assert(results.Results[1].Error, jc.DeepEquals, &params.Error{
+		Message: `"unit-gitlab-0" is not a valid application tag`,
+	})
+}
+
+func (s *firewallerSuite) TestWatchForModelConfigChanges(c *gc.C) {
+	s.modelConfigChanges <- struct{}{}
+
+	result, err := s.facade.WatchForModelConfigChanges(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.NotifyWatcherId, gc.Equals, "1")
+	c.Assert(s.resources.Get("1"), gc.Equals, s.st.modelConfigWatcher)
+
+	config, err := s.facade.ModelConfig(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(config.Config, jc.DeepEquals, params.ModelConfig(s.st.modelConfig.AllAttrs()))
+}
+
 func (s *firewallerSuite) TestLife(c *gc.C) {
 	results, err := s.facade.Life(context.Background(), params.Entities{
 		Entities: []params.Entity{
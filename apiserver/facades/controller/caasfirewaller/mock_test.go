@@ -4,7 +4,10 @@
 package caasfirewaller_test
 
 import (
+	"context"
+
 	"github.com/juju/charm/v11"
+	"github.com/juju/collections/set"
 	"github.com/juju/names/v4"
 	"github.com/juju/testing"
 
@@ -12,6 +15,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/controller/caasfirewaller"
 	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/network"
+	environsconfig "github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 	statetesting "github.com/juju/juju/state/testing"
 )
@@ -22,6 +26,22 @@ type mockState struct {
 	applicationsWatcher *statetesting.MockStringsWatcher
 	openPortsWatcher    *statetesting.MockStringsWatcher
 	appExposedWatcher   *statetesting.MockNotifyWatcher
+	remoteApplications  []string
+	modelConfig         *environsconfig.Config
+	modelConfigWatcher  *statetesting.MockNotifyWatcher
+}
+
+func (st *mockState) WatchForModelConfigChanges() state.NotifyWatcher {
+	st.MethodCall(st, "WatchForModelConfigChanges")
+	return st.modelConfigWatcher
+}
+
+func (st *mockState) ModelConfig(context.Context) (*environsconfig.Config, error) {
+	st.MethodCall(st, "ModelConfig")
+	if err := st.NextErr(); err != nil {
+		return nil, err
+	}
+	return st.modelConfig, nil
 }
 
 func (st *mockState) WatchApplications() state.StringsWatcher {
@@ -42,6 +62,14 @@ func (st *mockState) Application(name string) (caasfirewaller.Application, error
 	return &st.application, nil
 }
 
+func (st *mockState) IsRemoteApplication(name string) (bool, error) {
+	st.MethodCall(st, "IsRemoteApplication", name)
+	if err := st.NextErr(); err != nil {
+		return false, err
+	}
+	return set.NewStrings(st.remoteApplications...).Contains(name), nil
+}
+
 func (st *mockState) FindEntity(tag names.Tag) (state.Entity, error) {
 	st.MethodCall(st, "FindEntity", tag)
 	if err := st.NextErr(); err != nil {
@@ -75,6 +103,11 @@ type mockApplication struct {
 
 	charm         mockCharm
 	appPortRanges network.GroupedPortRanges
+
+	exposedEndpoints map[string]state.ExposedEndpoint
+	relations        []string
+	relationsWatcher state.StringsWatcher
+	configWatcher    state.NotifyWatcher
 }
 
 func (a *mockApplication) Life() state.Life {
@@ -87,6 +120,21 @@ func (a *mockApplication) IsExposed() bool {
 	return a.exposed
 }
 
+func (a *mockApplication) ExposedEndpoints() map[string]state.ExposedEndpoint {
+	a.MethodCall(a, "ExposedEndpoints")
+	return a.exposedEndpoints
+}
+
+func (a *mockApplication) RelatedApplications() ([]string, error) {
+	a.MethodCall(a, "RelatedApplications")
+	return a.relations, a.NextErr()
+}
+
+func (a *mockApplication) WatchRelations() state.StringsWatcher {
+	a.MethodCall(a, "WatchRelations")
+	return a.relationsWatcher
+}
+
 func (a *mockApplication) ApplicationConfig() (config.ConfigAttributes, error) {
 	a.MethodCall(a, "ApplicationConfig")
 	return config.ConfigAttributes{"foo": "bar"}, a.NextErr()
@@ -97,6 +145,11 @@ func (a *mockApplication) Watch() state.NotifyWatcher {
 	return a.watcher
 }
 
+func (a *mockApplication) WatchApplicationConfig() state.NotifyWatcher {
+	a.MethodCall(a, "WatchApplicationConfig")
+	return a.configWatcher
+}
+
 func (a *mockApplication) OpenedPortRanges() (network.GroupedPortRanges, error) {
 	a.MethodCall(a, "OpenedPortRanges")
 	return a.appPortRanges, nil
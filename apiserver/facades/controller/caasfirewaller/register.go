@@ -15,15 +15,31 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("CAASFirewaller", 1, func(ctx facade.Context) (facade.Facade, error) {
+		return newStateFacadeV1(ctx)
+	}, reflect.TypeOf((*FacadeV1)(nil)))
+	registry.MustRegister("CAASFirewaller", 2, func(ctx facade.Context) (facade.Facade, error) {
 		return newStateFacade(ctx)
 	}, reflect.TypeOf((*Facade)(nil)))
 }
 
+// newStateFacadeV1 provides the signature required for FacadeV1 registration.
+func newStateFacadeV1(ctx facade.Context) (*FacadeV1, error) {
+	api, err := newStateFacade(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &FacadeV1{Facade: api}, nil
+}
+
 // newStateFacade provides the signature required for facade registration.
 func newStateFacade(ctx facade.Context) (*Facade, error) {
 	authorizer := ctx.Auth()
 	resources := ctx.Resources()
 
+	model, err := ctx.State().Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	commonState := &charmscommon.StateShim{ctx.State()}
 	commonCharmsAPI, err := charmscommon.NewCharmInfoAPI(commonState, authorizer)
 	if err != nil {
@@ -37,6 +53,7 @@ func newStateFacade(ctx facade.Context) (*Facade, error) {
 		resources,
 		authorizer,
 		&stateShim{ctx.State()},
+		model,
 		commonCharmsAPI,
 		appCharmInfoAPI,
 	)
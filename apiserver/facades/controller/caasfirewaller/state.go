@@ -4,6 +4,8 @@
 package caasfirewaller
 
 import (
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
 	"github.com/juju/names/v4"
 
 	charmscommon "github.com/juju/juju/apiserver/common/charms"
@@ -17,6 +19,7 @@ import (
 type CAASFirewallerState interface {
 	FindEntity(tag names.Tag) (state.Entity, error)
 	Application(string) (Application, error)
+	IsRemoteApplication(string) (bool, error)
 
 	WatchApplications() state.StringsWatcher
 	WatchOpenedPorts() state.StringsWatcher
@@ -26,10 +29,14 @@ type CAASFirewallerState interface {
 // required by the CAAS operator facade.
 type Application interface {
 	IsExposed() bool
+	ExposedEndpoints() map[string]state.ExposedEndpoint
 	ApplicationConfig() (config.ConfigAttributes, error)
 	Watch() state.NotifyWatcher
+	WatchApplicationConfig() state.NotifyWatcher
 	Charm() (ch charmscommon.Charm, force bool, err error)
 	OpenedPortRanges() (network.GroupedPortRanges, error)
+	WatchRelations() state.StringsWatcher
+	RelatedApplications() ([]string, error)
 }
 
 type stateShim struct {
@@ -44,6 +51,18 @@ func (s *stateShim) Application(id string) (Application, error) {
 	return &applicationShim{app}, nil
 }
 
+// IsRemoteApplication returns whether the named application is
+// offered by another model.
+func (s *stateShim) IsRemoteApplication(name string) (bool, error) {
+	_, err := s.State.RemoteApplication(name)
+	if errors.Is(err, errors.NotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
 type applicationShim struct {
 	*state.Application
 }
@@ -59,3 +78,23 @@ func (a *applicationShim) OpenedPortRanges() (network.GroupedPortRanges, error)
 	}
 	return pg.ByEndpoint(), nil
 }
+
+// RelatedApplications returns the names of the applications at the other
+// end of the application's relations.
+func (a *applicationShim) RelatedApplications() ([]string, error) {
+	relations, err := a.Application.Relations()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	related := set.NewStrings()
+	for _, rel := range relations {
+		eps, err := rel.RelatedEndpoints(a.Name())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, ep := range eps {
+			related.Add(ep.ApplicationName)
+		}
+	}
+	return related.SortedValues(), nil
+}
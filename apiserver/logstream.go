@@ -127,9 +127,32 @@ func (h *logStreamEndpointHandler) newTailer(
 		}
 	}
 
+	if cfg.StartTime != "" {
+		startTime, err := time.Parse(time.RFC3339Nano, cfg.StartTime)
+		if err != nil {
+			return nil, errors.Annotatef(err, "invalid start time")
+		}
+		if startTime.After(start) {
+			start = startTime
+		}
+	}
+	var end time.Time
+	if cfg.EndTime != "" {
+		end, err = time.Parse(time.RFC3339Nano, cfg.EndTime)
+		if err != nil {
+			return nil, errors.Annotatef(err, "invalid end time")
+		}
+		if end.Before(start) {
+			return nil, errors.NotValidf("end time %q before start time", cfg.EndTime)
+		}
+	}
+
 	tailerArgs := corelogger.LogTailerParams{
-		StartTime:    start,
-		InitialLines: cfg.MaxLookbackRecords,
+		StartTime:      start,
+		EndTime:        end,
+		InitialLines:   cfg.MaxLookbackRecords,
+		IncludeMessage: cfg.IncludeMessage,
+		ExcludeMessage: cfg.ExcludeMessage,
 	}
 	tailer, err := source.newTailer(tailerArgs)
 	if err != nil {
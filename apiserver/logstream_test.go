@@ -97,6 +97,56 @@ func (s *LogStreamIntSuite) TestParamStartTruncate(c *gc.C) {
 	})
 }
 
+func (s *LogStreamIntSuite) TestParamFilters(c *gc.C) {
+	cfg := params.LogStreamConfig{
+		Sink:           "spam",
+		StartTime:      "2015-06-19T15:00:00Z",
+		EndTime:        "2015-06-19T16:00:00Z",
+		IncludeMessage: []string{"^stuff"},
+		ExcludeMessage: []string{"whoops", "oops"},
+	}
+	req := s.newReq(c, cfg)
+
+	stub := &testing.Stub{}
+	source := &stubSource{stub: stub}
+	source.ReturnGetStart = 10
+	handler := logStreamEndpointHandler{
+		stopCh:    nil,
+		newSource: source.newSource,
+	}
+
+	_, err := handler.newLogStreamRequestHandler(nil, req, clock.WallClock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	stub.CheckCallNames(c, "newSource", "getStart", "newTailer")
+	stub.CheckCall(c, 2, "newTailer", corelogger.LogTailerParams{
+		StartTime:      time.Date(2015, 6, 19, 15, 0, 0, 0, time.UTC),
+		EndTime:        time.Date(2015, 6, 19, 16, 0, 0, 0, time.UTC),
+		IncludeMessage: []string{"^stuff"},
+		ExcludeMessage: []string{"whoops", "oops"},
+	})
+}
+
+func (s *LogStreamIntSuite) TestParamEndBeforeStart(c *gc.C) {
+	cfg := params.LogStreamConfig{
+		Sink:    "spam",
+		EndTime: "1969-12-31T00:00:00Z",
+	}
+	req := s.newReq(c, cfg)
+
+	stub := &testing.Stub{}
+	source := &stubSource{stub: stub}
+	source.ReturnGetStart = 10
+	handler := logStreamEndpointHandler{
+		stopCh:    nil,
+		newSource: source.newSource,
+	}
+
+	_, err := handler.newLogStreamRequestHandler(nil, req, clock.WallClock)
+	c.Assert(err, gc.ErrorMatches, `creating new tailer: end time "1969-12-31T00:00:00Z" before start time not valid`)
+	stub.CheckCallNames(c, "newSource", "getStart", "close")
+}
+
 func (s *LogStreamIntSuite) TestFullRequest(c *gc.C) {
 
 	// Create test data: i.e. log records for tailing...
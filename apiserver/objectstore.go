@@ -0,0 +1,70 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/objectstore"
+)
+
+// objectStoreHandler serves the raw contents of the objects held in a
+// model's object store. It is used by controllers to retrieve objects from
+// their peers, so that every controller in an HA set holds every object.
+type objectStoreHandler struct {
+	objectStoreForRequest func(*http.Request) (objectstore.ObjectStore, error)
+}
+
+// ServeHTTP is part of the http.Handler interface.
+func (h *objectStoreHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var err error
+	switch r.Method {
+	case "GET":
+		err = h.serveGet(w, r)
+	default:
+		err = errors.MethodNotAllowedf("unsupported method: %q", r.Method)
+	}
+	if err != nil {
+		if err := sendError(w, err); err != nil {
+			logger.Errorf("%v", errors.Annotate(err, "cannot return error to controller"))
+		}
+	}
+}
+
+func (h *objectStoreHandler) serveGet(w http.ResponseWriter, r *http.Request) error {
+	path := r.URL.Query().Get("path")
+	if path == "" {
+		return errors.BadRequestf("missing path")
+	}
+
+	store, err := h.objectStoreForRequest(r)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// Only objects held by this controller are served. If the object were
+	// retrieved from a peer, two controllers that both lack an object would
+	// keep asking each other for it.
+	reader, size, err := store.GetLocal(r.Context(), path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer reader.Close()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.WriteHeader(http.StatusOK)
+
+	// The headers have already been sent, so it's too late to report an
+	// error to the peer. The peer verifies the hash of what it receives, so
+	// a truncated object will be rejected.
+	if _, err := io.Copy(w, reader); err != nil {
+		logger.Warningf("sending object at path %q: %v", path, err)
+	}
+	return nil
+}
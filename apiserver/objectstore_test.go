@@ -0,0 +1,113 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/juju/loggo"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apitesting "github.com/juju/juju/apiserver/testing"
+	internalobjectstore "github.com/juju/juju/internal/objectstore"
+	jujutesting "github.com/juju/juju/juju/testing"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing/factory"
+)
+
+type objectStoreSuite struct {
+	jujutesting.ApiServerSuite
+	machineTag names.Tag
+	password   string
+	nonce      string
+}
+
+var _ = gc.Suite(&objectStoreSuite{})
+
+func (s *objectStoreSuite) SetUpTest(c *gc.C) {
+	s.ApiServerSuite.SetUpTest(c)
+	f, release := s.NewFactory(c, s.ControllerModelUUID())
+	defer release()
+	s.nonce = "nonce"
+	m, password := f.MakeMachineReturningPassword(c, &factory.MachineParams{
+		Nonce: s.nonce,
+		Jobs:  []state.MachineJob{state.JobManageModel},
+	})
+	s.machineTag = m.Tag()
+	s.password = password
+}
+
+func (s *objectStoreSuite) objectURL(path string) string {
+	return s.URL(fmt.Sprintf("/model/%s/objects", s.ControllerModelUUID()), url.Values{
+		"path": []string{path},
+	}).String()
+}
+
+func (s *objectStoreSuite) TestNoAuth(c *gc.C) {
+	apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:       "GET",
+		URL:          s.objectURL("foo"),
+		ExpectStatus: http.StatusUnauthorized,
+	})
+}
+
+func (s *objectStoreSuite) TestRejectsUserLogins(c *gc.C) {
+	f, release := s.NewFactory(c, s.ControllerModelUUID())
+	defer release()
+	user := f.MakeUser(c, &factory.UserParams{Password: "sekrit"})
+	apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:       "GET",
+		URL:          s.objectURL("foo"),
+		Tag:          user.Tag().String(),
+		Password:     "sekrit",
+		ExpectStatus: http.StatusForbidden,
+	})
+}
+
+func (s *objectStoreSuite) TestGetObject(c *gc.C) {
+	st := s.ControllerModel(c).State()
+	store, err := internalobjectstore.NewStateObjectStore(context.Background(), s.ControllerModelUUID(), st, nil, loggo.GetLogger("juju.objectstore"))
+	c.Assert(err, jc.ErrorIsNil)
+	defer store.Kill()
+
+	content := "some content"
+	err = store.Put(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)))
+	c.Assert(err, jc.ErrorIsNil)
+
+	resp := apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:       "GET",
+		URL:          s.objectURL("foo"),
+		Tag:          s.machineTag.String(),
+		Password:     s.password,
+		Nonce:        s.nonce,
+		ExpectStatus: http.StatusOK,
+	})
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, content)
+	c.Check(resp.ContentLength, gc.Equals, int64(len(content)))
+}
+
+func (s *objectStoreSuite) TestGetObjectNotFound(c *gc.C) {
+	resp := apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:       "GET",
+		URL:          s.objectURL("missing"),
+		Tag:          s.machineTag.String(),
+		Password:     s.password,
+		Nonce:        s.nonce,
+		ExpectStatus: http.StatusNotFound,
+	})
+	body := apitesting.AssertResponse(c, resp, http.StatusNotFound, params.ContentTypeJSON)
+	c.Check(string(body), jc.Contains, "not found")
+}
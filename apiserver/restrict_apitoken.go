@@ -0,0 +1,25 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"fmt"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+)
+
+// apiTokenDeniedMethods are the methods, by facade, that users who logged
+// in with an API token can't call, as they would let the user gain more
+// access than the token allows.
+var apiTokenDeniedMethods = map[string]set.Strings{
+	"UserManager": set.NewStrings("AddAPITokens", "SetPassword", "ResetPassword"),
+}
+
+func apiTokenMethodsOnly(facadeName, methodName string) error {
+	if apiTokenDeniedMethods[facadeName].Contains(methodName) {
+		return errors.NewNotSupported(nil, fmt.Sprintf("%s.%s not supported for API token logins", facadeName, methodName))
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver"
+	"github.com/juju/juju/rpc"
+	"github.com/juju/juju/testing"
+)
+
+type restrictAPITokenSuite struct {
+	testing.BaseSuite
+	root rpc.Root
+}
+
+var _ = gc.Suite(&restrictAPITokenSuite{})
+
+func (s *restrictAPITokenSuite) SetUpSuite(c *gc.C) {
+	s.BaseSuite.SetUpSuite(c)
+	s.root = apiserver.TestingAPITokenRoot()
+}
+
+func (s *restrictAPITokenSuite) TestAllowed(c *gc.C) {
+	s.assertMethod(c, "UserManager", 3, "APITokens")
+	s.assertMethod(c, "UserManager", 3, "RemoveAPITokens")
+	s.assertMethod(c, "Client", clientFacadeVersion, "FullStatus")
+}
+
+func (s *restrictAPITokenSuite) TestNotAllowed(c *gc.C) {
+	for _, method := range []string{"AddAPITokens", "SetPassword", "ResetPassword"} {
+		caller, err := s.root.FindMethod("UserManager", 3, method)
+		c.Check(err, gc.ErrorMatches, `UserManager.`+method+` not supported for API token logins`)
+		c.Check(err, jc.ErrorIs, errors.NotSupported)
+		c.Check(caller, gc.IsNil)
+	}
+}
+
+func (s *restrictAPITokenSuite) assertMethod(c *gc.C, facadeName string, version int, method string) {
+	caller, err := s.root.FindMethod(facadeName, version, method)
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(caller, gc.NotNil)
+}
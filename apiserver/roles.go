@@ -0,0 +1,112 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/internal/rpcreflect"
+	"github.com/juju/juju/rpc"
+)
+
+// userRoleMethods returns the facade methods allowed by the roles granted
+// to the user on the model they logged in to. Roles don't apply to logins
+// with API tokens, so that a token's maximum access still holds.
+func (a *admin) userRoleMethods(ctx context.Context, auth *authResult) ([]string, error) {
+	if !auth.userLogin || auth.controllerOnlyLogin || auth.apiTokenLogin {
+		return nil, nil
+	}
+	userTag, ok := a.root.authInfo.Entity.Tag().(names.UserTag)
+	if !ok {
+		return nil, nil
+	}
+	serviceFactory := a.srv.shared.serviceFactoryGetter.FactoryForModel(database.ControllerNS)
+	if serviceFactory == nil {
+		return nil, nil
+	}
+	roles := serviceFactory.Role()
+	if roles == nil {
+		return nil, nil
+	}
+	methods, err := roles.UserRoleMethods(ctx, userTag.Id(), a.root.model.UUID())
+	return methods, errors.Trace(err)
+}
+
+// roleRoot dispatches the methods allowed by a user's roles to an
+// elevated root, whose authorizer grants the user write access to the
+// model, and all other methods to the user's own root.
+type roleRoot struct {
+	rpc.Root
+	elevated rpc.Root
+	methods  []string
+}
+
+// newRoleRoot returns a root that dispatches the role methods to elevated
+// and all other methods to root.
+func newRoleRoot(root, elevated rpc.Root, methods []string) *roleRoot {
+	return &roleRoot{
+		Root:     root,
+		elevated: elevated,
+		methods:  methods,
+	}
+}
+
+// FindMethod implements rpc.Root.
+func (r *roleRoot) FindMethod(facadeName string, version int, methodName string) (rpcreflect.MethodCaller, error) {
+	if user.RoleAllowsMethod(r.methods, facadeName, methodName) {
+		return r.elevated.FindMethod(facadeName, version, methodName)
+	}
+	return r.Root.FindMethod(facadeName, version, methodName)
+}
+
+// roleAPIRootHandler is an apiRootHandler whose authorizer grants the
+// authenticated user write access to the model, for the facades that
+// serve the methods allowed by the user's roles.
+type roleAPIRootHandler struct {
+	apiRootHandler
+	modelTag names.ModelTag
+}
+
+// Authorizer implements apiRootHandler.
+func (h roleAPIRootHandler) Authorizer() facade.Authorizer {
+	return roleAuthorizer{
+		Authorizer: h.apiRootHandler.Authorizer(),
+		modelTag:   h.modelTag,
+	}
+}
+
+// roleAuthorizer grants the authenticated user write access to the model
+// in addition to the access they already have. Roles never grant admin
+// access to a model, or any access to other entities.
+type roleAuthorizer struct {
+	facade.Authorizer
+	modelTag names.ModelTag
+}
+
+// HasPermission implements facade.Authorizer.
+func (a roleAuthorizer) HasPermission(operation permission.Access, target names.Tag) error {
+	return a.EntityHasPermission(a.GetAuthTag(), operation, target)
+}
+
+// EntityHasPermission implements facade.Authorizer.
+func (a roleAuthorizer) EntityHasPermission(entity names.Tag, operation permission.Access, target names.Tag) error {
+	if a.grantsWrite(entity, target) && permission.WriteAccess.EqualOrGreaterModelAccessThan(operation) {
+		return nil
+	}
+	return a.Authorizer.EntityHasPermission(entity, operation, target)
+}
+
+func (a roleAuthorizer) grantsWrite(entity, target names.Tag) bool {
+	authTag := a.GetAuthTag()
+	return entity != nil && authTag != nil && target != nil &&
+		entity.String() == authTag.String() &&
+		target.String() == a.modelTag.String()
+}
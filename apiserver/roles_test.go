@@ -0,0 +1,77 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"context"
+
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/internal/rpcreflect"
+	"github.com/juju/juju/testing"
+)
+
+type rolesSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&rolesSuite{})
+
+// recordingRoot is an rpc.Root that records the methods looked up on it.
+type recordingRoot struct {
+	calls []string
+}
+
+func (r *recordingRoot) FindMethod(facadeName string, _ int, methodName string) (rpcreflect.MethodCaller, error) {
+	r.calls = append(r.calls, facadeName+"."+methodName)
+	return nil, nil
+}
+
+func (r *recordingRoot) StartTrace(ctx context.Context) (context.Context, trace.Span) {
+	return ctx, trace.NoopSpan{}
+}
+
+func (r *recordingRoot) Kill() {}
+
+func (s *rolesSuite) TestRoleRoot(c *gc.C) {
+	var root, elevated recordingRoot
+	roleRoot := apiserver.TestingRoleRoot(&root, &elevated, []string{"Action.EnqueueOperation", "Client.*"})
+
+	for _, method := range []struct{ facade, name string }{
+		{"Action", "EnqueueOperation"},
+		{"Action", "Cancel"},
+		{"Client", "FullStatus"},
+		{"Application", "SetConfigs"},
+	} {
+		_, err := roleRoot.FindMethod(method.facade, 1, method.name)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	c.Check(elevated.calls, jc.DeepEquals, []string{"Action.EnqueueOperation", "Client.FullStatus"})
+	c.Check(root.calls, jc.DeepEquals, []string{"Action.Cancel", "Application.SetConfigs"})
+}
+
+func (s *rolesSuite) TestRoleAuthorizer(c *gc.C) {
+	userTag := names.NewUserTag("read-" + testing.ModelTag.String())
+	authorizer := apiserver.NewRoleAuthorizerForTest(apiservertesting.FakeAuthorizer{Tag: userTag}, testing.ModelTag)
+
+	// The user has write access to the model through the role.
+	c.Check(authorizer.HasPermission(permission.ReadAccess, testing.ModelTag), jc.ErrorIsNil)
+	c.Check(authorizer.HasPermission(permission.WriteAccess, testing.ModelTag), jc.ErrorIsNil)
+	c.Check(authorizer.EntityHasPermission(userTag, permission.WriteAccess, testing.ModelTag), jc.ErrorIsNil)
+
+	// Roles don't grant admin access to the model, or access to anything
+	// else.
+	c.Check(authorizer.HasPermission(permission.AdminAccess, testing.ModelTag), gc.ErrorMatches, "permission denied")
+	c.Check(authorizer.HasPermission(permission.WriteAccess, names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00e")), gc.ErrorMatches, "permission denied")
+	c.Check(authorizer.HasPermission(permission.SuperuserAccess, testing.ControllerTag), gc.ErrorMatches, "permission denied")
+
+	// Nor do they grant access to other users.
+	c.Check(authorizer.EntityHasPermission(names.NewUserTag("bob"), permission.WriteAccess, testing.ModelTag), gc.ErrorMatches, "permission denied")
+}
@@ -429,6 +429,9 @@ func restrictAPIRoot(
 		}
 		apiRoot = restrictedRoot
 	}
+	if auth.apiTokenLogin {
+		apiRoot = restrictRoot(apiRoot, apiTokenMethodsOnly)
+	}
 	if auth.controllerOnlyLogin {
 		apiRoot = restrictRoot(apiRoot, controllerFacadesOnly)
 	} else {
@@ -795,6 +798,12 @@ func (ctx *facadeContext) ControllerDB() (changestream.WatchableDB, error) {
 	return db, errors.Trace(err)
 }
 
+// ModelDB returns a watchable database for the model with the input UUID.
+func (ctx *facadeContext) ModelDB(modelUUID string) (changestream.WatchableDB, error) {
+	db, err := ctx.r.shared.dbGetter.GetWatchableDB(modelUUID)
+	return db, errors.Trace(err)
+}
+
 // ServiceFactory returns the services factory for the current model.
 func (ctx *facadeContext) ServiceFactory() servicefactory.ServiceFactory {
 	return ctx.r.serviceFactory
@@ -14,10 +14,12 @@ import (
 
 	"github.com/juju/juju/apiserver/facade"
 	jujucontroller "github.com/juju/juju/controller"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/multiwatcher"
 	"github.com/juju/juju/core/presence"
+	"github.com/juju/juju/internal/pubsub/apiserver"
 	"github.com/juju/juju/internal/pubsub/controller"
 	"github.com/juju/juju/internal/servicefactory"
 	"github.com/juju/juju/state"
@@ -62,7 +64,7 @@ type sharedServerContext struct {
 	dataDir    string
 	logDir     string
 
-	unsubscribe func()
+	unsubscribe []func()
 }
 
 type sharedServerConfig struct {
@@ -151,12 +153,22 @@ func newSharedServerContext(config sharedServerConfig) (*sharedServerContext, er
 		ctx.logger.Criticalf("programming error in subscribe function: %v", err)
 		return nil, errors.Trace(err)
 	}
-	ctx.unsubscribe = unsubscribe
+	ctx.unsubscribe = append(ctx.unsubscribe, unsubscribe)
+
+	unsubscribe, err = ctx.centralHub.Subscribe(apiserver.AuditLogQueryTopic, ctx.onAuditLogQuery)
+	if err != nil {
+		ctx.logger.Criticalf("programming error in subscribe function: %v", err)
+		ctx.Close()
+		return nil, errors.Trace(err)
+	}
+	ctx.unsubscribe = append(ctx.unsubscribe, unsubscribe)
 	return ctx, nil
 }
 
 func (c *sharedServerContext) Close() {
-	c.unsubscribe()
+	for _, unsubscribe := range c.unsubscribe {
+		unsubscribe()
+	}
 }
 
 func (c *sharedServerContext) onConfigChanged(topic string, data controller.ConfigChangedMessage, err error) {
@@ -180,6 +192,31 @@ func (c *sharedServerContext) onConfigChanged(topic string, data controller.Conf
 	}
 }
 
+// onAuditLogQuery responds to a query, from the Controller facade on
+// any of the API servers, with the matching records from the audit log
+// on this machine.
+func (c *sharedServerContext) onAuditLogQuery(topic string, data apiserver.AuditLogQuery, err error) {
+	if err != nil {
+		c.logger.Criticalf("programming error in %s message data: %v", topic, err)
+		return
+	}
+
+	response := apiserver.AuditLogRecords{
+		RequestID: data.RequestID,
+	}
+	records, truncated, err := auditlog.ReadRecords(c.logDir, data.Filter)
+	if err != nil {
+		c.logger.Warningf("reading audit log: %v", err)
+		response.Error = err.Error()
+	} else {
+		response.Records = records
+		response.Truncated = truncated
+	}
+	if _, err := c.centralHub.Publish(apiserver.AuditLogRecordsTopic, response); err != nil {
+		c.logger.Warningf("publishing audit log records: %v", err)
+	}
+}
+
 func (c *sharedServerContext) maxDebugLogDuration() time.Duration {
 	c.configMutex.RLock()
 	defer c.configMutex.RUnlock()
@@ -17,7 +17,9 @@ import (
 	gc "gopkg.in/check.v1"
 
 	corecontroller "github.com/juju/juju/controller"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/presence"
+	"github.com/juju/juju/internal/pubsub/apiserver"
 	"github.com/juju/juju/internal/pubsub/controller"
 	"github.com/juju/juju/state"
 	statetesting "github.com/juju/juju/state/testing"
@@ -174,6 +176,42 @@ func (s *sharedServerContextSuite) TestControllerConfigChanged(c *gc.C) {
 	c.Check(stub.published, gc.HasLen, 0)
 }
 
+func (s *sharedServerContextSuite) TestAuditLogQuery(c *gc.C) {
+	logFile := auditlog.NewLogFile(s.config.logDir, 300, 10)
+	err := logFile.AddConversation(auditlog.Conversation{
+		ConversationID: "abc",
+		Who:            "mary",
+		When:           "2026-10-13T10:00:00Z",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(logFile.Close(), jc.ErrorIsNil)
+	s.newContext(c)
+
+	responses := make(chan apiserver.AuditLogRecords, 1)
+	unsubscribe, err := s.hub.Subscribe(apiserver.AuditLogRecordsTopic, func(topic string, data apiserver.AuditLogRecords, err error) {
+		c.Check(err, jc.ErrorIsNil)
+		responses <- data
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer unsubscribe()
+
+	_, err = s.hub.Publish(apiserver.AuditLogQueryTopic, apiserver.AuditLogQuery{
+		RequestID: "req-1",
+		Filter:    auditlog.Filter{Who: "mary"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case response := <-responses:
+		c.Check(response.RequestID, gc.Equals, "req-1")
+		c.Check(response.Error, gc.Equals, "")
+		c.Assert(response.Records, gc.HasLen, 1)
+		c.Check(response.Records[0].ConversationID(), gc.Equals, "abc")
+	case <-time.After(testing.LongWait):
+		c.Fatalf("no response to audit log query")
+	}
+}
+
 type noopRegisterer struct {
 	prometheus.Registerer
 }
@@ -0,0 +1,117 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package stateauthenticator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/httpcontext"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/state"
+)
+
+// APITokenService authenticates the API tokens that users can log in with.
+type APITokenService interface {
+	// AuthenticateAPIToken returns the API token with the given secret.
+	AuthenticateAPIToken(ctx context.Context, secret string) (user.APIToken, error)
+}
+
+// APITokenAuthenticator authenticates users that log in with an API token
+// minted by "juju add-token". The user must still have access to the
+// model, and is limited to the access allowed by the token.
+type APITokenAuthenticator struct {
+	statePool *state.StatePool
+	tokens    APITokenService
+}
+
+// NewAPITokenAuthenticator returns a new APITokenAuthenticator.
+func NewAPITokenAuthenticator(statePool *state.StatePool, tokens APITokenService) *APITokenAuthenticator {
+	return &APITokenAuthenticator{
+		statePool: statePool,
+		tokens:    tokens,
+	}
+}
+
+// Authenticate implements authentication.HTTPAuthenticator, for requests
+// with an API token as their bearer token. Other requests are left for
+// the next authenticator.
+func (a *APITokenAuthenticator) Authenticate(req *http.Request) (authentication.AuthInfo, error) {
+	scheme, secret, ok := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || !strings.HasPrefix(secret, user.APITokenPrefix) {
+		return authentication.AuthInfo{}, fmt.Errorf("api token %w", errors.NotFound)
+	}
+	modelUUID := httpcontext.RequestModelUUID(req)
+	if modelUUID == "" {
+		return authentication.AuthInfo{}, errors.New("model UUID not found")
+	}
+	return a.authenticate(req.Context(), modelUUID, secret)
+}
+
+// AuthenticateLoginRequest implements authentication.LoginAuthenticator.
+// Tokens that aren't API tokens are left for the next authenticator.
+func (a *APITokenAuthenticator) AuthenticateLoginRequest(
+	ctx context.Context,
+	_ string,
+	modelUUID string,
+	authParams authentication.AuthParams,
+) (authentication.AuthInfo, error) {
+	if !strings.HasPrefix(authParams.Token, user.APITokenPrefix) {
+		return authentication.AuthInfo{}, fmt.Errorf("api token %w", errors.NotSupported)
+	}
+	return a.authenticate(ctx, modelUUID, authParams.Token)
+}
+
+func (a *APITokenAuthenticator) authenticate(ctx context.Context, modelUUID, secret string) (authentication.AuthInfo, error) {
+	token, err := a.tokens.AuthenticateAPIToken(ctx, secret)
+	if errors.Is(err, usererrors.APITokenNotFound) || errors.Is(err, usererrors.APITokenExpired) {
+		return authentication.AuthInfo{}, errors.NewUnauthorized(err, "invalid API token")
+	} else if err != nil {
+		return authentication.AuthInfo{}, errors.Trace(err)
+	}
+	if token.ModelUUID != "" && modelUUID != "" && modelUUID != token.ModelUUID {
+		return authentication.AuthInfo{}, errors.Unauthorizedf("API token %q can't be used with model %q", token.Name, modelUUID)
+	}
+
+	st, err := a.statePool.Get(modelUUID)
+	if err != nil {
+		return authentication.AuthInfo{}, errors.Trace(err)
+	}
+	defer st.Release()
+
+	userTag := names.NewUserTag(token.UserName)
+	entity, err := modelUserEntityFinder{st.State}.FindEntity(userTag)
+	if err != nil {
+		return authentication.AuthInfo{}, errors.NewUnauthorized(err, "")
+	}
+	if u, ok := entity.(*modelUserEntity); ok {
+		if u.user != nil && u.user.IsDisabled() {
+			return authentication.AuthInfo{}, errors.Unauthorizedf("user %q is disabled", userTag.Id())
+		}
+		if err := u.UpdateLastLogin(); err != nil {
+			logger.Warningf("updating last login time for %v", userTag)
+		}
+	}
+
+	// The delegator outlives this request's use of the pooled state, so
+	// it uses the system state, which is never released.
+	systemState, err := a.statePool.SystemState()
+	if err != nil {
+		return authentication.AuthInfo{}, errors.Trace(err)
+	}
+	return authentication.AuthInfo{
+		Entity: entity,
+		Delegator: &authentication.APITokenPermissionDelegator{
+			PermissionDelegator: &PermissionDelegator{State: systemState},
+			Token:               token,
+		},
+	}, nil
+}
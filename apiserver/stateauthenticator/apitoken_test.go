@@ -0,0 +1,154 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package stateauthenticator_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/httpcontext"
+	"github.com/juju/juju/apiserver/stateauthenticator"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	statetesting "github.com/juju/juju/state/testing"
+	"github.com/juju/juju/testing/factory"
+)
+
+type apiTokenAuthenticatorSuite struct {
+	statetesting.StateSuite
+	tokens *MockAPITokenService
+}
+
+var _ = gc.Suite(&apiTokenAuthenticatorSuite{})
+
+const secret = user.APITokenPrefix + "deadbeef"
+
+func (s *apiTokenAuthenticatorSuite) setupMocks(c *gc.C) (*gomock.Controller, *stateauthenticator.APITokenAuthenticator) {
+	ctrl := gomock.NewController(c)
+	s.tokens = NewMockAPITokenService(ctrl)
+	return ctrl, stateauthenticator.NewAPITokenAuthenticator(s.StatePool, s.tokens)
+}
+
+func (s *apiTokenAuthenticatorSuite) token(userName, modelUUID string) user.APIToken {
+	return user.APIToken{
+		Name:      "ci",
+		UserName:  userName,
+		ModelUUID: modelUUID,
+		MaxAccess: permission.ReadAccess,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func (s *apiTokenAuthenticatorSuite) TestNotAPIToken(c *gc.C) {
+	ctrl, authenticator := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	_, err := authenticator.AuthenticateLoginRequest(context.Background(), "", s.State.ModelUUID(), authentication.AuthParams{Token: "token"})
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *apiTokenAuthenticatorSuite) TestAuthenticate(c *gc.C) {
+	ctrl, authenticator := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	bob := s.Factory.MakeUser(c, &factory.UserParams{Name: "bob", Access: permission.AdminAccess})
+	token := s.token("bob", s.State.ModelUUID())
+	s.tokens.EXPECT().AuthenticateAPIToken(gomock.Any(), secret).Return(token, nil)
+
+	authInfo, err := authenticator.AuthenticateLoginRequest(context.Background(), "", s.State.ModelUUID(), authentication.AuthParams{Token: secret})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(authInfo.Entity.Tag(), gc.Equals, bob.Tag())
+
+	// The user's admin access to the model is limited by the token.
+	access, err := authInfo.Delegator.SubjectPermissions(authInfo.Entity, s.Model.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.ReadAccess)
+}
+
+func (s *apiTokenAuthenticatorSuite) authenticateHTTP(authenticator *stateauthenticator.APITokenAuthenticator, header string) (authentication.AuthInfo, error) {
+	var (
+		authInfo authentication.AuthInfo
+		err      error
+	)
+	handler := &httpcontext.ImpliedModelHandler{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			authInfo, err = authenticator.Authenticate(req)
+		}),
+		ModelUUID: s.State.ModelUUID(),
+	}
+	req := httptest.NewRequest("GET", "/log", nil)
+	req.Header.Set("Authorization", header)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	return authInfo, err
+}
+
+func (s *apiTokenAuthenticatorSuite) TestAuthenticateHTTP(c *gc.C) {
+	ctrl, authenticator := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	bob := s.Factory.MakeUser(c, &factory.UserParams{Name: "bob", Access: permission.AdminAccess})
+	s.tokens.EXPECT().AuthenticateAPIToken(gomock.Any(), secret).Return(s.token("bob", s.State.ModelUUID()), nil)
+
+	authInfo, err := s.authenticateHTTP(authenticator, "Bearer "+secret)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(authInfo.Entity.Tag(), gc.Equals, bob.Tag())
+
+	access, err := authInfo.Delegator.SubjectPermissions(authInfo.Entity, s.Model.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.ReadAccess)
+}
+
+func (s *apiTokenAuthenticatorSuite) TestAuthenticateHTTPNotAPIToken(c *gc.C) {
+	ctrl, authenticator := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	_, err := s.authenticateHTTP(authenticator, "Bearer token")
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+	_, err = s.authenticateHTTP(authenticator, "Basic "+secret)
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *apiTokenAuthenticatorSuite) TestAuthenticateInvalidToken(c *gc.C) {
+	ctrl, authenticator := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.tokens.EXPECT().AuthenticateAPIToken(gomock.Any(), secret).Return(user.APIToken{}, usererrors.APITokenExpired)
+
+	_, err := authenticator.AuthenticateLoginRequest(context.Background(), "", s.State.ModelUUID(), authentication.AuthParams{Token: secret})
+	c.Check(err, jc.ErrorIs, errors.Unauthorized)
+}
+
+func (s *apiTokenAuthenticatorSuite) TestAuthenticateOtherModel(c *gc.C) {
+	ctrl, authenticator := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.Factory.MakeUser(c, &factory.UserParams{Name: "bob"})
+	token := s.token("bob", "deadbeef-1bad-500d-9000-4b1d0d06f00d")
+	s.tokens.EXPECT().AuthenticateAPIToken(gomock.Any(), secret).Return(token, nil)
+
+	_, err := authenticator.AuthenticateLoginRequest(context.Background(), "", s.State.ModelUUID(), authentication.AuthParams{Token: secret})
+	c.Check(err, gc.ErrorMatches, `API token "ci" can't be used with model ".*"`)
+	c.Check(err, jc.ErrorIs, errors.Unauthorized)
+}
+
+func (s *apiTokenAuthenticatorSuite) TestAuthenticateDisabledUser(c *gc.C) {
+	ctrl, authenticator := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.Factory.MakeUser(c, &factory.UserParams{Name: "bob", Disabled: true})
+	s.tokens.EXPECT().AuthenticateAPIToken(gomock.Any(), secret).Return(s.token("bob", ""), nil)
+
+	_, err := authenticator.AuthenticateLoginRequest(context.Background(), "", s.State.ModelUUID(), authentication.AuthParams{Token: secret})
+	c.Check(err, gc.ErrorMatches, `user "bob" is disabled`)
+	c.Check(err, jc.ErrorIs, errors.Unauthorized)
+}
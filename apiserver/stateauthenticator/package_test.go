@@ -10,6 +10,7 @@ import (
 )
 
 //go:generate go run go.uber.org/mock/mockgen -package stateauthenticator_test -destination controller_config_mock_test.go github.com/juju/juju/apiserver/stateauthenticator ControllerConfigGetter
+//go:generate go run go.uber.org/mock/mockgen -package stateauthenticator_test -destination apitoken_mock_test.go github.com/juju/juju/apiserver/stateauthenticator APITokenService
 
 func TestPackage(t *testing.T) {
 	coretesting.MgoTestPackage(t)
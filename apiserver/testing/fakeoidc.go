@@ -0,0 +1,212 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package testing
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/juju/errors"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+const (
+	fakeDeviceCode = "fake-device-code"
+	fakeUserCode   = "FAKE-CODE"
+)
+
+// OIDCIssuer is a fake OpenID Connect provider. It serves a discovery
+// document, its signing keys and the device authorization flow, and
+// issues ID tokens for a single client.
+type OIDCIssuer struct {
+	// URL is the issuer identifier, the https root of the fake server.
+	URL string
+
+	// ClientID is the client the ID tokens are issued for.
+	ClientID string
+
+	server     *httptest.Server
+	publicKeys jwk.Set
+	signingKey jwk.Key
+
+	mu           sync.Mutex
+	deviceClaims map[string]interface{}
+	pendingPolls int
+	deviceErr    string
+}
+
+// NewOIDCIssuer starts a fake OpenID Connect provider issuing ID tokens
+// for the client. It must be closed when the test is done.
+func NewOIDCIssuer(clientID string) (*OIDCIssuer, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	signingKey, err := jwk.FromRaw(privateKey)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	kid := uuid.NewString()
+	if err := signingKey.Set(jwk.KeyIDKey, kid); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := signingKey.Set(jwk.AlgorithmKey, jwa.RS256); err != nil {
+		return nil, errors.Trace(err)
+	}
+	publicKey, err := signingKey.PublicKey()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	publicKeys := jwk.NewSet()
+	if err := publicKeys.AddKey(publicKey); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	issuer := &OIDCIssuer{
+		ClientID:   clientID,
+		publicKeys: publicKeys,
+		signingKey: signingKey,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", issuer.serveDiscovery)
+	mux.HandleFunc("/jwks", issuer.serveKeys)
+	mux.HandleFunc("/device", issuer.serveDevice)
+	mux.HandleFunc("/token", issuer.serveToken)
+	issuer.server = httptest.NewTLSServer(mux)
+	issuer.URL = issuer.server.URL
+	return issuer, nil
+}
+
+// Close stops the fake provider.
+func (i *OIDCIssuer) Close() {
+	i.server.Close()
+}
+
+// Client returns an HTTP client which trusts the fake provider's
+// certificate.
+func (i *OIDCIssuer) Client() *http.Client {
+	return i.server.Client()
+}
+
+// IDToken returns a signed ID token for the client, expiring in an
+// hour. The claims are added to, and may override, the standard ones.
+func (i *OIDCIssuer) IDToken(claims map[string]interface{}) (string, error) {
+	builder := jwt.NewBuilder().
+		Issuer(i.URL).
+		Audience([]string{i.ClientID}).
+		IssuedAt(time.Now()).
+		Expiration(time.Now().Add(time.Hour))
+	for name, value := range claims {
+		builder = builder.Claim(name, value)
+	}
+	token, err := builder.Build()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.RS256, i.signingKey))
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(signed), nil
+}
+
+// SetDeviceFlow sets the claims of the ID token issued by the device
+// authorization flow, and how many times the client is told to keep
+// polling before it's issued.
+func (i *OIDCIssuer) SetDeviceFlow(claims map[string]interface{}, pendingPolls int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.deviceClaims = claims
+	i.pendingPolls = pendingPolls
+	i.deviceErr = ""
+}
+
+// DenyDeviceFlow makes the device authorization flow fail with the
+// given OAuth error code, eg "access_denied".
+func (i *OIDCIssuer) DenyDeviceFlow(code string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.deviceErr = code
+}
+
+func (i *OIDCIssuer) serveDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                        i.URL,
+		"jwks_uri":                      i.URL + "/jwks",
+		"device_authorization_endpoint": i.URL + "/device",
+		"token_endpoint":                i.URL + "/token",
+	})
+}
+
+func (i *OIDCIssuer) serveKeys(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, i.publicKeys)
+}
+
+func (i *OIDCIssuer) serveDevice(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost || req.FormValue("client_id") != i.ClientID {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_client"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"device_code":               fakeDeviceCode,
+		"user_code":                 fakeUserCode,
+		"verification_uri":          i.URL + "/activate",
+		"verification_uri_complete": i.URL + "/activate?user_code=" + fakeUserCode,
+		"expires_in":                600,
+		"interval":                  1,
+	})
+}
+
+func (i *OIDCIssuer) serveToken(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost ||
+		req.FormValue("client_id") != i.ClientID ||
+		req.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:device_code" ||
+		req.FormValue("device_code") != fakeDeviceCode {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	i.mu.Lock()
+	claims := i.deviceClaims
+	pending := i.pendingPolls > 0
+	if pending {
+		i.pendingPolls--
+	}
+	deviceErr := i.deviceErr
+	i.mu.Unlock()
+
+	switch {
+	case deviceErr != "":
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": deviceErr})
+		return
+	case pending:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "authorization_pending"})
+		return
+	}
+	idToken, err := i.IDToken(claims)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": "fake-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
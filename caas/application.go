@@ -9,8 +9,10 @@ import (
 	"github.com/juju/version/v2"
 	core "k8s.io/api/core/v1"
 
+	"github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/resources"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/internal/storage"
@@ -38,6 +40,20 @@ type Application interface {
 	// give full access to the cluster.
 	Trust(bool) error
 
+	// UpdateNetworkPolicy restricts the traffic allowed to reach the
+	// application's pods to that described by the supplied parameters.
+	UpdateNetworkPolicy(NetworkPolicyParam) error
+
+	// DeleteNetworkPolicy removes the application's network policy, if any.
+	DeleteNetworkPolicy() error
+
+	// UpdateIngress creates or updates the ingress routing external
+	// traffic to the application's service.
+	UpdateIngress(IngressParam) error
+
+	// DeleteIngress removes the application's ingress, if any.
+	DeleteIngress() error
+
 	State() (ApplicationState, error)
 
 	// Units of the application fetched from kubernetes by matching pod labels.
@@ -65,6 +81,46 @@ type ServiceParam struct {
 	Ports []ServicePort `json:"ports"`
 }
 
+// NetworkPolicyParam defines parameters for an UpdateNetworkPolicy request.
+type NetworkPolicyParam struct {
+	// RelatedApplications are the applications in the same model whose
+	// pods may reach any port on the application's pods. Applications
+	// offered by other models are reached through IngressCIDRs instead.
+	RelatedApplications []string `json:"related-applications,omitempty"`
+
+	// IngressCIDRs are the source CIDRs which may reach Ports. They are
+	// empty unless the application is exposed.
+	IngressCIDRs []string `json:"ingress-cidrs,omitempty"`
+
+	// Ports are the opened port ranges reachable from IngressCIDRs.
+	Ports []network.PortRange `json:"ports,omitempty"`
+
+	// IngressPort is the TCP port routed to by the application's ingress,
+	// zero if there is none. The namespace of the ingress controller isn't
+	// known, so pods in any namespace may reach it.
+	IngressPort int `json:"ingress-port,omitempty"`
+}
+
+// IngressParam defines parameters for an UpdateIngress request.
+type IngressParam struct {
+	// Host is the host name routed to the application.
+	Host string `json:"host"`
+
+	// Path is the HTTP path prefix routed to the application.
+	Path string `json:"path,omitempty"`
+
+	// Port is the application service port traffic is routed to.
+	Port int `json:"port"`
+
+	// TLSSecretName is the name of the secret holding the certificate
+	// used to terminate TLS for Host. TLS is not configured if empty.
+	TLSSecretName string `json:"tls-secret-name,omitempty"`
+
+	// ClassName is the ingress class implementing the ingress. The
+	// cluster's default ingress class is used if empty.
+	ClassName string `json:"class-name,omitempty"`
+}
+
 // ServiceInterface provides the API to get/set service.
 type ServiceInterface interface {
 	// UpdateService updates the default service with specific service type and port mappings.
@@ -77,6 +133,10 @@ type ServiceInterface interface {
 type ApplicationState struct {
 	DesiredReplicas int
 	Replicas        []string
+
+	// AutoscaledReplicas is the number of replicas recommended by the
+	// application's autoscaler, or 0 if it isn't autoscaled.
+	AutoscaledReplicas int
 }
 
 // ApplicationConfig is the config passed to the application units.
@@ -135,6 +195,15 @@ type ApplicationConfig struct {
 	// Trust is set to true to give the application cloud access.
 	Trust bool
 
+	// MinAvailable is the number of application pods which must remain
+	// available during voluntary disruptions, such as node drains. No
+	// disruption budget is maintained when it is zero.
+	MinAvailable int
+
+	// Autoscaling is the policy by which the substrate scales the number
+	// of application pods. The scale is managed by Juju alone when nil.
+	Autoscaling *application.AutoscalingPolicy
+
 	// InitialScale is used to provide the initial desired scale of the application.
 	// After the application is created, InitialScale has no effect.
 	InitialScale int
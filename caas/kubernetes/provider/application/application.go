@@ -164,6 +164,14 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 		return errors.Annotatef(err, "applying service account and secrets")
 	}
 
+	if err := a.applyPodDisruptionBudget(applier, config); err != nil {
+		return errors.Annotatef(err, "applying pod disruption budget")
+	}
+
+	if err := a.applyHorizontalPodAutoscaler(applier, config); err != nil {
+		return errors.Annotatef(err, "applying horizontal pod autoscaler")
+	}
+
 	if err := a.configureDefaultService(a.annotations(config)); err != nil {
 		return errors.Annotatef(err, "ensuring the default service %q", a.name)
 	}
@@ -600,6 +608,10 @@ func (a *app) Exists() (caas.DeploymentState, error) {
 		{"clusterRoleBinding", a.clusterRoleBindingExists, false},
 		{"clusterRole", a.clusterRoleExists, false},
 		{"serviceAccount", a.serviceAccountExists, false},
+		{"networkPolicy", a.networkPolicyExists, false},
+		{"ingress", a.ingressExists, false},
+		{"podDisruptionBudget", a.podDisruptionBudgetExists, false},
+		{"horizontalPodAutoscaler", a.horizontalPodAutoscalerExists, false},
 	}
 	switch a.deploymentType {
 	case caas.DeploymentStateful:
@@ -985,6 +997,10 @@ func (a *app) Delete() error {
 	applier.Delete(resources.NewClusterRoleBinding(a.qualifiedClusterName(), nil))
 	applier.Delete(resources.NewClusterRole(a.qualifiedClusterName(), nil))
 	applier.Delete(resources.NewServiceAccount(a.serviceAccountName(), a.namespace, nil))
+	applier.Delete(resources.NewNetworkPolicy(a.networkPolicyName(), a.namespace, nil))
+	applier.Delete(resources.NewIngress(a.ingressName(), a.namespace, nil))
+	applier.Delete(resources.NewPodDisruptionBudget(a.podDisruptionBudgetName(), a.namespace, nil))
+	applier.Delete(resources.NewHorizontalPodAutoscaler(a.horizontalPodAutoscalerName(), a.namespace, nil))
 
 	// Cleanup lists of resources.
 	cleanup := []resources.Resource(nil)
@@ -1064,6 +1080,9 @@ func (a *app) State() (caas.ApplicationState, error) {
 			return caas.ApplicationState{}, errors.Errorf("missing replicas")
 		}
 		state.DesiredReplicas = int(*ss.Spec.Replicas)
+		if state.AutoscaledReplicas, err = a.autoscaledReplicas(); err != nil {
+			return caas.ApplicationState{}, errors.Trace(err)
+		}
 	case caas.DeploymentStateless:
 		d := resources.NewDeployment(a.name, a.namespace, nil)
 		err := d.Get(context.Background(), a.client)
@@ -1074,6 +1093,9 @@ func (a *app) State() (caas.ApplicationState, error) {
 			return caas.ApplicationState{}, errors.Errorf("missing replicas")
 		}
 		state.DesiredReplicas = int(*d.Spec.Replicas)
+		if state.AutoscaledReplicas, err = a.autoscaledReplicas(); err != nil {
+			return caas.ApplicationState{}, errors.Trace(err)
+		}
 	case caas.DeploymentDaemon:
 		d := resources.NewDaemonSet(a.name, a.namespace, nil)
 		err := d.Get(context.Background(), a.client)
@@ -1646,6 +1668,7 @@ func (a *app) ApplicationPodSpec(config caas.ApplicationConfig) (*corev1.PodSpec
 	if err != nil {
 		return nil, errors.Annotate(err, "processing constraints")
 	}
+	spec.TopologySpreadConstraints = a.topologySpreadConstraints(config)
 	if config.Rootless {
 		spec.SecurityContext = &corev1.PodSecurityContext{
 			FSGroup:            pointer.Int64(constants.JujuFSGroupID),
@@ -424,6 +424,22 @@ func (s *applicationSuite) assertDelete(c *gc.C, app caas.Application) {
 	statefulSets, err := s.client.AppsV1().StatefulSets(s.namespace).List(context.TODO(), metav1.ListOptions{})
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(statefulSets.Items, gc.IsNil)
+
+	networkPolicies, err := s.client.NetworkingV1().NetworkPolicies(s.namespace).List(context.TODO(), metav1.ListOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(networkPolicies.Items, gc.IsNil)
+
+	ingresses, err := s.client.NetworkingV1().Ingresses(s.namespace).List(context.TODO(), metav1.ListOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ingresses.Items, gc.IsNil)
+
+	pdbs, err := s.client.PolicyV1().PodDisruptionBudgets(s.namespace).List(context.TODO(), metav1.ListOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(pdbs.Items, gc.IsNil)
+
+	hpas, err := s.client.AutoscalingV2().HorizontalPodAutoscalers(s.namespace).List(context.TODO(), metav1.ListOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(hpas.Items, gc.IsNil)
 }
 
 func getPodSpec() corev1.PodSpec {
@@ -1233,6 +1249,10 @@ func (s *applicationSuite) TestDeleteStateful(c *gc.C) {
 		s.applier.EXPECT().Delete(resources.NewClusterRoleBinding("test-gitlab", nil)),
 		s.applier.EXPECT().Delete(resources.NewClusterRole("test-gitlab", nil)),
 		s.applier.EXPECT().Delete(resources.NewServiceAccount("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewNetworkPolicy("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewIngress("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewPodDisruptionBudget("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewHorizontalPodAutoscaler("gitlab", "test", nil)),
 		s.applier.EXPECT().Run(context.Background(), s.client, false).Return(nil),
 	)
 	c.Assert(app.Delete(), jc.ErrorIsNil)
@@ -1251,6 +1271,10 @@ func (s *applicationSuite) TestDeleteStateless(c *gc.C) {
 		s.applier.EXPECT().Delete(resources.NewClusterRoleBinding("test-gitlab", nil)),
 		s.applier.EXPECT().Delete(resources.NewClusterRole("test-gitlab", nil)),
 		s.applier.EXPECT().Delete(resources.NewServiceAccount("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewNetworkPolicy("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewIngress("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewPodDisruptionBudget("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewHorizontalPodAutoscaler("gitlab", "test", nil)),
 		s.applier.EXPECT().Run(context.Background(), s.client, false).Return(nil),
 	)
 	c.Assert(app.Delete(), jc.ErrorIsNil)
@@ -1269,6 +1293,10 @@ func (s *applicationSuite) TestDeleteDaemon(c *gc.C) {
 		s.applier.EXPECT().Delete(resources.NewClusterRoleBinding("test-gitlab", nil)),
 		s.applier.EXPECT().Delete(resources.NewClusterRole("test-gitlab", nil)),
 		s.applier.EXPECT().Delete(resources.NewServiceAccount("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewNetworkPolicy("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewIngress("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewPodDisruptionBudget("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewHorizontalPodAutoscaler("gitlab", "test", nil)),
 		s.applier.EXPECT().Run(context.Background(), s.client, false).Return(nil),
 	)
 	c.Assert(app.Delete(), jc.ErrorIsNil)
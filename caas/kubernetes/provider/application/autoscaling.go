@@ -0,0 +1,173 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/juju/errors"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/caas/kubernetes/provider/resources"
+)
+
+// applyHorizontalPodAutoscaler maintains a horizontal pod autoscaler which
+// scales the application's workload within the bounds of its autoscaling
+// policy, or removes it when the application has no autoscaling policy.
+// The autoscaler only ever scales up; scaling down has to tear down units,
+// so it is left to the caas application provisioner, which reconciles the
+// autoscaler's recommendation back into the application's scale.
+func (a *app) applyHorizontalPodAutoscaler(applier resources.Applier, config caas.ApplicationConfig) error {
+	policy := config.Autoscaling
+	if policy == nil {
+		applier.Delete(resources.NewHorizontalPodAutoscaler(a.horizontalPodAutoscalerName(), a.namespace, nil))
+		return nil
+	}
+	if err := policy.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+
+	var kind string
+	switch a.deploymentType {
+	case caas.DeploymentStateful:
+		kind = "StatefulSet"
+	case caas.DeploymentStateless:
+		kind = "Deployment"
+	default:
+		return errors.NotSupportedf("autoscaling deployment type %q", a.deploymentType)
+	}
+
+	scaleDownDisabled := autoscalingv2.DisabledPolicySelect
+	var metrics []autoscalingv2.MetricSpec
+	addMetric := func(name corev1.ResourceName, target int) {
+		if target == 0 {
+			return
+		}
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: name,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: pointer.Int32(int32(target)),
+				},
+			},
+		})
+	}
+	addMetric(corev1.ResourceCPU, policy.CPUTarget)
+	addMetric(corev1.ResourceMemory, policy.MemoryTarget)
+
+	hpa := resources.NewHorizontalPodAutoscaler(a.horizontalPodAutoscalerName(), a.namespace, &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      a.labels(),
+			Annotations: a.annotations(config),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       kind,
+				Name:       a.name,
+			},
+			MinReplicas: pointer.Int32(int32(policy.MinUnits)),
+			MaxReplicas: int32(policy.MaxUnits),
+			Metrics:     metrics,
+			Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+				ScaleDown: &autoscalingv2.HPAScalingRules{
+					SelectPolicy: &scaleDownDisabled,
+				},
+			},
+		},
+	})
+	applier.Apply(hpa)
+	return nil
+}
+
+func (a *app) horizontalPodAutoscalerName() string {
+	return a.name
+}
+
+const (
+	// autoscaleTolerance is how far the observed utilisation may stray
+	// from its target before a change in scale is recommended, matching
+	// the default of the kubernetes controller manager.
+	autoscaleTolerance = 0.1
+
+	// autoscaleDownStabilisation is how long the application has to have
+	// run at its current scale before a scale down is recommended,
+	// matching the default scale down stabilisation window.
+	autoscaleDownStabilisation = 5 * time.Minute
+)
+
+// autoscaledReplicas returns the number of replicas recommended by the
+// application's horizontal pod autoscaler, or 0 if it has none.
+// As the autoscaler doesn't scale down, a scale down is recommended
+// here from the utilisation it observes in the same way it would itself.
+func (a *app) autoscaledReplicas() (int, error) {
+	hpa := resources.NewHorizontalPodAutoscaler(a.horizontalPodAutoscalerName(), a.namespace, nil)
+	err := hpa.Get(context.Background(), a.client)
+	if errors.Is(err, errors.NotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Trace(err)
+	}
+	current := int(hpa.Status.CurrentReplicas)
+	desired := int(hpa.Status.DesiredReplicas)
+	if desired > current || current == 0 {
+		return desired, nil
+	}
+	if last := hpa.Status.LastScaleTime; last != nil && a.clock.Now().Sub(last.Time) < autoscaleDownStabilisation {
+		return current, nil
+	}
+
+	targets := make(map[corev1.ResourceName]int32)
+	for _, metric := range hpa.Spec.Metrics {
+		if metric.Resource != nil && metric.Resource.Target.AverageUtilization != nil {
+			targets[metric.Resource.Name] = *metric.Resource.Target.AverageUtilization
+		}
+	}
+	recommended := 0
+	for _, metric := range hpa.Status.CurrentMetrics {
+		if metric.Resource == nil || metric.Resource.Current.AverageUtilization == nil {
+			continue
+		}
+		target, ok := targets[metric.Resource.Name]
+		if !ok || target == 0 {
+			continue
+		}
+		ratio := float64(*metric.Resource.Current.AverageUtilization) / float64(target)
+		replicas := current
+		if math.Abs(1-ratio) > autoscaleTolerance {
+			replicas = int(math.Ceil(ratio * float64(current)))
+		}
+		if replicas > recommended {
+			recommended = replicas
+		}
+	}
+	if recommended == 0 || recommended > current {
+		// Without metrics, or while they call for more replicas,
+		// leave any scaling up to the autoscaler.
+		return current, nil
+	}
+	if min := hpa.Spec.MinReplicas; min != nil && recommended < int(*min) {
+		recommended = int(*min)
+	}
+	return recommended, nil
+}
+
+func (a *app) horizontalPodAutoscalerExists() (exists bool, terminating bool, err error) {
+	hpa := resources.NewHorizontalPodAutoscaler(a.horizontalPodAutoscalerName(), a.namespace, nil)
+	err = hpa.Get(context.Background(), a.client)
+	if errors.Is(err, errors.NotFound) {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, errors.Trace(err)
+	}
+	return true, hpa.DeletionTimestamp != nil, nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/juju/juju/caas"
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+)
+
+func (s *applicationSuite) autoscalingConfig(policy *coreapplication.AutoscalingPolicy) caas.ApplicationConfig {
+	config := s.disruptionConfig(0, constraints.Value{})
+	config.Autoscaling = policy
+	return config
+}
+
+func (s *applicationSuite) TestEnsureHorizontalPodAutoscaler(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.Ensure(s.autoscalingConfig(&coreapplication.AutoscalingPolicy{
+		MinUnits:     2,
+		MaxUnits:     6,
+		CPUTarget:    75,
+		MemoryTarget: 60,
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+
+	disabled := autoscalingv2.DisabledPolicySelect
+	hpa, err := s.client.AutoscalingV2().HorizontalPodAutoscalers(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(hpa.Labels, jc.DeepEquals, map[string]string{
+		"app.kubernetes.io/managed-by": "juju",
+		"app.kubernetes.io/name":       "gitlab",
+	})
+	c.Assert(hpa.Spec, jc.DeepEquals, autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "StatefulSet",
+			Name:       "gitlab",
+		},
+		MinReplicas: pointer.Int32(2),
+		MaxReplicas: 6,
+		Metrics: []autoscalingv2.MetricSpec{{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: pointer.Int32(75),
+				},
+			},
+		}, {
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceMemory,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: pointer.Int32(60),
+				},
+			},
+		}},
+		Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleDown: &autoscalingv2.HPAScalingRules{
+				SelectPolicy: &disabled,
+			},
+		},
+	})
+
+	// Removing the policy removes the autoscaler.
+	err = app.Ensure(s.autoscalingConfig(nil))
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.client.AutoscalingV2().HorizontalPodAutoscalers(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.Satisfies, k8serrors.IsNotFound)
+}
+
+func (s *applicationSuite) TestEnsureHorizontalPodAutoscalerStateless(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateless, false)
+	defer ctrl.Finish()
+
+	err := app.Ensure(s.autoscalingConfig(&coreapplication.AutoscalingPolicy{
+		MinUnits:  1,
+		MaxUnits:  3,
+		CPUTarget: 80,
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+
+	hpa, err := s.client.AutoscalingV2().HorizontalPodAutoscalers(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(hpa.Spec.ScaleTargetRef.Kind, gc.Equals, "Deployment")
+	c.Assert(hpa.Spec.Metrics, gc.HasLen, 1)
+}
+
+func (s *applicationSuite) TestEnsureHorizontalPodAutoscalerNotValid(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.Ensure(s.autoscalingConfig(&coreapplication.AutoscalingPolicy{
+		MinUnits:  3,
+		MaxUnits:  2,
+		CPUTarget: 80,
+	}))
+	c.Assert(err, gc.ErrorMatches, `applying horizontal pod autoscaler: autoscale max units 2 less than min units 3 not valid`)
+}
+
+func (s *applicationSuite) TestEnsureHorizontalPodAutoscalerDaemonNotSupported(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentDaemon, false)
+	defer ctrl.Finish()
+
+	err := app.Ensure(s.autoscalingConfig(&coreapplication.AutoscalingPolicy{
+		MinUnits:  1,
+		MaxUnits:  2,
+		CPUTarget: 80,
+	}))
+	c.Assert(err, gc.ErrorMatches, `applying horizontal pod autoscaler: autoscaling deployment type "daemon" not supported`)
+}
+
+func (s *applicationSuite) assertAutoscaledReplicas(c *gc.C, status autoscalingv2.HorizontalPodAutoscalerStatus, expected int) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	_, err := s.client.AppsV1().StatefulSets(s.namespace).Create(context.Background(), &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: s.appName},
+		Spec:       appsv1.StatefulSetSpec{Replicas: pointer.Int32(status.CurrentReplicas)},
+	}, metav1.CreateOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.client.AutoscalingV2().HorizontalPodAutoscalers(s.namespace).Create(context.Background(), &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: s.appName},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			MinReplicas: pointer.Int32(2),
+			MaxReplicas: 10,
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: pointer.Int32(80),
+					},
+				},
+			}},
+		},
+		Status: status,
+	}, metav1.CreateOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	state, err := app.State()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(state.DesiredReplicas, gc.Equals, int(status.CurrentReplicas))
+	c.Assert(state.AutoscaledReplicas, gc.Equals, expected)
+}
+
+func cpuUtilisation(utilisation int32) []autoscalingv2.MetricStatus {
+	return []autoscalingv2.MetricStatus{{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricStatus{
+			Name:    corev1.ResourceCPU,
+			Current: autoscalingv2.MetricValueStatus{AverageUtilization: pointer.Int32(utilisation)},
+		},
+	}}
+}
+
+func (s *applicationSuite) TestStateAutoscaledUp(c *gc.C) {
+	s.assertAutoscaledReplicas(c, autoscalingv2.HorizontalPodAutoscalerStatus{
+		CurrentReplicas: 4,
+		DesiredReplicas: 6,
+		CurrentMetrics:  cpuUtilisation(120),
+	}, 6)
+}
+
+func (s *applicationSuite) TestStateAutoscaledDown(c *gc.C) {
+	s.assertAutoscaledReplicas(c, autoscalingv2.HorizontalPodAutoscalerStatus{
+		CurrentReplicas: 6,
+		DesiredReplicas: 6,
+		CurrentMetrics:  cpuUtilisation(40),
+	}, 3)
+}
+
+func (s *applicationSuite) TestStateAutoscaledDownToMinimum(c *gc.C) {
+	s.assertAutoscaledReplicas(c, autoscalingv2.HorizontalPodAutoscalerStatus{
+		CurrentReplicas: 6,
+		DesiredReplicas: 6,
+		CurrentMetrics:  cpuUtilisation(5),
+	}, 2)
+}
+
+func (s *applicationSuite) TestStateAutoscaledWithinTolerance(c *gc.C) {
+	s.assertAutoscaledReplicas(c, autoscalingv2.HorizontalPodAutoscalerStatus{
+		CurrentReplicas: 6,
+		DesiredReplicas: 6,
+		CurrentMetrics:  cpuUtilisation(75),
+	}, 6)
+}
+
+func (s *applicationSuite) TestStateAutoscaledNoMetrics(c *gc.C) {
+	s.assertAutoscaledReplicas(c, autoscalingv2.HorizontalPodAutoscalerStatus{
+		CurrentReplicas: 6,
+		DesiredReplicas: 6,
+	}, 6)
+}
+
+func (s *applicationSuite) TestStateAutoscaledDownStabilising(c *gc.C) {
+	lastScale := metav1.NewTime(s.clock.Now().Add(-time.Minute))
+	s.assertAutoscaledReplicas(c, autoscalingv2.HorizontalPodAutoscalerStatus{
+		CurrentReplicas: 6,
+		DesiredReplicas: 6,
+		LastScaleTime:   &lastScale,
+		CurrentMetrics:  cpuUtilisation(40),
+	}, 6)
+}
+
+func (s *applicationSuite) TestStateNotAutoscaled(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	_, err := s.client.AppsV1().StatefulSets(s.namespace).Create(context.Background(), &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: s.appName},
+		Spec:       appsv1.StatefulSetSpec{Replicas: pointer.Int32(3)},
+	}, metav1.CreateOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	state, err := app.State()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(state.AutoscaledReplicas, gc.Equals, 0)
+}
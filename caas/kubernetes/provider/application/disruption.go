@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/caas/kubernetes/provider/resources"
+)
+
+const (
+	// zoneTopologyKey is the well known node label holding the
+	// node's availability zone.
+	zoneTopologyKey = "topology.kubernetes.io/zone"
+
+	// hostnameTopologyKey is the well known node label holding the
+	// node's host name.
+	hostnameTopologyKey = "kubernetes.io/hostname"
+)
+
+// applyPodDisruptionBudget maintains a pod disruption budget which keeps
+// MinAvailable pods of the application running during voluntary
+// disruptions, or removes it when MinAvailable is zero.
+func (a *app) applyPodDisruptionBudget(applier resources.Applier, config caas.ApplicationConfig) error {
+	if config.MinAvailable < 0 {
+		return errors.NotValidf("min available %d", config.MinAvailable)
+	}
+	if config.MinAvailable == 0 {
+		applier.Delete(resources.NewPodDisruptionBudget(a.podDisruptionBudgetName(), a.namespace, nil))
+		return nil
+	}
+	minAvailable := intstr.FromInt(config.MinAvailable)
+	pdb := resources.NewPodDisruptionBudget(a.podDisruptionBudgetName(), a.namespace, &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      a.labels(),
+			Annotations: a.annotations(config),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: a.selectorLabels(),
+			},
+		},
+	})
+	applier.Apply(pdb)
+	return nil
+}
+
+// topologySpreadConstraints returns the constraints spreading the
+// application's pods evenly across the zones in the zones constraint and,
+// when a disruption budget is requested, across nodes so that draining a
+// single node takes down as few units as possible.
+func (a *app) topologySpreadConstraints(config caas.ApplicationConfig) []corev1.TopologySpreadConstraint {
+	var out []corev1.TopologySpreadConstraint
+	selector := &metav1.LabelSelector{
+		MatchLabels: a.selectorLabels(),
+	}
+	if config.Constraints.Zones != nil && len(*config.Constraints.Zones) > 1 {
+		out = append(out, corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       zoneTopologyKey,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector:     selector,
+		})
+	}
+	if config.MinAvailable > 0 {
+		out = append(out, corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       hostnameTopologyKey,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     selector,
+		})
+	}
+	return out
+}
+
+func (a *app) podDisruptionBudgetName() string {
+	return a.name
+}
+
+func (a *app) podDisruptionBudgetExists() (exists bool, terminating bool, err error) {
+	pdb := resources.NewPodDisruptionBudget(a.podDisruptionBudgetName(), a.namespace, nil)
+	err = pdb.Get(context.Background(), a.client)
+	if errors.Is(err, errors.NotFound) {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, errors.Trace(err)
+	}
+	return true, pdb.DeletionTimestamp != nil, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version/v2"
+	gc "gopkg.in/check.v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/core/constraints"
+	coreresources "github.com/juju/juju/core/resources"
+)
+
+func (s *applicationSuite) disruptionConfig(minAvailable int, cons constraints.Value) caas.ApplicationConfig {
+	return caas.ApplicationConfig{
+		AgentVersion:       version.MustParse(defaultAgentVersion),
+		AgentImagePath:     "operator/image-path:1.1.1",
+		CharmBaseImagePath: "ubuntu@22.04",
+		Containers: map[string]caas.ContainerConfig{
+			"gitlab": {
+				Name: "gitlab",
+				Image: coreresources.DockerImageDetails{
+					RegistryPath: "docker.io/library/gitlab:latest",
+				},
+			},
+		},
+		Constraints:  cons,
+		MinAvailable: minAvailable,
+		InitialScale: 3,
+	}
+}
+
+func (s *applicationSuite) TestEnsurePodDisruptionBudget(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateless, false)
+	defer ctrl.Finish()
+
+	err := app.Ensure(s.disruptionConfig(2, constraints.Value{}))
+	c.Assert(err, jc.ErrorIsNil)
+
+	pdb, err := s.client.PolicyV1().PodDisruptionBudgets(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(pdb.Labels, jc.DeepEquals, map[string]string{
+		"app.kubernetes.io/managed-by": "juju",
+		"app.kubernetes.io/name":       "gitlab",
+	})
+	minAvailable := intstr.FromInt(2)
+	c.Assert(pdb.Spec.MinAvailable, jc.DeepEquals, &minAvailable)
+	c.Assert(pdb.Spec.Selector, jc.DeepEquals, &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app.kubernetes.io/name": "gitlab"},
+	})
+
+	// Resetting min available removes the budget.
+	err = app.Ensure(s.disruptionConfig(0, constraints.Value{}))
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.client.PolicyV1().PodDisruptionBudgets(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.Satisfies, k8serrors.IsNotFound)
+}
+
+func (s *applicationSuite) TestEnsurePodDisruptionBudgetNotValid(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateless, false)
+	defer ctrl.Finish()
+
+	err := app.Ensure(s.disruptionConfig(-1, constraints.Value{}))
+	c.Assert(err, gc.ErrorMatches, `applying pod disruption budget: min available -1 not valid`)
+}
+
+func (s *applicationSuite) TestApplicationPodSpecTopologySpread(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateless, false)
+	defer ctrl.Finish()
+
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app.kubernetes.io/name": "gitlab"},
+	}
+
+	spec, err := app.ApplicationPodSpec(s.disruptionConfig(0, constraints.MustParse("zones=a")))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(spec.TopologySpreadConstraints, gc.HasLen, 0)
+
+	spec, err = app.ApplicationPodSpec(s.disruptionConfig(2, constraints.MustParse("zones=a,b")))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(spec.TopologySpreadConstraints, jc.DeepEquals, []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       "topology.kubernetes.io/zone",
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+		LabelSelector:     selector,
+	}, {
+		MaxSkew:           1,
+		TopologyKey:       "kubernetes.io/hostname",
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector:     selector,
+	}})
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/caas/kubernetes/provider/resources"
+)
+
+// UpdateIngress maintains an ingress which routes traffic for the
+// requested host and path to a port of the application's service.
+func (a *app) UpdateIngress(param caas.IngressParam) error {
+	logger.Debugf("application %q, ingress %+v", a.name, param)
+	if param.Host == "" {
+		return errors.NotValidf("empty ingress host")
+	}
+	if param.Port <= 0 {
+		return errors.NotValidf("ingress port %d", param.Port)
+	}
+	path := param.Path
+	if path == "" {
+		path = "/"
+	}
+
+	pathType := networkingv1.PathTypePrefix
+	spec := networkingv1.IngressSpec{
+		Rules: []networkingv1.IngressRule{{
+			Host: param.Host,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     path,
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{
+								Name: a.name,
+								Port: networkingv1.ServiceBackendPort{
+									Number: int32(param.Port),
+								},
+							},
+						},
+					}},
+				},
+			},
+		}},
+	}
+	if param.ClassName != "" {
+		className := param.ClassName
+		spec.IngressClassName = &className
+	}
+	if param.TLSSecretName != "" {
+		spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      []string{param.Host},
+			SecretName: param.TLSSecretName,
+		}}
+	}
+
+	ingress := resources.NewIngress(a.ingressName(), a.namespace, &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: a.labels(),
+		},
+		Spec: spec,
+	})
+	applier := a.newApplier()
+	applier.Apply(ingress)
+	err := applier.Run(context.Background(), a.client, false)
+	return errors.Annotatef(err, "updating ingress for %q", a.name)
+}
+
+// DeleteIngress removes the application's ingress.
+func (a *app) DeleteIngress() error {
+	applier := a.newApplier()
+	applier.Delete(resources.NewIngress(a.ingressName(), a.namespace, nil))
+	err := applier.Run(context.Background(), a.client, false)
+	return errors.Annotatef(err, "deleting ingress for %q", a.name)
+}
+
+func (a *app) ingressName() string {
+	return a.name
+}
+
+func (a *app) ingressExists() (exists bool, terminating bool, err error) {
+	ing := resources.NewIngress(a.ingressName(), a.namespace, nil)
+	err = ing.Get(context.Background(), a.client)
+	if errors.Is(err, errors.NotFound) {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, errors.Trace(err)
+	}
+	return true, ing.DeletionTimestamp != nil, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/juju/juju/caas"
+)
+
+func (s *applicationSuite) TestUpdateIngress(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.UpdateIngress(caas.IngressParam{
+		Host:          "gitlab.example.com",
+		Path:          "/git",
+		Port:          8080,
+		TLSSecretName: "gitlab-tls",
+		ClassName:     "nginx",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	ing, err := s.client.NetworkingV1().Ingresses(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ing.Labels, jc.DeepEquals, map[string]string{
+		"app.kubernetes.io/managed-by": "juju",
+		"app.kubernetes.io/name":       "gitlab",
+	})
+	className := "nginx"
+	pathType := networkingv1.PathTypePrefix
+	c.Assert(ing.Spec, jc.DeepEquals, networkingv1.IngressSpec{
+		IngressClassName: &className,
+		TLS: []networkingv1.IngressTLS{{
+			Hosts:      []string{"gitlab.example.com"},
+			SecretName: "gitlab-tls",
+		}},
+		Rules: []networkingv1.IngressRule{{
+			Host: "gitlab.example.com",
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     "/git",
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{
+								Name: "gitlab",
+								Port: networkingv1.ServiceBackendPort{Number: 8080},
+							},
+						},
+					}},
+				},
+			},
+		}},
+	})
+}
+
+func (s *applicationSuite) TestUpdateIngressDefaults(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.UpdateIngress(caas.IngressParam{
+		Host: "gitlab.example.com",
+		Port: 80,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	ing, err := s.client.NetworkingV1().Ingresses(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ing.Spec.IngressClassName, gc.IsNil)
+	c.Assert(ing.Spec.TLS, gc.HasLen, 0)
+	c.Assert(ing.Spec.Rules, gc.HasLen, 1)
+	c.Assert(ing.Spec.Rules[0].HTTP.Paths[0].Path, gc.Equals, "/")
+}
+
+func (s *applicationSuite) TestUpdateIngressNotValid(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.UpdateIngress(caas.IngressParam{Port: 80})
+	c.Assert(err, gc.ErrorMatches, "empty ingress host not valid")
+
+	err = app.UpdateIngress(caas.IngressParam{Host: "gitlab.example.com"})
+	c.Assert(err, gc.ErrorMatches, "ingress port 0 not valid")
+}
+
+func (s *applicationSuite) TestDeleteIngress(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.UpdateIngress(caas.IngressParam{
+		Host: "gitlab.example.com",
+		Port: 80,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = app.DeleteIngress()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.client.NetworkingV1().Ingresses(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.Satisfies, k8serrors.IsNotFound)
+
+	// Deleting a missing ingress is not an error.
+	err = app.DeleteIngress()
+	c.Assert(err, jc.ErrorIsNil)
+}
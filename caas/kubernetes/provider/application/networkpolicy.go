@@ -0,0 +1,160 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/caas/kubernetes/provider/resources"
+	"github.com/juju/juju/caas/kubernetes/provider/utils"
+	"github.com/juju/juju/core/network"
+)
+
+// UpdateNetworkPolicy maintains a network policy which only admits traffic
+// to the application's pods from the pods of the application itself and of
+// its related applications, from the ingress CIDRs to the opened ports, and
+// from the pods of any namespace to the port routed to by its ingress.
+func (a *app) UpdateNetworkPolicy(param caas.NetworkPolicyParam) error {
+	applier := a.newApplier()
+	if err := a.applyNetworkPolicy(applier, param); err != nil {
+		return errors.Trace(err)
+	}
+	err := applier.Run(context.Background(), a.client, false)
+	return errors.Annotatef(err, "updating network policy for %q", a.name)
+}
+
+// DeleteNetworkPolicy removes the application's network policy.
+func (a *app) DeleteNetworkPolicy() error {
+	applier := a.newApplier()
+	applier.Delete(resources.NewNetworkPolicy(a.networkPolicyName(), a.namespace, nil))
+	err := applier.Run(context.Background(), a.client, false)
+	return errors.Annotatef(err, "deleting network policy for %q", a.name)
+}
+
+func (a *app) applyNetworkPolicy(applier resources.Applier, param caas.NetworkPolicyParam) error {
+	logger.Debugf("application %q, network policy %+v", a.name, param)
+	ingress := []networkingv1.NetworkPolicyIngressRule{{
+		From: a.relatedPeers(param.RelatedApplications),
+	}}
+
+	ports, err := networkPolicyPorts(param.Ports)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Exposing an application without opening any ports gives
+	// nothing to reach, and a rule without ports would admit all of them.
+	if len(param.IngressCIDRs) > 0 && len(ports) > 0 {
+		rule := networkingv1.NetworkPolicyIngressRule{Ports: ports}
+		for _, cidr := range set.NewStrings(param.IngressCIDRs...).SortedValues() {
+			rule.From = append(rule.From, networkingv1.NetworkPolicyPeer{
+				IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+			})
+		}
+		ingress = append(ingress, rule)
+	}
+	if param.IngressPort > 0 {
+		protocol := corev1.ProtocolTCP
+		port := intstr.FromInt(param.IngressPort)
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			Ports: []networkingv1.NetworkPolicyPort{{
+				Protocol: &protocol,
+				Port:     &port,
+			}},
+			From: []networkingv1.NetworkPolicyPeer{{
+				NamespaceSelector: &metav1.LabelSelector{},
+			}},
+		})
+	}
+
+	policy := resources.NewNetworkPolicy(a.networkPolicyName(), a.namespace, &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: a.labels(),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: a.selectorLabels(),
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     ingress,
+		},
+	})
+	applier.Apply(policy)
+	return nil
+}
+
+// relatedPeers returns the pod selectors for the application itself,
+// so that peers can always reach each other, and for each related
+// application.
+func (a *app) relatedPeers(relatedApps []string) []networkingv1.NetworkPolicyPeer {
+	peers := []networkingv1.NetworkPolicyPeer{{
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels: a.selectorLabels(),
+		},
+	}}
+	related := set.NewStrings(relatedApps...)
+	related.Remove(a.name)
+	for _, name := range related.SortedValues() {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: utils.SelectorLabelsForApp(name, a.legacyLabels),
+			},
+		})
+	}
+	return peers
+}
+
+func networkPolicyPorts(portRanges []network.PortRange) ([]networkingv1.NetworkPolicyPort, error) {
+	var out []networkingv1.NetworkPolicyPort
+	for _, pr := range portRanges {
+		var protocol corev1.Protocol
+		switch strings.ToLower(pr.Protocol) {
+		case "tcp":
+			protocol = corev1.ProtocolTCP
+		case "udp":
+			protocol = corev1.ProtocolUDP
+		case "sctp":
+			protocol = corev1.ProtocolSCTP
+		case "icmp":
+			// Network policies only filter ports.
+			continue
+		default:
+			return nil, errors.NotValidf("protocol %q for port range %q", pr.Protocol, pr)
+		}
+		port := intstr.FromInt(pr.FromPort)
+		npp := networkingv1.NetworkPolicyPort{
+			Protocol: &protocol,
+			Port:     &port,
+		}
+		if pr.ToPort > pr.FromPort {
+			endPort := int32(pr.ToPort)
+			npp.EndPort = &endPort
+		}
+		out = append(out, npp)
+	}
+	return out, nil
+}
+
+func (a *app) networkPolicyName() string {
+	return a.name
+}
+
+func (a *app) networkPolicyExists() (exists bool, terminating bool, err error) {
+	np := resources.NewNetworkPolicy(a.networkPolicyName(), a.namespace, nil)
+	err = np.Get(context.Background(), a.client)
+	if errors.Is(err, errors.NotFound) {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, errors.Trace(err)
+	}
+	return true, np.DeletionTimestamp != nil, nil
+}
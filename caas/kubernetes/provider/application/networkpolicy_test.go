@@ -0,0 +1,156 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/core/network"
+)
+
+func appPeer(name string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app.kubernetes.io/name": name},
+		},
+	}
+}
+
+func (s *applicationSuite) getNetworkPolicy(c *gc.C) *networkingv1.NetworkPolicy {
+	np, err := s.client.NetworkingV1().NetworkPolicies(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	return np
+}
+
+func (s *applicationSuite) TestUpdateNetworkPolicy(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.UpdateNetworkPolicy(caas.NetworkPolicyParam{
+		RelatedApplications: []string{"postgresql", "gitlab", "nginx"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	np := s.getNetworkPolicy(c)
+	c.Assert(np.Labels, jc.DeepEquals, map[string]string{
+		"app.kubernetes.io/managed-by": "juju",
+		"app.kubernetes.io/name":       "gitlab",
+	})
+	c.Assert(np.Spec, jc.DeepEquals, networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"app.kubernetes.io/name": "gitlab"},
+		},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{{
+			From: []networkingv1.NetworkPolicyPeer{
+				appPeer("gitlab"), appPeer("nginx"), appPeer("postgresql"),
+			},
+		}},
+	})
+}
+
+func (s *applicationSuite) TestUpdateNetworkPolicyExposed(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.UpdateNetworkPolicy(caas.NetworkPolicyParam{
+		RelatedApplications: []string{"nginx"},
+		IngressCIDRs:        []string{"10.0.0.0/24", "192.168.1.0/24"},
+		Ports: []network.PortRange{
+			network.MustParsePortRange("80/tcp"),
+			network.MustParsePortRange("5000-5010/udp"),
+			network.MustParsePortRange("icmp"),
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	tcp, udp := corev1.ProtocolTCP, corev1.ProtocolUDP
+	port80, port5000 := intstr.FromInt(80), intstr.FromInt(5000)
+	endPort := int32(5010)
+	np := s.getNetworkPolicy(c)
+	c.Assert(np.Spec.Ingress, jc.DeepEquals, []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{appPeer("gitlab"), appPeer("nginx")},
+	}, {
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: &tcp, Port: &port80},
+			{Protocol: &udp, Port: &port5000, EndPort: &endPort},
+		},
+		From: []networkingv1.NetworkPolicyPeer{
+			{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/24"}},
+			{IPBlock: &networkingv1.IPBlock{CIDR: "192.168.1.0/24"}},
+		},
+	}})
+
+	// Unexposing and removing the relation leaves only the peers.
+	err = app.UpdateNetworkPolicy(caas.NetworkPolicyParam{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	np = s.getNetworkPolicy(c)
+	c.Assert(np.Spec.Ingress, jc.DeepEquals, []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{appPeer("gitlab")},
+	}})
+}
+
+func (s *applicationSuite) TestUpdateNetworkPolicyExposedNoPorts(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.UpdateNetworkPolicy(caas.NetworkPolicyParam{
+		IngressCIDRs: []string{"0.0.0.0/0"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	np := s.getNetworkPolicy(c)
+	c.Assert(np.Spec.Ingress, jc.DeepEquals, []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{appPeer("gitlab")},
+	}})
+}
+
+func (s *applicationSuite) TestDeleteNetworkPolicy(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.UpdateNetworkPolicy(caas.NetworkPolicyParam{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = app.DeleteNetworkPolicy()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.client.NetworkingV1().NetworkPolicies(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.Satisfies, k8serrors.IsNotFound)
+
+	// Deleting a missing network policy is not an error.
+	err = app.DeleteNetworkPolicy()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestUpdateNetworkPolicyIngress(c *gc.C) {
+	app, ctrl := s.getApp(c, caas.DeploymentStateful, false)
+	defer ctrl.Finish()
+
+	err := app.UpdateNetworkPolicy(caas.NetworkPolicyParam{
+		IngressPort: 8080,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	tcp := corev1.ProtocolTCP
+	port := intstr.FromInt(8080)
+	np := s.getNetworkPolicy(c)
+	c.Assert(np.Spec.Ingress, jc.DeepEquals, []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{appPeer("gitlab")},
+	}, {
+		Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: &port}},
+		From: []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{},
+		}},
+	}})
+}
@@ -171,6 +171,7 @@ func (s *BaseSuite) SetUpTest(c *gc.C) {
 		config.NameKey:                  "test",
 		k8sconstants.OperatorStorageKey: "",
 		k8sconstants.WorkloadStorageKey: "",
+		k8sconstants.IngressClassKey:    "",
 	}))
 	c.Assert(err, jc.ErrorIsNil)
 	s.cfg = cfg
@@ -500,6 +501,7 @@ func (s *fakeClientSuite) SetUpTest(c *gc.C) {
 		config.NameKey:                  "test",
 		k8sconstants.OperatorStorageKey: "",
 		k8sconstants.WorkloadStorageKey: "",
+		k8sconstants.IngressClassKey:    "",
 	}))
 	c.Assert(err, jc.ErrorIsNil)
 
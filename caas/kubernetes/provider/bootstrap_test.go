@@ -73,6 +73,7 @@ func (s *bootstrapSuite) SetUpTest(c *gc.C) {
 		config.NameKey:                  "controller-1",
 		k8sconstants.OperatorStorageKey: "",
 		k8sconstants.WorkloadStorageKey: "",
+		k8sconstants.IngressClassKey:    "",
 	}))
 	c.Assert(err, jc.ErrorIsNil)
 	s.cfg = cfg
@@ -71,6 +71,10 @@ const (
 	JujuGroupID = 170
 	// JujuFSGroupID is the group id for all fs entries written to k8s volumes.
 	JujuFSGroupID = 170
+
+	// IngressClassKey is the model config attribute used to specify
+	// the ingress class of ingresses created for exposed applications.
+	IngressClassKey = "ingress-class"
 )
 
 // DefaultPropagationPolicy returns the default propagation policy.
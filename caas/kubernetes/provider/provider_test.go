@@ -31,6 +31,7 @@ func fakeConfigAttrs(attrs ...coretesting.Attrs) coretesting.Attrs {
 		"uuid":             utils.MustNewUUID().String(),
 		"operator-storage": "",
 		"workload-storage": "",
+		"ingress-class":    "",
 	})
 	for _, attrs := range attrs {
 		merged = merged.Merge(attrs)
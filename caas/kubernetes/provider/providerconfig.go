@@ -43,6 +43,11 @@ var configSchema = environschema.Fields{
 		Group:       environschema.AccountGroup,
 		Immutable:   true,
 	},
+	k8sconstants.IngressClassKey: {
+		Description: "The ingress class used for ingresses of exposed applications.",
+		Type:        environschema.Tstring,
+		Group:       environschema.AccountGroup,
+	},
 }
 
 var providerConfigFields = func() schema.Fields {
@@ -56,6 +61,7 @@ var providerConfigFields = func() schema.Fields {
 var providerConfigDefaults = schema.Defaults{
 	k8sconstants.WorkloadStorageKey: "",
 	k8sconstants.OperatorStorageKey: "",
+	k8sconstants.IngressClassKey:    "",
 }
 
 type brokerConfig struct {
@@ -105,6 +105,7 @@ func (s *rbacSuite) switchToControllerModel(c *gc.C) {
 		config.NameKey:                  environsbootstrap.ControllerModelName,
 		k8sconstants.OperatorStorageKey: "",
 		k8sconstants.WorkloadStorageKey: "",
+		k8sconstants.IngressClassKey:    "",
 	}))
 	c.Assert(err, jc.ErrorIsNil)
 	s.cfg = cfg
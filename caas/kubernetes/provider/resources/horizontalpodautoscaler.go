@@ -0,0 +1,113 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
+	"github.com/juju/juju/core/status"
+)
+
+// HorizontalPodAutoscaler extends the k8s horizontal pod autoscaler.
+type HorizontalPodAutoscaler struct {
+	autoscalingv2.HorizontalPodAutoscaler
+}
+
+// NewHorizontalPodAutoscaler creates a new horizontal pod autoscaler resource.
+func NewHorizontalPodAutoscaler(name string, namespace string, in *autoscalingv2.HorizontalPodAutoscaler) *HorizontalPodAutoscaler {
+	if in == nil {
+		in = &autoscalingv2.HorizontalPodAutoscaler{}
+	}
+	in.SetName(name)
+	in.SetNamespace(namespace)
+	return &HorizontalPodAutoscaler{*in}
+}
+
+// Clone returns a copy of the resource.
+func (r *HorizontalPodAutoscaler) Clone() Resource {
+	clone := *r
+	return &clone
+}
+
+// ID returns a comparable ID for the Resource
+func (r *HorizontalPodAutoscaler) ID() ID {
+	return ID{"HorizontalPodAutoscaler", r.Name, r.Namespace}
+}
+
+// Apply patches the resource change.
+func (r *HorizontalPodAutoscaler) Apply(ctx context.Context, client kubernetes.Interface) error {
+	api := client.AutoscalingV2().HorizontalPodAutoscalers(r.Namespace)
+	data, err := runtime.Encode(unstructured.UnstructuredJSONScheme, &r.HorizontalPodAutoscaler)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	res, err := api.Patch(ctx, r.Name, types.StrategicMergePatchType, data, metav1.PatchOptions{
+		FieldManager: JujuFieldManager,
+	})
+	if k8serrors.IsNotFound(err) {
+		res, err = api.Create(ctx, &r.HorizontalPodAutoscaler, metav1.CreateOptions{
+			FieldManager: JujuFieldManager,
+		})
+	}
+	if k8serrors.IsConflict(err) {
+		return errors.Annotatef(errConflict, "horizontal pod autoscaler %q", r.Name)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	r.HorizontalPodAutoscaler = *res
+	return nil
+}
+
+// Get refreshes the resource.
+func (r *HorizontalPodAutoscaler) Get(ctx context.Context, client kubernetes.Interface) error {
+	api := client.AutoscalingV2().HorizontalPodAutoscalers(r.Namespace)
+	res, err := api.Get(ctx, r.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return errors.NewNotFound(err, "k8s")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	r.HorizontalPodAutoscaler = *res
+	return nil
+}
+
+// Delete removes the resource.
+func (r *HorizontalPodAutoscaler) Delete(ctx context.Context, client kubernetes.Interface) error {
+	api := client.AutoscalingV2().HorizontalPodAutoscalers(r.Namespace)
+	err := api.Delete(ctx, r.Name, metav1.DeleteOptions{
+		PropagationPolicy: k8sconstants.DefaultPropagationPolicy(),
+	})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// Events emitted by the resource.
+func (r *HorizontalPodAutoscaler) Events(ctx context.Context, client kubernetes.Interface) ([]corev1.Event, error) {
+	return ListEventsForObject(ctx, client, r.Namespace, r.Name, "HorizontalPodAutoscaler")
+}
+
+// ComputeStatus returns a juju status for the resource.
+func (r *HorizontalPodAutoscaler) ComputeStatus(_ context.Context, _ kubernetes.Interface, now time.Time) (string, status.Status, time.Time, error) {
+	if r.DeletionTimestamp != nil {
+		return "", status.Terminated, r.DeletionTimestamp.Time, nil
+	}
+	return "", status.Active, now, nil
+}
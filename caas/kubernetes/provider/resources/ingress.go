@@ -0,0 +1,113 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
+	"github.com/juju/juju/core/status"
+)
+
+// Ingress extends the k8s ingress.
+type Ingress struct {
+	networkingv1.Ingress
+}
+
+// NewIngress creates a new ingress resource.
+func NewIngress(name string, namespace string, in *networkingv1.Ingress) *Ingress {
+	if in == nil {
+		in = &networkingv1.Ingress{}
+	}
+	in.SetName(name)
+	in.SetNamespace(namespace)
+	return &Ingress{*in}
+}
+
+// Clone returns a copy of the resource.
+func (r *Ingress) Clone() Resource {
+	clone := *r
+	return &clone
+}
+
+// ID returns a comparable ID for the Resource
+func (r *Ingress) ID() ID {
+	return ID{"Ingress", r.Name, r.Namespace}
+}
+
+// Apply patches the resource change.
+func (r *Ingress) Apply(ctx context.Context, client kubernetes.Interface) error {
+	api := client.NetworkingV1().Ingresses(r.Namespace)
+	data, err := runtime.Encode(unstructured.UnstructuredJSONScheme, &r.Ingress)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	res, err := api.Patch(ctx, r.Name, types.StrategicMergePatchType, data, metav1.PatchOptions{
+		FieldManager: JujuFieldManager,
+	})
+	if k8serrors.IsNotFound(err) {
+		res, err = api.Create(ctx, &r.Ingress, metav1.CreateOptions{
+			FieldManager: JujuFieldManager,
+		})
+	}
+	if k8serrors.IsConflict(err) {
+		return errors.Annotatef(errConflict, "ingress %q", r.Name)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	r.Ingress = *res
+	return nil
+}
+
+// Get refreshes the resource.
+func (r *Ingress) Get(ctx context.Context, client kubernetes.Interface) error {
+	api := client.NetworkingV1().Ingresses(r.Namespace)
+	res, err := api.Get(ctx, r.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return errors.NewNotFound(err, "k8s")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	r.Ingress = *res
+	return nil
+}
+
+// Delete removes the resource.
+func (r *Ingress) Delete(ctx context.Context, client kubernetes.Interface) error {
+	api := client.NetworkingV1().Ingresses(r.Namespace)
+	err := api.Delete(ctx, r.Name, metav1.DeleteOptions{
+		PropagationPolicy: k8sconstants.DefaultPropagationPolicy(),
+	})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// Events emitted by the resource.
+func (r *Ingress) Events(ctx context.Context, client kubernetes.Interface) ([]corev1.Event, error) {
+	return ListEventsForObject(ctx, client, r.Namespace, r.Name, "Ingress")
+}
+
+// ComputeStatus returns a juju status for the resource.
+func (r *Ingress) ComputeStatus(_ context.Context, _ kubernetes.Interface, now time.Time) (string, status.Status, time.Time, error) {
+	if r.DeletionTimestamp != nil {
+		return "", status.Terminated, r.DeletionTimestamp.Time, nil
+	}
+	return "", status.Active, now, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/juju/juju/caas/kubernetes/provider/resources"
+)
+
+type ingressSuite struct {
+	resourceSuite
+}
+
+var _ = gc.Suite(&ingressSuite{})
+
+func (s *ingressSuite) TestApply(c *gc.C) {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ingress1",
+			Namespace: "test",
+		},
+	}
+	// Create.
+	ingResource := resources.NewIngress("ingress1", "test", ing)
+	c.Assert(ingResource.Apply(context.TODO(), s.client), jc.ErrorIsNil)
+	result, err := s.client.NetworkingV1().Ingresses("test").Get(context.TODO(), "ingress1", metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(len(result.GetAnnotations()), gc.Equals, 0)
+
+	// Update.
+	ing.SetAnnotations(map[string]string{"a": "b"})
+	ingResource = resources.NewIngress("ingress1", "test", ing)
+	c.Assert(ingResource.Apply(context.TODO(), s.client), jc.ErrorIsNil)
+
+	result, err = s.client.NetworkingV1().Ingresses("test").Get(context.TODO(), "ingress1", metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.GetName(), gc.Equals, `ingress1`)
+	c.Assert(result.GetNamespace(), gc.Equals, `test`)
+	c.Assert(result.GetAnnotations(), gc.DeepEquals, map[string]string{"a": "b"})
+}
+
+func (s *ingressSuite) TestGet(c *gc.C) {
+	template := networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ingress1",
+			Namespace: "test",
+		},
+	}
+	ingress1 := template
+	ingress1.SetAnnotations(map[string]string{"a": "b"})
+	_, err := s.client.NetworkingV1().Ingresses("test").Create(context.TODO(), &ingress1, metav1.CreateOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	ingResource := resources.NewIngress("ingress1", "test", &template)
+	c.Assert(len(ingResource.GetAnnotations()), gc.Equals, 0)
+	err = ingResource.Get(context.TODO(), s.client)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ingResource.GetName(), gc.Equals, `ingress1`)
+	c.Assert(ingResource.GetNamespace(), gc.Equals, `test`)
+	c.Assert(ingResource.GetAnnotations(), gc.DeepEquals, map[string]string{"a": "b"})
+}
+
+func (s *ingressSuite) TestDelete(c *gc.C) {
+	ing := networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ingress1",
+			Namespace: "test",
+		},
+	}
+	_, err := s.client.NetworkingV1().Ingresses("test").Create(context.TODO(), &ing, metav1.CreateOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := s.client.NetworkingV1().Ingresses("test").Get(context.TODO(), "ingress1", metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.GetName(), gc.Equals, `ingress1`)
+
+	ingResource := resources.NewIngress("ingress1", "test", &ing)
+	err = ingResource.Delete(context.TODO(), s.client)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = ingResource.Get(context.TODO(), s.client)
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+
+	_, err = s.client.NetworkingV1().Ingresses("test").Get(context.TODO(), "ingress1", metav1.GetOptions{})
+	c.Assert(err, jc.Satisfies, k8serrors.IsNotFound)
+}
@@ -66,6 +66,34 @@ func (mr *MockApplicationMockRecorder) Delete() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockApplication)(nil).Delete))
 }
 
+// DeleteIngress mocks base method.
+func (m *MockApplication) DeleteIngress() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIngress")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIngress indicates an expected call of DeleteIngress.
+func (mr *MockApplicationMockRecorder) DeleteIngress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngress", reflect.TypeOf((*MockApplication)(nil).DeleteIngress))
+}
+
+// DeleteNetworkPolicy mocks base method.
+func (m *MockApplication) DeleteNetworkPolicy() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkPolicy")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkPolicy indicates an expected call of DeleteNetworkPolicy.
+func (mr *MockApplicationMockRecorder) DeleteNetworkPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkPolicy", reflect.TypeOf((*MockApplication)(nil).DeleteNetworkPolicy))
+}
+
 // Ensure mocks base method.
 func (m *MockApplication) Ensure(arg0 caas.ApplicationConfig) error {
 	m.ctrl.T.Helper()
@@ -183,6 +211,34 @@ func (mr *MockApplicationMockRecorder) UnitsToRemove(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnitsToRemove", reflect.TypeOf((*MockApplication)(nil).UnitsToRemove), arg0, arg1)
 }
 
+// UpdateIngress mocks base method.
+func (m *MockApplication) UpdateIngress(arg0 caas.IngressParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIngress", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIngress indicates an expected call of UpdateIngress.
+func (mr *MockApplicationMockRecorder) UpdateIngress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngress", reflect.TypeOf((*MockApplication)(nil).UpdateIngress), arg0)
+}
+
+// UpdateNetworkPolicy mocks base method.
+func (m *MockApplication) UpdateNetworkPolicy(arg0 caas.NetworkPolicyParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkPolicy indicates an expected call of UpdateNetworkPolicy.
+func (mr *MockApplicationMockRecorder) UpdateNetworkPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkPolicy", reflect.TypeOf((*MockApplication)(nil).UpdateNetworkPolicy), arg0)
+}
+
 // UpdatePorts mocks base method.
 func (m *MockApplication) UpdatePorts(arg0 []caas.ServicePort, arg1 bool) error {
 	m.ctrl.T.Helper()
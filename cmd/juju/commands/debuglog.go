@@ -4,8 +4,10 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -44,6 +46,11 @@ Each log line is emitted in this format:
 
   <entity> <timestamp> <log-level> <module>:<line-no> <message>
 
+With '--format=json', each log record is instead emitted as a single JSON
+object on its own line, holding the timestamp, model UUID, entity, level,
+module, location, labels and message of the record. The '--color', '--date',
+'--ms' and '--location' options have no effect on JSON output.
+
 The "entity" is the source of the message: a machine or unit. The names for
 machines and units can be seen in the output of `[1:] + "`juju status`" + `.
 
@@ -58,6 +65,17 @@ with the prefix will match.
 
 The '--include-label' and '--exclude-label' options filter by logging label. 
 
+The '--include-message' and '--exclude-message' options filter by the text of
+the log message, using regular expressions. The filtering is done by the
+controller, so only matching messages are sent to the client.
+
+The '--since' and '--until' options restrict the messages shown to a time
+window. Each takes either a duration, which is relative to the current time
+(e.g. "2h"), or a time in RFC3339 format (e.g. "2016-10-09T08:15:23Z") or in
+the "2006-01-02 15:04:05" format, which is interpreted in the local timezone
+unless '--utc' is given. Setting '--since' implies '--replay'. Once the
+'--until' time has passed, no more messages are shown.
+
 The filtering options combine as follows:
 * All --include options are logically ORed together.
 * All --exclude options are logically ORed together.
@@ -65,9 +83,12 @@ The filtering options combine as follows:
 * All --exclude-module options are logically ORed together.
 * All --include-label options are logically ORed together.
 * All --exclude-label options are logically ORed together.
+* All --include-message options are logically ORed together.
+* All --exclude-message options are logically ORed together.
 * The combined --include, --exclude, --include-module, --exclude-module,
-  --include-label and --exclude-label selections are logically ANDed to form
-  the complete filter.
+  --include-label, --exclude-label, --include-message, --exclude-message,
+  --since and --until selections are logically ANDed to form the complete
+  filter.
 
 `
 
@@ -110,6 +131,21 @@ To see all WARNING and ERROR messages and then continue showing any
 new WARNING and ERROR messages as they are logged:
 
     juju debug-log --replay --level WARNING
+
+Stream all ERROR messages as JSON, one object per line:
+
+    juju debug-log --level ERROR --format json
+
+Show the messages from the last hour that mention a timeout, except those
+from the juju.worker.uniter module, and then stop:
+
+    juju debug-log --since 1h --no-tail \
+        --include-message "(?i)timed? ?out" \
+        --exclude-module juju.worker.uniter
+
+Show the messages logged in a ten minute window:
+
+    juju debug-log --since "2016-10-09 08:10:00" --until "2016-10-09 08:20:00" --utc
 `
 
 func (c *debugLogCommand) Info() *cmd.Info {
@@ -153,10 +189,22 @@ type debugLogCommand struct {
 	retry      bool
 	retryDelay time.Duration
 
+	since string
+	until string
+
 	format string
 	tz     *time.Location
+	clock  clock.Clock
+
+	outputFormat string
+	modelUUID    string
 }
 
+const (
+	debugLogFormatText = "text"
+	debugLogFormatJSON = "json"
+)
+
 func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeEntity), "i", "Only show log messages for these entities")
@@ -167,6 +215,10 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeModule), "exclude-module", "Do not show log messages for these logging modules")
 	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeLabel), "include-label", "Only show log messages for these logging labels")
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeLabel), "exclude-label", "Do not show log messages for these logging labels")
+	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeMessage), "include-message", "Only show log messages matching these regular expressions")
+	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeMessage), "exclude-message", "Do not show log messages matching these regular expressions")
+	f.StringVar(&c.since, "since", "", "Only show log messages logged after this time or duration ago")
+	f.StringVar(&c.until, "until", "", "Only show log messages logged before this time or duration ago")
 
 	f.StringVar(&c.level, "l", "", "Log level to show, one of [TRACE, DEBUG, INFO, WARNING, ERROR]")
 	f.StringVar(&c.level, "level", "", "")
@@ -185,6 +237,8 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.date, "date", false, "Show dates as well as times")
 	f.BoolVar(&c.ms, "ms", false, "Show times to millisecond precision")
 
+	f.StringVar(&c.outputFormat, "format", debugLogFormatText, "Specify output format (json|text)")
+
 	f.BoolVar(&c.retry, "retry", false, "Retry connection on failure")
 	f.DurationVar(&c.retryDelay, "retry-delay", 1*time.Second, "Retry delay between connection failure retries")
 }
@@ -207,9 +261,42 @@ func (c *debugLogCommand) Init(args []string) error {
 	if c.retryDelay < 0 {
 		return errors.NotValidf("negative retry delay")
 	}
+	switch c.outputFormat {
+	case "":
+		c.outputFormat = debugLogFormatText
+	case debugLogFormatText, debugLogFormatJSON:
+	default:
+		return errors.NotValidf("format %q", c.outputFormat)
+	}
+	if c.outputFormat == debugLogFormatJSON && c.color {
+		return errors.NotValidf("setting --color with --format=json")
+	}
 	if c.utc {
 		c.tz = time.UTC
 	}
+	for _, pattern := range append(c.params.IncludeMessage, c.params.ExcludeMessage...) {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return errors.Errorf("message filter %q is not a valid regular expression: %v", pattern, err)
+		}
+	}
+	if c.since != "" {
+		since, err := c.parseTime(c.since)
+		if err != nil {
+			return errors.Annotate(err, "parsing --since")
+		}
+		c.params.StartTime = since
+		c.params.Replay = true
+	}
+	if c.until != "" {
+		until, err := c.parseTime(c.until)
+		if err != nil {
+			return errors.Annotate(err, "parsing --until")
+		}
+		if !c.params.StartTime.IsZero() && until.Before(c.params.StartTime) {
+			return errors.NotValidf("--until before --since")
+		}
+		c.params.EndTime = until
+	}
 	if c.date {
 		c.format = "2006-01-02 15:04:05"
 	} else {
@@ -233,6 +320,33 @@ func (c *debugLogCommand) Init(args []string) error {
 	return cmd.CheckEmpty(args)
 }
 
+// parseTime parses the value of a --since or --until flag, which is
+// either a duration before now or an absolute time.
+func (c *debugLogCommand) parseTime(value string) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		if d < 0 {
+			return time.Time{}, errors.NotValidf("negative duration %q", value)
+		}
+		clk := c.clock
+		if clk == nil {
+			clk = clock.WallClock
+		}
+		return clk.Now().Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	loc := c.tz
+	if loc == nil {
+		loc = time.Local
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", value, loc); err == nil {
+		return t, nil
+	}
+	return time.Time{}, errors.Errorf(
+		"%q is not a duration, an RFC3339 time or a time in the form \"2006-01-02 15:04:05\"", value)
+}
+
 func (c *debugLogCommand) parseEntity(entity string) string {
 	tag, err := names.ParseTag(entity)
 	switch {
@@ -298,9 +412,14 @@ func (c *debugLogCommand) Run(ctx *cmd.Context) error {
 		c.params.NoTail = !isTerminal(ctx.Stdout)
 	}
 
-	writer := ansiterm.NewWriter(ctx.Stdout)
-	if c.color {
-		writer.SetColorCapable(true)
+	writeRecord := c.textRecordWriter(ctx)
+	if c.outputFormat == debugLogFormatJSON {
+		_, details, err := c.ModelDetails()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.modelUUID = details.ModelUUID
+		writeRecord = c.jsonRecordWriter(ctx)
 	}
 
 	err := retry.Call(retry.CallArgs{
@@ -321,7 +440,9 @@ func (c *debugLogCommand) Run(ctx *cmd.Context) error {
 				if !ok {
 					return ErrConnectionClosed
 				}
-				c.writeLogRecord(writer, msg)
+				if err := writeRecord(msg); err != nil {
+					return errors.Trace(err)
+				}
 			}
 		},
 		IsFatalError: func(err error) bool {
@@ -370,6 +491,48 @@ var SeverityColor = map[string]*ansiterm.Context{
 	},
 }
 
+func (c *debugLogCommand) textRecordWriter(ctx *cmd.Context) func(common.LogMessage) error {
+	writer := ansiterm.NewWriter(ctx.Stdout)
+	if c.color {
+		writer.SetColorCapable(true)
+	}
+	return func(r common.LogMessage) error {
+		c.writeLogRecord(writer, r)
+		return nil
+	}
+}
+
+// jsonLogRecord is the representation of a log record written by
+// --format=json.
+type jsonLogRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	ModelUUID string    `json:"model-uuid"`
+	Entity    string    `json:"entity"`
+	Level     string    `json:"level"`
+	Module    string    `json:"module"`
+	Location  string    `json:"location,omitempty"`
+	Labels    []string  `json:"labels,omitempty"`
+	Message   string    `json:"message"`
+}
+
+func (c *debugLogCommand) jsonRecordWriter(ctx *cmd.Context) func(common.LogMessage) error {
+	// The encoder writes a trailing newline after each value, giving
+	// one object per line.
+	encoder := json.NewEncoder(ctx.Stdout)
+	return func(r common.LogMessage) error {
+		return encoder.Encode(jsonLogRecord{
+			Timestamp: r.Timestamp.In(c.tz),
+			ModelUUID: c.modelUUID,
+			Entity:    r.Entity,
+			Level:     r.Severity,
+			Module:    r.Module,
+			Location:  r.Location,
+			Labels:    r.Labels,
+			Message:   r.Message,
+		})
+	}
+}
+
 func (c *debugLogCommand) writeLogRecord(w *ansiterm.Writer, r common.LogMessage) {
 	ts := r.Timestamp.In(c.tz).Format(c.format)
 	fmt.Fprintf(w, "%s: %s ", r.Entity, ts)
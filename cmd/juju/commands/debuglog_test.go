@@ -6,6 +6,7 @@ package commands
 import (
 	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/cmd/v3/cmdtesting"
 	"github.com/juju/loggo"
 	jc "github.com/juju/testing/checkers"
@@ -122,6 +123,54 @@ func (s *DebugLogSuite) TestArgParsing(c *gc.C) {
 		}, {
 			args:     []string{"--retry-delay", "-1s"},
 			errMatch: `negative retry delay not valid`,
+		}, {
+			args: []string{"--include-message", "time(d|out)", "--include-message", "refused"},
+			expected: common.DebugLogParams{
+				IncludeMessage: []string{"time(d|out)", "refused"},
+				Backlog:        10,
+			},
+		}, {
+			args: []string{"--exclude-message", "^DEBUG"},
+			expected: common.DebugLogParams{
+				ExcludeMessage: []string{"^DEBUG"},
+				Backlog:        10,
+			},
+		}, {
+			args:     []string{"--include-message", "time(d"},
+			errMatch: `message filter "time\(d" is not a valid regular expression: .*`,
+		}, {
+			args: []string{"--since", "2016-10-09T08:15:23Z"},
+			expected: common.DebugLogParams{
+				Backlog:   10,
+				Replay:    true,
+				StartTime: time.Date(2016, 10, 9, 8, 15, 23, 0, time.UTC),
+			},
+		}, {
+			args: []string{"--until", "2016-10-09 08:15:23", "--utc"},
+			expected: common.DebugLogParams{
+				Backlog: 10,
+				EndTime: time.Date(2016, 10, 9, 8, 15, 23, 0, time.UTC),
+			},
+		}, {
+			args:     []string{"--since", "2016-10-09T08:15:23Z", "--until", "2016-10-09T08:00:00Z"},
+			errMatch: `--until before --since not valid`,
+		}, {
+			args:     []string{"--since", "yesterday"},
+			errMatch: `parsing --since: "yesterday" is not a duration, an RFC3339 time or a time in the form "2006-01-02 15:04:05"`,
+		}, {
+			args:     []string{"--until", "-1h"},
+			errMatch: `parsing --until: negative duration "-1h" not valid`,
+		}, {
+			args: []string{"--format", "json"},
+			expected: common.DebugLogParams{
+				Backlog: 10,
+			},
+		}, {
+			args:     []string{"--format", "yaml"},
+			errMatch: `format "yaml" not valid`,
+		}, {
+			args:     []string{"--format", "json", "--color"},
+			errMatch: `setting --color with --format=json not valid`,
 		},
 	} {
 		c.Logf("test %v", i)
@@ -137,6 +186,20 @@ func (s *DebugLogSuite) TestArgParsing(c *gc.C) {
 	}
 }
 
+func (s *DebugLogSuite) TestSinceUntilDurations(c *gc.C) {
+	now := time.Date(2016, 10, 9, 8, 15, 23, 0, time.UTC)
+	command := &debugLogCommand{clock: testclock.NewClock(now)}
+	command.SetClientStore(jujuclienttesting.MinimalStore())
+	err := cmdtesting.InitCommand(modelcmd.Wrap(command), []string{"--since", "2h", "--until", "30m"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(command.params, jc.DeepEquals, common.DebugLogParams{
+		Backlog:   10,
+		Replay:    true,
+		StartTime: now.Add(-2 * time.Hour),
+		EndTime:   now.Add(-30 * time.Minute),
+	})
+}
+
 func (s *DebugLogSuite) TestParamsPassed(c *gc.C) {
 	fake := &fakeDebugLogAPI{}
 	s.PatchValue(&getDebugLogAPI, func(_ *debugLogCommand) (DebugLogAPI, error) {
@@ -202,6 +265,48 @@ func (s *DebugLogSuite) TestLogOutput(c *gc.C) {
 		"machine-0: 14:15:23 INFO test.module somefile.go:123 this is the log output\n")
 }
 
+func (s *DebugLogSuite) TestLogOutputJSON(c *gc.C) {
+	// test timezone is 6 hours east of UTC
+	tz := time.FixedZone("test", 6*60*60)
+	s.PatchValue(&getDebugLogAPI, func(_ *debugLogCommand) (DebugLogAPI, error) {
+		return &fakeDebugLogAPI{log: []common.LogMessage{
+			{
+				Entity:    "machine-0",
+				Timestamp: time.Date(2016, 10, 9, 8, 15, 23, 345000000, time.UTC),
+				Severity:  "INFO",
+				Module:    "test.module",
+				Location:  "somefile.go:123",
+				Message:   "this is the log output",
+				Labels:    []string{"http", "foo"},
+			}, {
+				Entity:    "unit-foo-0",
+				Timestamp: time.Date(2016, 10, 9, 8, 15, 24, 0, time.UTC),
+				Severity:  "ERROR",
+				Module:    "test.module",
+				Message:   "this is \"quoted\"",
+			},
+		}}, nil
+	})
+	store := jujuclienttesting.MinimalStore()
+	details := store.Models["arthur"].Models["king/sword"]
+	details.ModelUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+	store.Models["arthur"].Models["king/sword"] = details
+
+	ctx, err := cmdtesting.RunCommand(c, newDebugLogCommandTZ(store, tz), "--format", "json", "--utc")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, ``+
+		`{"timestamp":"2016-10-09T08:15:23.345Z","model-uuid":"deadbeef-0bad-400d-8000-4b1d0d06f00d",`+
+		`"entity":"machine-0","level":"INFO","module":"test.module","location":"somefile.go:123",`+
+		`"labels":["http","foo"],"message":"this is the log output"}`+"\n"+
+		`{"timestamp":"2016-10-09T08:15:24Z","model-uuid":"deadbeef-0bad-400d-8000-4b1d0d06f00d",`+
+		`"entity":"unit-foo-0","level":"ERROR","module":"test.module",`+
+		`"message":"this is \"quoted\""}`+"\n")
+
+	ctx, err = cmdtesting.RunCommand(c, newDebugLogCommandTZ(store, tz), "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), jc.Contains, `"timestamp":"2016-10-09T14:15:23.345+06:00"`)
+}
+
 func (s *DebugLogSuite) TestLogOutputWithLogs(c *gc.C) {
 	// test timezone is 6 hours east of UTC
 	tz := time.FixedZone("test", 6*60*60)
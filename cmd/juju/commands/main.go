@@ -430,6 +430,17 @@ func registerCommands(r commandRegistry) {
 	r.Register(user.NewLogoutCommand())
 	r.Register(user.NewRemoveCommand())
 	r.Register(user.NewWhoAmICommand())
+	r.Register(user.NewAddGroupCommand())
+	r.Register(user.NewRemoveGroupCommand())
+	r.Register(user.NewAddToGroupCommand())
+	r.Register(user.NewRemoveFromGroupCommand())
+	r.Register(user.NewListGroupsCommand())
+	r.Register(user.NewAddTokenCommand())
+	r.Register(user.NewRemoveTokenCommand())
+	r.Register(user.NewListTokensCommand())
+	r.Register(user.NewAddRoleCommand())
+	r.Register(user.NewRemoveRoleCommand())
+	r.Register(user.NewListRolesCommand())
 
 	// Manage machines
 	r.Register(machine.NewAddCommand())
@@ -531,6 +542,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(controller.NewEnableDestroyControllerCommand())
 	r.Register(controller.NewShowControllerCommand())
 	r.Register(controller.NewConfigCommand())
+	r.Register(controller.NewShowAuditLogCommand())
 
 	// Debug Metrics
 	r.Register(metricsdebug.New())
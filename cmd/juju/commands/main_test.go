@@ -308,14 +308,18 @@ var commandNames = []string{
 	"actions",
 	"add-cloud",
 	"add-credential",
+	"add-group",
 	"add-k8s",
 	"add-machine",
 	"add-model",
 	"add-secret-backend",
 	"add-space",
 	"add-ssh-key",
+	"add-role",
 	"add-secret",
 	"add-storage",
+	"add-to-group",
+	"add-token",
 	"add-unit",
 	"add-user",
 	"agree",
@@ -370,6 +374,7 @@ var commandNames = []string{
 	"grant",
 	"grant-secret",
 	"grant-cloud",
+	"groups",
 	"help",
 	"help-tool",
 	"import-filesystem",
@@ -385,6 +390,7 @@ var commandNames = []string{
 	"list-credentials",
 	"list-disabled-commands",
 	"list-firewall-rules",
+	"list-groups",
 	"list-machines",
 	"list-models",
 	"list-offers",
@@ -392,6 +398,7 @@ var commandNames = []string{
 	"list-payloads",
 	"list-regions",
 	"list-resources",
+	"list-roles",
 	"list-secret-backends",
 	"list-secrets",
 	"list-spaces",
@@ -399,6 +406,7 @@ var commandNames = []string{
 	"list-storage",
 	"list-storage-pools",
 	"list-subnets",
+	"list-tokens",
 	"list-users",
 	"login",
 	"logout",
@@ -422,10 +430,13 @@ var commandNames = []string{
 	"remove-application",
 	"remove-cloud",
 	"remove-credential",
+	"remove-from-group",
+	"remove-group",
 	"remove-k8s",
 	"remove-machine",
 	"remove-offer",
 	"remove-relation",
+	"remove-role",
 	"remove-saas",
 	"remove-secret-backend",
 	"remove-secret",
@@ -433,12 +444,14 @@ var commandNames = []string{
 	"remove-ssh-key",
 	"remove-storage",
 	"remove-storage-pool",
+	"remove-token",
 	"remove-unit",
 	"remove-user",
 	"rename-space",
 	"resolved",
 	"resolve",
 	"resources",
+	"roles",
 	"resume-relation",
 	"retry-provisioning",
 	"revoke",
@@ -459,6 +472,7 @@ var commandNames = []string{
 	"set-model-constraints",
 	"show-action",
 	"show-application",
+	"show-audit-log",
 	"show-cloud",
 	"show-controller",
 	"show-credential",
@@ -485,6 +499,7 @@ var commandNames = []string{
 	"suspend-relation",
 	"switch",
 	"sync-agent-binary",
+	"tokens",
 	"trust",
 	"unexpose",
 	"unregister",
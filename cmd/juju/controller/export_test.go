@@ -93,6 +93,17 @@ func NewEnableDestroyControllerCommandForTest(api removeBlocksAPI, store jujucli
 	return modelcmd.WrapController(c)
 }
 
+// NewShowAuditLogCommandForTest returns a showAuditLogCommand with the
+// API and clock mocked out.
+func NewShowAuditLogCommandForTest(api auditLogAPI, clock clock.Clock, store jujuclient.ClientStore) cmd.Command {
+	c := &showAuditLogCommand{
+		api:   api,
+		clock: clock,
+	}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
 // NewDestroyCommandForTest returns a DestroyCommand with the controller and
 // client endpoints mocked out.
 func NewDestroyCommandForTest(
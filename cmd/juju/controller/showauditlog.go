@@ -0,0 +1,277 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewShowAuditLogCommand returns a command that shows the audit log
+// records from all of the controller machines.
+func NewShowAuditLogCommand() cmd.Command {
+	return modelcmd.WrapController(&showAuditLogCommand{
+		clock: clock.WallClock,
+	})
+}
+
+type showAuditLogCommand struct {
+	modelcmd.ControllerCommandBase
+	out cmd.Output
+
+	api   auditLogAPI
+	clock clock.Clock
+
+	from         string
+	to           string
+	filter       params.AuditRecordsArgs
+	methods      string
+	conversation string
+}
+
+type auditLogAPI interface {
+	Close() error
+	AuditRecords(params.AuditRecordsArgs) (params.AuditRecordsResult, error)
+}
+
+const showAuditLogDoc = `
+Shows the audit log records from all of the controller machines, merged
+into a single list in time order.
+
+Each API connection from a client is recorded as a conversation, with
+the user, model and command line. The API calls made in the conversation
+and any errors they returned follow it, and share its conversation ID.
+
+Records can be filtered by the user who made the connection, the model it
+was made to, the API methods called (in the form "Facade.Method") or the
+conversation ID. When filtering by method, the conversation record of each
+matching call is always shown, so it is clear who made it.
+
+The --from and --to options take either a time in RFC3339 format, or a
+duration such as "24h", meaning that long ago.
+
+Only a controller superuser can see the audit log. Auditing needs to be
+enabled on the controller (see the "auditing-enabled" controller config
+setting); by default read-only API calls are not recorded.
+`
+
+const showAuditLogExamples = `
+Show who removed an application from the admin/prod model last week:
+
+    juju show-audit-log --model admin/prod --method Application.DestroyApplication --from 168h
+
+Show all of the calls mary made in the last day:
+
+    juju show-audit-log --user mary --from 24h
+
+Show a single conversation in JSON:
+
+    juju show-audit-log --conversation 6b6d6a0e2d8f7c31 --format json
+`
+
+// Info implements Command.Info.
+func (c *showAuditLogCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "show-audit-log",
+		Purpose:  "Shows the audit log records from the controller.",
+		Doc:      showAuditLogDoc,
+		Examples: showAuditLogExamples,
+		SeeAlso: []string{
+			"controller-config",
+			"debug-log",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *showAuditLogCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"json":    cmd.FormatJson,
+		"tabular": formatAuditLogTabular,
+		"yaml":    cmd.FormatYaml,
+	})
+	f.StringVar(&c.filter.Who, "user", "", "Only show conversations by this user")
+	f.StringVar(&c.filter.Model, "model", "", `Only show conversations with this model, by "owner/name" or UUID`)
+	f.StringVar(&c.methods, "method", "", `Only show calls to these comma-separated "Facade.Method" names`)
+	f.StringVar(&c.conversation, "conversation", "", "Only show the conversation with this ID")
+	f.StringVar(&c.from, "from", "", "Only show records from this time, or this long ago")
+	f.StringVar(&c.to, "to", "", "Only show records up to this time, or this long ago")
+	f.IntVar(&c.filter.Limit, "limit", 0, "The maximum number of records to show (default 1000)")
+}
+
+// Init implements Command.Init.
+func (c *showAuditLogCommand) Init(args []string) error {
+	if c.filter.Limit < 0 {
+		return errors.NotValidf("negative --limit")
+	}
+	c.filter.ConversationID = c.conversation
+	if c.methods != "" {
+		for _, method := range strings.Split(c.methods, ",") {
+			method = strings.TrimSpace(method)
+			if !strings.Contains(method, ".") {
+				return errors.Errorf(`method %q not valid, expected "Facade.Method"`, method)
+			}
+			c.filter.Methods = append(c.filter.Methods, method)
+		}
+	}
+
+	now := c.clock.Now()
+	var err error
+	if c.filter.From, err = parseAuditTime(c.from, now); err != nil {
+		return errors.Annotate(err, "--from")
+	}
+	if c.filter.To, err = parseAuditTime(c.to, now); err != nil {
+		return errors.Annotate(err, "--to")
+	}
+	if c.filter.From != nil && c.filter.To != nil && c.filter.To.Before(*c.filter.From) {
+		return errors.New("--to before --from not valid")
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// parseAuditTime parses an RFC3339 time or a duration before now.
+func parseAuditTime(value string, now time.Time) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		if d < 0 {
+			return nil, errors.NotValidf("negative duration %q", value)
+		}
+		t := now.Add(-d).UTC()
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.Errorf("%q is not a duration or an RFC3339 time", value)
+	}
+	return &t, nil
+}
+
+func (c *showAuditLogCommand) getAPI() (auditLogAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	return c.NewControllerAPIClient()
+}
+
+// Run implements Command.Run.
+func (c *showAuditLogCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	result, err := client.AuditRecords(c.filter)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Missing > 0 {
+		ctx.Warningf("%d controller(s) did not respond, their records are missing", result.Missing)
+	}
+	if result.Truncated {
+		ctx.Warningf("more records matched than shown; narrow the filter or use --limit")
+	}
+	return c.out.Write(ctx, toAuditLogOutput(result.Records))
+}
+
+// auditLogRecord is the output form of an audit record. The user and
+// model of the conversation are included with each of its requests and
+// responses.
+type auditLogRecord struct {
+	Time         string   `yaml:"time" json:"time"`
+	Controller   string   `yaml:"controller" json:"controller"`
+	Conversation string   `yaml:"conversation-id" json:"conversation-id"`
+	Type         string   `yaml:"type" json:"type"`
+	Who          string   `yaml:"who,omitempty" json:"who,omitempty"`
+	Model        string   `yaml:"model,omitempty" json:"model,omitempty"`
+	What         string   `yaml:"what,omitempty" json:"what,omitempty"`
+	RequestID    uint64   `yaml:"request-id,omitempty" json:"request-id,omitempty"`
+	Method       string   `yaml:"method,omitempty" json:"method,omitempty"`
+	Args         string   `yaml:"args,omitempty" json:"args,omitempty"`
+	Errors       []string `yaml:"errors,omitempty" json:"errors,omitempty"`
+}
+
+func toAuditLogOutput(records []params.AuditRecord) []auditLogRecord {
+	conversations := make(map[string]*params.AuditConversation)
+	result := make([]auditLogRecord, 0, len(records))
+	for _, r := range records {
+		out := auditLogRecord{
+			Controller:   r.ControllerID,
+			Conversation: r.ConversationID,
+		}
+		switch {
+		case r.Conversation != nil:
+			conversations[r.ConversationID] = r.Conversation
+			out.Type = "conversation"
+			out.Time = r.Conversation.When
+			out.What = r.Conversation.What
+		case r.Request != nil:
+			out.Type = "request"
+			out.Time = r.Request.When
+			out.RequestID = r.Request.RequestID
+			out.Method = r.Request.Facade + "." + r.Request.Method
+			out.Args = r.Request.Args
+		case r.Errors != nil:
+			out.Type = "response"
+			out.Time = r.Errors.When
+			out.RequestID = r.Errors.RequestID
+			for _, e := range r.Errors.Errors {
+				msg := e.Message
+				if e.Code != "" {
+					msg += " (" + e.Code + ")"
+				}
+				out.Errors = append(out.Errors, msg)
+			}
+		default:
+			continue
+		}
+		if conv, ok := conversations[r.ConversationID]; ok {
+			out.Who = conv.Who
+			out.Model = conv.ModelName
+		}
+		result = append(result, out)
+	}
+	return result
+}
+
+func formatAuditLogTabular(writer io.Writer, value interface{}) error {
+	records, ok := value.([]auditLogRecord)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", records, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+	w.Println("Time", "Controller", "Conversation", "User", "Model", "Record")
+	for _, r := range records {
+		var detail string
+		switch r.Type {
+		case "conversation":
+			detail = r.What
+		case "request":
+			detail = r.Method
+		case "response":
+			detail = "ok"
+			if len(r.Errors) > 0 {
+				detail = "error: " + strings.Join(r.Errors, "; ")
+			}
+		}
+		w.Println(r.Time, r.Controller, r.Conversation, r.Who, r.Model, r.Type+" "+detail)
+	}
+	return tw.Flush()
+}
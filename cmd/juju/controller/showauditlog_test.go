@@ -0,0 +1,170 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/cmd/v3"
+	"github.com/juju/cmd/v3/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/controller"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/rpc/params"
+)
+
+type showAuditLogSuite struct {
+	baseControllerSuite
+	api   *fakeAuditLogAPI
+	clock *testclock.Clock
+	store *jujuclient.MemStore
+}
+
+var _ = gc.Suite(&showAuditLogSuite{})
+
+func (s *showAuditLogSuite) SetUpTest(c *gc.C) {
+	s.baseControllerSuite.SetUpTest(c)
+
+	s.api = &fakeAuditLogAPI{
+		result: params.AuditRecordsResult{
+			Controllers: []string{"0", "1"},
+			Records: []params.AuditRecord{{
+				ControllerID:   "0",
+				ConversationID: "aa",
+				Conversation: &params.AuditConversation{
+					Who:       "mary",
+					What:      "juju remove-application mysql",
+					When:      "2026-10-13T10:00:00Z",
+					ModelName: "admin/prod",
+				},
+			}, {
+				ControllerID:   "0",
+				ConversationID: "aa",
+				Request: &params.AuditRequest{
+					RequestID: 1,
+					When:      "2026-10-13T10:00:01Z",
+					Facade:    "Application",
+					Method:    "DestroyApplication",
+				},
+			}, {
+				ControllerID:   "0",
+				ConversationID: "aa",
+				Errors: &params.AuditResponseErrors{
+					RequestID: 1,
+					When:      "2026-10-13T10:00:02Z",
+					Errors:    []params.AuditError{{Message: "boom", Code: "oops"}},
+				},
+			}},
+		},
+	}
+	s.clock = testclock.NewClock(time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC))
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "fake"
+	s.store.Controllers["fake"] = jujuclient.ControllerDetails{}
+}
+
+func (s *showAuditLogSuite) newCommand() cmd.Command {
+	return controller.NewShowAuditLogCommandForTest(s.api, s.clock, s.store)
+}
+
+func (s *showAuditLogSuite) TestTabular(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+Time                  Controller  Conversation  User  Model       Record
+2026-10-13T10:00:00Z  0           aa            mary  admin/prod  conversation juju remove-application mysql
+2026-10-13T10:00:01Z  0           aa            mary  admin/prod  request Application.DestroyApplication
+2026-10-13T10:00:02Z  0           aa            mary  admin/prod  response error: boom (oops)
+`[1:])
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "")
+}
+
+func (s *showAuditLogSuite) TestYAML(c *gc.C) {
+	s.api.result.Records = s.api.result.Records[1:2]
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+- time: "2026-10-13T10:00:01Z"
+  controller: "0"
+  conversation-id: aa
+  type: request
+  request-id: 1
+  method: Application.DestroyApplication
+`[1:])
+}
+
+func (s *showAuditLogSuite) TestFilter(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand(),
+		"--user", "mary",
+		"--model", "admin/prod",
+		"--method", "Application.DestroyApplication, Application.Deploy",
+		"--from", "48h",
+		"--to", "2026-10-14T00:00:00Z",
+		"--limit", "10",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	from := time.Date(2026, 10, 12, 12, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	c.Check(s.api.args, jc.DeepEquals, params.AuditRecordsArgs{
+		From:    &from,
+		To:      &to,
+		Who:     "mary",
+		Model:   "admin/prod",
+		Methods: []string{"Application.DestroyApplication", "Application.Deploy"},
+		Limit:   10,
+	})
+}
+
+func (s *showAuditLogSuite) TestWarnings(c *gc.C) {
+	s.api.result.Missing = 1
+	s.api.result.Truncated = true
+	_, err := cmdtesting.RunCommand(c, s.newCommand())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(c.GetTestLog(), gc.Matches, "(?s).*WARNING cmd 1 controller\\(s\\) did not respond.*WARNING cmd more records matched.*")
+}
+
+func (s *showAuditLogSuite) TestInitErrors(c *gc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"--limit", "-1"},
+		err:  "negative --limit not valid",
+	}, {
+		args: []string{"--method", "DestroyApplication"},
+		err:  `method "DestroyApplication" not valid, expected "Facade.Method"`,
+	}, {
+		args: []string{"--from", "yesterday"},
+		err:  `--from: "yesterday" is not a duration or an RFC3339 time`,
+	}, {
+		args: []string{"--from", "1h", "--to", "2h"},
+		err:  "--to before --from not valid",
+	}, {
+		args: []string{"whoops"},
+		err:  `unrecognized args: \["whoops"\]`,
+	}} {
+		_, err := cmdtesting.RunCommand(c, s.newCommand(), test.args...)
+		c.Check(err, gc.ErrorMatches, test.err, gc.Commentf("args %v", test.args))
+	}
+	c.Check(s.api.called, jc.IsFalse)
+}
+
+type fakeAuditLogAPI struct {
+	result params.AuditRecordsResult
+	args   params.AuditRecordsArgs
+	called bool
+}
+
+func (f *fakeAuditLogAPI) Close() error {
+	return nil
+}
+
+func (f *fakeAuditLogAPI) AuditRecords(args params.AuditRecordsArgs) (params.AuditRecordsResult, error) {
+	f.called = true
+	f.args = args
+	return f.result, nil
+}
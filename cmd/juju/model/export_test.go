@@ -126,6 +126,46 @@ func NewRevokeCommandForTest(modelsApi RevokeModelAPI, offersAPI RevokeOfferAPI,
 	return modelcmd.WrapController(cmd), &RevokeCommand{cmd}
 }
 
+// NewGrantGroupCommandForTest returns a GrantCommand with the group api
+// provided as specified.
+func NewGrantGroupCommandForTest(groupsApi GrantGroupAPI, store jujuclient.ClientStore) (cmd.Command, *GrantCommand) {
+	cmd := &grantCommand{
+		groupsApi: groupsApi,
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.WrapController(cmd), &GrantCommand{cmd}
+}
+
+// NewRevokeGroupCommandForTest returns a RevokeCommand with the group api
+// provided as specified.
+func NewRevokeGroupCommandForTest(groupsApi RevokeGroupAPI, store jujuclient.ClientStore) (cmd.Command, *RevokeCommand) {
+	cmd := &revokeCommand{
+		groupsApi: groupsApi,
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.WrapController(cmd), &RevokeCommand{cmd}
+}
+
+// NewGrantRoleCommandForTest returns a GrantCommand with the role api
+// provided as specified.
+func NewGrantRoleCommandForTest(rolesApi GrantRoleAPI, store jujuclient.ClientStore) (cmd.Command, *GrantCommand) {
+	cmd := &grantCommand{
+		rolesApi: rolesApi,
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.WrapController(cmd), &GrantCommand{cmd}
+}
+
+// NewRevokeRoleCommandForTest returns a RevokeCommand with the role api
+// provided as specified.
+func NewRevokeRoleCommandForTest(rolesApi RevokeRoleAPI, store jujuclient.ClientStore) (cmd.Command, *RevokeCommand) {
+	cmd := &revokeCommand{
+		rolesApi: rolesApi,
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.WrapController(cmd), &RevokeCommand{cmd}
+}
+
 type GrantCloudCommand struct {
 	*grantCloudCommand
 }
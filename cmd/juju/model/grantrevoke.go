@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/cmd/v3"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"github.com/juju/names/v4"
 
 	"github.com/juju/juju/api/client/applicationoffers"
@@ -20,7 +21,7 @@ import (
 )
 
 var usageGrantSummary = `
-Grants access level to a Juju user for a model, controller, or application offer.`[1:]
+Grants access level to a Juju user or user group for a model, controller, or application offer.`[1:]
 
 func filterAccessLevels(accessLevels []permission.Access, filter func(permission.Access) error) []string {
 	ret := []string{}
@@ -48,6 +49,16 @@ By default, the controller is the current controller.
 Users with read access are limited in what they can do with models:
 ` + "`juju models`, `juju machines`, and `juju status`" + `.
 
+With --group, access is granted to a user group rather than to a single
+user, and the user name is omitted. Every member of the group gets the
+access in addition to any access granted to them directly. Groups can't
+be granted access to application offers.
+
+A custom role, added with ` + "`juju add-role`" + `, can be granted to a user on
+models in place of an access level. The user can then call the API methods
+allowed by the role on those models as if they had write access, without
+being able to change anything else. Roles can't be granted to groups.
+
 `[1:] + validAccessLevels
 
 const usageGrantExamples = `
@@ -75,10 +86,18 @@ Grant user 'sam' 'read' access to application offers 'fred/prod.hosted-mysql' an
 
     juju grant sam read fred/prod.hosted-mysql mary/test.hosted-mysql
 
+Grant the 'engineers' user group 'write' access to model 'mymodel':
+
+    juju grant --group engineers write mymodel
+
+Grant user 'sam' the custom 'support' role on model 'mymodel':
+
+    juju grant sam support mymodel
+
 `
 
 var usageRevokeSummary = `
-Revokes access from a Juju user for a model, controller, or application offer.`[1:]
+Revokes access from a Juju user or user group for a model, controller, or application offer.`[1:]
 
 var usageRevokeDetails = `
 By default, the controller is the current controller.
@@ -87,6 +106,12 @@ Revoking write access, from a user who has that permission, will leave
 that user with read access. Revoking read access, however, also revokes
 write access.
 
+With --group, access is revoked from a user group rather than from a single
+user, and the user name is omitted.
+
+A custom role granted to a user on models is revoked by naming the role in
+place of an access level.
+
 `[1:] + validAccessLevels
 
 const usageRevokeExamples = `
@@ -105,31 +130,49 @@ Revoke 'read' (and 'write') access from user 'joe' for application offer 'fred/p
 Revoke 'consume' access from user 'sam' for models 'fred/prod.hosted-mysql' and 'mary/test.hosted-mysql':
 
     juju revoke sam consume fred/prod.hosted-mysql mary/test.hosted-mysql
+
+Revoke 'write' access from the 'engineers' user group for model 'mymodel':
+
+    juju revoke --group engineers write mymodel
+
+Revoke the custom 'support' role from user 'sam' for model 'mymodel':
+
+    juju revoke sam support mymodel
 `
 
 type accessCommand struct {
 	modelcmd.ControllerCommandBase
 
 	User       string
+	Group      string
 	ModelNames []string
 	OfferURLs  []*crossmodel.OfferURL
 	Access     string
+	Role       string
+}
+
+// SetFlags implements cmd.Command.
+func (c *accessCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.Group, "group", "", "Change the access of the named user group instead of a user")
 }
 
 // Init implements cmd.Command.
 func (c *accessCommand) Init(args []string) error {
-	if len(args) < 1 {
-		return errors.New("no user specified")
+	if c.Group == "" {
+		if len(args) < 1 {
+			return errors.New("no user specified")
+		}
+		c.User, args = args[0], args[1:]
 	}
 
-	if len(args) < 2 {
+	if len(args) < 1 {
 		return errors.New("no permission level specified")
 	}
 
-	c.User = args[0]
-	c.Access = args[1]
+	c.Access = args[0]
 	// The remaining args are either model names or offer names.
-	for _, arg := range args[2:] {
+	for _, arg := range args[1:] {
 		url, err := crossmodel.ParseOfferURL(arg)
 		if err == nil {
 			c.OfferURLs = append(c.OfferURLs, url)
@@ -151,6 +194,9 @@ func (c *accessCommand) Init(args []string) error {
 	if len(c.ModelNames) > 0 && len(c.OfferURLs) > 0 {
 		return errors.New("either specify model names or offer URLs but not both")
 	}
+	if c.Group != "" && len(c.OfferURLs) > 0 {
+		return errors.New("user groups can't be granted access to offers")
+	}
 
 	if len(c.ModelNames) > 0 || len(c.OfferURLs) > 0 {
 		if err := permission.ValidateControllerAccess(permission.Access(c.Access)); err == nil {
@@ -160,7 +206,15 @@ func (c *accessCommand) Init(args []string) error {
 		}
 	}
 	if len(c.ModelNames) > 0 {
-		return permission.ValidateModelAccess(permission.Access(c.Access))
+		if permission.Access(c.Access).Validate() == nil {
+			return permission.ValidateModelAccess(permission.Access(c.Access))
+		}
+		// Anything that isn't an access level names a custom role.
+		if c.Group != "" {
+			return errors.New("user groups can't be granted roles")
+		}
+		c.Role, c.Access = c.Access, ""
+		return nil
 	}
 	if len(c.OfferURLs) > 0 {
 		return permission.ValidateOfferAccess(permission.Access(c.Access))
@@ -183,19 +237,23 @@ type grantCommand struct {
 	accessCommand
 	modelsApi GrantModelAPI
 	offersApi GrantOfferAPI
+	groupsApi GrantGroupAPI
+	rolesApi  GrantRoleAPI
 }
 
 // Info implements Command.Info.
 func (c *grantCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "grant",
-		Args:     "<user name> <permission> [<model name> ... | <offer url> ...]",
+		Args:     "(<user name> | --group <group name>) <permission> [<model name> ... | <offer url> ...]",
 		Purpose:  usageGrantSummary,
 		Doc:      usageGrantDetails,
 		Examples: usageGrantExamples,
 		SeeAlso: []string{
 			"revoke",
 			"add-user",
+			"add-group",
+			"add-role",
 		},
 	})
 }
@@ -222,6 +280,20 @@ func (c *grantCommand) getOfferAPI() (GrantOfferAPI, error) {
 	return applicationoffers.NewClient(root), nil
 }
 
+func (c *grantCommand) getGroupAPI() (GrantGroupAPI, error) {
+	if c.groupsApi != nil {
+		return c.groupsApi, nil
+	}
+	return c.NewUserManagerAPIClient()
+}
+
+func (c *grantCommand) getRoleAPI() (GrantRoleAPI, error) {
+	if c.rolesApi != nil {
+		return c.rolesApi, nil
+	}
+	return c.NewUserManagerAPIClient()
+}
+
 // GrantModelAPI defines the API functions used by the grant command.
 type GrantModelAPI interface {
 	Close() error
@@ -240,8 +312,28 @@ type GrantOfferAPI interface {
 	GrantOffer(user, access string, offerURLs ...string) error
 }
 
+// GrantGroupAPI defines the API functions used by the grant command to
+// change the access of user groups.
+type GrantGroupAPI interface {
+	Close() error
+	GrantGroup(group, access string, targets ...names.Tag) error
+}
+
+// GrantRoleAPI defines the API functions used by the grant command to
+// grant custom roles.
+type GrantRoleAPI interface {
+	Close() error
+	GrantRole(role, user string, models ...names.ModelTag) error
+}
+
 // Run implements cmd.Command.
 func (c *grantCommand) Run(ctx *cmd.Context) error {
+	if c.Group != "" {
+		return c.runForGroup()
+	}
+	if c.Role != "" {
+		return c.runForRole()
+	}
 	if len(c.ModelNames) > 0 {
 		return c.runForModel()
 	}
@@ -264,6 +356,34 @@ func (c *grantCommand) runForController() error {
 	return block.ProcessBlockedError(client.GrantController(c.User, c.Access), block.BlockChange)
 }
 
+func (c *grantCommand) runForGroup() error {
+	targets, err := c.groupTargets()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.getGroupAPI()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return block.ProcessBlockedError(client.GrantGroup(c.Group, c.Access, targets...), block.BlockChange)
+}
+
+func (c *grantCommand) runForRole() error {
+	models, err := c.roleModels()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.getRoleAPI()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return block.ProcessBlockedError(client.GrantRole(c.Role, c.User, models...), block.BlockChange)
+}
+
 func (c *grantCommand) runForModel() error {
 	client, err := c.getModelAPI()
 	if err != nil {
@@ -303,13 +423,15 @@ type revokeCommand struct {
 	accessCommand
 	modelsApi RevokeModelAPI
 	offersApi RevokeOfferAPI
+	groupsApi RevokeGroupAPI
+	rolesApi  RevokeRoleAPI
 }
 
 // Info implements cmd.Command.
 func (c *revokeCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "revoke",
-		Args:     "<user name> <permission> [<model name> ... | <offer url> ...]",
+		Args:     "(<user name> | --group <group name>) <permission> [<model name> ... | <offer url> ...]",
 		Purpose:  usageRevokeSummary,
 		Doc:      usageRevokeDetails,
 		Examples: usageRevokeExamples,
@@ -341,6 +463,20 @@ func (c *revokeCommand) getOfferAPI() (RevokeOfferAPI, error) {
 	return applicationoffers.NewClient(root), nil
 }
 
+func (c *revokeCommand) getGroupAPI() (RevokeGroupAPI, error) {
+	if c.groupsApi != nil {
+		return c.groupsApi, nil
+	}
+	return c.NewUserManagerAPIClient()
+}
+
+func (c *revokeCommand) getRoleAPI() (RevokeRoleAPI, error) {
+	if c.rolesApi != nil {
+		return c.rolesApi, nil
+	}
+	return c.NewUserManagerAPIClient()
+}
+
 // RevokeModelAPI defines the API functions used by the revoke command.
 type RevokeModelAPI interface {
 	Close() error
@@ -359,8 +495,28 @@ type RevokeOfferAPI interface {
 	RevokeOffer(user, access string, offerURLs ...string) error
 }
 
+// RevokeGroupAPI defines the API functions used by the revoke command to
+// change the access of user groups.
+type RevokeGroupAPI interface {
+	Close() error
+	RevokeGroup(group, access string, targets ...names.Tag) error
+}
+
+// RevokeRoleAPI defines the API functions used by the revoke command to
+// revoke custom roles.
+type RevokeRoleAPI interface {
+	Close() error
+	RevokeRole(role, user string, models ...names.ModelTag) error
+}
+
 // Run implements cmd.Command.
 func (c *revokeCommand) Run(ctx *cmd.Context) error {
+	if c.Group != "" {
+		return c.runForGroup()
+	}
+	if c.Role != "" {
+		return c.runForRole()
+	}
 	if len(c.ModelNames) > 0 {
 		return c.runForModel()
 	}
@@ -383,6 +539,34 @@ func (c *revokeCommand) runForController() error {
 	return block.ProcessBlockedError(client.RevokeController(c.User, c.Access), block.BlockChange)
 }
 
+func (c *revokeCommand) runForGroup() error {
+	targets, err := c.groupTargets()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.getGroupAPI()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return block.ProcessBlockedError(client.RevokeGroup(c.Group, c.Access, targets...), block.BlockChange)
+}
+
+func (c *revokeCommand) runForRole() error {
+	models, err := c.roleModels()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.getRoleAPI()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return block.ProcessBlockedError(client.RevokeRole(c.Role, c.User, models...), block.BlockChange)
+}
+
 func (c *revokeCommand) runForModel() error {
 	client, err := c.getModelAPI()
 	if err != nil {
@@ -397,6 +581,44 @@ func (c *revokeCommand) runForModel() error {
 	return block.ProcessBlockedError(client.RevokeModel(c.User, c.Access, models...), block.BlockChange)
 }
 
+// groupTargets returns the tags of the models named on the command line,
+// or the tag of the current controller if no models were named.
+func (c *accessCommand) groupTargets() ([]names.Tag, error) {
+	if len(c.ModelNames) > 0 {
+		uuids, err := c.ModelUUIDs(c.ModelNames)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		targets := make([]names.Tag, len(uuids))
+		for i, uuid := range uuids {
+			targets[i] = names.NewModelTag(uuid)
+		}
+		return targets, nil
+	}
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	details, err := c.ClientStore().ControllerByName(controllerName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return []names.Tag{names.NewControllerTag(details.ControllerUUID)}, nil
+}
+
+// roleModels returns the tags of the models named on the command line.
+func (c *accessCommand) roleModels() ([]names.ModelTag, error) {
+	uuids, err := c.ModelUUIDs(c.ModelNames)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	models := make([]names.ModelTag, len(uuids))
+	for i, uuid := range uuids {
+		models[i] = names.NewModelTag(uuid)
+	}
+	return models, nil
+}
+
 type accountDetailsGetter interface {
 	CurrentAccountDetails() (*jujuclient.AccountDetails, error)
 }
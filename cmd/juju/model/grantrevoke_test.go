@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/cmd/v3"
 	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/names/v4"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
@@ -254,3 +255,208 @@ func (f *fakeOffersGrantRevokeAPI) fake(user, access string, offerURLs ...string
 	f.offerURLs = append(f.offerURLs, offerURLs...)
 	return f.err
 }
+
+type fakeGroupGrantRevokeAPI struct {
+	err     error
+	group   string
+	access  string
+	targets []names.Tag
+}
+
+func (f *fakeGroupGrantRevokeAPI) Close() error { return nil }
+
+func (f *fakeGroupGrantRevokeAPI) GrantGroup(group, access string, targets ...names.Tag) error {
+	return f.fake(group, access, targets...)
+}
+
+func (f *fakeGroupGrantRevokeAPI) RevokeGroup(group, access string, targets ...names.Tag) error {
+	return f.fake(group, access, targets...)
+}
+
+func (f *fakeGroupGrantRevokeAPI) fake(group, access string, targets ...names.Tag) error {
+	f.group = group
+	f.access = access
+	f.targets = targets
+	return f.err
+}
+
+type groupGrantRevokeSuite struct {
+	// base is not embedded so that its tests aren't run again.
+	base         grantRevokeSuite
+	store        *jujuclient.MemStore
+	fakeGroupAPI *fakeGroupGrantRevokeAPI
+}
+
+var _ = gc.Suite(&groupGrantRevokeSuite{})
+
+func (s *groupGrantRevokeSuite) SetUpSuite(c *gc.C) {
+	s.base.SetUpSuite(c)
+}
+
+func (s *groupGrantRevokeSuite) TearDownSuite(c *gc.C) {
+	s.base.TearDownSuite(c)
+}
+
+func (s *groupGrantRevokeSuite) SetUpTest(c *gc.C) {
+	s.base.SetUpTest(c)
+	s.store = s.base.store
+	s.store.Controllers["test-master"] = jujuclient.ControllerDetails{
+		ControllerUUID: testing.ControllerTag.Id(),
+	}
+	s.fakeGroupAPI = &fakeGroupGrantRevokeAPI{}
+}
+
+func (s *groupGrantRevokeSuite) TearDownTest(c *gc.C) {
+	s.base.TearDownTest(c)
+}
+
+func (s *groupGrantRevokeSuite) TestInit(c *gc.C) {
+	wrappedCmd, grantCmd := model.NewGrantGroupCommandForTest(nil, s.store)
+	err := cmdtesting.InitCommand(wrappedCmd, []string{"--group", "engineers"})
+	c.Assert(err, gc.ErrorMatches, "no permission level specified")
+
+	err = cmdtesting.InitCommand(wrappedCmd, []string{"--group", "engineers", "read", "model1", "model2"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(grantCmd.Group, gc.Equals, "engineers")
+	c.Assert(grantCmd.User, gc.Equals, "")
+	c.Assert(grantCmd.Access, gc.Equals, "read")
+	c.Assert(grantCmd.ModelNames, jc.DeepEquals, []string{"model1", "model2"})
+
+	wrappedCmd, _ = model.NewGrantGroupCommandForTest(nil, s.store)
+	err = cmdtesting.InitCommand(wrappedCmd, []string{"--group", "engineers", "read", "fred/model.offer1"})
+	c.Assert(err, gc.ErrorMatches, "user groups can't be granted access to offers")
+}
+
+func (s *groupGrantRevokeSuite) TestGrantModels(c *gc.C) {
+	command, _ := model.NewGrantGroupCommandForTest(s.fakeGroupAPI, s.store)
+	_, err := cmdtesting.RunCommand(c, command, "--group", "engineers", "write", "foo", "bar")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fakeGroupAPI.group, gc.Equals, "engineers")
+	c.Assert(s.fakeGroupAPI.access, gc.Equals, "write")
+	c.Assert(s.fakeGroupAPI.targets, jc.DeepEquals, []names.Tag{
+		names.NewModelTag(fooModelUUID), names.NewModelTag(barModelUUID),
+	})
+}
+
+func (s *groupGrantRevokeSuite) TestGrantController(c *gc.C) {
+	command, _ := model.NewGrantGroupCommandForTest(s.fakeGroupAPI, s.store)
+	_, err := cmdtesting.RunCommand(c, command, "--group", "engineers", "superuser")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fakeGroupAPI.access, gc.Equals, "superuser")
+	c.Assert(s.fakeGroupAPI.targets, jc.DeepEquals, []names.Tag{testing.ControllerTag})
+}
+
+func (s *groupGrantRevokeSuite) TestRevokeModels(c *gc.C) {
+	command, _ := model.NewRevokeGroupCommandForTest(s.fakeGroupAPI, s.store)
+	_, err := cmdtesting.RunCommand(c, command, "--group", "engineers", "read", "baz")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fakeGroupAPI.group, gc.Equals, "engineers")
+	c.Assert(s.fakeGroupAPI.access, gc.Equals, "read")
+	c.Assert(s.fakeGroupAPI.targets, jc.DeepEquals, []names.Tag{names.NewModelTag(bazModelUUID)})
+}
+
+func (s *groupGrantRevokeSuite) TestBlocked(c *gc.C) {
+	s.fakeGroupAPI.err = apiservererrors.OperationBlockedError("TestBlockGrant")
+	command, _ := model.NewGrantGroupCommandForTest(s.fakeGroupAPI, s.store)
+	_, err := cmdtesting.RunCommand(c, command, "--group", "engineers", "read", "foo")
+	testing.AssertOperationWasBlocked(c, err, ".*TestBlockGrant.*")
+}
+
+type fakeRoleGrantRevokeAPI struct {
+	err    error
+	role   string
+	user   string
+	models []names.ModelTag
+}
+
+func (f *fakeRoleGrantRevokeAPI) Close() error { return nil }
+
+func (f *fakeRoleGrantRevokeAPI) GrantRole(role, user string, models ...names.ModelTag) error {
+	return f.fake(role, user, models...)
+}
+
+func (f *fakeRoleGrantRevokeAPI) RevokeRole(role, user string, models ...names.ModelTag) error {
+	return f.fake(role, user, models...)
+}
+
+func (f *fakeRoleGrantRevokeAPI) fake(role, user string, models ...names.ModelTag) error {
+	f.role = role
+	f.user = user
+	f.models = models
+	return f.err
+}
+
+type roleGrantRevokeSuite struct {
+	// base is not embedded so that its tests aren't run again.
+	base        grantRevokeSuite
+	store       *jujuclient.MemStore
+	fakeRoleAPI *fakeRoleGrantRevokeAPI
+}
+
+var _ = gc.Suite(&roleGrantRevokeSuite{})
+
+func (s *roleGrantRevokeSuite) SetUpSuite(c *gc.C) {
+	s.base.SetUpSuite(c)
+}
+
+func (s *roleGrantRevokeSuite) TearDownSuite(c *gc.C) {
+	s.base.TearDownSuite(c)
+}
+
+func (s *roleGrantRevokeSuite) SetUpTest(c *gc.C) {
+	s.base.SetUpTest(c)
+	s.store = s.base.store
+	s.fakeRoleAPI = &fakeRoleGrantRevokeAPI{}
+}
+
+func (s *roleGrantRevokeSuite) TearDownTest(c *gc.C) {
+	s.base.TearDownTest(c)
+}
+
+func (s *roleGrantRevokeSuite) TestInit(c *gc.C) {
+	wrappedCmd, grantCmd := model.NewGrantRoleCommandForTest(nil, s.store)
+	err := cmdtesting.InitCommand(wrappedCmd, []string{"sam", "support", "model1", "model2"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(grantCmd.User, gc.Equals, "sam")
+	c.Assert(grantCmd.Role, gc.Equals, "support")
+	c.Assert(grantCmd.Access, gc.Equals, "")
+	c.Assert(grantCmd.ModelNames, jc.DeepEquals, []string{"model1", "model2"})
+
+	// Access levels are never taken as role names.
+	wrappedCmd, grantCmd = model.NewGrantRoleCommandForTest(nil, s.store)
+	err = cmdtesting.InitCommand(wrappedCmd, []string{"sam", "write", "model1"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(grantCmd.Role, gc.Equals, "")
+	c.Assert(grantCmd.Access, gc.Equals, "write")
+
+	wrappedCmd, _ = model.NewGrantRoleCommandForTest(nil, s.store)
+	err = cmdtesting.InitCommand(wrappedCmd, []string{"--group", "engineers", "support", "model1"})
+	c.Assert(err, gc.ErrorMatches, "user groups can't be granted roles")
+}
+
+func (s *roleGrantRevokeSuite) TestGrantRole(c *gc.C) {
+	command, _ := model.NewGrantRoleCommandForTest(s.fakeRoleAPI, s.store)
+	_, err := cmdtesting.RunCommand(c, command, "sam", "support", "foo", "bar")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fakeRoleAPI.role, gc.Equals, "support")
+	c.Assert(s.fakeRoleAPI.user, gc.Equals, "sam")
+	c.Assert(s.fakeRoleAPI.models, jc.DeepEquals, []names.ModelTag{
+		names.NewModelTag(fooModelUUID), names.NewModelTag(barModelUUID),
+	})
+}
+
+func (s *roleGrantRevokeSuite) TestRevokeRole(c *gc.C) {
+	command, _ := model.NewRevokeRoleCommandForTest(s.fakeRoleAPI, s.store)
+	_, err := cmdtesting.RunCommand(c, command, "sam", "support", "baz")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fakeRoleAPI.role, gc.Equals, "support")
+	c.Assert(s.fakeRoleAPI.user, gc.Equals, "sam")
+	c.Assert(s.fakeRoleAPI.models, jc.DeepEquals, []names.ModelTag{names.NewModelTag(bazModelUUID)})
+}
+
+func (s *roleGrantRevokeSuite) TestBlocked(c *gc.C) {
+	s.fakeRoleAPI.err = apiservererrors.OperationBlockedError("TestBlockGrant")
+	command, _ := model.NewGrantRoleCommandForTest(s.fakeRoleAPI, s.store)
+	_, err := cmdtesting.RunCommand(c, command, "sam", "support", "foo")
+	testing.AssertOperationWasBlocked(c, err, ".*TestBlockGrant.*")
+}
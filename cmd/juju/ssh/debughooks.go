@@ -22,6 +22,7 @@ import (
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/internal/network/ssh"
+	"github.com/juju/juju/worker/uniter/hook"
 	unitdebug "github.com/juju/juju/worker/uniter/runner/debug"
 )
 
@@ -224,6 +225,11 @@ func (c *debugHooksCommand) getValidHooks(meta *charm.Meta) (set.Strings, error)
 		hook := fmt.Sprintf("juju-info-%s", hook)
 		validHooks.Add(hook)
 	}
+	for name := range meta.Containers {
+		validHooks.Add(fmt.Sprintf("%s-%s", name, hook.PebbleCustomNotice))
+		validHooks.Add(fmt.Sprintf("%s-%s", name, hook.PebbleCheckFailed))
+		validHooks.Add(fmt.Sprintf("%s-%s", name, hook.PebbleCheckRecovered))
+	}
 	return validHooks.Union(meta.Hooks()), nil
 }
 
@@ -0,0 +1,382 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"io"
+	"time"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v4"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+)
+
+// defaultAPITokenExpiry is how long an API token is valid for when no
+// expiry is given.
+const defaultAPITokenExpiry = 30 * 24 * time.Hour
+
+var usageAddTokenSummary = `
+Creates an API token for the current user.`[1:]
+
+var usageAddTokenDetails = `
+An API token lets automation such as CI pipelines log in to a controller as
+the current user without knowing the user's password. Log in with a token
+using ` + "`juju login --token`" + `.
+
+A token grants no more than the access given with --max-access, which can be
+one of read, write, admin or superuser. The user's own access still applies,
+so a token never grants more than the user has. If a model is given with
+--model the token can only be used with that model.
+
+Tokens expire after the duration given with --expires. The token is only
+shown once, when it is created; remove a token that is no longer needed with
+` + "`juju remove-token`" + `.
+
+`[1:]
+
+const usageAddTokenExamples = `
+    juju add-token ci
+    juju add-token ci -m production --max-access write --expires 24h
+`
+
+var usageRemoveTokenSummary = `
+Removes an API token belonging to the current user.`[1:]
+
+var usageRemoveTokenDetails = `
+The token can't be used to log in once it is removed. Connections already
+made with the token are not closed.
+
+Controller superusers can remove another user's token with --user.
+
+`[1:]
+
+const usageRemoveTokenExamples = `
+    juju remove-token ci
+    juju remove-token ci --user bob
+`
+
+var usageListTokensSummary = `
+Lists the API tokens belonging to the current user.`[1:]
+
+var usageListTokensDetails = `
+The secret of each token is not shown; it is only available when the token
+is created.
+
+Controller superusers can list another user's tokens with --user.
+
+`[1:]
+
+const usageListTokensExamples = `
+    juju tokens
+    juju tokens --format yaml
+    juju tokens --user bob
+`
+
+// APITokenAPI defines the usermanager API methods that the token commands
+// use.
+type APITokenAPI interface {
+	AddAPIToken(name string, model names.ModelTag, maxAccess string, expires time.Time) (string, error)
+	APITokens(user names.UserTag) ([]params.APIToken, error)
+	RemoveAPIToken(user names.UserTag, name string) error
+	Close() error
+}
+
+// apiTokenCommandBase is the common base for the token commands.
+type apiTokenCommandBase struct {
+	modelcmd.ControllerCommandBase
+	api APITokenAPI
+}
+
+// apiTokenUserFlag holds the --user flag of the token commands that can
+// act on another user's tokens.
+type apiTokenUserFlag struct {
+	User string
+}
+
+// SetFlags adds the --user flag to the flag set.
+func (u *apiTokenUserFlag) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&u.User, "user", "", "The user whose tokens to use (controller superusers only)")
+}
+
+// userTag returns the tag of the user given with --user, or an empty tag
+// for the current user.
+func (u *apiTokenUserFlag) userTag() (names.UserTag, error) {
+	if u.User == "" {
+		return names.UserTag{}, nil
+	}
+	if !names.IsValidUser(u.User) {
+		return names.UserTag{}, errors.NotValidf("user name %q", u.User)
+	}
+	return names.NewUserTag(u.User), nil
+}
+
+func (c *apiTokenCommandBase) getAPITokenAPI() (APITokenAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	return c.NewUserManagerAPIClient()
+}
+
+// NewAddTokenCommand returns a command to create an API token.
+func NewAddTokenCommand() cmd.Command {
+	return modelcmd.WrapController(&addTokenCommand{})
+}
+
+// addTokenCommand creates an API token for the current user.
+type addTokenCommand struct {
+	apiTokenCommandBase
+	Name      string
+	ModelName string
+	MaxAccess string
+	Expires   time.Duration
+}
+
+// Info implements Command.Info.
+func (c *addTokenCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "add-token",
+		Args:     "<token name>",
+		Purpose:  usageAddTokenSummary,
+		Doc:      usageAddTokenDetails,
+		Examples: usageAddTokenExamples,
+		SeeAlso: []string{
+			"tokens",
+			"remove-token",
+			"login",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *addTokenCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.apiTokenCommandBase.SetFlags(f)
+	f.StringVar(&c.ModelName, "m", "", "Only allow the token to be used with this model")
+	f.StringVar(&c.ModelName, "model", "", "")
+	f.StringVar(&c.MaxAccess, "max-access", string(permission.ReadAccess), "The most access the token grants (read, write, admin or superuser)")
+	f.DurationVar(&c.Expires, "expires", defaultAPITokenExpiry, "How long the token is valid for")
+}
+
+// Init implements Command.Init.
+func (c *addTokenCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no token name supplied")
+	}
+	c.Name = args[0]
+	if c.Expires <= 0 {
+		return errors.NotValidf("expiry %v", c.Expires)
+	}
+	switch permission.Access(c.MaxAccess) {
+	case permission.ReadAccess, permission.WriteAccess, permission.AdminAccess:
+	case permission.SuperuserAccess:
+		if c.ModelName != "" {
+			return errors.New("superuser tokens can't be restricted to a model")
+		}
+	default:
+		return errors.NotValidf("max access %q", c.MaxAccess)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *addTokenCommand) Run(ctx *cmd.Context) error {
+	var modelTag names.ModelTag
+	if c.ModelName != "" {
+		modelUUIDs, err := c.ModelUUIDs([]string{c.ModelName})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		modelTag = names.NewModelTag(modelUUIDs[0])
+	}
+
+	api, err := c.getAPITokenAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	secret, err := api.AddAPIToken(c.Name, modelTag, c.MaxAccess, time.Now().Add(c.Expires))
+	if err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("API token %q added; it will not be shown again", c.Name)
+	_, err = io.WriteString(ctx.Stdout, secret+"\n")
+	return errors.Trace(err)
+}
+
+// NewRemoveTokenCommand returns a command to remove an API token.
+func NewRemoveTokenCommand() cmd.Command {
+	return modelcmd.WrapController(&removeTokenCommand{})
+}
+
+// removeTokenCommand removes an API token belonging to the current user,
+// or to the user given with --user.
+type removeTokenCommand struct {
+	apiTokenCommandBase
+	apiTokenUserFlag
+	Name string
+	user names.UserTag
+}
+
+// Info implements Command.Info.
+func (c *removeTokenCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-token",
+		Args:     "<token name>",
+		Purpose:  usageRemoveTokenSummary,
+		Doc:      usageRemoveTokenDetails,
+		Examples: usageRemoveTokenExamples,
+		SeeAlso: []string{
+			"tokens",
+			"add-token",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *removeTokenCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.apiTokenCommandBase.SetFlags(f)
+	c.apiTokenUserFlag.SetFlags(f)
+}
+
+// Init implements Command.Init.
+func (c *removeTokenCommand) Init(args []string) (err error) {
+	if len(args) == 0 {
+		return errors.New("no token name supplied")
+	}
+	c.Name = args[0]
+	if c.user, err = c.userTag(); err != nil {
+		return errors.Trace(err)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *removeTokenCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPITokenAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.RemoveAPIToken(c.user, c.Name); err != nil {
+		return block.ProcessBlockedError(err, block.BlockRemove)
+	}
+	ctx.Infof("API token %q removed", c.Name)
+	return nil
+}
+
+// NewListTokensCommand returns a command to list API tokens.
+func NewListTokensCommand() cmd.Command {
+	return modelcmd.WrapController(&listTokensCommand{})
+}
+
+// listTokensCommand lists the API tokens belonging to the current user,
+// or to the user given with --user.
+type listTokensCommand struct {
+	apiTokenCommandBase
+	apiTokenUserFlag
+	out  cmd.Output
+	user names.UserTag
+}
+
+// APITokenInfo holds the information about an API token that is written
+// by the tokens command.
+type APITokenInfo struct {
+	Name        string    `yaml:"name" json:"name"`
+	Model       string    `yaml:"model,omitempty" json:"model,omitempty"`
+	MaxAccess   string    `yaml:"max-access" json:"max-access"`
+	DateCreated time.Time `yaml:"date-created" json:"date-created"`
+	Expires     time.Time `yaml:"expires" json:"expires"`
+}
+
+// Info implements Command.Info.
+func (c *listTokensCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "tokens",
+		Purpose:  usageListTokensSummary,
+		Doc:      usageListTokensDetails,
+		Aliases:  []string{"list-tokens"},
+		Examples: usageListTokensExamples,
+		SeeAlso: []string{
+			"add-token",
+			"remove-token",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listTokensCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.apiTokenCommandBase.SetFlags(f)
+	c.apiTokenUserFlag.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatTokensTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listTokensCommand) Init(args []string) (err error) {
+	if c.user, err = c.userTag(); err != nil {
+		return errors.Trace(err)
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements Command.Run.
+func (c *listTokensCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPITokenAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	tokens, err := api.APITokens(c.user)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(tokens) == 0 {
+		ctx.Infof("No API tokens to display.")
+		return nil
+	}
+	info := make([]APITokenInfo, len(tokens))
+	for i, token := range tokens {
+		info[i] = APITokenInfo{
+			Name:        token.Name,
+			MaxAccess:   token.MaxAccess,
+			DateCreated: token.DateCreated,
+			Expires:     token.Expires,
+		}
+		if token.ModelTag != "" {
+			modelTag, err := names.ParseModelTag(token.ModelTag)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			info[i].Model = modelTag.Id()
+		}
+	}
+	return c.out.Write(ctx, info)
+}
+
+func formatTokensTabular(writer io.Writer, value interface{}) error {
+	tokens, ok := value.([]APITokenInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", tokens, value)
+	}
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Name", "Model", "Max access", "Expires")
+	for _, token := range tokens {
+		w.Println(token.Name, token.Model, token.MaxAccess, token.Expires.UTC().Format(time.RFC3339))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/rpc/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type APITokenCommandsSuite struct {
+	BaseSuite
+	api *mockAPITokenAPI
+}
+
+var _ = gc.Suite(&APITokenCommandsSuite{})
+
+func (s *APITokenCommandsSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.api = &mockAPITokenAPI{secret: "juju-api-token-secret"}
+}
+
+type mockAPITokenAPI struct {
+	testing.Stub
+	secret string
+	tokens []params.APIToken
+}
+
+func (m *mockAPITokenAPI) AddAPIToken(name string, model names.ModelTag, maxAccess string, expires time.Time) (string, error) {
+	m.MethodCall(m, "AddAPIToken", name, model, maxAccess, expires)
+	return m.secret, m.NextErr()
+}
+
+func (m *mockAPITokenAPI) APITokens(user names.UserTag) ([]params.APIToken, error) {
+	m.MethodCall(m, "APITokens", user)
+	return m.tokens, m.NextErr()
+}
+
+func (m *mockAPITokenAPI) RemoveAPIToken(user names.UserTag, name string) error {
+	m.MethodCall(m, "RemoveAPIToken", user, name)
+	return m.NextErr()
+}
+
+func (m *mockAPITokenAPI) Close() error {
+	return nil
+}
+
+func (s *APITokenCommandsSuite) TestAddToken(c *gc.C) {
+	before := time.Now()
+	ctx, err := cmdtesting.RunCommand(c, user.NewAddTokenCommandForTest(s.api, s.store), "ci")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "juju-api-token-secret\n")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "API token \"ci\" added; it will not be shown again\n")
+
+	s.api.CheckCallNames(c, "AddAPIToken")
+	args := s.api.Calls()[0].Args
+	c.Check(args[0], gc.Equals, "ci")
+	c.Check(args[1], gc.Equals, names.ModelTag{})
+	c.Check(args[2], gc.Equals, "read")
+	expires := args[3].(time.Time)
+	c.Check(expires.Before(before.Add(30*24*time.Hour)), jc.IsFalse)
+}
+
+func (s *APITokenCommandsSuite) TestAddTokenForModel(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewAddTokenCommandForTest(s.api, s.store),
+		"ci", "-m", "adam/test", "--max-access", "write", "--expires", "1h")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCallNames(c, "AddAPIToken")
+	args := s.api.Calls()[0].Args
+	c.Check(args[1], gc.Equals, coretesting.ModelTag)
+	c.Check(args[2], gc.Equals, "write")
+}
+
+func (s *APITokenCommandsSuite) TestAddTokenInitErrors(c *gc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		err: "no token name supplied",
+	}, {
+		args: []string{"ci", "--max-access", "consume"},
+		err:  `max access "consume" not valid`,
+	}, {
+		args: []string{"ci", "--max-access", "superuser", "-m", "adam/test"},
+		err:  "superuser tokens can't be restricted to a model",
+	}, {
+		args: []string{"ci", "--expires", "-1h"},
+		err:  "expiry -1h0m0s not valid",
+	}, {
+		args: []string{"ci", "cd"},
+		err:  `unrecognized args: \["cd"\]`,
+	}} {
+		c.Logf("args: %s", strings.Join(test.args, " "))
+		_, err := cmdtesting.RunCommand(c, user.NewAddTokenCommandForTest(s.api, s.store), test.args...)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *APITokenCommandsSuite) TestAddTokenError(c *gc.C) {
+	s.api.SetErrors(errors.New("api token already exists"))
+	_, err := cmdtesting.RunCommand(c, user.NewAddTokenCommandForTest(s.api, s.store), "ci")
+	c.Assert(err, gc.ErrorMatches, "api token already exists")
+}
+
+func (s *APITokenCommandsSuite) TestRemoveToken(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewRemoveTokenCommandForTest(s.api, s.store), "ci")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "API token \"ci\" removed\n")
+	s.api.CheckCall(c, 0, "RemoveAPIToken", names.UserTag{}, "ci")
+
+	_, err = cmdtesting.RunCommand(c, user.NewRemoveTokenCommandForTest(s.api, s.store))
+	c.Assert(err, gc.ErrorMatches, "no token name supplied")
+}
+
+func (s *APITokenCommandsSuite) TestRemoveTokenForUser(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewRemoveTokenCommandForTest(s.api, s.store), "ci", "--user", "bob")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "RemoveAPIToken", names.NewUserTag("bob"), "ci")
+
+	_, err = cmdtesting.RunCommand(c, user.NewRemoveTokenCommandForTest(s.api, s.store), "ci", "--user", "not valid!")
+	c.Assert(err, gc.ErrorMatches, `user name "not valid!" not valid`)
+}
+
+func (s *APITokenCommandsSuite) TestListTokens(c *gc.C) {
+	created := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s.api.tokens = []params.APIToken{{
+		Name:        "ci",
+		ModelTag:    coretesting.ModelTag.String(),
+		MaxAccess:   "write",
+		DateCreated: created,
+		Expires:     created.Add(24 * time.Hour),
+	}, {
+		Name:        "deploy",
+		MaxAccess:   "admin",
+		DateCreated: created,
+		Expires:     created.Add(48 * time.Hour),
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewListTokensCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+Name    Model                                 Max access  Expires
+ci      deadbeef-0bad-400d-8000-4b1d0d06f00d  write       2026-10-19T12:00:00Z
+deploy                                        admin       2026-10-20T12:00:00Z
+`[1:])
+}
+
+func (s *APITokenCommandsSuite) TestListTokensYAML(c *gc.C) {
+	created := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	s.api.tokens = []params.APIToken{{
+		Name:        "deploy",
+		MaxAccess:   "admin",
+		DateCreated: created,
+		Expires:     created.Add(48 * time.Hour),
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewListTokensCommandForTest(s.api, s.store), "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+- name: deploy
+  max-access: admin
+  date-created: 2026-10-18T12:00:00Z
+  expires: 2026-10-20T12:00:00Z
+`[1:])
+}
+
+func (s *APITokenCommandsSuite) TestListTokensForUser(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewListTokensCommandForTest(s.api, s.store), "--user", "bob")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "APITokens", names.NewUserTag("bob"))
+}
+
+func (s *APITokenCommandsSuite) TestListTokensEmpty(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewListTokensCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "No API tokens to display.\n")
+}
@@ -18,6 +18,8 @@ var (
 	ListModels       = &listModels
 	NewAPIConnection = &newAPIConnection
 	LoginClientStore = &loginClientStore
+	GetOIDCLoginInfo = &getOIDCLoginInfo
+	GetOIDCIDToken   = &getOIDCIDToken
 )
 
 const NoModelsMessage = noModelsMessage
@@ -124,3 +126,102 @@ func NewWhoAmICommandForTest(store jujuclient.ClientStore) cmd.Command {
 	c := &whoAmICommand{store: store}
 	return c
 }
+
+// NewAddGroupCommandForTest returns an add-group command with the api
+// provided as specified.
+func NewAddGroupCommandForTest(api GroupAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &addGroupCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewRemoveGroupCommandForTest returns a remove-group command with the api
+// provided as specified.
+func NewRemoveGroupCommandForTest(api GroupAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &removeGroupCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewAddToGroupCommandForTest returns an add-to-group command with the api
+// provided as specified.
+func NewAddToGroupCommandForTest(api GroupAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &addToGroupCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewRemoveFromGroupCommandForTest returns a remove-from-group command with
+// the api provided as specified.
+func NewRemoveFromGroupCommandForTest(api GroupAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &removeFromGroupCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewListGroupsCommandForTest returns a groups command with the api
+// provided as specified.
+func NewListGroupsCommandForTest(api GroupAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &listGroupsCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewAddTokenCommandForTest returns an add-token command with the api
+// provided as specified.
+func NewAddTokenCommandForTest(api APITokenAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &addTokenCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewRemoveTokenCommandForTest returns a remove-token command with the api
+// provided as specified.
+func NewRemoveTokenCommandForTest(api APITokenAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &removeTokenCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewListTokensCommandForTest returns a tokens command with the api
+// provided as specified.
+func NewListTokensCommandForTest(api APITokenAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &listTokensCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewAddRoleCommandForTest returns an add-role command with the api
+// provided as specified.
+func NewAddRoleCommandForTest(api RoleAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &addRoleCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewRemoveRoleCommandForTest returns a remove-role command with the api
+// provided as specified.
+func NewRemoveRoleCommandForTest(api RoleAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &removeRoleCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewListRolesCommandForTest returns a roles command with the api provided
+// as specified.
+func NewListRolesCommandForTest(api RoleAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &listRolesCommand{}
+	c.api = api
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
@@ -0,0 +1,417 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/rpc/params"
+)
+
+var usageAddGroupSummary = `
+Adds a user group to a controller.`[1:]
+
+var usageAddGroupDetails = `
+A user group is a named set of users on a controller. Access granted to a
+group with ` + "`juju grant --group`" + ` applies to every member of the group,
+in addition to any access granted to the member directly.
+
+Members can be local users or external users, and can be listed when the
+group is added or added later with ` + "`juju add-to-group`" + `.
+
+`[1:]
+
+const usageAddGroupExamples = `
+    juju add-group engineers
+    juju add-group engineers bob mary@external
+`
+
+var usageRemoveGroupSummary = `
+Removes a user group from a controller.`[1:]
+
+var usageRemoveGroupDetails = `
+Removing a group revokes all of the access that was granted to it. Its
+members keep any access granted to them directly.
+
+`[1:]
+
+const usageRemoveGroupExamples = `
+    juju remove-group engineers
+`
+
+var usageAddToGroupSummary = `
+Adds users to a user group.`[1:]
+
+var usageAddToGroupDetails = `
+The users gain the access granted to the group the next time they log in.
+
+`[1:]
+
+const usageAddToGroupExamples = `
+    juju add-to-group engineers bob
+    juju add-to-group engineers jim mary@external
+`
+
+var usageRemoveFromGroupSummary = `
+Removes users from a user group.`[1:]
+
+var usageRemoveFromGroupDetails = `
+The users lose the access granted to the group the next time they log in.
+
+`[1:]
+
+const usageRemoveFromGroupExamples = `
+    juju remove-from-group engineers bob
+`
+
+var usageListGroupsSummary = `
+Lists the user groups on a controller.`[1:]
+
+var usageListGroupsDetails = `
+Controller superusers see every group. Other users see only the groups
+they are members of.
+
+`[1:]
+
+const usageListGroupsExamples = `
+    juju groups
+    juju groups engineers --format yaml
+`
+
+// GroupAPI defines the usermanager API methods that the group commands use.
+type GroupAPI interface {
+	AddGroup(name string, members ...string) error
+	RemoveGroup(name string) error
+	AddGroupMembers(name string, members ...string) error
+	RemoveGroupMembers(name string, members ...string) error
+	Groups(names ...string) ([]params.UserGroup, error)
+	Close() error
+}
+
+// groupCommandBase is the common base for the group commands.
+type groupCommandBase struct {
+	modelcmd.ControllerCommandBase
+	api GroupAPI
+}
+
+func (c *groupCommandBase) getGroupAPI() (GroupAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	return c.NewUserManagerAPIClient()
+}
+
+// groupMembersCommandBase is the common base for the commands that take a
+// group name and a list of users.
+type groupMembersCommandBase struct {
+	groupCommandBase
+	Group   string
+	Members []string
+}
+
+func (c *groupMembersCommandBase) init(args []string, membersRequired bool) error {
+	if len(args) == 0 {
+		return errors.New("no group name supplied")
+	}
+	c.Group, c.Members = args[0], args[1:]
+	if membersRequired && len(c.Members) == 0 {
+		return errors.New("no users supplied")
+	}
+	return nil
+}
+
+// NewAddGroupCommand returns a command to add a user group.
+func NewAddGroupCommand() cmd.Command {
+	return modelcmd.WrapController(&addGroupCommand{})
+}
+
+// addGroupCommand adds a user group to a controller.
+type addGroupCommand struct {
+	groupMembersCommandBase
+}
+
+// Info implements Command.Info.
+func (c *addGroupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "add-group",
+		Args:     "<group name> [<user name> ...]",
+		Purpose:  usageAddGroupSummary,
+		Doc:      usageAddGroupDetails,
+		Examples: usageAddGroupExamples,
+		SeeAlso: []string{
+			"groups",
+			"add-to-group",
+			"remove-group",
+			"grant",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *addGroupCommand) Init(args []string) error {
+	return c.init(args, false)
+}
+
+// Run implements Command.Run.
+func (c *addGroupCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getGroupAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.AddGroup(c.Group, c.Members...); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Group %q added", c.Group)
+	return nil
+}
+
+// NewRemoveGroupCommand returns a command to remove a user group.
+func NewRemoveGroupCommand() cmd.Command {
+	return modelcmd.WrapController(&removeGroupCommand{})
+}
+
+// removeGroupCommand removes a user group from a controller.
+type removeGroupCommand struct {
+	groupCommandBase
+	Group string
+}
+
+// Info implements Command.Info.
+func (c *removeGroupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-group",
+		Args:     "<group name>",
+		Purpose:  usageRemoveGroupSummary,
+		Doc:      usageRemoveGroupDetails,
+		Examples: usageRemoveGroupExamples,
+		SeeAlso: []string{
+			"groups",
+			"add-group",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *removeGroupCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no group name supplied")
+	}
+	c.Group = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *removeGroupCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getGroupAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.RemoveGroup(c.Group); err != nil {
+		return block.ProcessBlockedError(err, block.BlockRemove)
+	}
+	ctx.Infof("Group %q removed", c.Group)
+	return nil
+}
+
+// NewAddToGroupCommand returns a command to add users to a user group.
+func NewAddToGroupCommand() cmd.Command {
+	return modelcmd.WrapController(&addToGroupCommand{})
+}
+
+// addToGroupCommand adds users to a user group.
+type addToGroupCommand struct {
+	groupMembersCommandBase
+}
+
+// Info implements Command.Info.
+func (c *addToGroupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "add-to-group",
+		Args:     "<group name> <user name> [<user name> ...]",
+		Purpose:  usageAddToGroupSummary,
+		Doc:      usageAddToGroupDetails,
+		Examples: usageAddToGroupExamples,
+		SeeAlso: []string{
+			"groups",
+			"add-group",
+			"remove-from-group",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *addToGroupCommand) Init(args []string) error {
+	return c.init(args, true)
+}
+
+// Run implements Command.Run.
+func (c *addToGroupCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getGroupAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.AddGroupMembers(c.Group, c.Members...); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Added %s to group %q", strings.Join(c.Members, ", "), c.Group)
+	return nil
+}
+
+// NewRemoveFromGroupCommand returns a command to remove users from a user
+// group.
+func NewRemoveFromGroupCommand() cmd.Command {
+	return modelcmd.WrapController(&removeFromGroupCommand{})
+}
+
+// removeFromGroupCommand removes users from a user group.
+type removeFromGroupCommand struct {
+	groupMembersCommandBase
+}
+
+// Info implements Command.Info.
+func (c *removeFromGroupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-from-group",
+		Args:     "<group name> <user name> [<user name> ...]",
+		Purpose:  usageRemoveFromGroupSummary,
+		Doc:      usageRemoveFromGroupDetails,
+		Examples: usageRemoveFromGroupExamples,
+		SeeAlso: []string{
+			"groups",
+			"add-to-group",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *removeFromGroupCommand) Init(args []string) error {
+	return c.init(args, true)
+}
+
+// Run implements Command.Run.
+func (c *removeFromGroupCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getGroupAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.RemoveGroupMembers(c.Group, c.Members...); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Removed %s from group %q", strings.Join(c.Members, ", "), c.Group)
+	return nil
+}
+
+// NewListGroupsCommand returns a command to list user groups.
+func NewListGroupsCommand() cmd.Command {
+	return modelcmd.WrapController(&listGroupsCommand{})
+}
+
+// listGroupsCommand lists the user groups on a controller.
+type listGroupsCommand struct {
+	groupCommandBase
+	out    cmd.Output
+	Groups []string
+}
+
+// GroupInfo holds the information about a user group that is written
+// by the groups command.
+type GroupInfo struct {
+	Name        string    `yaml:"name" json:"name"`
+	Members     []string  `yaml:"members,omitempty" json:"members,omitempty"`
+	CreatedBy   string    `yaml:"created-by" json:"created-by"`
+	DateCreated time.Time `yaml:"date-created" json:"date-created"`
+}
+
+// Info implements Command.Info.
+func (c *listGroupsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "groups",
+		Args:     "[<group name> ...]",
+		Purpose:  usageListGroupsSummary,
+		Doc:      usageListGroupsDetails,
+		Aliases:  []string{"list-groups"},
+		Examples: usageListGroupsExamples,
+		SeeAlso: []string{
+			"add-group",
+			"add-to-group",
+			"grant",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listGroupsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.groupCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatGroupsTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listGroupsCommand) Init(args []string) error {
+	c.Groups = args
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *listGroupsCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getGroupAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	groups, err := api.Groups(c.Groups...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(groups) == 0 {
+		ctx.Infof("No groups to display.")
+		return nil
+	}
+	info := make([]GroupInfo, len(groups))
+	for i, group := range groups {
+		info[i] = GroupInfo{
+			Name:        group.Name,
+			Members:     group.Members,
+			CreatedBy:   group.CreatedBy,
+			DateCreated: group.DateCreated,
+		}
+	}
+	return c.out.Write(ctx, info)
+}
+
+func formatGroupsTabular(writer io.Writer, value interface{}) error {
+	groups, ok := value.([]GroupInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", groups, value)
+	}
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Name", "Members", "Created by")
+	for _, group := range groups {
+		w.Println(group.Name, strings.Join(group.Members, ","), group.CreatedBy)
+	}
+	return tw.Flush()
+}
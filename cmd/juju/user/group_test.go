@@ -0,0 +1,149 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/rpc/params"
+)
+
+type GroupCommandsSuite struct {
+	BaseSuite
+	api *mockGroupAPI
+}
+
+var _ = gc.Suite(&GroupCommandsSuite{})
+
+func (s *GroupCommandsSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.api = &mockGroupAPI{}
+}
+
+type mockGroupAPI struct {
+	testing.Stub
+	groups []params.UserGroup
+}
+
+func (m *mockGroupAPI) AddGroup(name string, members ...string) error {
+	m.MethodCall(m, "AddGroup", name, members)
+	return m.NextErr()
+}
+
+func (m *mockGroupAPI) RemoveGroup(name string) error {
+	m.MethodCall(m, "RemoveGroup", name)
+	return m.NextErr()
+}
+
+func (m *mockGroupAPI) AddGroupMembers(name string, members ...string) error {
+	m.MethodCall(m, "AddGroupMembers", name, members)
+	return m.NextErr()
+}
+
+func (m *mockGroupAPI) RemoveGroupMembers(name string, members ...string) error {
+	m.MethodCall(m, "RemoveGroupMembers", name, members)
+	return m.NextErr()
+}
+
+func (m *mockGroupAPI) Groups(names ...string) ([]params.UserGroup, error) {
+	m.MethodCall(m, "Groups", names)
+	return m.groups, m.NextErr()
+}
+
+func (m *mockGroupAPI) Close() error {
+	return nil
+}
+
+func (s *GroupCommandsSuite) TestAddGroup(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewAddGroupCommandForTest(s.api, s.store), "engineers", "bob", "mary@external")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Group \"engineers\" added\n")
+	s.api.CheckCall(c, 0, "AddGroup", "engineers", []string{"bob", "mary@external"})
+}
+
+func (s *GroupCommandsSuite) TestAddGroupNoName(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewAddGroupCommandForTest(s.api, s.store))
+	c.Assert(err, gc.ErrorMatches, "no group name supplied")
+}
+
+func (s *GroupCommandsSuite) TestAddGroupError(c *gc.C) {
+	s.api.SetErrors(errors.New("group already exists"))
+	_, err := cmdtesting.RunCommand(c, user.NewAddGroupCommandForTest(s.api, s.store), "engineers")
+	c.Assert(err, gc.ErrorMatches, "group already exists")
+}
+
+func (s *GroupCommandsSuite) TestRemoveGroup(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewRemoveGroupCommandForTest(s.api, s.store), "engineers")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Group \"engineers\" removed\n")
+	s.api.CheckCall(c, 0, "RemoveGroup", "engineers")
+
+	_, err = cmdtesting.RunCommand(c, user.NewRemoveGroupCommandForTest(s.api, s.store), "engineers", "operators")
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["operators"\]`)
+}
+
+func (s *GroupCommandsSuite) TestAddToGroup(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewAddToGroupCommandForTest(s.api, s.store), "engineers", "bob", "jim")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Added bob, jim to group \"engineers\"\n")
+	s.api.CheckCall(c, 0, "AddGroupMembers", "engineers", []string{"bob", "jim"})
+
+	_, err = cmdtesting.RunCommand(c, user.NewAddToGroupCommandForTest(s.api, s.store), "engineers")
+	c.Assert(err, gc.ErrorMatches, "no users supplied")
+}
+
+func (s *GroupCommandsSuite) TestRemoveFromGroup(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewRemoveFromGroupCommandForTest(s.api, s.store), "engineers", "bob")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Removed bob from group \"engineers\"\n")
+	s.api.CheckCall(c, 0, "RemoveGroupMembers", "engineers", []string{"bob"})
+}
+
+func (s *GroupCommandsSuite) TestListGroups(c *gc.C) {
+	s.api.groups = []params.UserGroup{{
+		Name: "engineers", Members: []string{"bob", "mary@external"}, CreatedBy: "admin",
+	}, {
+		Name: "operators", CreatedBy: "admin",
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewListGroupsCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+Name       Members            Created by
+engineers  bob,mary@external  admin
+operators                     admin
+`[1:])
+	s.api.CheckCall(c, 0, "Groups", []string(nil))
+}
+
+func (s *GroupCommandsSuite) TestListGroupsYAML(c *gc.C) {
+	s.api.groups = []params.UserGroup{{
+		Name:        "engineers",
+		Members:     []string{"bob"},
+		CreatedBy:   "admin",
+		DateCreated: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewListGroupsCommandForTest(s.api, s.store), "engineers", "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+- name: engineers
+  members:
+  - bob
+  created-by: admin
+  date-created: 2026-10-18T12:00:00Z
+`[1:])
+	s.api.CheckCall(c, 0, "Groups", []string{"engineers"})
+}
+
+func (s *GroupCommandsSuite) TestListGroupsEmpty(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewListGroupsCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "No groups to display.\n")
+}
@@ -5,7 +5,9 @@ package user
 
 import (
 	"bytes"
+	"context"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"net"
@@ -18,6 +20,7 @@ import (
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
+	jujuhttp "github.com/juju/http/v2"
 	"github.com/juju/names/v4"
 	"gopkg.in/httprequest.v1"
 
@@ -57,6 +60,20 @@ After login, a token ("macaroon") will become active. It has an expiration
 time of 24 hours. Upon expiration, no further Juju commands can be issued
 and the user will be prompted to log in again.
 
+If the --oidc option is provided, the user logs into a known controller
+with an OpenID Connect provider the controller trusts. The command
+shows a URL and a code; open the URL in a browser, on any machine, and
+enter the code to log in with the provider. The ID token issued is used
+for later commands until it expires, when "juju login --oidc" must be
+run again. If the controller trusts several providers, choose one with
+--oidc-issuer.
+
+If the --token option is provided, the user logs into a known controller
+with an API token minted by "juju add-token", which is read from the
+terminal, or from stdin with --no-prompt. The token is used for later
+commands until it expires or is removed, and limits the access the user
+has to that chosen when the token was minted.
+
 Aliases
 -------
 
@@ -70,6 +87,9 @@ const loginExamples = `
     juju login somepubliccontroller
     juju login jimm.jujucharms.com
     juju login -u bob
+    juju login --oidc
+    juju login --oidc --oidc-issuer https://sso.example.com
+    echo "$JUJU_TOKEN" | juju login -c ci-controller --token --no-prompt
 `
 
 // Functions defined as variables so they can be overridden in tests.
@@ -79,6 +99,10 @@ var (
 	listModels       = func(c api.Connection, userName string) ([]apibase.UserModel, error) {
 		return modelmanager.NewClient(c).ListModels(userName)
 	}
+	getOIDCLoginInfo = oidcLoginInfo
+	getOIDCIDToken   = func(ctx context.Context, flow authentication.OIDCDeviceFlow) (string, error) {
+		return flow.IDToken(ctx)
+	}
 	// loginClientStore is used as the client store. When it is nil,
 	// the default client store will be used.
 	loginClientStore jujuclient.ClientStore
@@ -100,6 +124,9 @@ type loginCommand struct {
 	noPrompt         bool
 	noPromptPassword string
 	trust            bool
+	oidc             bool
+	oidcIssuer       string
+	apiToken         bool
 	pollster         *interact.Pollster
 
 	// controllerName holds the name of the current controller.
@@ -139,6 +166,9 @@ func (c *loginCommand) SetFlags(fset *gnuflag.FlagSet) {
 	fset.StringVar(&c.username, "user", "", "")
 	fset.BoolVar(&c.noPrompt, "no-prompt", false, "don't prompt for password just read a line from stdin")
 	fset.BoolVar(&c.trust, "trust", false, "automatically trust controller CA certificate")
+	fset.BoolVar(&c.oidc, "oidc", false, "log in with an OpenID Connect provider trusted by the controller")
+	fset.StringVar(&c.oidcIssuer, "oidc-issuer", "", "the URL of the OpenID Connect provider to log in with")
+	fset.BoolVar(&c.apiToken, "token", false, "log in with an API token minted by juju add-token")
 }
 
 // Init implements Command.Init.
@@ -148,6 +178,24 @@ func (c *loginCommand) Init(args []string) error {
 		return errors.Trace(err)
 	}
 	c.domain = domain
+	if c.oidcIssuer != "" && !c.oidc {
+		return errors.New("--oidc-issuer requires --oidc")
+	}
+	if c.oidc && c.domain != "" {
+		return errors.New("--oidc can only be used with a known controller")
+	}
+	if c.oidc && c.username != "" {
+		return errors.New("cannot specify both --oidc and --user")
+	}
+	if c.apiToken && c.oidc {
+		return errors.New("cannot specify both --token and --oidc")
+	}
+	if c.apiToken && c.domain != "" {
+		return errors.New("--token can only be used with a known controller")
+	}
+	if c.apiToken && c.username != "" {
+		return errors.New("cannot specify both --token and --user")
+	}
 	return nil
 }
 
@@ -235,6 +283,16 @@ func (c *loginCommand) run(ctx *cmd.Context) error {
 		return errors.Errorf("controller %q does not exist", c.controllerName)
 	case controllerDetails == nil:
 		return errors.Errorf("no current controller")
+	case c.oidc:
+		conn, accountDetails, err = c.oidcLogin(ctx, store, c.controllerName, controllerDetails)
+		if err != nil {
+			return errors.Annotatef(err, "cannot log into controller %q", c.controllerName)
+		}
+	case c.apiToken:
+		conn, accountDetails, err = c.apiTokenLogin(ctx, store, c.controllerName)
+		if err != nil {
+			return errors.Annotatef(err, "cannot log into controller %q", c.controllerName)
+		}
 	default:
 		conn, accountDetails, err = c.existingControllerLogin(ctx, store, c.controllerName, oldAccountDetails)
 		if err != nil {
@@ -298,6 +356,163 @@ func (c *loginCommand) existingControllerLogin(ctx *cmd.Context, store jujuclien
 	return c.login(ctx, currentAccountDetails, dial)
 }
 
+// oidcLogin logs into an existing controller with an ID token from one
+// of the OpenID Connect providers the controller trusts.
+func (c *loginCommand) oidcLogin(
+	ctx *cmd.Context,
+	store jujuclient.ClientStore,
+	controllerName string,
+	controllerDetails *jujuclient.ControllerDetails,
+) (api.Connection, *jujuclient.AccountDetails, error) {
+	info, err := getOIDCLoginInfo(controllerDetails)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "getting OIDC providers")
+	}
+	issuer, err := c.chooseOIDCIssuer(info.Issuers)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	idToken, err := getOIDCIDToken(ctx, authentication.OIDCDeviceFlow{
+		Issuer:   issuer.URL,
+		ClientID: issuer.ClientID,
+		Scopes:   issuer.Scopes,
+		Prompt: func(verificationURI, userCode string) error {
+			fmt.Fprintf(ctx.Stderr, "To log in, open %s in a browser and enter the code %s\n", verificationURI, userCode)
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	return c.tokenLogin(store, controllerName, &jujuclient.AccountDetails{OIDCToken: idToken})
+}
+
+// apiTokenLogin logs into an existing controller with an API token
+// minted by "juju add-token".
+func (c *loginCommand) apiTokenLogin(
+	ctx *cmd.Context,
+	store jujuclient.ClientStore,
+	controllerName string,
+) (api.Connection, *jujuclient.AccountDetails, error) {
+	var (
+		token string
+		err   error
+	)
+	if c.noPrompt {
+		fmt.Fprintln(ctx.Stderr, "reading API token from stdin...")
+		token, err = readLine(ctx.Stdin)
+	} else {
+		token, err = c.pollster.EnterPassword("API token")
+	}
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, nil, errors.New("no API token supplied")
+	}
+	return c.tokenLogin(store, controllerName, &jujuclient.AccountDetails{APIToken: token})
+}
+
+// tokenLogin logs into an existing controller with the token in the
+// account details, filling in the user name of the account from the
+// user the token logged in as.
+func (c *loginCommand) tokenLogin(
+	store jujuclient.ClientStore,
+	controllerName string,
+	accountDetails *jujuclient.AccountDetails,
+) (api.Connection, *jujuclient.AccountDetails, error) {
+	args, err := c.NewAPIConnectionParams(store, controllerName, "", accountDetails)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	conn, err := newAPIConnection(args)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	user, ok := conn.AuthTag().(names.UserTag)
+	if !ok {
+		conn.Close()
+		return nil, nil, errors.Errorf("logged in as %v, not a user", conn.AuthTag())
+	}
+	accountDetails.User = user.Id()
+	return conn, accountDetails, nil
+}
+
+// chooseOIDCIssuer returns the provider chosen with --oidc-issuer, or
+// the only provider the controller trusts.
+func (c *loginCommand) chooseOIDCIssuer(issuers []params.OIDCIssuerInfo) (params.OIDCIssuerInfo, error) {
+	if len(issuers) == 0 {
+		return params.OIDCIssuerInfo{}, errors.NotSupportedf("OIDC login on this controller")
+	}
+	urls := make([]string, len(issuers))
+	for i, issuer := range issuers {
+		if issuer.URL == c.oidcIssuer {
+			return issuer, nil
+		}
+		urls[i] = issuer.URL
+	}
+	if c.oidcIssuer != "" {
+		return params.OIDCIssuerInfo{}, errors.NotFoundf("OIDC provider %q", c.oidcIssuer)
+	}
+	if len(issuers) > 1 {
+		return params.OIDCIssuerInfo{}, errors.Errorf(
+			"the controller trusts several OIDC providers, choose one with --oidc-issuer: %s",
+			strings.Join(urls, ", "))
+	}
+	return issuers[0], nil
+}
+
+// oidcLoginInfo asks the controller which OpenID Connect providers it
+// trusts, trying each of its API addresses in turn.
+func oidcLoginInfo(controllerDetails *jujuclient.ControllerDetails) (params.OIDCLoginInfo, error) {
+	var options []jujuhttp.Option
+	if controllerDetails.CACert != "" {
+		options = append(options,
+			jujuhttp.WithCACertificates(controllerDetails.CACert),
+			jujuhttp.WithSkipHostnameVerification(true),
+		)
+	}
+	client := jujuhttp.NewClient(options...)
+
+	var lastErr error
+	for _, addr := range controllerDetails.APIEndpoints {
+		info, err := fetchOIDCLoginInfo(client, "https://"+addr+"/oidc-login")
+		if err == nil {
+			return info, nil
+		}
+		lastErr = err
+	}
+	if lastErr == nil {
+		lastErr = errors.New("no API addresses")
+	}
+	return params.OIDCLoginInfo{}, errors.Trace(lastErr)
+}
+
+func fetchOIDCLoginInfo(client *jujuhttp.Client, url string) (params.OIDCLoginInfo, error) {
+	var info params.OIDCLoginInfo
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return info, errors.Trace(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return info, errors.Trace(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode == http.StatusNotFound {
+		return info, errors.NotSupportedf("OIDC login on this controller")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return info, errors.Errorf("unexpected HTTP response %q", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return info, errors.Annotate(err, "cannot decode OIDC login response")
+	}
+	return info, nil
+}
+
 // publicControllerLogin logs into the public controller at the given
 // host. The currentAccountDetails parameter holds existing account
 // information about the controller account.
@@ -5,6 +5,7 @@ package user_test
 
 import (
 	"bytes"
+	"context"
 	"strings"
 
 	"github.com/juju/cmd/v3"
@@ -14,6 +15,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/authentication"
 	apibase "github.com/juju/juju/api/base"
 	"github.com/juju/juju/cmd/juju/user"
 	"github.com/juju/juju/cmd/modelcmd"
@@ -69,6 +71,24 @@ func (s *LoginCommandSuite) TestInitError(c *gc.C) {
 	}, {
 		args:   []string{"foobar", "extra"},
 		stderr: `ERROR unrecognized args: \["extra"\]\n`,
+	}, {
+		args:   []string{"--oidc-issuer", "https://sso.example.com"},
+		stderr: `ERROR --oidc-issuer requires --oidc\n`,
+	}, {
+		args:   []string{"--oidc", "jimm.jujucharms.com"},
+		stderr: `ERROR --oidc can only be used with a known controller\n`,
+	}, {
+		args:   []string{"--oidc", "-u", "bob"},
+		stderr: `ERROR cannot specify both --oidc and --user\n`,
+	}, {
+		args:   []string{"--token", "--oidc"},
+		stderr: `ERROR cannot specify both --token and --oidc\n`,
+	}, {
+		args:   []string{"--token", "jimm.jujucharms.com"},
+		stderr: `ERROR --token can only be used with a known controller\n`,
+	}, {
+		args:   []string{"--token", "-u", "bob"},
+		stderr: `ERROR cannot specify both --token and --user\n`,
 	}} {
 		c.Logf("test %d", i)
 		stdout, stderr, code := runLogin(c, "", test.args...)
@@ -262,6 +282,123 @@ There are no models available(.|\n)*`[1:])
 	c.Assert(code, gc.Equals, 0)
 }
 
+func (s *LoginCommandSuite) patchOIDC(c *gc.C, issuers ...params.OIDCIssuerInfo) *authentication.OIDCDeviceFlow {
+	var flow authentication.OIDCDeviceFlow
+	s.PatchValue(user.GetOIDCLoginInfo, func(details *jujuclient.ControllerDetails) (params.OIDCLoginInfo, error) {
+		c.Check(details.ControllerUUID, gc.Equals, testing.ControllerTag.Id())
+		return params.OIDCLoginInfo{Issuers: issuers}, nil
+	})
+	s.PatchValue(user.GetOIDCIDToken, func(_ context.Context, f authentication.OIDCDeviceFlow) (string, error) {
+		flow = f
+		if err := f.Prompt("https://sso.example.com/activate", "ABCD-EFGH"); err != nil {
+			return "", err
+		}
+		return "id-token", nil
+	})
+	return &flow
+}
+
+func (s *LoginCommandSuite) TestLoginOIDC(c *gc.C) {
+	flow := s.patchOIDC(c, params.OIDCIssuerInfo{
+		URL:      "https://sso.example.com",
+		ClientID: "juju",
+		Scopes:   []string{"openid", "email"},
+	})
+	s.apiConnection.authTag = names.NewUserTag("mary@example.com")
+
+	stdout, stderr, code := runLogin(c, "", "--oidc")
+	c.Check(stdout, gc.Equals, "")
+	c.Check(stderr, gc.Matches, `
+To log in, open https://sso.example.com/activate in a browser and enter the code ABCD-EFGH
+Welcome, mary@example.com. You are now logged into "testing".
+
+There are no models available(.|\n)*`[1:])
+	c.Assert(code, gc.Equals, 0)
+	c.Check(flow.Issuer, gc.Equals, "https://sso.example.com")
+	c.Check(flow.ClientID, gc.Equals, "juju")
+	c.Check(flow.Scopes, jc.DeepEquals, []string{"openid", "email"})
+	c.Check(s.apiConnectionParams.AccountDetails, jc.DeepEquals, &jujuclient.AccountDetails{
+		OIDCToken: "id-token",
+	})
+
+	details, err := s.store.AccountDetails("testing")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(details, jc.DeepEquals, &jujuclient.AccountDetails{
+		User:            "mary@example.com",
+		OIDCToken:       "id-token",
+		LastKnownAccess: "superuser",
+	})
+}
+
+func (s *LoginCommandSuite) TestLoginAPIToken(c *gc.C) {
+	s.apiConnection.authTag = names.NewUserTag("ci-bot")
+
+	stdout, stderr, code := runLogin(c, "juju-api-token-deadbeef\n", "--token", "--no-prompt")
+	c.Check(stdout, gc.Equals, "")
+	c.Check(stderr, gc.Matches, `
+reading API token from stdin...
+Welcome, ci-bot. You are now logged into "testing".
+
+There are no models available(.|\n)*`[1:])
+	c.Assert(code, gc.Equals, 0)
+	c.Check(s.apiConnectionParams.AccountDetails, jc.DeepEquals, &jujuclient.AccountDetails{
+		APIToken: "juju-api-token-deadbeef",
+	})
+
+	details, err := s.store.AccountDetails("testing")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(details, jc.DeepEquals, &jujuclient.AccountDetails{
+		User:            "ci-bot",
+		APIToken:        "juju-api-token-deadbeef",
+		LastKnownAccess: "superuser",
+	})
+}
+
+func (s *LoginCommandSuite) TestLoginAPITokenEmpty(c *gc.C) {
+	_, stderr, code := runLogin(c, "\n", "--token", "--no-prompt")
+	c.Check(stderr, gc.Equals, `
+reading API token from stdin...
+ERROR cannot log into controller "testing": no API token supplied
+`[1:])
+	c.Assert(code, gc.Equals, 1)
+}
+
+func (s *LoginCommandSuite) TestLoginOIDCChooseIssuer(c *gc.C) {
+	flow := s.patchOIDC(c, params.OIDCIssuerInfo{
+		URL:      "https://sso.example.com",
+		ClientID: "juju",
+	}, params.OIDCIssuerInfo{
+		URL:      "https://login.example.org",
+		ClientID: "juju-prod",
+	})
+
+	_, stderr, code := runLogin(c, "", "--oidc")
+	c.Check(stderr, gc.Equals, `
+ERROR cannot log into controller "testing": the controller trusts several OIDC providers, choose one with --oidc-issuer: https://sso.example.com, https://login.example.org
+`[1:])
+	c.Assert(code, gc.Equals, 1)
+
+	_, stderr, code = runLogin(c, "", "--oidc", "--oidc-issuer", "https://other.example.com")
+	c.Check(stderr, gc.Equals, `
+ERROR cannot log into controller "testing": OIDC provider "https://other.example.com" not found
+`[1:])
+	c.Assert(code, gc.Equals, 1)
+
+	_, _, code = runLogin(c, "", "--oidc", "--oidc-issuer", "https://login.example.org")
+	c.Assert(code, gc.Equals, 0)
+	c.Check(flow.ClientID, gc.Equals, "juju-prod")
+}
+
+func (s *LoginCommandSuite) TestLoginOIDCNotSupported(c *gc.C) {
+	s.patchOIDC(c)
+
+	_, stderr, code := runLogin(c, "", "--oidc")
+	c.Check(stderr, gc.Equals, `
+ERROR cannot log into controller "testing": OIDC login on this controller not supported
+`[1:])
+	c.Assert(code, gc.Equals, 1)
+}
+
 func (s *LoginCommandSuite) TestLoginWithCAVerification(c *gc.C) {
 	caCert := testing.CACertX509
 	fingerprint, _, err := pki.Fingerprint([]byte(testing.CACert))
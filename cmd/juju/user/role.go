@@ -0,0 +1,283 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/rpc/params"
+)
+
+var usageAddRoleSummary = `
+Adds a custom role to a controller.`[1:]
+
+var usageAddRoleDetails = `
+A role is a named set of API methods, each given as "Facade.Method", or as
+"Facade.*" to allow every method of a facade. A role granted to a user on
+a model with ` + "`juju grant`" + ` lets the user call the role's methods
+on that model as if they had write access, without giving them write
+access to anything else.
+
+Roles can't share their names with the access levels, such as "read" or
+"write". Only controller superusers can add roles.
+
+`[1:]
+
+const usageAddRoleExamples = `
+    juju add-role support Action.* Client.FullStatus
+    juju add-role config-reader Application.CharmConfig
+`
+
+var usageRemoveRoleSummary = `
+Removes a custom role from a controller.`[1:]
+
+var usageRemoveRoleDetails = `
+Removing a role revokes it from every user it was granted to.
+
+`[1:]
+
+const usageRemoveRoleExamples = `
+    juju remove-role support
+`
+
+var usageListRolesSummary = `
+Lists the custom roles on a controller.`[1:]
+
+var usageListRolesDetails = `
+Every user can list the roles, along with the methods each role allows.
+
+`[1:]
+
+const usageListRolesExamples = `
+    juju roles
+    juju roles --format yaml
+`
+
+// RoleAPI defines the usermanager API methods that the role commands use.
+type RoleAPI interface {
+	AddRole(name string, methods ...string) error
+	RemoveRole(name string) error
+	Roles() ([]params.Role, error)
+	Close() error
+}
+
+// roleCommandBase is the common base for the role commands.
+type roleCommandBase struct {
+	modelcmd.ControllerCommandBase
+	api RoleAPI
+}
+
+func (c *roleCommandBase) getRoleAPI() (RoleAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	return c.NewUserManagerAPIClient()
+}
+
+// NewAddRoleCommand returns a command to add a custom role.
+func NewAddRoleCommand() cmd.Command {
+	return modelcmd.WrapController(&addRoleCommand{})
+}
+
+// addRoleCommand adds a custom role to a controller.
+type addRoleCommand struct {
+	roleCommandBase
+	Role    string
+	Methods []string
+}
+
+// Info implements Command.Info.
+func (c *addRoleCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "add-role",
+		Args:     "<role name> <facade method> [<facade method> ...]",
+		Purpose:  usageAddRoleSummary,
+		Doc:      usageAddRoleDetails,
+		Examples: usageAddRoleExamples,
+		SeeAlso: []string{
+			"roles",
+			"remove-role",
+			"grant",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *addRoleCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no role name supplied")
+	}
+	c.Role, c.Methods = args[0], args[1:]
+	if len(c.Methods) == 0 {
+		return errors.New("no facade methods supplied")
+	}
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *addRoleCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getRoleAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.AddRole(c.Role, c.Methods...); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Role %q added", c.Role)
+	return nil
+}
+
+// NewRemoveRoleCommand returns a command to remove a custom role.
+func NewRemoveRoleCommand() cmd.Command {
+	return modelcmd.WrapController(&removeRoleCommand{})
+}
+
+// removeRoleCommand removes a custom role from a controller.
+type removeRoleCommand struct {
+	roleCommandBase
+	Role string
+}
+
+// Info implements Command.Info.
+func (c *removeRoleCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-role",
+		Args:     "<role name>",
+		Purpose:  usageRemoveRoleSummary,
+		Doc:      usageRemoveRoleDetails,
+		Examples: usageRemoveRoleExamples,
+		SeeAlso: []string{
+			"roles",
+			"add-role",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *removeRoleCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no role name supplied")
+	}
+	c.Role = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *removeRoleCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getRoleAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.RemoveRole(c.Role); err != nil {
+		return block.ProcessBlockedError(err, block.BlockRemove)
+	}
+	ctx.Infof("Role %q removed", c.Role)
+	return nil
+}
+
+// NewListRolesCommand returns a command to list custom roles.
+func NewListRolesCommand() cmd.Command {
+	return modelcmd.WrapController(&listRolesCommand{})
+}
+
+// listRolesCommand lists the custom roles on a controller.
+type listRolesCommand struct {
+	roleCommandBase
+	out cmd.Output
+}
+
+// RoleInfo holds the information about a custom role that is written by
+// the roles command.
+type RoleInfo struct {
+	Name        string    `yaml:"name" json:"name"`
+	Methods     []string  `yaml:"methods" json:"methods"`
+	CreatedBy   string    `yaml:"created-by" json:"created-by"`
+	DateCreated time.Time `yaml:"date-created" json:"date-created"`
+}
+
+// Info implements Command.Info.
+func (c *listRolesCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "roles",
+		Purpose:  usageListRolesSummary,
+		Doc:      usageListRolesDetails,
+		Aliases:  []string{"list-roles"},
+		Examples: usageListRolesExamples,
+		SeeAlso: []string{
+			"add-role",
+			"grant",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listRolesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.roleCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatRolesTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *listRolesCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements Command.Run.
+func (c *listRolesCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getRoleAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	roles, err := api.Roles()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(roles) == 0 {
+		ctx.Infof("No roles to display.")
+		return nil
+	}
+	info := make([]RoleInfo, len(roles))
+	for i, role := range roles {
+		info[i] = RoleInfo{
+			Name:        role.Name,
+			Methods:     role.Methods,
+			CreatedBy:   role.CreatedBy,
+			DateCreated: role.DateCreated,
+		}
+	}
+	return c.out.Write(ctx, info)
+}
+
+func formatRolesTabular(writer io.Writer, value interface{}) error {
+	roles, ok := value.([]RoleInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", roles, value)
+	}
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Name", "Methods", "Created by")
+	for _, role := range roles {
+		w.Println(role.Name, strings.Join(role.Methods, ","), role.CreatedBy)
+	}
+	return tw.Flush()
+}
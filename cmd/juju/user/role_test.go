@@ -0,0 +1,124 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/rpc/params"
+)
+
+type RoleCommandsSuite struct {
+	BaseSuite
+	api *mockRoleAPI
+}
+
+var _ = gc.Suite(&RoleCommandsSuite{})
+
+func (s *RoleCommandsSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.api = &mockRoleAPI{}
+}
+
+type mockRoleAPI struct {
+	testing.Stub
+	roles []params.Role
+}
+
+func (m *mockRoleAPI) AddRole(name string, methods ...string) error {
+	m.MethodCall(m, "AddRole", name, methods)
+	return m.NextErr()
+}
+
+func (m *mockRoleAPI) RemoveRole(name string) error {
+	m.MethodCall(m, "RemoveRole", name)
+	return m.NextErr()
+}
+
+func (m *mockRoleAPI) Roles() ([]params.Role, error) {
+	m.MethodCall(m, "Roles")
+	return m.roles, m.NextErr()
+}
+
+func (m *mockRoleAPI) Close() error {
+	return nil
+}
+
+func (s *RoleCommandsSuite) TestAddRole(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewAddRoleCommandForTest(s.api, s.store), "support", "Action.*", "Client.FullStatus")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Role \"support\" added\n")
+	s.api.CheckCall(c, 0, "AddRole", "support", []string{"Action.*", "Client.FullStatus"})
+}
+
+func (s *RoleCommandsSuite) TestAddRoleInitErrors(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewAddRoleCommandForTest(s.api, s.store))
+	c.Assert(err, gc.ErrorMatches, "no role name supplied")
+
+	_, err = cmdtesting.RunCommand(c, user.NewAddRoleCommandForTest(s.api, s.store), "support")
+	c.Assert(err, gc.ErrorMatches, "no facade methods supplied")
+}
+
+func (s *RoleCommandsSuite) TestAddRoleError(c *gc.C) {
+	s.api.SetErrors(errors.New("role name not valid"))
+	_, err := cmdtesting.RunCommand(c, user.NewAddRoleCommandForTest(s.api, s.store), "write", "Action.*")
+	c.Assert(err, gc.ErrorMatches, "role name not valid")
+}
+
+func (s *RoleCommandsSuite) TestRemoveRole(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewRemoveRoleCommandForTest(s.api, s.store), "support")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Role \"support\" removed\n")
+	s.api.CheckCall(c, 0, "RemoveRole", "support")
+
+	_, err = cmdtesting.RunCommand(c, user.NewRemoveRoleCommandForTest(s.api, s.store), "support", "auditor")
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["auditor"\]`)
+}
+
+func (s *RoleCommandsSuite) TestListRoles(c *gc.C) {
+	s.api.roles = []params.Role{{
+		Name: "auditor", Methods: []string{"Client.FullStatus"}, CreatedBy: "admin",
+	}, {
+		Name: "support", Methods: []string{"Action.*", "Client.FullStatus"}, CreatedBy: "admin",
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewListRolesCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+Name     Methods                     Created by
+auditor  Client.FullStatus           admin
+support  Action.*,Client.FullStatus  admin
+`[1:])
+	s.api.CheckCallNames(c, "Roles")
+}
+
+func (s *RoleCommandsSuite) TestListRolesYAML(c *gc.C) {
+	s.api.roles = []params.Role{{
+		Name:        "support",
+		Methods:     []string{"Action.*"},
+		CreatedBy:   "admin",
+		DateCreated: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewListRolesCommandForTest(s.api, s.store), "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+- name: support
+  methods:
+  - Action.*
+  created-by: admin
+  date-created: 2026-10-18T12:00:00Z
+`[1:])
+}
+
+func (s *RoleCommandsSuite) TestListRolesEmpty(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewListRolesCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "No roles to display.\n")
+}
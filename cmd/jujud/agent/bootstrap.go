@@ -440,7 +440,7 @@ func (c *BootstrapCommand) Run(ctx *cmd.Context) error {
 		}
 	}
 
-	objectStore, err := objectstore.NewStateObjectStore(ctx, st.ModelUUID(), st, logger)
+	objectStore, err := objectstore.NewStateObjectStore(ctx, st.ModelUUID(), st, nil, logger)
 	if err != nil {
 		return errors.Trace(err)
 	}
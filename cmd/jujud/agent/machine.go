@@ -83,10 +83,12 @@ import (
 	"github.com/juju/juju/upgrades"
 	jujuversion "github.com/juju/juju/version"
 	jworker "github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/changestream"
 	"github.com/juju/juju/worker/dbaccessor"
 	"github.com/juju/juju/worker/deployer"
 	"github.com/juju/juju/worker/gate"
 	"github.com/juju/juju/worker/introspection"
+	leasemanager "github.com/juju/juju/worker/lease"
 	"github.com/juju/juju/worker/logsender"
 	"github.com/juju/juju/worker/logsender/logsendermetrics"
 	"github.com/juju/juju/worker/migrationmaster"
@@ -598,6 +600,8 @@ func (a *MachineAgent) makeEngineCreator(
 			Logger: loggo.GetLogger("juju.localhub"),
 		})
 		pubsubReporter := psworker.NewReporter()
+		leaseReporter := leasemanager.NewReporter()
+		changeStreamReporter := changestream.NewReporter()
 		presenceRecorder := presence.New(clock.WallClock)
 		updateAgentConfLogging := func(loggingConfig string) error {
 			return a.AgentConfigWriter.ChangeConfig(func(setter agent.ConfigSetter) error {
@@ -620,29 +624,31 @@ func (a *MachineAgent) makeEngineCreator(
 		charmhubHTTPClient := charmhub.DefaultHTTPClient(charmhubLogger)
 
 		manifoldsCfg := machine.ManifoldsConfig{
-			PreviousAgentVersion: previousAgentVersion,
-			AgentName:            agentName,
-			Agent:                agent.APIHostPortsSetter{Agent: a},
-			RootDir:              a.rootDir,
-			AgentConfigChanged:   a.configChangedVal,
-			UpgradeDBLock:        a.upgradeDBLock,
-			UpgradeStepsLock:     a.upgradeStepsLock,
-			UpgradeCheckLock:     a.initialUpgradeCheckComplete,
-			NewDBWorkerFunc:      a.newDBWorkerFunc,
-			OpenStatePool:        a.initState,
-			MachineStartup:       a.machineStartup,
-			PreUpgradeSteps:      a.preUpgradeSteps,
-			UpgradeSteps:         a.upgradeSteps,
-			LogSource:            a.bufferedLogger.Logs(),
-			NewDeployContext:     deployer.NewNestedContext,
-			Clock:                clock.WallClock,
-			ValidateMigration:    a.validateMigration,
-			PrometheusRegisterer: a.prometheusRegistry,
-			CentralHub:           a.centralHub,
-			LocalHub:             localHub,
-			PubSubReporter:       pubsubReporter,
-			PresenceRecorder:     presenceRecorder,
-			UpdateLoggerConfig:   updateAgentConfLogging,
+			PreviousAgentVersion:    previousAgentVersion,
+			AgentName:               agentName,
+			Agent:                   agent.APIHostPortsSetter{Agent: a},
+			RootDir:                 a.rootDir,
+			AgentConfigChanged:      a.configChangedVal,
+			UpgradeDBLock:           a.upgradeDBLock,
+			UpgradeStepsLock:        a.upgradeStepsLock,
+			UpgradeCheckLock:        a.initialUpgradeCheckComplete,
+			NewDBWorkerFunc:         a.newDBWorkerFunc,
+			OpenStatePool:           a.initState,
+			MachineStartup:          a.machineStartup,
+			PreUpgradeSteps:         a.preUpgradeSteps,
+			UpgradeSteps:            a.upgradeSteps,
+			LogSource:               a.bufferedLogger.Logs(),
+			NewDeployContext:        deployer.NewNestedContext,
+			Clock:                   clock.WallClock,
+			ValidateMigration:       a.validateMigration,
+			PrometheusRegisterer:    a.prometheusRegistry,
+			CentralHub:              a.centralHub,
+			LocalHub:                localHub,
+			PubSubReporter:          pubsubReporter,
+			SetLeaseReporter:        leaseReporter.SetManager,
+			SetChangeStreamReporter: changeStreamReporter.SetWorker,
+			PresenceRecorder:        presenceRecorder,
+			UpdateLoggerConfig:      updateAgentConfLogging,
 			NewAgentStatusSetter: func(apiCaller base.APICaller) (upgradesteps.StatusSetter, error) {
 				return a.statusSetter(apiCaller)
 			},
@@ -681,6 +687,8 @@ func (a *MachineAgent) makeEngineCreator(
 			Engine:             eng,
 			StatePoolReporter:  &statePoolReporter,
 			PubSubReporter:     pubsubReporter,
+			LeaseReporter:      leaseReporter,
+			ChangeStream:       changeStreamReporter,
 			MachineLock:        a.machineLock,
 			NewSocketName:      a.newIntrospectionSocketName,
 			PrometheusGatherer: a.prometheusRegistry,
@@ -201,6 +201,14 @@ type ManifoldsConfig struct {
 	// worker.
 	PubSubReporter psworker.Reporter
 
+	// SetLeaseReporter is called with the lease manager when it starts,
+	// and with nil when it stops, so that it can be introspected.
+	SetLeaseReporter func(leasemanager.Reporter)
+
+	// SetChangeStreamReporter is called with the change stream worker when
+	// it starts, and with nil when it stops, so that it can be introspected.
+	SetChangeStreamReporter func(changestream.Reporter)
+
 	// PresenceRecorder
 	PresenceRecorder presence.Recorder
 
@@ -720,6 +728,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			PrometheusRegisterer: config.PrometheusRegisterer,
 			NewWatchableDB:       changestream.NewWatchableDB,
 			NewMetricsCollector:  changestream.NewMetricsCollector,
+			SetReporter:          config.SetChangeStreamReporter,
 		})),
 
 		changeStreamPrunerName: ifPrimaryController(changestreampruner.Manifold(changestreampruner.ManifoldConfig{
@@ -755,6 +764,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Logger:               loggo.GetLogger("juju.worker.lease"),
 			LogDir:               agentConfig.LogDir(),
 			PrometheusRegisterer: config.PrometheusRegisterer,
+			SetReporter:          config.SetLeaseReporter,
 			NewWorker:            leasemanager.NewWorker,
 			NewStore:             leasemanager.NewStore,
 		})),
@@ -803,9 +813,11 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			AgentName:            agentName,
 			StateName:            stateName,
 			TraceName:            traceName,
+			ServiceFactoryName:   serviceFactoryName,
 			Clock:                config.Clock,
 			Logger:               loggo.GetLogger("juju.worker.objectstore"),
-			NewObjectStoreWorker: internalobjectstore.NewStateObjectStore,
+			NewObjectStoreWorker: internalobjectstore.ObjectStoreFactory,
+			CentralHub:           config.CentralHub,
 		})),
 	}
 
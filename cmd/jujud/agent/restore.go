@@ -0,0 +1,311 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agent
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/cmd/v3"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/agent/agentbootstrap"
+	agentconfig "github.com/juju/juju/agent/config"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/internal/agent/agentconf"
+	"github.com/juju/juju/core/backups"
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/internal/database"
+	jujuversion "github.com/juju/juju/version"
+)
+
+const restoreBackupDoc = `
+Restore the controller state held in a backup archive, created with
+"juju create-backup", onto this machine.
+
+The machine agent must be stopped before running this command. The files
+bundled in the archive, including the agent configuration, are unpacked into
+a staging directory, and any existing Dqlite data is moved aside. A new
+single-node Dqlite cluster is then seeded with the controller and model
+databases from the archive. Only once that succeeds are the staged files
+moved to their original locations; if it fails, the previous Dqlite data is
+put back and no other files are changed.
+
+The restore covers the Dqlite databases only. Backup archives don't hold the
+Mongo database, which is left as it is.
+
+Once the restore is complete, start the machine agent. Other controller
+machines must have their Dqlite data directory removed before their agents
+are restarted, so that they rejoin the restored controller as new nodes.
+`
+
+// RestoreCommand represents a jujud restore-backup command.
+type RestoreCommand struct {
+	cmd.CommandBase
+	agentconf.AgentConf
+
+	ArchiveFile string
+
+	DqliteInitializer agentbootstrap.DqliteInitializerFunc
+}
+
+// NewRestoreCommand returns a new RestoreCommand that has been initialized.
+func NewRestoreCommand() *RestoreCommand {
+	return &RestoreCommand{
+		AgentConf:         agentconf.NewAgentConf(""),
+		DqliteInitializer: database.BootstrapDqlite,
+	}
+}
+
+// Info returns a description of the command.
+func (c *RestoreCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "restore-backup",
+		Args:    "<backup-file>",
+		Purpose: "restore controller state from a backup archive",
+		Doc:     restoreBackupDoc,
+	})
+}
+
+// SetFlags adds the flags for this command to the passed gnuflag.FlagSet.
+func (c *RestoreCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.AgentConf.AddFlags(f)
+}
+
+// Init initializes the command for running.
+func (c *RestoreCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("backup file must be specified")
+	}
+	c.ArchiveFile = args[0]
+	return c.AgentConf.CheckArgs(args[1:])
+}
+
+// Run restores the controller from the backup archive.
+func (c *RestoreCommand) Run(ctx *cmd.Context) (err error) {
+	archive, err := os.Open(c.ArchiveFile)
+	if err != nil {
+		return errors.Annotate(err, "opening backup archive")
+	}
+	defer func() { _ = archive.Close() }()
+
+	ws, err := backups.NewArchiveWorkspaceReader(archive)
+	if err != nil {
+		return errors.Annotate(err, "unpacking backup archive")
+	}
+	defer func() { _ = ws.Close() }()
+
+	meta, err := ws.Metadata()
+	if err != nil {
+		return errors.Annotate(err, "reading backup metadata")
+	}
+	if err := checkBackupVersion(meta); err != nil {
+		return errors.Trace(err)
+	}
+
+	dumps, err := ws.DBDumpFiles()
+	if err != nil {
+		return errors.Annotate(err, "reading database snapshots")
+	}
+	controllerDump, ok := dumps[coredatabase.ControllerNS]
+	if !ok {
+		return errors.NotFoundf("controller database snapshot in backup archive")
+	}
+
+	ctx.Infof("Backup %s holds the Dqlite databases only; the Mongo database is not restored", meta.ID())
+
+	// The files are unpacked into a staging directory in the data
+	// directory, so that they can be renamed into place once the
+	// databases are restored.
+	staging, err := os.MkdirTemp(c.DataDir(), "restore-")
+	if err != nil {
+		return errors.Annotate(err, "creating restore staging directory")
+	}
+	defer func() { _ = os.RemoveAll(staging) }()
+
+	ctx.Infof("Unpacking files from backup %s", meta.ID())
+	if err := ws.UnpackFilesBundle(staging); err != nil {
+		return errors.Annotate(err, "unpacking files")
+	}
+
+	// The staged agent config is read and updated in place. It still
+	// refers to the real data directory, which is where the databases
+	// are restored to.
+	machineID := meta.Controller.MachineID
+	staged := agentconf.NewAgentConf(filepath.Join(staging, c.DataDir()))
+	if err := agentconfig.ReadAgentConfig(staged, machineID); err != nil {
+		return errors.Annotate(err, "reading restored agent config")
+	}
+	if err := rewriteAgentConfig(staged); err != nil {
+		return errors.Annotate(err, "updating restored agent config")
+	}
+
+	// The restored Dqlite node files describe the node's membership of
+	// the backed up cluster. The databases are loaded into a new
+	// single-node cluster instead, which writes its own.
+	if removed, err := removeDqliteNodeFiles(staged.DataDir()); err != nil {
+		return errors.Trace(err)
+	} else if removed {
+		ctx.Infof("Dqlite node files from the backup are replaced by those of a new single-node cluster")
+	}
+
+	var concerns []database.BootstrapConcern
+	var files []*os.File
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}()
+	for namespace, path := range dumps {
+		f, err := os.Open(path)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		files = append(files, f)
+
+		if path == controllerDump {
+			concerns = append([]database.BootstrapConcern{
+				database.BootstrapRestoreConcern(namespace, f, database.RestoreControllerNodeInit(machineID)),
+			}, concerns...)
+			continue
+		}
+		concerns = append(concerns, database.BootstrapRestoreConcern(namespace, f, database.EmptyInit))
+	}
+
+	// Move the existing Dqlite data aside, so that it can be put back
+	// should the databases fail to restore.
+	movedTo, err := c.moveDqliteDataAside()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if movedTo != "" {
+		ctx.Infof("Previous Dqlite data moved to %s", movedTo)
+	}
+
+	ctx.Infof("Restoring %d database(s)", len(concerns))
+	if err := c.DqliteInitializer(
+		ctx,
+		database.NewNodeManager(staged.CurrentConfig(), logger, coredatabase.NoopSlowQueryLogger{}),
+		logger,
+		false,
+		concerns...,
+	); err != nil {
+		if rErr := c.restoreDqliteData(movedTo); rErr != nil {
+			ctx.Warningf("Putting back the previous Dqlite data from %s: %v", movedTo, rErr)
+		} else if movedTo != "" {
+			ctx.Infof("Previous Dqlite data put back")
+		}
+		return errors.Annotate(err, "restoring databases")
+	}
+
+	ctx.Infof("Restoring files from backup %s", meta.ID())
+	if err := moveStagedFiles(staging); err != nil {
+		return errors.Annotate(err, "restoring files")
+	}
+
+	ctx.Infof("Restore of controller machine %s complete; start the machine agent", machineID)
+	return nil
+}
+
+// checkBackupVersion ensures that the backup was taken by a controller
+// running the same major and minor version as this agent.
+func checkBackupVersion(meta *backups.Metadata) error {
+	backupVersion := meta.Origin.Version
+	if backupVersion.Major != jujuversion.Current.Major || backupVersion.Minor != jujuversion.Current.Minor {
+		return errors.NotSupportedf(
+			"restoring a backup from version %s to version %s", backupVersion, jujuversion.Current)
+	}
+	return nil
+}
+
+// rewriteAgentConfig points the restored agent at the local API server.
+// The addresses of the other controllers are learned once the agent starts.
+func rewriteAgentConfig(conf agentconfig.AgentConf) error {
+	return conf.ChangeConfig(func(config agent.ConfigSetter) error {
+		info, ok := config.StateServingInfo()
+		if !ok {
+			return errors.New("restored agent config has no state serving info")
+		}
+		hostPorts := network.NewMachineHostPorts(info.APIPort, "localhost").HostPorts()
+		return config.SetAPIHostPorts([]network.HostPorts{hostPorts})
+	})
+}
+
+// moveDqliteDataAside renames any existing Dqlite data directory so that a
+// new cluster can be seeded from the backup. The new location is returned,
+// or an empty string if there was no data directory.
+func (c *RestoreCommand) moveDqliteDataAside() (string, error) {
+	dir := filepath.Join(c.DataDir(), "dqlite")
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	for i := 0; ; i++ {
+		target := fmt.Sprintf("%s.pre-restore.%d", dir, i)
+		if _, err := os.Stat(target); err == nil {
+			continue
+		} else if !os.IsNotExist(err) {
+			return "", errors.Trace(err)
+		}
+		return target, errors.Annotate(os.Rename(dir, target), "moving Dqlite data aside")
+	}
+}
+
+// restoreDqliteData removes the Dqlite data seeded by a failed restore and
+// renames the previous data, moved aside to the input directory, back into
+// place. An empty directory means there was no previous data.
+func (c *RestoreCommand) restoreDqliteData(movedTo string) error {
+	dir := filepath.Join(c.DataDir(), "dqlite")
+	if err := os.RemoveAll(dir); err != nil {
+		return errors.Trace(err)
+	}
+	if movedTo == "" {
+		return nil
+	}
+	return errors.Trace(os.Rename(movedTo, dir))
+}
+
+// removeDqliteNodeFiles removes the Dqlite node files unpacked from the
+// backup archive into the input data directory, reporting whether there
+// were any.
+func removeDqliteNodeFiles(dataDir string) (bool, error) {
+	dir := filepath.Join(dataDir, "dqlite")
+	var removed bool
+	for _, name := range []string{"info.yaml", "cluster.yaml"} {
+		err := os.Remove(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return false, errors.Annotatef(err, "removing restored Dqlite %s", name)
+		}
+		removed = true
+	}
+	return removed, nil
+}
+
+// moveStagedFiles renames each file unpacked into the staging directory to
+// the location it was backed up from, replacing any file already there.
+func moveStagedFiles(staging string) error {
+	return filepath.WalkDir(staging, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return errors.Trace(err)
+		}
+		rel, err := filepath.Rel(staging, path)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		target := filepath.Join(string(os.PathSeparator), rel)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Trace(os.Rename(path, target))
+	})
+}
@@ -0,0 +1,269 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agent
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/cmd/v3/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version/v2"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/internal/database"
+	"github.com/juju/juju/testing"
+	jujuversion "github.com/juju/juju/version"
+)
+
+const controllerDump = `
+CREATE TABLE controller_node (
+    controller_id  TEXT PRIMARY KEY,
+    dqlite_node_id TEXT,
+    bind_address   TEXT
+);
+INSERT INTO "controller_node" VALUES('0','1234','10.0.0.1');
+INSERT INTO "controller_node" VALUES('1','5678','10.0.0.2');
+`
+
+const modelDump = `
+CREATE TABLE model_config (key TEXT PRIMARY KEY, value TEXT);
+INSERT INTO "model_config" VALUES('name','controller');
+`
+
+type RestoreSuite struct {
+	testing.BaseSuite
+
+	dataDir     string
+	archiveFile string
+}
+
+var _ = gc.Suite(&RestoreSuite{})
+
+type fakeDumper struct{}
+
+func (fakeDumper) DumpDatabases(_ context.Context, dumpDir string) error {
+	if err := os.WriteFile(filepath.Join(dumpDir, "controller.sql"), []byte(controllerDump), 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dumpDir, testing.ModelTag.Id()+".sql"), []byte(modelDump), 0600)
+}
+
+func (s *RestoreSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+
+	s.dataDir = c.MkDir()
+
+	conf, err := agent.NewStateMachineConfig(agent.AgentConfigParams{
+		Paths: agent.Paths{
+			DataDir: s.dataDir,
+			LogDir:  c.MkDir(),
+		},
+		Tag:               names.NewMachineTag("0"),
+		UpgradedToVersion: jujuversion.Current,
+		Password:          "sekrit",
+		Nonce:             agent.BootstrapNonce,
+		Controller:        testing.ControllerTag,
+		Model:             testing.ModelTag,
+		APIAddresses:      []string{"10.0.0.1:17070", "10.0.0.2:17070"},
+		CACert:            testing.CACert,
+	}, controller.StateServingInfo{
+		Cert:         testing.ServerCert,
+		PrivateKey:   testing.ServerKey,
+		CAPrivateKey: testing.CAKey,
+		APIPort:      17070,
+		StatePort:    37017,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(conf.Write(), jc.ErrorIsNil)
+
+	paths := backups.Paths{BackupDir: c.MkDir(), DataDir: s.dataDir}
+	files, err := backups.FilesToBackUp(paths, "0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	meta := backups.NewMetadata()
+	meta.Origin.Version = jujuversion.Current
+	meta.Controller.MachineID = "0"
+	s.archiveFile, err = backups.Create(context.Background(), meta, paths, fakeDumper{}, files)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *RestoreSuite) newRestoreCommand(c *gc.C, args ...string) *RestoreCommand {
+	command := NewRestoreCommand()
+	command.DqliteInitializer = func(
+		ctx context.Context,
+		mgr database.BootstrapNodeManager,
+		logger database.Logger,
+		_ bool,
+		concerns ...database.BootstrapConcern,
+	) error {
+		return database.BootstrapDqlite(ctx, mgr, logger, true, concerns...)
+	}
+	err := cmdtesting.InitCommand(command, append([]string{"--data-dir", s.dataDir, s.archiveFile}, args...))
+	c.Assert(err, jc.ErrorIsNil)
+	return command
+}
+
+func (s *RestoreSuite) TestInitRequiresArchive(c *gc.C) {
+	err := cmdtesting.InitCommand(NewRestoreCommand(), nil)
+	c.Assert(err, gc.ErrorMatches, "backup file must be specified")
+}
+
+func (s *RestoreSuite) TestRestore(c *gc.C) {
+	// The agent config must be restored from the archive.
+	configPath := agent.ConfigPath(s.dataDir, names.NewMachineTag("0"))
+	c.Assert(os.Remove(configPath), jc.ErrorIsNil)
+
+	// Existing Dqlite data must be moved aside.
+	existing := filepath.Join(s.dataDir, "dqlite")
+	c.Assert(os.MkdirAll(existing, 0700), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(existing, "info.yaml"), []byte("old"), 0600), jc.ErrorIsNil)
+
+	err := s.newRestoreCommand(c).Run(cmdtesting.Context(c))
+	c.Assert(err, jc.ErrorIsNil)
+
+	old, err := os.ReadFile(filepath.Join(existing+".pre-restore.0", "info.yaml"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(old), gc.Equals, "old")
+
+	conf, err := agent.ReadConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	addrs, err := conf.APIAddresses()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addrs, jc.DeepEquals, []string{"localhost:17070"})
+
+	staged, err := filepath.Glob(filepath.Join(s.dataDir, "restore-*"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(staged, gc.HasLen, 0)
+
+	db, err := sql.Open("sqlite3", filepath.Join(existing, "controller"))
+	c.Assert(err, jc.ErrorIsNil)
+	defer db.Close()
+
+	var nodeID, bindAddress sql.NullString
+	row := db.QueryRow("SELECT dqlite_node_id, bind_address FROM controller_node WHERE controller_id = '1'")
+	c.Assert(row.Scan(&nodeID, &bindAddress), jc.ErrorIsNil)
+	c.Check(nodeID.Valid, jc.IsFalse)
+	c.Check(bindAddress.Valid, jc.IsFalse)
+
+	row = db.QueryRow("SELECT bind_address FROM controller_node WHERE controller_id = '0'")
+	c.Assert(row.Scan(&bindAddress), jc.ErrorIsNil)
+	c.Check(bindAddress.String, gc.Equals, "127.0.0.1")
+
+	modelDB, err := sql.Open("sqlite3", filepath.Join(existing, testing.ModelTag.Id()))
+	c.Assert(err, jc.ErrorIsNil)
+	defer modelDB.Close()
+
+	var name string
+	row = modelDB.QueryRow("SELECT value FROM model_config WHERE key = 'name'")
+	c.Assert(row.Scan(&name), jc.ErrorIsNil)
+	c.Check(name, gc.Equals, "controller")
+}
+
+func (s *RestoreSuite) TestRestoreDatabaseFailure(c *gc.C) {
+	existing := filepath.Join(s.dataDir, "dqlite")
+	c.Assert(os.MkdirAll(existing, 0700), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(existing, "info.yaml"), []byte("old"), 0600), jc.ErrorIsNil)
+
+	// The agent config on disk differs from the one in the archive.
+	configPath := agent.ConfigPath(s.dataDir, names.NewMachineTag("0"))
+	conf, err := agent.ReadConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	conf.SetAPIHostPorts([]network.HostPorts{network.NewMachineHostPorts(17070, "10.0.0.3").HostPorts()})
+	c.Assert(conf.Write(), jc.ErrorIsNil)
+
+	command := s.newRestoreCommand(c)
+	command.DqliteInitializer = func(
+		ctx context.Context,
+		mgr database.BootstrapNodeManager,
+		_ database.Logger,
+		_ bool,
+		_ ...database.BootstrapConcern,
+	) error {
+		dir, err := mgr.EnsureDataDir()
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(os.WriteFile(filepath.Join(dir, "info.yaml"), []byte("partial"), 0600), jc.ErrorIsNil)
+		return errors.New("boom")
+	}
+	err = command.Run(cmdtesting.Context(c))
+	c.Assert(err, gc.ErrorMatches, "restoring databases: boom")
+
+	// The previous Dqlite data is back in place, and the files on disk
+	// are left as they were.
+	old, err := os.ReadFile(filepath.Join(existing, "info.yaml"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(old), gc.Equals, "old")
+	_, err = os.Stat(existing + ".pre-restore.0")
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+
+	conf, err = agent.ReadConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	addrs, err := conf.APIAddresses()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addrs, jc.DeepEquals, []string{"10.0.0.3:17070"})
+
+	// The staging directory is removed.
+	staged, err := filepath.Glob(filepath.Join(s.dataDir, "restore-*"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(staged, gc.HasLen, 0)
+}
+
+func (s *RestoreSuite) TestRestoreKeepsPreviousDqliteNodeFiles(c *gc.C) {
+	// Back up a machine with Dqlite node files.
+	existing := filepath.Join(s.dataDir, "dqlite")
+	c.Assert(os.MkdirAll(existing, 0700), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(existing, "info.yaml"), []byte("backed up"), 0600), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(existing, "cluster.yaml"), []byte("backed up"), 0600), jc.ErrorIsNil)
+	paths := backups.Paths{BackupDir: c.MkDir(), DataDir: s.dataDir}
+	files, err := backups.FilesToBackUp(paths, "0")
+	c.Assert(err, jc.ErrorIsNil)
+	meta := backups.NewMetadata()
+	meta.Origin.Version = jujuversion.Current
+	meta.Controller.MachineID = "0"
+	s.archiveFile, err = backups.Create(context.Background(), meta, paths, fakeDumper{}, files)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(os.WriteFile(filepath.Join(existing, "info.yaml"), []byte("old"), 0600), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(existing, "cluster.yaml"), []byte("old"), 0600), jc.ErrorIsNil)
+
+	err = s.newRestoreCommand(c).Run(cmdtesting.Context(c))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The previous node files are moved aside untouched by the restore,
+	// and the new cluster doesn't use those from the backup.
+	for _, name := range []string{"info.yaml", "cluster.yaml"} {
+		old, err := os.ReadFile(filepath.Join(existing+".pre-restore.0", name))
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(string(old), gc.Equals, "old")
+
+		current, err := os.ReadFile(filepath.Join(existing, name))
+		if err == nil {
+			c.Check(string(current), gc.Not(gc.Equals), "backed up")
+		} else {
+			c.Check(err, jc.Satisfies, os.IsNotExist)
+		}
+	}
+}
+
+func (s *RestoreSuite) TestRestoreVersionMismatch(c *gc.C) {
+	meta := backups.NewMetadata()
+	meta.Origin.Version = version.MustParse("2.9.0")
+	meta.Controller.MachineID = "0"
+	paths := backups.Paths{BackupDir: c.MkDir(), DataDir: s.dataDir}
+
+	var err error
+	s.archiveFile, err = backups.Create(context.Background(), meta, paths, fakeDumper{}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.newRestoreCommand(c).Run(cmdtesting.Context(c))
+	c.Assert(err, gc.ErrorMatches, `restoring a backup from version 2.9.0 to version .* not supported`)
+}
@@ -19,6 +19,7 @@ import (
 	"gopkg.in/juju/environschema.v1"
 	"gopkg.in/yaml.v2"
 
+	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/internal/pki"
 )
 
@@ -92,6 +93,22 @@ const (
 	// interesting calls though.)
 	AuditLogExcludeMethods = "audit-log-exclude-methods"
 
+	// AuditLogForwardURL is the http or https URL to which audit
+	// records are forwarded, in addition to the local audit log.
+	AuditLogForwardURL = "audit-log-forward-url"
+
+	// AuditLogForwardCACert is the CA certificate used to verify the
+	// audit log forwarding endpoint.
+	AuditLogForwardCACert = "audit-log-forward-ca-cert"
+
+	// AuditLogForwardToken is the bearer token sent to the audit log
+	// forwarding endpoint.
+	AuditLogForwardToken = "audit-log-forward-token"
+
+	// AuditLogSpoolMaxSize is the maximum size of the spool holding
+	// audit records that haven't yet been forwarded, eg "100M".
+	AuditLogSpoolMaxSize = "audit-log-spool-max-size"
+
 	// ReadOnlyMethodsWildcard is the special value that can be added
 	// to the exclude-methods list that represents all of the read
 	// only methods (see apiserver/observer/auditfilter.go). This
@@ -115,6 +132,11 @@ const (
 	// permissions model.
 	LoginTokenRefreshURL = "login-token-refresh-url"
 
+	// OIDCIssuers is a YAML list of the OpenID Connect providers whose
+	// ID tokens are accepted for user login, and the access granted to
+	// the groups in those tokens. See OIDCIssuer for the format.
+	OIDCIssuers = "oidc-issuers"
+
 	// IdentityURL sets the URL of the identity manager.
 	// Use this when users should be managed externally rather than
 	// created locally on the controller.
@@ -281,6 +303,31 @@ const (
 	// OpenTelemetryStackTraces return whether stack traces should be added per
 	// span.
 	OpenTelemetryStackTraces = "open-telemetry-stack-traces"
+
+	// ObjectStoreType is the type of object store to use for storing blobs.
+	// This isn't currently allowed to be changed dynamically, that will come
+	// when we support multiple object store types (not including state).
+	ObjectStoreType = "object-store-type"
+
+	// ObjectStoreS3Endpoint is the endpoint of the S3 compatible object
+	// store, used when the object store type is "s3".
+	ObjectStoreS3Endpoint = "object-store-s3-endpoint"
+
+	// ObjectStoreS3Bucket is the bucket in which the S3 compatible object
+	// store keeps its objects.
+	ObjectStoreS3Bucket = "object-store-s3-bucket"
+
+	// ObjectStoreS3StaticKey is the static access key for the S3 compatible
+	// object store.
+	ObjectStoreS3StaticKey = "object-store-s3-static-key"
+
+	// ObjectStoreS3StaticSecret is the static secret key for the S3
+	// compatible object store.
+	ObjectStoreS3StaticSecret = "object-store-s3-static-secret"
+
+	// ObjectStoreS3StaticSession is the optional static session token for
+	// the S3 compatible object store.
+	ObjectStoreS3StaticSession = "object-store-s3-static-session"
 )
 
 // Attribute Defaults
@@ -317,6 +364,10 @@ const (
 	// keep.
 	DefaultAuditLogMaxBackups = 10
 
+	// DefaultAuditLogSpoolMaxSizeMB is the default size in MB of the
+	// spool of audit records waiting to be forwarded.
+	DefaultAuditLogSpoolMaxSizeMB = 100
+
 	// DefaultNUMAControlPolicy should not be used by default.
 	// Only use numactl if user specifically requests it
 	DefaultNUMAControlPolicy = false
@@ -423,6 +474,14 @@ const (
 	// DefaultOpenTelemetryStackTraces is the default value for it the open
 	// telemetry tracing has stack traces or not.
 	DefaultOpenTelemetryStackTraces = false
+
+	// DefaultObjectStoreType is the default type of object store to use for
+	// storing blobs.
+	DefaultObjectStoreType = objectstore.StateBackend
+
+	// DefaultObjectStoreS3Bucket is the default bucket used by the S3
+	// compatible object store.
+	DefaultObjectStoreS3Bucket = "juju"
 )
 
 var (
@@ -441,6 +500,7 @@ var (
 		ControllerName,
 		ControllerUUIDKey,
 		LoginTokenRefreshURL,
+		OIDCIssuers,
 		IdentityPublicKey,
 		IdentityURL,
 		SetNUMAControlPolicyKey,
@@ -466,6 +526,10 @@ var (
 		AuditLogMaxSize,
 		AuditLogMaxBackups,
 		AuditLogExcludeMethods,
+		AuditLogForwardURL,
+		AuditLogForwardCACert,
+		AuditLogForwardToken,
+		AuditLogSpoolMaxSize,
 		CAASOperatorImagePath,
 		CAASImageRepo,
 		Features,
@@ -481,6 +545,12 @@ var (
 		OpenTelemetryEndpoint,
 		OpenTelemetryInsecure,
 		OpenTelemetryStackTraces,
+		ObjectStoreType,
+		ObjectStoreS3Endpoint,
+		ObjectStoreS3Bucket,
+		ObjectStoreS3StaticKey,
+		ObjectStoreS3StaticSecret,
+		ObjectStoreS3StaticSession,
 	}
 
 	// For backwards compatibility, we must include "anything", "juju-apiserver"
@@ -508,8 +578,12 @@ var (
 		AuditingEnabled,
 		AuditLogCaptureArgs,
 		AuditLogExcludeMethods,
+		AuditLogForwardCACert,
+		AuditLogForwardToken,
+		AuditLogForwardURL,
 		AuditLogMaxBackups,
 		AuditLogMaxSize,
+		AuditLogSpoolMaxSize,
 		CAASImageRepo,
 		ControllerName,
 		ControllerResourceDownloadLimit,
@@ -537,6 +611,15 @@ var (
 		QueryTracingThreshold,
 	)
 
+	// SensitiveConfigAttributes contains the controller config attributes
+	// that hold credentials. They are only used by the controller itself,
+	// so they must never be sent to API clients or agents.
+	SensitiveConfigAttributes = set.NewStrings(
+		AuditLogForwardToken,
+		ObjectStoreS3StaticSecret,
+		ObjectStoreS3StaticSession,
+	)
+
 	methodNameRE = regexp.MustCompile(`[[:alpha:]][[:alnum:]]*\.[[:alpha:]][[:alnum:]]*`)
 )
 
@@ -554,6 +637,18 @@ func ControllerOnlyAttribute(attr string) bool {
 // Config is a string-keyed map of controller configuration attributes.
 type Config map[string]interface{}
 
+// Redacted returns a copy of the config without the attributes that hold
+// credentials, so that it can be sent to API clients and agents.
+func (c Config) Redacted() Config {
+	redacted := make(Config, len(c))
+	for k, v := range c {
+		if !SensitiveConfigAttributes.Contains(k) {
+			redacted[k] = v
+		}
+	}
+	return redacted
+}
+
 // Validate validates the controller configuration.
 func (c Config) Validate() error {
 	return Validate(c)
@@ -788,6 +883,31 @@ func (c Config) AuditLogExcludeMethods() set.Strings {
 	return set.NewStrings(strings.Split(v, ",")...)
 }
 
+// AuditLogForwardURL returns the URL to which audit records are
+// forwarded, and whether forwarding has been configured.
+func (c Config) AuditLogForwardURL() (string, bool) {
+	v := c.asString(AuditLogForwardURL)
+	return v, v != ""
+}
+
+// AuditLogForwardCACert returns the CA certificate used to verify the
+// audit log forwarding endpoint.
+func (c Config) AuditLogForwardCACert() string {
+	return c.asString(AuditLogForwardCACert)
+}
+
+// AuditLogForwardToken returns the bearer token sent to the audit log
+// forwarding endpoint.
+func (c Config) AuditLogForwardToken() string {
+	return c.asString(AuditLogForwardToken)
+}
+
+// AuditLogSpoolMaxSizeMB returns the maximum size in MB of the spool
+// of audit records waiting to be forwarded.
+func (c Config) AuditLogSpoolMaxSizeMB() int {
+	return c.sizeMBOrDefault(AuditLogSpoolMaxSize, DefaultAuditLogSpoolMaxSizeMB)
+}
+
 // Features returns the controller config set features flags.
 func (c Config) Features() set.Strings {
 	v := c.asString(Features)
@@ -858,6 +978,13 @@ func (c Config) LoginTokenRefreshURL() string {
 	return c.asString(LoginTokenRefreshURL)
 }
 
+// OIDCIssuers returns the OpenID Connect providers trusted for user
+// login. The value is checked by Validate, so any error is ignored here.
+func (c Config) OIDCIssuers() []OIDCIssuer {
+	issuers, _ := ParseOIDCIssuers(c.asString(OIDCIssuers))
+	return issuers
+}
+
 // MongoMemoryProfile returns the selected profile or low.
 func (c Config) MongoMemoryProfile() string {
 	if profile, ok := c[MongoMemoryProfile]; ok {
@@ -1040,6 +1167,47 @@ func (c Config) OpenTelemetryStackTraces() bool {
 	return c.boolOrDefault(OpenTelemetryStackTraces, DefaultOpenTelemetryStackTraces)
 }
 
+// ObjectStoreType returns the type of object store to use for storing blobs.
+func (c Config) ObjectStoreType() objectstore.BackendType {
+	if v, ok := c[ObjectStoreType].(string); ok {
+		return objectstore.BackendType(v)
+	}
+	return DefaultObjectStoreType
+}
+
+// ObjectStoreS3Endpoint returns the endpoint of the S3 compatible object
+// store.
+func (c Config) ObjectStoreS3Endpoint() string {
+	return c.asString(ObjectStoreS3Endpoint)
+}
+
+// ObjectStoreS3Bucket returns the bucket used by the S3 compatible object
+// store.
+func (c Config) ObjectStoreS3Bucket() string {
+	if v := c.asString(ObjectStoreS3Bucket); v != "" {
+		return v
+	}
+	return DefaultObjectStoreS3Bucket
+}
+
+// ObjectStoreS3StaticKey returns the static access key for the S3
+// compatible object store.
+func (c Config) ObjectStoreS3StaticKey() string {
+	return c.asString(ObjectStoreS3StaticKey)
+}
+
+// ObjectStoreS3StaticSecret returns the static secret key for the S3
+// compatible object store.
+func (c Config) ObjectStoreS3StaticSecret() string {
+	return c.asString(ObjectStoreS3StaticSecret)
+}
+
+// ObjectStoreS3StaticSession returns the static session token for the S3
+// compatible object store.
+func (c Config) ObjectStoreS3StaticSession() string {
+	return c.asString(ObjectStoreS3StaticSession)
+}
+
 // Validate ensures that config is a valid configuration.
 func Validate(c Config) error {
 	if v, ok := c[IdentityPublicKey].(string); ok {
@@ -1073,6 +1241,12 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[OIDCIssuers].(string); ok {
+		if _, err := ParseOIDCIssuers(v); err != nil {
+			return errors.Annotate(err, "invalid OIDC issuers in configuration")
+		}
+	}
+
 	caCert, caCertOK := c.CACert()
 	if !caCertOK {
 		return errors.Errorf("missing CA certificate")
@@ -1117,6 +1291,32 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[ObjectStoreType].(string); ok {
+		backendType, err := objectstore.ParseObjectStoreType(v)
+		if err != nil {
+			return errors.Annotatef(err, "invalid %s", ObjectStoreType)
+		}
+		if backendType == objectstore.S3Backend {
+			if c.ObjectStoreS3Endpoint() == "" {
+				return errors.Errorf("%s is required when %s is %q", ObjectStoreS3Endpoint, ObjectStoreType, objectstore.S3Backend)
+			}
+			if c.ObjectStoreS3StaticKey() == "" || c.ObjectStoreS3StaticSecret() == "" {
+				return errors.Errorf("%s and %s are required when %s is %q",
+					ObjectStoreS3StaticKey, ObjectStoreS3StaticSecret, ObjectStoreType, objectstore.S3Backend)
+			}
+		}
+	}
+
+	if v, ok := c[ObjectStoreS3Endpoint].(string); ok && v != "" {
+		u, err := url.Parse(v)
+		if err != nil {
+			return errors.Annotatef(err, "invalid %s", ObjectStoreS3Endpoint)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.Errorf("invalid %s %q: expected http or https scheme", ObjectStoreS3Endpoint, v)
+		}
+	}
+
 	if mgoMemProfile, ok := c[MongoMemoryProfile].(string); ok {
 		if mgoMemProfile != MongoProfLow && mgoMemProfile != MongoProfDefault {
 			return errors.Errorf("mongo-memory-profile: expected one of %q or %q got string(%q)", MongoProfLow, MongoProfDefault, mgoMemProfile)
@@ -1226,6 +1426,31 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[AuditLogForwardURL].(string); ok && v != "" {
+		u, err := url.Parse(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid audit log forward URL")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.NotValidf("audit log forward URL scheme %q", u.Scheme)
+		}
+		if u.Host == "" {
+			return errors.NotValidf("audit log forward URL %q without host", v)
+		}
+	}
+
+	if v, ok := c[AuditLogForwardCACert].(string); ok && v != "" {
+		if _, err := pki.IsPemCA([]byte(v)); err != nil {
+			return errors.Annotate(err, "invalid audit log forward CA certificate")
+		}
+	}
+
+	if v, ok := c[AuditLogSpoolMaxSize].(string); ok {
+		if _, err := utils.ParseSize(v); err != nil {
+			return errors.Annotate(err, "invalid audit log spool max size in configuration")
+		}
+	}
+
 	if v, ok := c[ControllerAPIPort].(int); ok {
 		// TODO: change the validation so 0 is invalid and --reset is used.
 		// However that doesn't exist yet.
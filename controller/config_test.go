@@ -17,6 +17,8 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/internal/docker"
 	"github.com/juju/juju/internal/docker/registry"
 	"github.com/juju/juju/internal/docker/registry/mocks"
@@ -155,6 +157,57 @@ var newConfigTests = []struct {
 		controller.AuditLogExcludeMethods: "Dap.Kings,ReadOnlyMethods,Sharon Jones",
 	},
 	expectError: `invalid audit log exclude methods: should be a list of "Facade.Method" names \(or "ReadOnlyMethods"\), got "Sharon Jones" at position 3`,
+}, {
+	about: "invalid audit log forward URL scheme",
+	config: controller.Config{
+		controller.AuditLogForwardURL: "ftp://audit.example.com",
+	},
+	expectError: `audit log forward URL scheme "ftp" not valid`,
+}, {
+	about: "audit log forward URL without host",
+	config: controller.Config{
+		controller.AuditLogForwardURL: "https:///audit",
+	},
+	expectError: `audit log forward URL "https:///audit" without host not valid`,
+}, {
+	about: "invalid audit log forward CA cert",
+	config: controller.Config{
+		controller.AuditLogForwardCACert: "not a cert",
+	},
+	expectError: `invalid audit log forward CA certificate: .*`,
+}, {
+	about: "invalid audit log spool max size",
+	config: controller.Config{
+		controller.AuditLogSpoolMaxSize: "abcd",
+	},
+	expectError: `invalid audit log spool max size in configuration: expected a non-negative number, got "abcd"`,
+}, {
+	about: "invalid OIDC issuers",
+	config: controller.Config{
+		controller.OIDCIssuers: "- url: https://sso.example.com",
+	},
+	expectError: `invalid OIDC issuers in configuration: issuer "https://sso.example.com" without client-id not valid`,
+}, {
+	about: "invalid OIDC grant",
+	config: controller.Config{
+		controller.OIDCIssuers: `
+- url: https://sso.example.com
+  client-id: juju
+  grants:
+  - target: controller
+    access: write`,
+	},
+	expectError: `invalid OIDC issuers in configuration: issuer "https://sso.example.com" grant on "controller": "write" controller access not valid`,
+}, {
+	about: "OIDC issuers sharing a user domain",
+	config: controller.Config{
+		controller.OIDCIssuers: `
+- url: https://sso.example.com
+  client-id: juju
+- url: https://login.example.org
+  client-id: juju`,
+	},
+	expectError: `invalid OIDC issuers in configuration: issuers "https://sso.example.com" and "https://login.example.org" with the same user-domain "external" not valid`,
 }, {
 	about: "invalid model log max size",
 	config: controller.Config{
@@ -380,6 +433,31 @@ var newConfigTests = []struct {
 		controller.OpenTelemetryStackTraces: "invalid",
 	},
 	expectError: `open-telemetry-stack-traces: expected bool, got string\("invalid"\)`,
+}, {
+	about: "invalid object store type",
+	config: controller.Config{
+		controller.ObjectStoreType: "invalid",
+	},
+	expectError: `invalid object-store-type: object store type "invalid" not valid`,
+}, {
+	about: "s3 object store type without endpoint",
+	config: controller.Config{
+		controller.ObjectStoreType: "s3",
+	},
+	expectError: `object-store-s3-endpoint is required when object-store-type is "s3"`,
+}, {
+	about: "s3 object store type without credentials",
+	config: controller.Config{
+		controller.ObjectStoreType:       "s3",
+		controller.ObjectStoreS3Endpoint: "http://localhost:9000",
+	},
+	expectError: `object-store-s3-static-key and object-store-s3-static-secret are required when object-store-type is "s3"`,
+}, {
+	about: "invalid s3 object store endpoint",
+	config: controller.Config{
+		controller.ObjectStoreS3Endpoint: "ftp://localhost:9000",
+	},
+	expectError: `invalid object-store-s3-endpoint "ftp://localhost:9000": expected http or https scheme`,
 }}
 
 func (s *ConfigSuite) TestNewConfig(c *gc.C) {
@@ -743,6 +821,115 @@ func (s *ConfigSuite) TestMaxDebugLogDurationSchemaCoerce(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `max-debug-log-duration: conversion to duration: time: missing unit in duration "?12"?`)
 }
 
+func (s *ConfigSuite) TestAuditLogForwarding(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			controller.AuditLogForwardURL:    "https://audit.example.com/records",
+			controller.AuditLogForwardCACert: testing.CACert,
+			controller.AuditLogForwardToken:  "sekrit",
+			controller.AuditLogSpoolMaxSize:  "20M",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	url, ok := cfg.AuditLogForwardURL()
+	c.Check(ok, jc.IsTrue)
+	c.Check(url, gc.Equals, "https://audit.example.com/records")
+	c.Check(cfg.AuditLogForwardCACert(), gc.Equals, testing.CACert)
+	c.Check(cfg.AuditLogForwardToken(), gc.Equals, "sekrit")
+	c.Check(cfg.AuditLogSpoolMaxSizeMB(), gc.Equals, 20)
+}
+
+func (s *ConfigSuite) TestAuditLogForwardingDefaults(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	_, ok := cfg.AuditLogForwardURL()
+	c.Check(ok, jc.IsFalse)
+	c.Check(cfg.AuditLogSpoolMaxSizeMB(), gc.Equals, controller.DefaultAuditLogSpoolMaxSizeMB)
+}
+
+func (s *ConfigSuite) TestOIDCIssuers(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			controller.OIDCIssuers: `
+- url: https://sso.example.com
+  client-id: juju
+  grants:
+  - target: controller
+    access: login
+  - group: prod-operators
+    target: model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+    access: write
+- url: https://login.example.org/realms/ops
+  client-id: juju-ops
+  scopes: [openid, groups]
+  username-claim: preferred_username
+  groups-claim: roles
+  user-domain: ops
+`,
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cfg.OIDCIssuers(), jc.DeepEquals, []controller.OIDCIssuer{{
+		URL:           "https://sso.example.com",
+		ClientID:      "juju",
+		Scopes:        []string{"openid", "email", "profile"},
+		UsernameClaim: "email",
+		GroupsClaim:   "groups",
+		UserDomain:    "external",
+		Grants: []controller.OIDCGrant{{
+			Target: "controller",
+			Access: permission.LoginAccess,
+		}, {
+			Group:  "prod-operators",
+			Target: "model-deadbeef-0bad-400d-8000-4b1d0d06f00d",
+			Access: permission.WriteAccess,
+		}},
+	}, {
+		URL:           "https://login.example.org/realms/ops",
+		ClientID:      "juju-ops",
+		Scopes:        []string{"openid", "groups"},
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "roles",
+		UserDomain:    "ops",
+	}})
+}
+
+func (s *ConfigSuite) TestOIDCIssuersErrors(c *gc.C) {
+	for _, test := range []struct {
+		value string
+		err   string
+	}{{
+		value: "- url: sso.example.com\n  client-id: juju",
+		err:   `issuer URL "sso.example.com" scheme not valid`,
+	}, {
+		value: "- url: http://sso.example.com\n  client-id: juju",
+		err:   `issuer URL "http://sso.example.com" scheme not valid`,
+	}, {
+		value: "- url: https://sso.example.com\n  client-id: juju\n  user-domain: local",
+		err:   `issuer "https://sso.example.com" user-domain "local" not valid`,
+	}, {
+		value: "- url: https://sso.example.com\n  client-id: juju\n- url: https://sso.example.com\n  client-id: other",
+		err:   `duplicate issuer "https://sso.example.com" not valid`,
+	}, {
+		value: "- url: https://sso.example.com\n  client-id: juju\n  grants:\n  - target: application-mysql\n    access: admin",
+		err:   `issuer "https://sso.example.com": grant target "application-mysql" not valid`,
+	}, {
+		value: "- url: https://sso.example.com\n  clientid: juju",
+		err:   `(?s)yaml: unmarshal errors:.*field clientid not found.*`,
+	}} {
+		_, err := controller.ParseOIDCIssuers(test.value)
+		c.Check(err, gc.ErrorMatches, test.err, gc.Commentf("value %q", test.value))
+	}
+}
+
 func (s *ConfigSuite) TestFeatureFlags(c *gc.C) {
 	cfg, err := controller.NewConfig(
 		testing.ControllerTag.Id(),
@@ -955,6 +1142,63 @@ func (s *ConfigSuite) TestOpenTelemetryStackTraces(c *gc.C) {
 	c.Assert(cfg.OpenTelemetryStackTraces(), gc.Equals, true)
 }
 
+func (s *ConfigSuite) TestObjectStoreType(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(cfg.ObjectStoreType(), gc.Equals, controller.DefaultObjectStoreType)
+
+	cfg[controller.ObjectStoreType] = "file"
+	c.Assert(cfg.ObjectStoreType(), gc.Equals, objectstore.FileBackend)
+}
+
+func (s *ConfigSuite) TestObjectStoreS3(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			controller.ObjectStoreType:           "s3",
+			controller.ObjectStoreS3Endpoint:     "http://localhost:9000",
+			controller.ObjectStoreS3StaticKey:    "key",
+			controller.ObjectStoreS3StaticSecret: "secret",
+		})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(cfg.ObjectStoreType(), gc.Equals, objectstore.S3Backend)
+	c.Check(cfg.ObjectStoreS3Endpoint(), gc.Equals, "http://localhost:9000")
+	c.Check(cfg.ObjectStoreS3Bucket(), gc.Equals, controller.DefaultObjectStoreS3Bucket)
+	c.Check(cfg.ObjectStoreS3StaticKey(), gc.Equals, "key")
+	c.Check(cfg.ObjectStoreS3StaticSecret(), gc.Equals, "secret")
+	c.Check(cfg.ObjectStoreS3StaticSession(), gc.Equals, "")
+}
+
+func (s *ConfigSuite) TestRedacted(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			controller.ObjectStoreType:            "s3",
+			controller.ObjectStoreS3Endpoint:      "http://localhost:9000",
+			controller.ObjectStoreS3StaticKey:     "key",
+			controller.ObjectStoreS3StaticSecret:  "secret",
+			controller.ObjectStoreS3StaticSession: "session",
+			controller.AuditLogForwardURL:         "https://audit.example.com/records",
+			controller.AuditLogForwardToken:       "sekrit",
+		})
+	c.Assert(err, jc.ErrorIsNil)
+
+	redacted := cfg.Redacted()
+	c.Check(redacted.AuditLogForwardToken(), gc.Equals, "")
+	c.Check(redacted.ObjectStoreS3StaticKey(), gc.Equals, "key")
+	c.Check(redacted.ObjectStoreS3StaticSecret(), gc.Equals, "")
+	c.Check(redacted.ObjectStoreS3StaticSession(), gc.Equals, "")
+
+	// The original config is left untouched.
+	c.Check(cfg.ObjectStoreS3StaticSecret(), gc.Equals, "secret")
+}
+
 func (s *ConfigSuite) TestOpenTelemetryEndpointSettingValue(c *gc.C) {
 	mURL := "http://meshuggah.com/endpoint"
 	cfg, err := controller.NewConfig(
@@ -19,12 +19,17 @@ var configChecker = schema.FieldMap(schema.Fields{
 	AuditLogMaxSize:                  schema.String(),
 	AuditLogMaxBackups:               schema.ForceInt(),
 	AuditLogExcludeMethods:           schema.String(),
+	AuditLogForwardURL:               schema.String(),
+	AuditLogForwardCACert:            schema.String(),
+	AuditLogForwardToken:             schema.String(),
+	AuditLogSpoolMaxSize:             schema.String(),
 	APIPort:                          schema.ForceInt(),
 	APIPortOpenDelay:                 schema.TimeDurationString(),
 	ControllerAPIPort:                schema.ForceInt(),
 	ControllerName:                   schema.String(),
 	StatePort:                        schema.ForceInt(),
 	LoginTokenRefreshURL:             schema.String(),
+	OIDCIssuers:                      schema.String(),
 	IdentityURL:                      schema.String(),
 	IdentityPublicKey:                schema.String(),
 	SetNUMAControlPolicyKey:          schema.Bool(),
@@ -62,6 +67,12 @@ var configChecker = schema.FieldMap(schema.Fields{
 	OpenTelemetryEndpoint:            schema.String(),
 	OpenTelemetryInsecure:            schema.Bool(),
 	OpenTelemetryStackTraces:         schema.Bool(),
+	ObjectStoreType:                  schema.String(),
+	ObjectStoreS3Endpoint:            schema.String(),
+	ObjectStoreS3Bucket:              schema.String(),
+	ObjectStoreS3StaticKey:           schema.String(),
+	ObjectStoreS3StaticSecret:        schema.String(),
+	ObjectStoreS3StaticSession:       schema.String(),
 }, schema.Defaults{
 	AgentRateLimitMax:                schema.Omit,
 	AgentRateLimitRate:               schema.Omit,
@@ -74,8 +85,13 @@ var configChecker = schema.FieldMap(schema.Fields{
 	AuditLogMaxSize:                  fmt.Sprintf("%vM", DefaultAuditLogMaxSizeMB),
 	AuditLogMaxBackups:               DefaultAuditLogMaxBackups,
 	AuditLogExcludeMethods:           DefaultAuditLogExcludeMethods,
+	AuditLogForwardURL:               schema.Omit,
+	AuditLogForwardCACert:            schema.Omit,
+	AuditLogForwardToken:             schema.Omit,
+	AuditLogSpoolMaxSize:             fmt.Sprintf("%vM", DefaultAuditLogSpoolMaxSizeMB),
 	StatePort:                        DefaultStatePort,
 	LoginTokenRefreshURL:             schema.Omit,
+	OIDCIssuers:                      schema.Omit,
 	IdentityURL:                      schema.Omit,
 	IdentityPublicKey:                schema.Omit,
 	SetNUMAControlPolicyKey:          DefaultNUMAControlPolicy,
@@ -113,6 +129,12 @@ var configChecker = schema.FieldMap(schema.Fields{
 	OpenTelemetryEndpoint:            schema.Omit,
 	OpenTelemetryInsecure:            DefaultOpenTelemetryInsecure,
 	OpenTelemetryStackTraces:         DefaultOpenTelemetryStackTraces,
+	ObjectStoreType:                  DefaultObjectStoreType.String(),
+	ObjectStoreS3Endpoint:            schema.Omit,
+	ObjectStoreS3Bucket:              DefaultObjectStoreS3Bucket,
+	ObjectStoreS3StaticKey:           schema.Omit,
+	ObjectStoreS3StaticSecret:        schema.Omit,
+	ObjectStoreS3StaticSession:       schema.Omit,
 })
 
 // ConfigSchema holds information on all the fields defined by
@@ -154,6 +176,22 @@ var ConfigSchema = environschema.Fields{
 		Type:        environschema.Tstring,
 		Description: "A comma-delimited list of Facade.Method names that aren't interesting for audit logging purposes.",
 	},
+	AuditLogForwardURL: {
+		Type:        environschema.Tstring,
+		Description: "The http or https URL to which audit records are also forwarded, as newline-delimited JSON",
+	},
+	AuditLogForwardCACert: {
+		Type:        environschema.Tstring,
+		Description: "The CA certificate used to verify the audit log forwarding endpoint",
+	},
+	AuditLogForwardToken: {
+		Type:        environschema.Tstring,
+		Description: "The bearer token sent to the audit log forwarding endpoint",
+	},
+	AuditLogSpoolMaxSize: {
+		Type:        environschema.Tstring,
+		Description: "The maximum size of the local spool of audit records waiting to be forwarded",
+	},
 	APIPort: {
 		Type:        environschema.Tint,
 		Description: "The port used for api connections",
@@ -181,6 +219,10 @@ set, the api-port isn't opened until the controllers have started properly.`,
 		Type:        environschema.Tstring,
 		Description: `The url of the jwt well known endpoint`,
 	},
+	OIDCIssuers: {
+		Type:        environschema.Tstring,
+		Description: `A YAML list of the OpenID Connect providers trusted for user login, and the access granted to their groups`,
+	},
 	IdentityURL: {
 		Type:        environschema.Tstring,
 		Description: `The url of the identity manager`,
@@ -326,4 +368,28 @@ will be output if tracing is enabled.`,
 		Type:        environschema.Tbool,
 		Description: `Allows stack traces open telemetry tracing per span`,
 	},
+	ObjectStoreType: {
+		Type:        environschema.Tstring,
+		Description: `The type of object store backend to use for storing blobs`,
+	},
+	ObjectStoreS3Endpoint: {
+		Type:        environschema.Tstring,
+		Description: `The endpoint of the S3 compatible object store`,
+	},
+	ObjectStoreS3Bucket: {
+		Type:        environschema.Tstring,
+		Description: `The bucket used by the S3 compatible object store`,
+	},
+	ObjectStoreS3StaticKey: {
+		Type:        environschema.Tstring,
+		Description: `The static access key for the S3 compatible object store`,
+	},
+	ObjectStoreS3StaticSecret: {
+		Type:        environschema.Tstring,
+		Description: `The static secret key for the S3 compatible object store`,
+	},
+	ObjectStoreS3StaticSession: {
+		Type:        environschema.Tstring,
+		Description: `The static session token for the S3 compatible object store`,
+	},
 }
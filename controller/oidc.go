@@ -0,0 +1,190 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/core/permission"
+)
+
+const (
+	// DefaultOIDCUsernameClaim is the ID token claim holding the user
+	// name when an issuer doesn't specify one.
+	DefaultOIDCUsernameClaim = "email"
+
+	// DefaultOIDCGroupsClaim is the ID token claim holding the user's
+	// groups when an issuer doesn't specify one.
+	DefaultOIDCGroupsClaim = "groups"
+
+	// DefaultOIDCUserDomain is the domain given to user names from an
+	// ID token which don't have one.
+	DefaultOIDCUserDomain = "external"
+
+	// OIDCControllerTarget is the grant target for the controller.
+	OIDCControllerTarget = "controller"
+)
+
+// DefaultOIDCScopes are the scopes requested by clients logging in
+// when an issuer doesn't specify any.
+var DefaultOIDCScopes = []string{"openid", "email", "profile"}
+
+// OIDCIssuer is an OpenID Connect provider trusted for user login, as
+// configured by the oidc-issuers controller config setting, eg:
+//
+//	oidc-issuers: |
+//	  - url: https://sso.example.com
+//	    client-id: juju
+//	    grants:
+//	    - target: controller
+//	      access: login
+//	    - group: juju-admins
+//	      target: controller
+//	      access: superuser
+//	    - group: prod-operators
+//	      target: model-7b1a3f5c-9c3e-4d7f-8a1e-2b9d6c4e0f12
+//	      access: write
+type OIDCIssuer struct {
+	// URL is the https issuer identifier. The provider's discovery document
+	// is found below it, at /.well-known/openid-configuration.
+	URL string `yaml:"url"`
+
+	// ClientID is the client registered with the provider for Juju.
+	// ID tokens must have it as their audience.
+	ClientID string `yaml:"client-id"`
+
+	// Scopes are requested by clients logging in. Providers often need
+	// a specific scope to include groups in ID tokens.
+	Scopes []string `yaml:"scopes,omitempty"`
+
+	// UsernameClaim is the claim holding the Juju user name.
+	UsernameClaim string `yaml:"username-claim,omitempty"`
+
+	// GroupsClaim is the claim holding the user's groups.
+	GroupsClaim string `yaml:"groups-claim,omitempty"`
+
+	// UserDomain is the domain of every user from this issuer, so they
+	// can never be mistaken for local users or for the users of another
+	// issuer. Each issuer must have its own domain.
+	UserDomain string `yaml:"user-domain,omitempty"`
+
+	// Grants give access to the users from this issuer. A user has the
+	// highest access granted to them for each target, and no access
+	// if nothing is granted.
+	Grants []OIDCGrant `yaml:"grants,omitempty"`
+}
+
+// OIDCGrant gives access on a controller, model or cloud to the users
+// in a group.
+type OIDCGrant struct {
+	// Group is the group the user must be in. An empty group matches
+	// every user from the issuer.
+	Group string `yaml:"group,omitempty"`
+
+	// Target is "controller", or the tag of a model or cloud, eg
+	// "model-<uuid>" or "cloud-<name>".
+	Target string `yaml:"target"`
+
+	// Access is the access granted on the target.
+	Access permission.Access `yaml:"access"`
+}
+
+// targetKind returns the kind of the grant's target.
+func (g OIDCGrant) targetKind() (string, error) {
+	if g.Target == OIDCControllerTarget {
+		return names.ControllerTagKind, nil
+	}
+	tag, err := names.ParseTag(g.Target)
+	if err != nil || (tag.Kind() != names.ModelTagKind && tag.Kind() != names.CloudTagKind) {
+		return "", errors.NotValidf("grant target %q", g.Target)
+	}
+	return tag.Kind(), nil
+}
+
+// ParseOIDCIssuers parses and validates the value of the oidc-issuers
+// controller config setting, filling in defaults.
+func ParseOIDCIssuers(value string) ([]OIDCIssuer, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var issuers []OIDCIssuer
+	if err := yaml.UnmarshalStrict([]byte(value), &issuers); err != nil {
+		return nil, errors.Trace(err)
+	}
+	seen := make(map[string]bool)
+	domains := make(map[string]string)
+	for i := range issuers {
+		issuer := &issuers[i]
+		if err := issuer.validate(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if seen[issuer.URL] {
+			return nil, errors.NotValidf("duplicate issuer %q", issuer.URL)
+		}
+		seen[issuer.URL] = true
+
+		if len(issuer.Scopes) == 0 {
+			issuer.Scopes = append([]string(nil), DefaultOIDCScopes...)
+		}
+		if issuer.UsernameClaim == "" {
+			issuer.UsernameClaim = DefaultOIDCUsernameClaim
+		}
+		if issuer.GroupsClaim == "" {
+			issuer.GroupsClaim = DefaultOIDCGroupsClaim
+		}
+		if issuer.UserDomain == "" {
+			issuer.UserDomain = DefaultOIDCUserDomain
+		}
+		if other, ok := domains[issuer.UserDomain]; ok {
+			return nil, errors.NotValidf("issuers %q and %q with the same user-domain %q", other, issuer.URL, issuer.UserDomain)
+		}
+		domains[issuer.UserDomain] = issuer.URL
+	}
+	return issuers, nil
+}
+
+func (i OIDCIssuer) validate() error {
+	u, err := url.Parse(i.URL)
+	if err != nil {
+		return errors.Annotatef(err, "issuer URL %q", i.URL)
+	}
+	// The provider's keys are fetched from below the issuer URL, so
+	// it must be https for them to be trusted.
+	if u.Scheme != "https" {
+		return errors.NotValidf("issuer URL %q scheme", i.URL)
+	}
+	if u.Host == "" {
+		return errors.NotValidf("issuer URL %q without host", i.URL)
+	}
+	if i.ClientID == "" {
+		return errors.NotValidf("issuer %q without client-id", i.URL)
+	}
+	if i.UserDomain != "" && (i.UserDomain == "local" || !names.IsValidUser("user@"+i.UserDomain)) {
+		return errors.NotValidf("issuer %q user-domain %q", i.URL, i.UserDomain)
+	}
+	for _, grant := range i.Grants {
+		kind, err := grant.targetKind()
+		if err != nil {
+			return errors.Annotatef(err, "issuer %q", i.URL)
+		}
+		var validate func(permission.Access) error
+		switch kind {
+		case names.ControllerTagKind:
+			validate = permission.ValidateControllerAccess
+		case names.ModelTagKind:
+			validate = permission.ValidateModelAccess
+		case names.CloudTagKind:
+			validate = permission.ValidateCloudAccess
+		}
+		if err := validate(grant.Access); err != nil {
+			return errors.Annotatef(err, "issuer %q grant on %q", i.URL, grant.Target)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import "github.com/juju/errors"
+
+const (
+	// AutoscaleMinUnitsConfigOptionName is the option name used to set the
+	// fewest units an autoscaled Kubernetes application is scaled down to.
+	AutoscaleMinUnitsConfigOptionName = "kubernetes-autoscale-min-units"
+
+	// AutoscaleMaxUnitsConfigOptionName is the option name used to set the
+	// most units an autoscaled Kubernetes application is scaled up to.
+	// Autoscaling is only enabled when it is set.
+	AutoscaleMaxUnitsConfigOptionName = "kubernetes-autoscale-max-units"
+
+	// AutoscaleCPUTargetConfigOptionName is the option name used to set
+	// the average CPU utilisation, as a percentage of the requested CPU,
+	// targeted by the autoscaler.
+	AutoscaleCPUTargetConfigOptionName = "kubernetes-autoscale-cpu-target"
+
+	// AutoscaleMemoryTargetConfigOptionName is the option name used to set
+	// the average memory utilisation, as a percentage of the requested
+	// memory, targeted by the autoscaler.
+	AutoscaleMemoryTargetConfigOptionName = "kubernetes-autoscale-memory-target"
+)
+
+// DefaultAutoscaleCPUTarget is the CPU utilisation percentage targeted
+// when autoscaling is enabled without any utilisation target.
+const DefaultAutoscaleCPUTarget = 80
+
+// AutoscalingPolicy describes how the number of units of an application
+// is scaled in response to the load on its workload.
+type AutoscalingPolicy struct {
+	// MinUnits is the fewest units the application is scaled down to.
+	MinUnits int
+
+	// MaxUnits is the most units the application is scaled up to.
+	MaxUnits int
+
+	// CPUTarget is the targeted average CPU utilisation as a percentage
+	// of the requested CPU. Zero means CPU is not considered.
+	CPUTarget int
+
+	// MemoryTarget is the targeted average memory utilisation as a
+	// percentage of the requested memory. Zero means memory is not
+	// considered.
+	MemoryTarget int
+}
+
+// AutoscalingPolicyFromConfig returns the autoscaling policy held in the
+// supplied application config getter, or nil if autoscaling is disabled.
+func AutoscalingPolicyFromConfig(getInt func(name string, defaultValue int) int) *AutoscalingPolicy {
+	maxUnits := getInt(AutoscaleMaxUnitsConfigOptionName, 0)
+	if maxUnits == 0 {
+		return nil
+	}
+	policy := &AutoscalingPolicy{
+		MinUnits:     getInt(AutoscaleMinUnitsConfigOptionName, 1),
+		MaxUnits:     maxUnits,
+		CPUTarget:    getInt(AutoscaleCPUTargetConfigOptionName, 0),
+		MemoryTarget: getInt(AutoscaleMemoryTargetConfigOptionName, 0),
+	}
+	if policy.CPUTarget == 0 && policy.MemoryTarget == 0 {
+		policy.CPUTarget = DefaultAutoscaleCPUTarget
+	}
+	return policy
+}
+
+// Validate returns an error if the policy is not valid.
+func (p AutoscalingPolicy) Validate() error {
+	if p.MinUnits < 1 {
+		return errors.NotValidf("autoscale min units %d", p.MinUnits)
+	}
+	if p.MaxUnits < p.MinUnits {
+		return errors.NotValidf("autoscale max units %d less than min units %d", p.MaxUnits, p.MinUnits)
+	}
+	if p.CPUTarget < 0 || p.MemoryTarget < 0 {
+		return errors.NotValidf("negative autoscale utilisation target")
+	}
+	if p.CPUTarget == 0 && p.MemoryTarget == 0 {
+		return errors.NotValidf("autoscaling without a utilisation target")
+	}
+	return nil
+}
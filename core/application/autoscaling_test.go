@@ -0,0 +1,84 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/application"
+)
+
+type autoscalingSuite struct{}
+
+var _ = gc.Suite(&autoscalingSuite{})
+
+func getIntFrom(attrs map[string]int) func(string, int) int {
+	return func(name string, defaultValue int) int {
+		if v, ok := attrs[name]; ok {
+			return v
+		}
+		return defaultValue
+	}
+}
+
+func (s *autoscalingSuite) TestPolicyFromConfigDisabled(c *gc.C) {
+	policy := application.AutoscalingPolicyFromConfig(getIntFrom(map[string]int{
+		application.AutoscaleMinUnitsConfigOptionName: 2,
+	}))
+	c.Assert(policy, gc.IsNil)
+}
+
+func (s *autoscalingSuite) TestPolicyFromConfig(c *gc.C) {
+	policy := application.AutoscalingPolicyFromConfig(getIntFrom(map[string]int{
+		application.AutoscaleMinUnitsConfigOptionName:     2,
+		application.AutoscaleMaxUnitsConfigOptionName:     5,
+		application.AutoscaleMemoryTargetConfigOptionName: 70,
+	}))
+	c.Assert(policy, jc.DeepEquals, &application.AutoscalingPolicy{
+		MinUnits:     2,
+		MaxUnits:     5,
+		MemoryTarget: 70,
+	})
+}
+
+func (s *autoscalingSuite) TestPolicyFromConfigDefaults(c *gc.C) {
+	policy := application.AutoscalingPolicyFromConfig(getIntFrom(map[string]int{
+		application.AutoscaleMaxUnitsConfigOptionName: 5,
+	}))
+	c.Assert(policy, jc.DeepEquals, &application.AutoscalingPolicy{
+		MinUnits:  1,
+		MaxUnits:  5,
+		CPUTarget: application.DefaultAutoscaleCPUTarget,
+	})
+}
+
+func (s *autoscalingSuite) TestValidate(c *gc.C) {
+	for i, t := range []struct {
+		policy application.AutoscalingPolicy
+		err    string
+	}{{
+		policy: application.AutoscalingPolicy{MinUnits: 1, MaxUnits: 3, CPUTarget: 50},
+	}, {
+		policy: application.AutoscalingPolicy{MinUnits: 0, MaxUnits: 3, CPUTarget: 50},
+		err:    "autoscale min units 0 not valid",
+	}, {
+		policy: application.AutoscalingPolicy{MinUnits: 4, MaxUnits: 3, CPUTarget: 50},
+		err:    "autoscale max units 3 less than min units 4 not valid",
+	}, {
+		policy: application.AutoscalingPolicy{MinUnits: 1, MaxUnits: 3, CPUTarget: -1},
+		err:    "negative autoscale utilisation target not valid",
+	}, {
+		policy: application.AutoscalingPolicy{MinUnits: 1, MaxUnits: 3},
+		err:    "autoscaling without a utilisation target not valid",
+	}} {
+		c.Logf("test %d", i)
+		err := t.policy.Validate()
+		if t.err == "" {
+			c.Check(err, jc.ErrorIsNil)
+		} else {
+			c.Check(err, gc.ErrorMatches, t.err)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+// MinAvailableConfigOptionName is the option name used to set the number
+// of units of a Kubernetes application which must remain available during
+// voluntary disruptions such as node drains.
+const MinAvailableConfigOptionName = "kubernetes-min-available"
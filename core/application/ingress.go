@@ -0,0 +1,23 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+const (
+	// IngressHostConfigOptionName is the option name used to set the host
+	// routed to an exposed Kubernetes application. An ingress is only
+	// created for exposed applications with a host set.
+	IngressHostConfigOptionName = "kubernetes-ingress-host"
+
+	// IngressPathConfigOptionName is the option name used to set the HTTP
+	// path prefix routed to an exposed Kubernetes application.
+	IngressPathConfigOptionName = "kubernetes-ingress-path"
+
+	// IngressTLSSecretConfigOptionName is the option name used to set the
+	// secret holding the TLS certificate used to terminate the ingress.
+	IngressTLSSecretConfigOptionName = "kubernetes-ingress-tls-secret"
+
+	// IngressEndpointConfigOptionName is the option name used to set the
+	// endpoint whose opened port the ingress routes to.
+	IngressEndpointConfigOptionName = "kubernetes-ingress-endpoint"
+)
@@ -0,0 +1,10 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+// NetworkPolicyConfigOptionName is the option name used to have a network
+// policy maintained for a Kubernetes application, so that its pods only
+// accept traffic from related applications in the model and from the
+// CIDRs it is exposed to. There is no network policy unless it is true.
+const NetworkPolicyConfigOptionName = "kubernetes-network-policy"
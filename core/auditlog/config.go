@@ -29,6 +29,22 @@ type Config struct {
 	// consists of these method calls we won't log it.
 	ExcludeMethods set.Strings
 
+	// ForwardURL is the endpoint audit records are forwarded to, in
+	// addition to the local log. Empty means no forwarding.
+	ForwardURL string
+
+	// ForwardCACert is the CA certificate used to verify the
+	// forwarding endpoint.
+	ForwardCACert string
+
+	// ForwardToken is the bearer token sent to the forwarding
+	// endpoint.
+	ForwardToken string
+
+	// MaxSpoolSizeMB is the maximum size of the spool of records
+	// waiting to be forwarded.
+	MaxSpoolSizeMB int
+
 	// Target is the AuditLog entries should be written to.
 	Target AuditLog
 }
@@ -0,0 +1,487 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"gopkg.in/tomb.v2"
+)
+
+const (
+	spoolFileName  = "spool.jsonl"
+	offsetFileName = "spool.offset"
+
+	defaultForwardBatchSize  = 100
+	defaultCompactSpoolSize  = 1024 * 1024
+	defaultForwardRetryDelay = 5 * time.Second
+	maxForwardRetryDelay     = 5 * time.Minute
+)
+
+// Sender sends audit records to a remote endpoint.
+type Sender interface {
+	// Send sends the records, in order. It must only return nil once
+	// the endpoint has accepted all of them.
+	Send([]Record) error
+
+	// Close releases any resources held by the sender.
+	Close() error
+}
+
+// ForwarderConfig holds the parameters needed to create an AuditLog
+// that forwards records to a remote endpoint.
+type ForwarderConfig struct {
+	// Sender delivers records to the remote endpoint.
+	Sender Sender
+
+	// SpoolDir is the directory holding the records that have not yet
+	// been delivered.
+	SpoolDir string
+
+	// MaxSpoolSizeMB is the maximum size of the records in the spool
+	// which have not yet been delivered. Once it is reached, new records
+	// are not forwarded until the endpoint has caught up. Zero means no
+	// limit.
+	MaxSpoolSizeMB int
+
+	// BatchSize is the maximum number of records sent at once.
+	BatchSize int
+
+	// RetryDelay is the initial delay before retrying after a failed
+	// send. It doubles on each consecutive failure.
+	RetryDelay time.Duration
+
+	// Clock is used to wait between retries.
+	Clock clock.Clock
+}
+
+// Validate checks the forwarder configuration.
+func (cfg ForwarderConfig) Validate() error {
+	if cfg.Sender == nil {
+		return errors.NotValidf("nil Sender")
+	}
+	if cfg.SpoolDir == "" {
+		return errors.NotValidf("empty SpoolDir")
+	}
+	if cfg.MaxSpoolSizeMB < 0 {
+		return errors.NotValidf("negative MaxSpoolSizeMB")
+	}
+	if cfg.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	return nil
+}
+
+// forwarder is an AuditLog that delivers records to a remote endpoint
+// at least once. Each record is appended to a spool file before Add*
+// returns; a background loop sends the spooled records and persists
+// the offset of the last one the endpoint accepted. Records that could
+// not be delivered, because the endpoint was unavailable or the
+// controller restarted, remain in the spool and are sent later, so the
+// endpoint may see a record more than once but never misses one. Once
+// enough of the spool has been delivered it is compacted, so that it
+// doesn't grow while records keep arriving faster than the spool
+// drains completely.
+type forwarder struct {
+	tomb tomb.Tomb
+	cfg  ForwarderConfig
+
+	mu        sync.Mutex
+	spool     *os.File
+	spoolSize int64
+	delivered int64
+	dropped   int
+
+	wake chan struct{}
+}
+
+// NewForwarder returns an AuditLog which forwards records to the
+// configured endpoint, spooling them locally until they have been
+// delivered.
+func NewForwarder(cfg ForwarderConfig) (AuditLog, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultForwardBatchSize
+	}
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = defaultForwardRetryDelay
+	}
+
+	if err := os.MkdirAll(cfg.SpoolDir, 0700); err != nil {
+		return nil, errors.Annotate(err, "creating audit log spool directory")
+	}
+	spool, err := os.OpenFile(filepath.Join(cfg.SpoolDir, spoolFileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening audit log spool")
+	}
+	info, err := spool.Stat()
+	if err != nil {
+		_ = spool.Close()
+		return nil, errors.Trace(err)
+	}
+
+	f := &forwarder{
+		cfg:       cfg,
+		spool:     spool,
+		spoolSize: info.Size(),
+		wake:      make(chan struct{}, 1),
+	}
+	if f.delivered, err = f.readOffset(); err != nil {
+		_ = spool.Close()
+		return nil, errors.Trace(err)
+	}
+	if f.delivered > f.spoolSize {
+		f.delivered = f.spoolSize
+	}
+	// There may be records left over from a previous run.
+	f.wake <- struct{}{}
+	f.tomb.Go(f.loop)
+	return f, nil
+}
+
+// AddConversation implements AuditLog.
+func (f *forwarder) AddConversation(c Conversation) error {
+	return errors.Trace(f.addRecord(Record{Conversation: &c}))
+}
+
+// AddRequest implements AuditLog.
+func (f *forwarder) AddRequest(r Request) error {
+	return errors.Trace(f.addRecord(Record{Request: &r}))
+}
+
+// AddResponse implements AuditLog.
+func (f *forwarder) AddResponse(r ResponseErrors) error {
+	return errors.Trace(f.addRecord(Record{Errors: &r}))
+}
+
+// Close implements AuditLog. Records that have not been delivered stay
+// in the spool and will be sent by the next forwarder using it.
+func (f *forwarder) Close() error {
+	f.tomb.Kill(nil)
+	err := f.tomb.Wait()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if closeErr := f.spool.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := f.cfg.Sender.Close(); err == nil {
+		err = closeErr
+	}
+	return errors.Trace(err)
+}
+
+func (f *forwarder) addRecord(r Record) error {
+	bytes, err := json.Marshal(r)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	bytes = append(bytes, '\n')
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// A full spool means the endpoint has been unavailable for a long
+	// time. Failing here would fail the API request being audited, so
+	// instead the record is only kept in the other audit logs.
+	maxSize := int64(f.cfg.MaxSpoolSizeMB) * 1024 * 1024
+	if maxSize > 0 && f.spoolSize-f.delivered+int64(len(bytes)) > maxSize {
+		if f.dropped == 0 {
+			logger.Errorf("audit log spool is full, records will not be forwarded until the endpoint catches up")
+		}
+		f.dropped++
+		return nil
+	}
+
+	n, err := f.spool.Write(bytes)
+	f.spoolSize += int64(n)
+	if err != nil {
+		return errors.Annotate(err, "writing to audit log spool")
+	}
+	// The record must survive a crash once the request has been audited,
+	// otherwise it would never be delivered.
+	if err := f.spool.Sync(); err != nil {
+		return errors.Annotate(err, "syncing audit log spool")
+	}
+
+	select {
+	case f.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (f *forwarder) loop() error {
+	delay := f.cfg.RetryDelay
+	for {
+		select {
+		case <-f.tomb.Dying():
+			return tomb.ErrDying
+		case <-f.wake:
+		}
+
+		for {
+			more, err := f.forward()
+			if err != nil {
+				logger.Warningf("forwarding audit records (retrying in %s): %v", delay, err)
+				select {
+				case <-f.tomb.Dying():
+					return tomb.ErrDying
+				case <-f.cfg.Clock.After(delay):
+				}
+				if delay *= 2; delay > maxForwardRetryDelay {
+					delay = maxForwardRetryDelay
+				}
+				continue
+			}
+			delay = f.cfg.RetryDelay
+			if !more {
+				break
+			}
+			select {
+			case <-f.tomb.Dying():
+				return tomb.ErrDying
+			default:
+			}
+		}
+	}
+}
+
+// forward sends the next batch of spooled records. It reports whether
+// there may be more records left to send.
+func (f *forwarder) forward() (bool, error) {
+	offset, err := f.readOffset()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	records, next, err := f.readBatch(offset)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if len(records) > 0 {
+		if err := f.cfg.Sender.Send(records); err != nil {
+			return false, errors.Trace(err)
+		}
+	}
+	if next == offset {
+		return false, nil
+	}
+	if err := f.advance(next); err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// readBatch reads up to BatchSize complete records from the spool,
+// starting at offset. It returns the records and the offset following
+// the last one read.
+func (f *forwarder) readBatch(offset int64) ([]Record, int64, error) {
+	file, err := os.Open(filepath.Join(f.cfg.SpoolDir, spoolFileName))
+	if err != nil {
+		return nil, offset, errors.Trace(err)
+	}
+	defer func() { _ = file.Close() }()
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, offset, errors.Trace(err)
+	}
+
+	var records []Record
+	reader := bufio.NewReader(file)
+	for len(records) < f.cfg.BatchSize {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// Either the end of the spool, or a record that is still
+			// being written; it will be picked up next time.
+			break
+		} else if err != nil {
+			return nil, offset, errors.Trace(err)
+		}
+		offset += int64(len(line))
+
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			// Skip the record rather than wedging the spool forever.
+			logger.Errorf("discarding corrupt audit log spool record at offset %d: %v", offset-int64(len(line)), err)
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, offset, nil
+}
+
+// advance records that everything before offset has been delivered. If
+// that is the whole spool, the spool is emptied; if it is most of it,
+// the spool is compacted.
+func (f *forwarder) advance(offset int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Everything before the offset must be on disk before the offset is,
+	// so that a crash can't leave an offset past records that were lost.
+	if err := f.spool.Sync(); err != nil {
+		return errors.Annotate(err, "syncing audit log spool")
+	}
+
+	switch {
+	case offset >= f.spoolSize:
+		// As when compacting, reset the offset first so that a crash
+		// means records are sent again rather than skipped.
+		if err := f.writeOffset(0); err != nil {
+			return errors.Trace(err)
+		}
+		if err := f.spool.Truncate(0); err != nil {
+			return errors.Annotate(err, "truncating audit log spool")
+		}
+		if err := f.spool.Sync(); err != nil {
+			return errors.Annotate(err, "syncing audit log spool")
+		}
+		f.spoolSize = 0
+		offset = 0
+	case offset >= f.compactSize():
+		if err := f.compact(offset); err != nil {
+			return errors.Annotate(err, "compacting audit log spool")
+		}
+		offset = 0
+	default:
+		f.delivered = offset
+		return errors.Trace(f.writeOffset(offset))
+	}
+	f.delivered = 0
+	if f.dropped > 0 {
+		logger.Warningf("audit log spool caught up, %d records were not forwarded", f.dropped)
+		f.dropped = 0
+	}
+	return nil
+}
+
+// compactSize returns the size of the delivered part of the spool
+// beyond which the spool is compacted.
+func (f *forwarder) compactSize() int64 {
+	size := int64(defaultCompactSpoolSize)
+	if maxSize := int64(f.cfg.MaxSpoolSizeMB) * 1024 * 1024 / 2; maxSize > 0 && maxSize < size {
+		size = maxSize
+	}
+	return size
+}
+
+// compact replaces the spool with the records following offset, which
+// have not yet been delivered. It must be called with mu held.
+func (f *forwarder) compact(offset int64) error {
+	path := filepath.Join(f.cfg.SpoolDir, spoolFileName)
+	tmp := path + ".tmp"
+	if err := f.copyTail(path, tmp, offset); err != nil {
+		return errors.Trace(err)
+	}
+	// Open the new spool before it replaces the old one, so that the
+	// swap below can't fail.
+	spool, err := os.OpenFile(tmp, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Reset the offset before replacing the spool; a crash in between
+	// means records are sent again rather than skipped.
+	if err := f.writeOffset(0); err != nil {
+		_ = spool.Close()
+		return errors.Trace(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = spool.Close()
+		return errors.Trace(err)
+	}
+	if err := syncDir(f.cfg.SpoolDir); err != nil {
+		_ = spool.Close()
+		return errors.Trace(err)
+	}
+	_ = f.spool.Close()
+	f.spool = spool
+	f.spoolSize -= offset
+	return nil
+}
+
+func (f *forwarder) copyTail(from, to string, offset int64) error {
+	in, err := os.Open(from)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = in.Close() }()
+	if _, err := in.Seek(offset, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	out, err := os.OpenFile(to, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return errors.Trace(err)
+	}
+	if err := out.Sync(); err != nil {
+		_ = out.Close()
+		return errors.Trace(err)
+	}
+	return errors.Trace(out.Close())
+}
+
+func (f *forwarder) readOffset() (int64, error) {
+	data, err := os.ReadFile(filepath.Join(f.cfg.SpoolDir, offsetFileName))
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Trace(err)
+	}
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, errors.Annotate(err, "parsing audit log spool offset")
+	}
+	return offset, nil
+}
+
+func (f *forwarder) writeOffset(offset int64) error {
+	// Write, sync and rename so that a crash never leaves a partial or
+	// lost offset.
+	path := filepath.Join(f.cfg.SpoolDir, offsetFileName)
+	tmp := path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := file.WriteString(strconv.FormatInt(offset, 10)); err != nil {
+		_ = file.Close()
+		return errors.Trace(err)
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return errors.Trace(err)
+	}
+	if err := file.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(syncDir(f.cfg.SpoolDir))
+}
+
+// syncDir syncs the directory, so that renames within it survive a crash.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = d.Close() }()
+	return errors.Trace(d.Sync())
+}
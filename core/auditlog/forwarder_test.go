@@ -0,0 +1,313 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/auditlog"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type ForwarderSuite struct {
+	testing.IsolationSuite
+
+	spoolDir string
+	clock    *testclock.Clock
+}
+
+var _ = gc.Suite(&ForwarderSuite{})
+
+func (s *ForwarderSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.spoolDir = filepath.Join(c.MkDir(), "spool")
+	s.clock = testclock.NewClock(time.Now())
+}
+
+func (s *ForwarderSuite) newForwarder(c *gc.C, sender auditlog.Sender) auditlog.AuditLog {
+	log, err := auditlog.NewForwarder(auditlog.ForwarderConfig{
+		Sender:     sender,
+		SpoolDir:   s.spoolDir,
+		RetryDelay: time.Second,
+		Clock:      s.clock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return log
+}
+
+func (s *ForwarderSuite) TestValidate(c *gc.C) {
+	valid := auditlog.ForwarderConfig{
+		Sender:   newFakeSender(),
+		SpoolDir: s.spoolDir,
+		Clock:    s.clock,
+	}
+	c.Assert(valid.Validate(), jc.ErrorIsNil)
+
+	cfg := valid
+	cfg.Sender = nil
+	c.Check(cfg.Validate(), gc.ErrorMatches, "nil Sender not valid")
+
+	cfg = valid
+	cfg.SpoolDir = ""
+	c.Check(cfg.Validate(), gc.ErrorMatches, "empty SpoolDir not valid")
+
+	cfg = valid
+	cfg.MaxSpoolSizeMB = -1
+	c.Check(cfg.Validate(), gc.ErrorMatches, "negative MaxSpoolSizeMB not valid")
+
+	cfg = valid
+	cfg.Clock = nil
+	c.Check(cfg.Validate(), gc.ErrorMatches, "nil Clock not valid")
+}
+
+func (s *ForwarderSuite) TestForwardsRecords(c *gc.C) {
+	sender := newFakeSender()
+	log := s.newForwarder(c, sender)
+	defer func() { c.Check(log.Close(), jc.ErrorIsNil) }()
+
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "abc", Who: "mary"}), jc.ErrorIsNil)
+	c.Assert(log.AddRequest(auditlog.Request{ConversationID: "abc", RequestID: 1, Facade: "Application", Method: "Deploy"}), jc.ErrorIsNil)
+	c.Assert(log.AddResponse(auditlog.ResponseErrors{ConversationID: "abc", RequestID: 1}), jc.ErrorIsNil)
+
+	records := sender.waitRecords(c, 3)
+	c.Assert(records[0].Conversation, gc.NotNil)
+	c.Check(records[0].Conversation.Who, gc.Equals, "mary")
+	c.Assert(records[1].Request, gc.NotNil)
+	c.Check(records[1].Request.Method, gc.Equals, "Deploy")
+	c.Assert(records[2].Errors, gc.NotNil)
+	c.Check(records[2].Errors.RequestID, gc.Equals, uint64(1))
+}
+
+func (s *ForwarderSuite) TestRetriesAfterFailure(c *gc.C) {
+	sender := newFakeSender()
+	sender.setError(errors.New("endpoint down"))
+	log := s.newForwarder(c, sender)
+	defer func() { c.Check(log.Close(), jc.ErrorIsNil) }()
+
+	// The endpoint being down doesn't fail the audited request.
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "abc"}), jc.ErrorIsNil)
+
+	// Wait for the first attempt to fail before the endpoint recovers.
+	c.Assert(s.clock.WaitAdvance(0, coretesting.LongWait, 1), jc.ErrorIsNil)
+	sender.setError(nil)
+	c.Assert(s.clock.WaitAdvance(time.Second, coretesting.LongWait, 1), jc.ErrorIsNil)
+
+	records := sender.waitRecords(c, 1)
+	c.Check(records[0].Conversation.ConversationID, gc.Equals, "abc")
+}
+
+func (s *ForwarderSuite) TestResumesFromSpool(c *gc.C) {
+	failing := newFakeSender()
+	failing.setError(errors.New("endpoint down"))
+	log := s.newForwarder(c, failing)
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "abc"}), jc.ErrorIsNil)
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "def"}), jc.ErrorIsNil)
+	c.Assert(log.Close(), jc.ErrorIsNil)
+	c.Check(failing.closed(), jc.IsTrue)
+
+	sender := newFakeSender()
+	log = s.newForwarder(c, sender)
+	defer func() { c.Check(log.Close(), jc.ErrorIsNil) }()
+
+	records := sender.waitRecords(c, 2)
+	c.Check(records[0].Conversation.ConversationID, gc.Equals, "abc")
+	c.Check(records[1].Conversation.ConversationID, gc.Equals, "def")
+}
+
+func (s *ForwarderSuite) TestDeliveredRecordsNotResent(c *gc.C) {
+	sender := newFakeSender()
+	log := s.newForwarder(c, sender)
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "abc"}), jc.ErrorIsNil)
+	sender.waitRecords(c, 1)
+	c.Assert(log.Close(), jc.ErrorIsNil)
+
+	sender = newFakeSender()
+	log = s.newForwarder(c, sender)
+	defer func() { c.Check(log.Close(), jc.ErrorIsNil) }()
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "def"}), jc.ErrorIsNil)
+
+	records := sender.waitRecords(c, 1)
+	c.Check(records[0].Conversation.ConversationID, gc.Equals, "def")
+}
+
+func (s *ForwarderSuite) TestForwardsAfterBacklogDrained(c *gc.C) {
+	sender := newFakeSender()
+	sender.setError(errors.New("endpoint down"))
+	log, err := auditlog.NewForwarder(auditlog.ForwarderConfig{
+		Sender:     sender,
+		SpoolDir:   s.spoolDir,
+		BatchSize:  1,
+		RetryDelay: time.Second,
+		Clock:      s.clock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { c.Check(log.Close(), jc.ErrorIsNil) }()
+
+	// Build up a backlog spanning several batches.
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "a"}), jc.ErrorIsNil)
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "b"}), jc.ErrorIsNil)
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "c"}), jc.ErrorIsNil)
+	c.Assert(s.clock.WaitAdvance(0, coretesting.LongWait, 1), jc.ErrorIsNil)
+	sender.setError(nil)
+	c.Assert(s.clock.WaitAdvance(time.Second, coretesting.LongWait, 1), jc.ErrorIsNil)
+	sender.waitRecords(c, 3)
+
+	// Records added once the spool has been emptied are still sent.
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "d"}), jc.ErrorIsNil)
+	c.Assert(log.AddConversation(auditlog.Conversation{ConversationID: "e"}), jc.ErrorIsNil)
+	records := sender.waitRecords(c, 2)
+	c.Check(records[0].Conversation.ConversationID, gc.Equals, "d")
+	c.Check(records[1].Conversation.ConversationID, gc.Equals, "e")
+}
+
+func (s *ForwarderSuite) TestSpoolFull(c *gc.C) {
+	sender := newFakeSender()
+	sender.setError(errors.New("endpoint down"))
+	log, err := auditlog.NewForwarder(auditlog.ForwarderConfig{
+		Sender:         sender,
+		SpoolDir:       s.spoolDir,
+		MaxSpoolSizeMB: 1,
+		Clock:          s.clock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { c.Check(log.Close(), jc.ErrorIsNil) }()
+
+	args := strings.Repeat("x", 600*1024)
+	c.Assert(log.AddRequest(auditlog.Request{RequestID: 1, Args: args}), jc.ErrorIsNil)
+	// The second record doesn't fit, but that mustn't fail the request.
+	c.Assert(log.AddRequest(auditlog.Request{RequestID: 2, Args: args}), jc.ErrorIsNil)
+
+	info, err := os.Stat(filepath.Join(s.spoolDir, "spool.jsonl"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(info.Size() < 1024*1024, jc.IsTrue)
+}
+
+func (s *ForwarderSuite) TestSpoolCompactedWhileBusy(c *gc.C) {
+	sender := &slowSender{
+		fakeSender: newFakeSender(),
+		sending:    make(chan struct{}),
+		release:    make(chan struct{}),
+	}
+	log, err := auditlog.NewForwarder(auditlog.ForwarderConfig{
+		Sender:         sender,
+		SpoolDir:       s.spoolDir,
+		MaxSpoolSizeMB: 1,
+		Clock:          s.clock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { c.Check(log.Close(), jc.ErrorIsNil) }()
+
+	// Each record is added while the previous one is being sent, so the
+	// spool never drains completely. Together they are far larger than
+	// the spool limit, but none of them may be dropped.
+	const count = 10
+	args := strings.Repeat("x", 300*1024)
+	c.Assert(log.AddRequest(auditlog.Request{RequestID: 0, Args: args}), jc.ErrorIsNil)
+	for i := 1; i <= count; i++ {
+		sender.waitSending(c)
+		if i < count {
+			c.Assert(log.AddRequest(auditlog.Request{RequestID: uint64(i), Args: args}), jc.ErrorIsNil)
+		}
+		info, err := os.Stat(filepath.Join(s.spoolDir, "spool.jsonl"))
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(info.Size() <= 1024*1024, jc.IsTrue, gc.Commentf("spool size %d", info.Size()))
+		sender.release <- struct{}{}
+	}
+
+	records := sender.waitRecords(c, count)
+	for i, r := range records {
+		c.Check(r.Request.RequestID, gc.Equals, uint64(i))
+	}
+}
+
+type fakeSender struct {
+	mu       sync.Mutex
+	err      error
+	isClosed bool
+	sent     chan auditlog.Record
+}
+
+func newFakeSender() *fakeSender {
+	return &fakeSender{sent: make(chan auditlog.Record, 100)}
+}
+
+func (s *fakeSender) Send(records []auditlog.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	for _, r := range records {
+		s.sent <- r
+	}
+	return nil
+}
+
+func (s *fakeSender) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.isClosed = true
+	return nil
+}
+
+func (s *fakeSender) setError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *fakeSender) closed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.isClosed
+}
+
+func (s *fakeSender) waitRecords(c *gc.C, n int) []auditlog.Record {
+	var records []auditlog.Record
+	for len(records) < n {
+		select {
+		case r := <-s.sent:
+			records = append(records, r)
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out waiting for records, got %d of %d", len(records), n)
+		}
+	}
+	select {
+	case r := <-s.sent:
+		c.Fatalf("unexpected record %+v", r)
+	case <-time.After(coretesting.ShortWait):
+	}
+	return records
+}
+
+// slowSender is a fakeSender whose sends block until released.
+type slowSender struct {
+	*fakeSender
+	sending chan struct{}
+	release chan struct{}
+}
+
+func (s *slowSender) Send(records []auditlog.Record) error {
+	s.sending <- struct{}{}
+	<-s.release
+	return s.fakeSender.Send(records)
+}
+
+func (s *slowSender) waitSending(c *gc.C) {
+	select {
+	case <-s.sending:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for send")
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+)
+
+const logFileName = "audit.log"
+
+// Filter selects audit records. Empty fields match everything.
+type Filter struct {
+	// From and To bound the time of the records returned.
+	From time.Time `yaml:"from,omitempty"`
+	To   time.Time `yaml:"to,omitempty"`
+
+	// Who matches the user that started the conversation.
+	Who string `yaml:"who,omitempty"`
+
+	// Model matches the name ("owner/name") or UUID of the model the
+	// conversation was with.
+	Model string `yaml:"model,omitempty"`
+
+	// ConversationID selects a single conversation.
+	ConversationID string `yaml:"conversation-id,omitempty"`
+
+	// Methods is a list of "Facade.Method" names; only requests for
+	// these methods (and their responses) are returned.
+	Methods []string `yaml:"methods,omitempty"`
+
+	// Limit is the maximum number of records returned. Zero means no
+	// limit.
+	Limit int `yaml:"limit,omitempty"`
+}
+
+// RecordTime returns the time the record was written.
+func RecordTime(r Record) (time.Time, error) {
+	var when string
+	switch {
+	case r.Conversation != nil:
+		when = r.Conversation.When
+	case r.Request != nil:
+		when = r.Request.When
+	case r.Errors != nil:
+		when = r.Errors.When
+	default:
+		return time.Time{}, errors.NotValidf("empty audit record")
+	}
+	t, err := time.Parse(time.RFC3339, when)
+	return t, errors.Trace(err)
+}
+
+// ConversationID returns the ID of the conversation the record belongs
+// to.
+func (r Record) ConversationID() string {
+	switch {
+	case r.Conversation != nil:
+		return r.Conversation.ConversationID
+	case r.Request != nil:
+		return r.Request.ConversationID
+	case r.Errors != nil:
+		return r.Errors.ConversationID
+	}
+	return ""
+}
+
+// ReadRecords returns the records in the audit log files in logDir,
+// including rotated backups, which match the filter, oldest first. The
+// boolean result reports whether more records matched than the limit
+// allowed.
+//
+// A conversation record is returned with the first of its requests
+// that matches, so that who made the request is always known, even if
+// the conversation started before the time window.
+func ReadRecords(logDir string, filter Filter) ([]Record, bool, error) {
+	paths, err := logFiles(logDir)
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+
+	q := newQuery(filter)
+	for _, path := range paths {
+		if err := q.readFile(path); err != nil {
+			return nil, false, errors.Annotatef(err, "reading %s", filepath.Base(path))
+		}
+		if q.truncated {
+			break
+		}
+	}
+	return q.records, q.truncated, nil
+}
+
+// logFiles returns the audit log files in logDir, oldest first.
+func logFiles(logDir string) ([]string, error) {
+	backups, err := filepath.Glob(filepath.Join(logDir, "audit-*.log*"))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// Backup names have a UTC timestamp suffix, so they sort in the
+	// order they were rotated.
+	sort.Strings(backups)
+
+	current := filepath.Join(logDir, logFileName)
+	if _, err := os.Stat(current); err == nil {
+		backups = append(backups, current)
+	} else if !os.IsNotExist(err) {
+		return nil, errors.Trace(err)
+	}
+	return backups, nil
+}
+
+type query struct {
+	filter  Filter
+	methods set.Strings
+
+	// conversations holds the matching conversations whose record
+	// hasn't been returned yet.
+	conversations map[string]*Conversation
+	// emitted holds the conversations whose record has been returned.
+	emitted set.Strings
+	// requests holds the "conversation/request" keys of the returned
+	// requests, so their responses can be returned too.
+	requests set.Strings
+
+	records   []Record
+	truncated bool
+}
+
+func newQuery(filter Filter) *query {
+	return &query{
+		filter:        filter,
+		methods:       set.NewStrings(filter.Methods...),
+		conversations: make(map[string]*Conversation),
+		emitted:       set.NewStrings(),
+		requests:      set.NewStrings(),
+	}
+}
+
+func (q *query) readFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var reader io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer func() { _ = gz.Close() }()
+		reader = gz
+	}
+
+	scanner := bufio.NewScanner(reader)
+	// Requests with captured args can be large.
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			logger.Debugf("skipping unreadable audit record in %s: %v", path, err)
+			continue
+		}
+		if !q.add(record) {
+			return nil
+		}
+	}
+	return errors.Trace(scanner.Err())
+}
+
+// add considers the record for the result, returning false once the
+// limit has been reached.
+func (q *query) add(r Record) bool {
+	switch {
+	case r.Conversation != nil:
+		conv := r.Conversation
+		if !q.matchConversation(conv) {
+			return true
+		}
+		q.conversations[conv.ConversationID] = conv
+		if len(q.methods) == 0 && q.inRange(r) {
+			return q.emitConversation(conv.ConversationID)
+		}
+	case r.Request != nil:
+		req := r.Request
+		if _, ok := q.conversations[req.ConversationID]; !ok && !q.emitted.Contains(req.ConversationID) {
+			return true
+		}
+		if len(q.methods) > 0 && !q.methods.Contains(req.Facade+"."+req.Method) {
+			return true
+		}
+		if !q.inRange(r) {
+			return true
+		}
+		if !q.emitConversation(req.ConversationID) {
+			return false
+		}
+		q.requests.Add(requestKey(req.ConversationID, req.RequestID))
+		return q.emit(r)
+	case r.Errors != nil:
+		if !q.requests.Contains(requestKey(r.Errors.ConversationID, r.Errors.RequestID)) {
+			return true
+		}
+		return q.emit(r)
+	}
+	return true
+}
+
+func (q *query) matchConversation(c *Conversation) bool {
+	f := q.filter
+	if f.Who != "" && c.Who != f.Who {
+		return false
+	}
+	if f.Model != "" && c.ModelUUID != f.Model && !matchModelName(c.ModelName, f.Model) {
+		return false
+	}
+	if f.ConversationID != "" && c.ConversationID != f.ConversationID {
+		return false
+	}
+	return true
+}
+
+// matchModelName reports whether the model name recorded for a
+// conversation matches the name in the filter. Either may be qualified by
+// the model owner ("owner/name"); when only one is, the names are compared
+// without the owner, as conversations recorded by older controllers don't
+// include it.
+func matchModelName(recorded, filter string) bool {
+	if recorded == filter {
+		return true
+	}
+	if strings.Contains(recorded, "/") == strings.Contains(filter, "/") {
+		return false
+	}
+	return modelBaseName(recorded) == modelBaseName(filter)
+}
+
+func modelBaseName(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+func (q *query) inRange(r Record) bool {
+	if q.filter.From.IsZero() && q.filter.To.IsZero() {
+		return true
+	}
+	t, err := RecordTime(r)
+	if err != nil {
+		return false
+	}
+	if !q.filter.From.IsZero() && t.Before(q.filter.From) {
+		return false
+	}
+	if !q.filter.To.IsZero() && t.After(q.filter.To) {
+		return false
+	}
+	return true
+}
+
+func (q *query) emitConversation(id string) bool {
+	if q.emitted.Contains(id) {
+		return true
+	}
+	conv := q.conversations[id]
+	delete(q.conversations, id)
+	q.emitted.Add(id)
+	return q.emit(Record{Conversation: conv})
+}
+
+func (q *query) emit(r Record) bool {
+	if q.filter.Limit > 0 && len(q.records) >= q.filter.Limit {
+		q.truncated = true
+		return false
+	}
+	q.records = append(q.records, r)
+	return true
+}
+
+func requestKey(conversationID string, requestID uint64) string {
+	return conversationID + "/" + idString(requestID)
+}
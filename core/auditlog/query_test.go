@@ -0,0 +1,240 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/auditlog"
+)
+
+type QuerySuite struct {
+	testing.IsolationSuite
+
+	logDir string
+}
+
+var _ = gc.Suite(&QuerySuite{})
+
+func (s *QuerySuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.logDir = c.MkDir()
+
+	// An older, rotated and compressed, log holding mary's deploy.
+	s.writeLog(c, "audit-2026-10-12T00-00-00.000.log.gz",
+		conversation("aa", "mary", "admin/prod", "2026-10-11T09:00:00Z"),
+		request("aa", 1, "Application.Deploy", "2026-10-11T09:00:01Z"),
+		response("aa", 1, "2026-10-11T09:00:02Z"),
+	)
+	// The current log, with bob removing an application in two models,
+	// and a conversation which started in the previous log.
+	s.writeLog(c, "audit.log",
+		conversation("bb", "bob", "admin/prod", "2026-10-13T10:00:00Z"),
+		request("bb", 1, "Application.DestroyApplication", "2026-10-13T10:00:01Z"),
+		response("bb", 1, "2026-10-13T10:00:02Z"),
+		request("bb", 2, "Client.FullStatus", "2026-10-13T10:00:03Z"),
+		conversation("cc", "bob", "admin/test", "2026-10-13T11:00:00Z"),
+		request("cc", 1, "Application.DestroyApplication", "2026-10-13T11:00:01Z"),
+		request("aa", 2, "Application.DestroyApplication", "2026-10-13T12:00:00Z"),
+	)
+}
+
+func (s *QuerySuite) writeLog(c *gc.C, name string, records ...auditlog.Record) {
+	f, err := os.Create(filepath.Join(s.logDir, name))
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { _ = f.Close() }()
+
+	var w io.Writer = f
+	if filepath.Ext(name) == ".gz" {
+		gz := gzip.NewWriter(f)
+		defer func() { _ = gz.Close() }()
+		w = gz
+	}
+	enc := json.NewEncoder(w)
+	for _, r := range records {
+		c.Assert(enc.Encode(r), jc.ErrorIsNil)
+	}
+}
+
+func (s *QuerySuite) TestReadAll(c *gc.C) {
+	records, truncated, err := auditlog.ReadRecords(s.logDir, auditlog.Filter{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(truncated, jc.IsFalse)
+	c.Check(summarise(records), jc.DeepEquals, []string{
+		"aa conversation mary",
+		"aa request Application.Deploy",
+		"aa response 1",
+		"bb conversation bob",
+		"bb request Application.DestroyApplication",
+		"bb response 1",
+		"bb request Client.FullStatus",
+		"cc conversation bob",
+		"cc request Application.DestroyApplication",
+		"aa request Application.DestroyApplication",
+	})
+}
+
+func (s *QuerySuite) TestWhoRanMethodInModel(c *gc.C) {
+	records, _, err := auditlog.ReadRecords(s.logDir, auditlog.Filter{
+		Model:   "admin/prod",
+		Methods: []string{"Application.DestroyApplication"},
+		From:    time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	// mary's conversation started before the window, but is included
+	// so that it's clear who made the request.
+	c.Check(summarise(records), jc.DeepEquals, []string{
+		"bb conversation bob",
+		"bb request Application.DestroyApplication",
+		"bb response 1",
+		"aa conversation mary",
+		"aa request Application.DestroyApplication",
+	})
+}
+
+func (s *QuerySuite) TestModelName(c *gc.C) {
+	// Conversations recorded by older controllers hold the model name
+	// without its owner.
+	s.writeLog(c, "audit-2026-10-10T00-00-00.000.log.gz",
+		conversation("dd", "jim", "prod", "2026-10-09T09:00:00Z"),
+		request("dd", 1, "Application.Deploy", "2026-10-09T09:00:01Z"),
+	)
+	for _, test := range []struct {
+		model    string
+		expected []string
+	}{{
+		model:    "admin/prod",
+		expected: []string{"dd", "aa", "bb"},
+	}, {
+		model:    "prod",
+		expected: []string{"dd", "aa", "bb"},
+	}, {
+		model:    "bob/prod",
+		expected: []string{"dd"},
+	}, {
+		model:    "admin/test",
+		expected: []string{"cc"},
+	}} {
+		c.Logf("model %q", test.model)
+		records, _, err := auditlog.ReadRecords(s.logDir, auditlog.Filter{Model: test.model})
+		c.Assert(err, jc.ErrorIsNil)
+		var conversations []string
+		for _, r := range records {
+			if r.Conversation != nil {
+				conversations = append(conversations, r.ConversationID())
+			}
+		}
+		c.Check(conversations, jc.DeepEquals, test.expected)
+	}
+}
+
+func (s *QuerySuite) TestWho(c *gc.C) {
+	records, _, err := auditlog.ReadRecords(s.logDir, auditlog.Filter{
+		Who:   "mary",
+		Model: "admin/prod",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(summarise(records), jc.DeepEquals, []string{
+		"aa conversation mary",
+		"aa request Application.Deploy",
+		"aa response 1",
+		"aa request Application.DestroyApplication",
+	})
+}
+
+func (s *QuerySuite) TestConversationID(c *gc.C) {
+	records, _, err := auditlog.ReadRecords(s.logDir, auditlog.Filter{
+		ConversationID: "cc",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(summarise(records), jc.DeepEquals, []string{
+		"cc conversation bob",
+		"cc request Application.DestroyApplication",
+	})
+}
+
+func (s *QuerySuite) TestLimit(c *gc.C) {
+	records, truncated, err := auditlog.ReadRecords(s.logDir, auditlog.Filter{
+		Limit: 4,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(truncated, jc.IsTrue)
+	c.Check(summarise(records), jc.DeepEquals, []string{
+		"aa conversation mary",
+		"aa request Application.Deploy",
+		"aa response 1",
+		"bb conversation bob",
+	})
+}
+
+func (s *QuerySuite) TestNoLogs(c *gc.C) {
+	records, truncated, err := auditlog.ReadRecords(c.MkDir(), auditlog.Filter{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(truncated, jc.IsFalse)
+	c.Check(records, gc.HasLen, 0)
+}
+
+func (s *QuerySuite) TestRecordTime(c *gc.C) {
+	t, err := auditlog.RecordTime(request("aa", 1, "Application.Deploy", "2026-10-11T09:00:01Z"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(t, gc.Equals, time.Date(2026, 10, 11, 9, 0, 1, 0, time.UTC))
+
+	_, err = auditlog.RecordTime(auditlog.Record{})
+	c.Check(err, gc.ErrorMatches, "empty audit record not valid")
+}
+
+func conversation(id, who, model, when string) auditlog.Record {
+	return auditlog.Record{Conversation: &auditlog.Conversation{
+		ConversationID: id,
+		Who:            who,
+		ModelName:      model,
+		When:           when,
+	}}
+}
+
+func request(id string, requestID uint64, method, when string) auditlog.Record {
+	parts := strings.SplitN(method, ".", 2)
+	return auditlog.Record{Request: &auditlog.Request{
+		ConversationID: id,
+		RequestID:      requestID,
+		Facade:         parts[0],
+		Method:         parts[1],
+		When:           when,
+	}}
+}
+
+func response(id string, requestID uint64, when string) auditlog.Record {
+	return auditlog.Record{Errors: &auditlog.ResponseErrors{
+		ConversationID: id,
+		RequestID:      requestID,
+		When:           when,
+	}}
+}
+
+func summarise(records []auditlog.Record) []string {
+	var result []string
+	for _, r := range records {
+		switch {
+		case r.Conversation != nil:
+			result = append(result, r.ConversationID()+" conversation "+r.Conversation.Who)
+		case r.Request != nil:
+			result = append(result, r.ConversationID()+" request "+r.Request.Facade+"."+r.Request.Method)
+		case r.Errors != nil:
+			result = append(result, r.ConversationID()+" response "+strconv.FormatUint(r.Errors.RequestID, 10))
+		}
+	}
+	return result
+}
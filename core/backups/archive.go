@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/juju/errors"
 	"github.com/juju/utils/v3/tar"
@@ -166,6 +167,25 @@ func (ws *ArchiveWorkspace) Metadata() (*Metadata, error) {
 	return meta, errors.Trace(err)
 }
 
+// DBDumpFiles returns the paths of the database snapshots in the
+// workspace, keyed by the namespace of the database they hold.
+func (ws *ArchiveWorkspace) DBDumpFiles() (map[string]string, error) {
+	entries, err := os.ReadDir(ws.DBDumpDir)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	files := make(map[string]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != dbDumpExt {
+			continue
+		}
+		files[strings.TrimSuffix(name, dbDumpExt)] = filepath.Join(ws.DBDumpDir, name)
+	}
+	return files, nil
+}
+
 // ArchiveData is a wrapper around a the uncompressed data in a backup
 // archive file. It provides access to the content of the archive. While
 // ArchiveData provides useful functionality, it may not be appropriate
@@ -6,12 +6,14 @@ package backups_test
 import (
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/backups"
+	bt "github.com/juju/juju/core/backups/testing"
 )
 
 type workspaceSuiteV0 struct {
@@ -94,3 +96,24 @@ func (s *workspaceSuiteV1) SetUpTest(c *gc.C) {
 	s.IsolationSuite.SetUpTest(c)
 	s.baseArchiveDataSuite.setupMetadata(c, testMetadataV1)
 }
+
+func (s *workspaceSuiteV1) TestDBDumpFiles(c *gc.C) {
+	archiveFile, err := bt.NewArchive(s.meta, nil, []bt.File{
+		{Name: "controller.sql", Content: "CREATE TABLE band (name TEXT);"},
+		{Name: "deadbeef-0bad-400d-8000-4b1d0d06f00d.sql", Content: ""},
+		{Name: "juju", IsDir: true},
+		{Name: "juju/machines.bson", Content: "<BSON data goes here>"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	ws, err := backups.NewArchiveWorkspaceReader(archiveFile)
+	c.Assert(err, jc.ErrorIsNil)
+	defer ws.Close()
+
+	files, err := ws.DBDumpFiles()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(files, jc.DeepEquals, map[string]string{
+		"controller":                           filepath.Join(ws.DBDumpDir, "controller.sql"),
+		"deadbeef-0bad-400d-8000-4b1d0d06f00d": filepath.Join(ws.DBDumpDir, "deadbeef-0bad-400d-8000-4b1d0d06f00d.sql"),
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v3/tar"
+)
+
+// dbDumpExt is the extension of the database snapshot files written to
+// the dump directory of an archive.
+const dbDumpExt = ".sql"
+
+// DBDumpFileName returns the name of the file, within the dump directory of
+// an archive, that holds the snapshot of the database with the input
+// namespace.
+func DBDumpFileName(namespace string) string {
+	return namespace + dbDumpExt
+}
+
+// DBDumper describes a type that is able to write consistent snapshots of
+// the controller's databases.
+type DBDumper interface {
+	// DumpDatabases writes a snapshot of each database, one file per
+	// database, into the input directory.
+	DumpDatabases(ctx context.Context, dumpDir string) error
+}
+
+// FilesToBackUp returns the paths of the files on the controller machine
+// with the input ID that are required to restore it from a backup.
+// Files that do not exist on the machine are omitted.
+func FilesToBackUp(paths Paths, machineID string) ([]string, error) {
+	candidates := []string{
+		filepath.Join(paths.DataDir, "agents", "machine-"+machineID),
+		filepath.Join(paths.DataDir, "system-identity"),
+		filepath.Join(paths.DataDir, "server.pem"),
+		filepath.Join(paths.DataDir, "dqlite", "info.yaml"),
+		filepath.Join(paths.DataDir, "dqlite", "cluster.yaml"),
+	}
+
+	var files []string
+	for _, file := range candidates {
+		if _, err := os.Stat(file); os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// Create builds a new backup archive from the input files and the
+// database snapshots written by the input dumper. The archive is written to
+// the backup directory indicated by paths, or to the system's temporary
+// directory if none is set. The metadata is stored in the archive and then
+// completed with the archive's size and checksum.
+// The path of the created archive is returned.
+func Create(ctx context.Context, meta *Metadata, paths Paths, dumper DBDumper, files []string) (_ string, err error) {
+	ws, err := newArchiveWorkspace()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	defer func() { _ = ws.Close() }()
+
+	if err := os.MkdirAll(ws.DBDumpDir, 0700); err != nil {
+		return "", errors.Annotate(err, "creating dump directory")
+	}
+	if err := dumper.DumpDatabases(ctx, ws.DBDumpDir); err != nil {
+		return "", errors.Annotate(err, "dumping databases")
+	}
+
+	if err := writeFilesBundle(ws.FilesBundle, files); err != nil {
+		return "", errors.Annotate(err, "bundling files")
+	}
+
+	metaFile, err := meta.AsJSONBuffer()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := writeFile(ws.MetadataFile, metaFile); err != nil {
+		return "", errors.Annotate(err, "writing metadata")
+	}
+
+	backupDir := paths.BackupDir
+	if backupDir == "" {
+		backupDir = os.TempDir()
+	}
+	filename := filepath.Join(backupDir, meta.Started.Format(FilenameTemplate))
+
+	archive, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", errors.Annotate(err, "creating archive file")
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(filename)
+		}
+	}()
+
+	size, checksum, err := writeCompressedArchive(archive, ws)
+	if closeErr := archive.Close(); err == nil && closeErr != nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", errors.Annotate(err, "writing archive")
+	}
+
+	if err = meta.MarkComplete(size, checksum); err != nil {
+		return "", errors.Trace(err)
+	}
+	return filename, nil
+}
+
+func writeFilesBundle(target string, files []string) error {
+	f, err := os.Create(target)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = tar.TarFiles(files, f, string(os.PathSeparator))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return errors.Trace(err)
+}
+
+func writeFile(target string, r io.Reader) error {
+	f, err := os.Create(target)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return errors.Trace(err)
+}
+
+// writeCompressedArchive writes the workspace content directory to the
+// input writer as a gzipped tar file. The size and checksum of the
+// compressed data are returned.
+func writeCompressedArchive(w io.Writer, ws *ArchiveWorkspace) (int64, string, error) {
+	hasher := sha1.New()
+	counter := &countingWriter{}
+	gzw := gzip.NewWriter(io.MultiWriter(w, hasher, counter))
+
+	strip := ws.RootDir + string(os.PathSeparator)
+	if _, err := tar.TarFiles([]string{ws.ContentDir}, gzw, strip); err != nil {
+		return 0, "", errors.Trace(err)
+	}
+	if err := gzw.Close(); err != nil {
+		return 0, "", errors.Trace(err)
+	}
+	return counter.n, base64.StdEncoding.EncodeToString(hasher.Sum(nil)), nil
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/backups"
+)
+
+type createSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&createSuite{})
+
+type fakeDumper struct {
+	err error
+}
+
+func (d fakeDumper) DumpDatabases(_ context.Context, dumpDir string) error {
+	if d.err != nil {
+		return d.err
+	}
+	return os.WriteFile(filepath.Join(dumpDir, "controller.sql"), []byte("CREATE TABLE band (name TEXT);\n"), 0600)
+}
+
+func (s *createSuite) TestFilesToBackUp(c *gc.C) {
+	dataDir := c.MkDir()
+	agentDir := filepath.Join(dataDir, "agents", "machine-0")
+	c.Assert(os.MkdirAll(agentDir, 0700), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(dataDir, "system-identity"), []byte("key"), 0600), jc.ErrorIsNil)
+
+	files, err := backups.FilesToBackUp(backups.Paths{DataDir: dataDir}, "0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(files, jc.DeepEquals, []string{
+		agentDir,
+		filepath.Join(dataDir, "system-identity"),
+	})
+}
+
+func (s *createSuite) TestCreate(c *gc.C) {
+	dataDir := c.MkDir()
+	agentConf := filepath.Join(dataDir, "agents", "machine-0", "agent.conf")
+	c.Assert(os.MkdirAll(filepath.Dir(agentConf), 0700), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(agentConf, []byte("tag: machine-0"), 0600), jc.ErrorIsNil)
+
+	backupDir := c.MkDir()
+	meta := backups.NewMetadata()
+	meta.Notes = "nightly"
+
+	filename, err := backups.Create(
+		context.Background(), meta, backups.Paths{BackupDir: backupDir, DataDir: dataDir},
+		fakeDumper{}, []string{filepath.Dir(agentConf)},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(filepath.Dir(filename), gc.Equals, backupDir)
+	c.Check(strings.HasPrefix(filepath.Base(filename), backups.FilenamePrefix), jc.IsTrue)
+	c.Check(meta.Finished, gc.NotNil)
+
+	// The recorded size and checksum must match the archive on disk.
+	archive, err := os.Open(filename)
+	c.Assert(err, jc.ErrorIsNil)
+	defer archive.Close()
+	built, err := backups.BuildMetadata(archive)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(meta.Size(), gc.Equals, built.Size())
+	c.Check(meta.Checksum(), gc.Equals, built.Checksum())
+
+	_, err = archive.Seek(0, io.SeekStart)
+	c.Assert(err, jc.ErrorIsNil)
+	ws, err := backups.NewArchiveWorkspaceReader(archive)
+	c.Assert(err, jc.ErrorIsNil)
+	defer ws.Close()
+
+	stored, err := ws.Metadata()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(stored.Notes, gc.Equals, "nightly")
+
+	dump, err := os.ReadFile(filepath.Join(ws.DBDumpDir, "controller.sql"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(dump), gc.Equals, "CREATE TABLE band (name TEXT);\n")
+
+	conf, err := ws.OpenBundledFile(strings.TrimPrefix(agentConf, string(os.PathSeparator)))
+	c.Assert(err, jc.ErrorIsNil)
+	data, err := io.ReadAll(conf)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "tag: machine-0")
+}
+
+func (s *createSuite) TestCreateDumpError(c *gc.C) {
+	backupDir := c.MkDir()
+	_, err := backups.Create(
+		context.Background(), backups.NewMetadata(), backups.Paths{BackupDir: backupDir},
+		fakeDumper{err: errors.New("boom")}, nil,
+	)
+	c.Assert(err, gc.ErrorMatches, "dumping databases: boom")
+
+	entries, err := os.ReadDir(backupDir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entries, gc.HasLen, 0)
+}
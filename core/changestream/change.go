@@ -20,6 +20,9 @@ const (
 
 // ChangeEvent represents a new change set via the changestream.
 type ChangeEvent interface {
+	// ID returns the change log id of the event. The id can be used as a
+	// cursor to resume a subscription from this event.
+	ID() int64
 	// Type returns the type of change (create, update, delete).
 	Type() ChangeType
 	// Namespace returns the namespace of the change. This is normally the
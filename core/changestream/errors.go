@@ -0,0 +1,14 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changestream
+
+import "github.com/juju/errors"
+
+const (
+	// ErrChangeLogGap is returned when a subscription requests the events
+	// after a change log cursor, but some of those events have already been
+	// pruned from the change log. The subscriber can no longer resume from
+	// the cursor, and must reload its state from scratch.
+	ErrChangeLogGap = errors.ConstError("change log cursor has been pruned")
+)
@@ -15,6 +15,13 @@ type EventSource interface {
 	// Subscribe returns a subscription that can receive events from
 	// a change stream according to the input subscription options.
 	Subscribe(opts ...SubscriptionOption) (Subscription, error)
+
+	// SubscribeFrom returns a subscription that first receives the events
+	// that occurred after the input change log cursor, before receiving
+	// events from the change stream as they occur. If any of the events
+	// after the cursor have been pruned from the change log, then
+	// ErrChangeLogGap is returned.
+	SubscribeFrom(cursor int64, opts ...SubscriptionOption) (Subscription, error)
 }
 
 // WatchableDB describes the ability to run transactions against a database
@@ -0,0 +1,83 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changestream
+
+import "time"
+
+// Report is a snapshot of the change streams for every database that is
+// currently being watched. It is used to introspect the change stream, to
+// help diagnose watchers that are not receiving changes.
+type Report struct {
+	// Databases holds a report for every database that is being watched,
+	// sorted by namespace.
+	Databases []DatabaseReport `json:"databases" yaml:"databases"`
+}
+
+// DatabaseReport describes the state of the change stream for a single
+// database.
+type DatabaseReport struct {
+	// Namespace is the namespace of the database. This is the model UUID
+	// for model databases.
+	Namespace string `json:"namespace" yaml:"namespace"`
+
+	// LatestChangeID is the id of the latest change in the change log.
+	LatestChangeID int64 `json:"latest-change-id" yaml:"latest-change-id"`
+
+	// LastReceivedChangeID is the id of the last change that was received
+	// from the stream by the event multiplexer.
+	LastReceivedChangeID int64 `json:"last-received-change-id" yaml:"last-received-change-id"`
+
+	// Lag is the number of change log ids that the event multiplexer is
+	// behind the latest change.
+	Lag int64 `json:"lag" yaml:"lag"`
+
+	// Witnesses holds the bounds of the change log that have been witnessed
+	// by each controller.
+	Witnesses []WitnessReport `json:"witnesses" yaml:"witnesses"`
+
+	// Subscriptions holds a report for every active subscription, sorted by
+	// subscription id.
+	Subscriptions []SubscriptionReport `json:"subscriptions" yaml:"subscriptions"`
+}
+
+// WitnessReport describes the bounds of the change log that a controller has
+// witnessed. Changes below the lowest lower bound can be pruned.
+type WitnessReport struct {
+	ControllerID string    `json:"controller-id" yaml:"controller-id"`
+	LowerBound   int64     `json:"lower-bound" yaml:"lower-bound"`
+	UpperBound   int64     `json:"upper-bound" yaml:"upper-bound"`
+	UpdatedAt    time.Time `json:"updated-at" yaml:"updated-at"`
+}
+
+// SubscriptionReport describes a single subscription to the change stream.
+type SubscriptionReport struct {
+	ID uint64 `json:"id" yaml:"id"`
+
+	// Topics holds the namespaces and change masks that the subscription
+	// is interested in. It is empty if the subscription receives all
+	// changes.
+	Topics []TopicReport `json:"topics,omitempty" yaml:"topics,omitempty"`
+
+	// Cursor is the change log id that the subscription was resumed from.
+	Cursor int64 `json:"cursor,omitempty" yaml:"cursor,omitempty"`
+
+	// QueueDepth is the number of changes that have been dispatched to the
+	// subscription, but have not yet been received.
+	QueueDepth int64 `json:"queue-depth" yaml:"queue-depth"`
+
+	// LastChangeID is the id of the last change that was received by the
+	// subscription.
+	LastChangeID int64 `json:"last-change-id" yaml:"last-change-id"`
+
+	// Lag is the number of change log ids between the latest change and the
+	// last change received by the subscription, whilst the subscription has
+	// changes queued. A subscription with nothing queued is up to date.
+	Lag int64 `json:"lag" yaml:"lag"`
+}
+
+// TopicReport describes a namespace that a subscription is interested in.
+type TopicReport struct {
+	Namespace  string `json:"namespace" yaml:"namespace"`
+	ChangeMask string `json:"change-mask" yaml:"change-mask"`
+}
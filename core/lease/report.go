@@ -0,0 +1,53 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lease
+
+import "time"
+
+// Report is a snapshot of the leases known to a lease manager. It combines
+// the leases held in the store with the requests that are still in flight
+// inside the manager, and is used to introspect the manager.
+type Report struct {
+	// EntityUUID identifies the entity the lease manager is running for.
+	EntityUUID string `json:"entity-uuid" yaml:"entity-uuid"`
+
+	// OutstandingClaims is the number of claims currently being handled.
+	OutstandingClaims int64 `json:"outstanding-claims" yaml:"outstanding-claims"`
+
+	// OutstandingRevokes is the number of revokes currently being handled.
+	OutstandingRevokes int64 `json:"outstanding-revokes" yaml:"outstanding-revokes"`
+
+	// Leases holds a report for every lease that is held, pinned, claimed
+	// or waited upon, sorted by namespace, model and lease name.
+	Leases []LeaseReport `json:"leases" yaml:"leases"`
+}
+
+// LeaseReport describes the state of a single lease.
+type LeaseReport struct {
+	Namespace string `json:"namespace" yaml:"namespace"`
+	ModelUUID string `json:"model-uuid" yaml:"model-uuid"`
+	Lease     string `json:"lease" yaml:"lease"`
+
+	// Holder is the current holder of the lease. It is empty if the lease
+	// is not currently held.
+	Holder string `json:"holder,omitempty" yaml:"holder,omitempty"`
+
+	// Expiry is the time at which the lease will expire, if it is held.
+	Expiry *time.Time `json:"expiry,omitempty" yaml:"expiry,omitempty"`
+
+	// ExpiresIn is the time remaining until the lease expires, relative to
+	// the clock of the lease manager. A negative duration indicates that
+	// the lease has expired, but has not yet been removed from the store.
+	ExpiresIn string `json:"expires-in,omitempty" yaml:"expires-in,omitempty"`
+
+	// PinnedBy holds the entities that have pinned the lease.
+	PinnedBy []string `json:"pinned-by,omitempty" yaml:"pinned-by,omitempty"`
+
+	// PendingClaims holds the names of the holders that have a claim for
+	// the lease in flight.
+	PendingClaims []string `json:"pending-claims,omitempty" yaml:"pending-claims,omitempty"`
+
+	// Blocks is the number of callers waiting for the lease to expire.
+	Blocks int `json:"blocks,omitempty" yaml:"blocks,omitempty"`
+}
@@ -32,8 +32,10 @@ type LogTailer interface {
 // LogTailerParams specifies the filtering a LogTailer should
 // apply to log records in order to decide which to return.
 type LogTailerParams struct {
-	StartID       int64
-	StartTime     time.Time
+	StartID   int64
+	StartTime time.Time
+	// EndTime, if set, excludes records logged after this time.
+	EndTime       time.Time
 	MinLevel      loggo.Level
 	InitialLines  int
 	NoTail        bool
@@ -43,4 +45,8 @@ type LogTailerParams struct {
 	ExcludeModule []string
 	IncludeLabel  []string
 	ExcludeLabel  []string
+	// IncludeMessage and ExcludeMessage hold regular expressions
+	// which are matched against the log message text.
+	IncludeMessage []string
+	ExcludeMessage []string
 }
@@ -0,0 +1,14 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+// Metadata represents the metadata of an object held in the object store.
+type Metadata struct {
+	// Hash is the hex encoded SHA-384 hash of the object's contents.
+	Hash string
+	// Path is the path of the object within the object store.
+	Path string
+	// Size is the size of the object in bytes.
+	Size int64
+}
@@ -28,6 +28,7 @@ type Session interface {
 // ObjectStore represents a full object store for both read and write access.
 type ObjectStore interface {
 	ReadObjectStore
+	LocalReadObjectStore
 	WriteObjectStore
 }
 
@@ -38,6 +39,16 @@ type ReadObjectStore interface {
 	Get(context.Context, string) (io.ReadCloser, int64, error)
 }
 
+// LocalReadObjectStore represents an object store that can only be read
+// from, without retrieving objects from other controllers.
+type LocalReadObjectStore interface {
+	// GetLocal returns an io.ReadCloser for data at path, namespaced to the
+	// model, if it's held by this controller. Unlike Get, an object that
+	// hasn't been replicated to this controller yet is never retrieved from
+	// a peer, so that peers serving each other's requests can't loop.
+	GetLocal(context.Context, string) (io.ReadCloser, int64, error)
+}
+
 // WriteObjectStore represents an object store that can only be written to.
 type WriteObjectStore interface {
 	// Put stores data from reader at path, namespaced to the model.
@@ -50,3 +61,34 @@ type WriteObjectStore interface {
 	// Remove removes data at path, namespaced to the model.
 	Remove(ctx context.Context, path string) error
 }
+
+// BackendType is the type of backend used to store objects.
+type BackendType string
+
+const (
+	// StateBackend is the backend type for the state (GridFS) object store.
+	StateBackend BackendType = "state"
+	// FileBackend is the backend type for the file object store.
+	FileBackend BackendType = "file"
+	// S3Backend is the backend type for the S3 compatible object store.
+	S3Backend BackendType = "s3"
+)
+
+// String returns the string representation of the backend type.
+func (t BackendType) String() string {
+	return string(t)
+}
+
+// ParseObjectStoreType parses the given string into a BackendType.
+func ParseObjectStoreType(s string) (BackendType, error) {
+	switch s {
+	case string(StateBackend):
+		return StateBackend, nil
+	case string(FileBackend):
+		return FileBackend, nil
+	case string(S3Backend):
+		return S3Backend, nil
+	default:
+		return "", errors.NotValidf("object store type %q", s)
+	}
+}
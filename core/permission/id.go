@@ -0,0 +1,156 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package permission
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+)
+
+// ObjectType is the type of entity that access can be granted on.
+type ObjectType string
+
+const (
+	// Cloud is the object type of clouds, keyed by name.
+	Cloud ObjectType = "cloud"
+
+	// Controller is the object type of controllers, keyed by UUID.
+	Controller ObjectType = "controller"
+
+	// Model is the object type of models, keyed by UUID.
+	Model ObjectType = "model"
+
+	// Offer is the object type of application offers.
+	Offer ObjectType = "offer"
+)
+
+// Validate returns an error if the object type is not one that access can
+// be granted on.
+func (o ObjectType) Validate() error {
+	switch o {
+	case Cloud, Controller, Model, Offer:
+		return nil
+	}
+	return errors.NotValidf("object type %q", o)
+}
+
+// ValidateAccess returns an error if the access level can't be granted on
+// objects of this type.
+func (o ObjectType) ValidateAccess(access Access) error {
+	switch o {
+	case Cloud:
+		return ValidateCloudAccess(access)
+	case Controller:
+		return ValidateControllerAccess(access)
+	case Model:
+		return ValidateModelAccess(access)
+	case Offer:
+		return ValidateOfferAccess(access)
+	}
+	return errors.NotValidf("object type %q", o)
+}
+
+// HighestAccess returns the most capable of the access levels for objects
+// of this type. Access levels that don't apply to the type are ignored,
+// and NoAccess is returned if there are none that do.
+func (o ObjectType) HighestAccess(accesses ...Access) Access {
+	highest, highestValue := NoAccess, 0
+	for _, access := range accesses {
+		if value := o.accessValue(access); value > highestValue {
+			highest, highestValue = access, value
+		}
+	}
+	return highest
+}
+
+// LowestAccess returns the least capable of the access levels for objects
+// of this type. NoAccess is returned if there are none, or if any of them
+// is NoAccess or doesn't apply to the type.
+func (o ObjectType) LowestAccess(accesses ...Access) Access {
+	if len(accesses) == 0 {
+		return NoAccess
+	}
+	lowest, lowestValue := accesses[0], o.accessValue(accesses[0])
+	for _, access := range accesses[1:] {
+		if value := o.accessValue(access); value < lowestValue {
+			lowest, lowestValue = access, value
+		}
+	}
+	if lowestValue == 0 {
+		return NoAccess
+	}
+	return lowest
+}
+
+// accessValue orders the access levels for the object type, starting at
+// 1 for the least capable. It returns 0 for NoAccess and for access levels
+// that don't apply.
+func (o ObjectType) accessValue(access Access) int {
+	var value int
+	switch o {
+	case Cloud:
+		value = access.cloudValue() + 1
+	case Controller:
+		value = access.controllerValue()
+	case Model:
+		value = access.modelValue()
+	case Offer:
+		value = access.offerValue()
+	}
+	if value < 0 {
+		return 0
+	}
+	return value
+}
+
+// ID identifies an entity that access can be granted on.
+type ID struct {
+	// ObjectType is the type of the entity.
+	ObjectType ObjectType
+
+	// Key identifies the entity among those of its type: the UUID of a
+	// controller or model, or the name of a cloud or offer.
+	Key string
+}
+
+// ParseTagForID returns the ID of the entity with the tag. It returns an
+// error satisfying errors.NotValid if access can't be granted on entities
+// of the tag's kind.
+func ParseTagForID(tag names.Tag) (ID, error) {
+	if tag == nil {
+		return ID{}, errors.NotValidf("nil tag")
+	}
+	id := ID{Key: tag.Id()}
+	switch tag.Kind() {
+	case names.CloudTagKind:
+		id.ObjectType = Cloud
+	case names.ControllerTagKind:
+		id.ObjectType = Controller
+	case names.ModelTagKind:
+		id.ObjectType = Model
+	case names.ApplicationOfferTagKind:
+		id.ObjectType = Offer
+	default:
+		return ID{}, errors.NotValidf("granting access on %q", tag.Kind())
+	}
+	return id, nil
+}
+
+// Validate returns an error if the ID is incomplete or of an object type
+// that access can't be granted on.
+func (i ID) Validate() error {
+	if err := i.ObjectType.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	if i.Key == "" {
+		return errors.NotValidf("empty %s key", i.ObjectType)
+	}
+	return nil
+}
+
+// ValidateAccess returns an error if the access level can't be granted on
+// the entity.
+func (i ID) ValidateAccess(access Access) error {
+	return i.ObjectType.ValidateAccess(access)
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package permission_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/permission"
+)
+
+type idSuite struct{}
+
+var _ = gc.Suite(&idSuite{})
+
+func (*idSuite) TestParseTagForID(c *gc.C) {
+	for _, test := range []struct {
+		tag names.Tag
+		id  permission.ID
+	}{{
+		tag: names.NewCloudTag("aws"),
+		id:  permission.ID{ObjectType: permission.Cloud, Key: "aws"},
+	}, {
+		tag: names.NewControllerTag("deadbeef-1bad-500d-9000-4b1d0d06f00d"),
+		id:  permission.ID{ObjectType: permission.Controller, Key: "deadbeef-1bad-500d-9000-4b1d0d06f00d"},
+	}, {
+		tag: names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00d"),
+		id:  permission.ID{ObjectType: permission.Model, Key: "deadbeef-0bad-400d-8000-4b1d0d06f00d"},
+	}, {
+		tag: names.NewApplicationOfferTag("hosted-mysql"),
+		id:  permission.ID{ObjectType: permission.Offer, Key: "hosted-mysql"},
+	}} {
+		id, err := permission.ParseTagForID(test.tag)
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(id, gc.Equals, test.id)
+	}
+
+	_, err := permission.ParseTagForID(names.NewUserTag("bob"))
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+	_, err = permission.ParseTagForID(nil)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (*idSuite) TestValidate(c *gc.C) {
+	c.Check(permission.ID{ObjectType: permission.Model, Key: "uuid"}.Validate(), jc.ErrorIsNil)
+	c.Check(permission.ID{ObjectType: permission.Model}.Validate(), gc.ErrorMatches, "empty model key not valid")
+	c.Check(permission.ID{ObjectType: "user", Key: "bob"}.Validate(), gc.ErrorMatches, `object type "user" not valid`)
+}
+
+func (*idSuite) TestValidateAccess(c *gc.C) {
+	id := permission.ID{ObjectType: permission.Controller, Key: "uuid"}
+	c.Check(id.ValidateAccess(permission.SuperuserAccess), jc.ErrorIsNil)
+	c.Check(id.ValidateAccess(permission.WriteAccess), gc.ErrorMatches, `"write" controller access not valid`)
+
+	id = permission.ID{ObjectType: permission.Model, Key: "uuid"}
+	c.Check(id.ValidateAccess(permission.WriteAccess), jc.ErrorIsNil)
+	c.Check(id.ValidateAccess(permission.LoginAccess), gc.ErrorMatches, `"login" model access not valid`)
+}
+
+func (*idSuite) TestHighestAccess(c *gc.C) {
+	c.Check(permission.Model.HighestAccess(), gc.Equals, permission.NoAccess)
+	c.Check(permission.Model.HighestAccess(
+		permission.ReadAccess, permission.AdminAccess, permission.WriteAccess,
+	), gc.Equals, permission.AdminAccess)
+	c.Check(permission.Controller.HighestAccess(
+		permission.LoginAccess, permission.NoAccess,
+	), gc.Equals, permission.LoginAccess)
+	c.Check(permission.Cloud.HighestAccess(
+		permission.NoAccess, permission.AddModelAccess,
+	), gc.Equals, permission.AddModelAccess)
+	c.Check(permission.Offer.HighestAccess(
+		permission.ConsumeAccess, permission.ReadAccess,
+	), gc.Equals, permission.ConsumeAccess)
+
+	// Access levels that don't apply are ignored.
+	c.Check(permission.Controller.HighestAccess(
+		permission.AdminAccess, permission.LoginAccess,
+	), gc.Equals, permission.LoginAccess)
+}
+
+func (*idSuite) TestLowestAccess(c *gc.C) {
+	c.Check(permission.Model.LowestAccess(), gc.Equals, permission.NoAccess)
+	c.Check(permission.Model.LowestAccess(
+		permission.AdminAccess, permission.ReadAccess, permission.WriteAccess,
+	), gc.Equals, permission.ReadAccess)
+	c.Check(permission.Controller.LowestAccess(
+		permission.SuperuserAccess, permission.LoginAccess,
+	), gc.Equals, permission.LoginAccess)
+	c.Check(permission.Cloud.LowestAccess(
+		permission.AdminAccess, permission.AddModelAccess,
+	), gc.Equals, permission.AddModelAccess)
+	c.Check(permission.Offer.LowestAccess(
+		permission.AdminAccess, permission.ConsumeAccess,
+	), gc.Equals, permission.ConsumeAccess)
+	c.Check(permission.Model.LowestAccess(
+		permission.AdminAccess, permission.NoAccess,
+	), gc.Equals, permission.NoAccess)
+
+	// Access levels that don't apply mean there's no access.
+	c.Check(permission.Controller.LowestAccess(
+		permission.SuperuserAccess, permission.AdminAccess,
+	), gc.Equals, permission.NoAccess)
+}
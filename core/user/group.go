@@ -0,0 +1,25 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"time"
+)
+
+// Group is a named set of users. Access granted to a group is granted to
+// each of its members.
+type Group struct {
+	// Name is the name of the group.
+	Name string
+
+	// Members are the names of the users in the group. They may be local
+	// or external users, and needn't be known to the controller.
+	Members []string
+
+	// CreatorName is the name of the user that created the group.
+	CreatorName string
+
+	// CreatedAt is the time that the group was created at.
+	CreatedAt time.Time
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// AllRoleMethods is the method name that allows every method on a facade.
+const AllRoleMethods = "*"
+
+// Role is a named set of facade methods. A user granted a role on a model
+// can call those methods on the model as if they had write access to it.
+type Role struct {
+	// Name is the name of the role.
+	Name string
+
+	// Methods are the facade methods the role allows, each of the form
+	// Facade.Method. The method may be AllRoleMethods.
+	Methods []string
+
+	// CreatorName is the name of the user that created the role.
+	CreatorName string
+
+	// CreatedAt is the time that the role was created at.
+	CreatedAt time.Time
+}
+
+var validIdentifier = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// ParseRoleMethod splits a role method of the form Facade.Method into its
+// facade and method names.
+func ParseRoleMethod(s string) (string, string, error) {
+	facade, method, ok := strings.Cut(s, ".")
+	if !ok || !validIdentifier.MatchString(facade) ||
+		(method != AllRoleMethods && !validIdentifier.MatchString(method)) {
+		return "", "", errors.NotValidf("role method %q", s)
+	}
+	return facade, method, nil
+}
+
+// RoleAllowsMethod reports whether any of the role methods allows calling
+// the method on the facade.
+func RoleAllowsMethod(roleMethods []string, facade, method string) bool {
+	for _, roleMethod := range roleMethods {
+		f, m, _ := strings.Cut(roleMethod, ".")
+		if f == facade && (m == method || m == AllRoleMethods) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"time"
+
+	"github.com/juju/juju/core/permission"
+)
+
+// APITokenPrefix prefixes the secret of every API token, distinguishing
+// them from the other tokens that can be used to log in to the API.
+const APITokenPrefix = "juju-api-token-"
+
+// APIToken describes a token that a user can log in to the API with
+// instead of their password. The access a token login has is the lesser of
+// the user's own access and the maximum access of the token.
+type APIToken struct {
+	// Name is the name of the token, unique for the user.
+	Name string
+
+	// UserName is the name of the user that the token logs in as.
+	UserName string
+
+	// ModelUUID is the UUID of the only model that the token can be used
+	// with. If it is empty, the token can be used with any model.
+	ModelUUID string
+
+	// MaxAccess is the greatest access that the token allows.
+	MaxAccess permission.Access
+
+	// CreatedAt is the time that the token was created at.
+	CreatedAt time.Time
+
+	// ExpiresAt is the time after which the token can no longer be used.
+	ExpiresAt time.Time
+}
@@ -133,6 +133,26 @@ func (mr *MockWatchableDBMockRecorder) Subscribe(arg0 ...interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockWatchableDB)(nil).Subscribe), arg0...)
 }
 
+// SubscribeFrom mocks base method.
+func (m *MockWatchableDB) SubscribeFrom(arg0 int64, arg1 ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeFrom", varargs...)
+	ret0, _ := ret[0].(changestream.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeFrom indicates an expected call of SubscribeFrom.
+func (mr *MockWatchableDBMockRecorder) SubscribeFrom(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeFrom", reflect.TypeOf((*MockWatchableDB)(nil).SubscribeFrom), varargs...)
+}
+
 // Txn mocks base method.
 func (m *MockWatchableDB) Txn(arg0 context.Context, arg1 func(context.Context, *sqlair.TX) error) error {
 	m.ctrl.T.Helper()
@@ -188,3 +208,23 @@ func (mr *MockEventSourceMockRecorder) Subscribe(arg0 ...interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventSource)(nil).Subscribe), arg0...)
 }
+
+// SubscribeFrom mocks base method.
+func (m *MockEventSource) SubscribeFrom(arg0 int64, arg1 ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeFrom", varargs...)
+	ret0, _ := ret[0].(changestream.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeFrom indicates an expected call of SubscribeFrom.
+func (mr *MockEventSourceMockRecorder) SubscribeFrom(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeFrom", reflect.TypeOf((*MockEventSource)(nil).SubscribeFrom), varargs...)
+}
@@ -6,6 +6,7 @@ package eventsource
 import (
 	"context"
 	"database/sql"
+	"sync/atomic"
 
 	"github.com/juju/collections/transform"
 	"github.com/juju/errors"
@@ -30,6 +31,10 @@ type NamespaceWatcher struct {
 	changeMask changestream.ChangeType
 
 	predicate Predicate
+
+	// cursor is the change log id of the last change that has been
+	// dispatched, or discarded by the predicate.
+	cursor atomic.Int64
 }
 
 // NewNamespaceWatcher returns a new watcher that receives changes from the
@@ -71,12 +76,57 @@ func NewNamespacePredicateWatcher(
 	return w
 }
 
+// NewNamespaceWatcherFrom returns a new watcher that resumes from the
+// cursor reported by a previous watcher for the namespace, so that a
+// restarted consumer misses none of the changes made in the meantime.
+// Rather than the initial state, the first event is empty; the changes
+// after the cursor follow. If those changes have been pruned from the change
+// log, an error satisfying changestream.ErrChangeLogGap is returned, and the
+// consumer must start a new watcher with NewNamespaceWatcher instead.
+func NewNamespaceWatcherFrom(
+	base *BaseWatcher, cursor int64, namespace string, changeMask changestream.ChangeType,
+) (watcher.StringsWatcher, error) {
+	if changeMask == 0 {
+		return nil, errors.NotValidf("changeMask value: 0")
+	}
+	if cursor <= 0 {
+		return nil, errors.NotValidf("cursor %d", cursor)
+	}
+	subscription, err := base.watchableDB.SubscribeFrom(cursor, changestream.Namespace(namespace, changeMask))
+	if err != nil {
+		return nil, errors.Annotatef(err, "resuming namespace %q from %d", namespace, cursor)
+	}
+
+	w := &NamespaceWatcher{
+		BaseWatcher: base,
+		out:         make(chan []string),
+		namespace:   namespace,
+		changeMask:  changeMask,
+		predicate:   defaultPredicate,
+	}
+	w.cursor.Store(cursor)
+
+	w.tomb.Go(func() error {
+		defer close(w.out)
+		return w.run(subscription, nil, cursor)
+	})
+	return w, nil
+}
+
 // Changes returns the channel on which the keys for
 // changed rows are sent to downstream consumers.
 func (w *NamespaceWatcher) Changes() <-chan []string {
 	return w.out
 }
 
+// Cursor returns the change log id of the last change the watcher has sent
+// to its consumer, or zero if it has only sent the initial state. A
+// consumer that records the cursor can pass it to NewNamespaceWatcherFrom
+// when it restarts, to receive the changes it hasn't yet seen.
+func (w *NamespaceWatcher) Cursor() int64 {
+	return w.cursor.Load()
+}
+
 func (w *NamespaceWatcher) loop() error {
 	defer close(w.out)
 
@@ -87,13 +137,24 @@ func (w *NamespaceWatcher) loop() error {
 	if err != nil {
 		return errors.Annotatef(err, "subscribing to namespace %q", w.namespace)
 	}
-	defer subscription.Unsubscribe()
 
 	changes, err := w.getInitialState()
 	if err != nil {
+		subscription.Unsubscribe()
 		return errors.Annotatef(
 			err, "retrieving initial watcher state for namespace %q", w.namespace)
 	}
+	return w.run(subscription, changes, 0)
+}
+
+// run dispatches the initial changes, followed by those received from the
+// subscription. The cursor is the change log id of the last change already
+// received, or zero if there is none.
+func (w *NamespaceWatcher) run(subscription changestream.Subscription, changes []string, cursor int64) error {
+	defer func() {
+		subscription.Unsubscribe()
+	}()
+	opt := changestream.Namespace(w.namespace, w.changeMask)
 
 	// By reassigning the in and out channels, we effectively ticktock between
 	// read mode and dispatch mode. This ensures we always dispatch deltas that
@@ -111,17 +172,41 @@ func (w *NamespaceWatcher) loop() error {
 	// Cache the context so we don't have to call it on every iteration.
 	ctx := w.tomb.Context(context.Background())
 
+	// The cursor is the change log id of the last change received, from
+	// which the subscription can be resumed. It is only reported once the
+	// changes have been dispatched.
 	for {
 		select {
 		case <-w.tomb.Dying():
 			return tomb.ErrDying
 		case <-subscription.Done():
-			return ErrSubscriptionClosed
+			// The subscription is closed if the changes weren't received in
+			// time, typically because the consumer of this watcher was slow.
+			// Resume from the last change received, so that none are missed.
+			// If there isn't a change to resume from, or the changes have
+			// since been pruned, the watcher has to be restarted.
+			if cursor == 0 {
+				return ErrSubscriptionClosed
+			}
+			resumed, err := w.watchableDB.SubscribeFrom(cursor, opt)
+			if err != nil {
+				w.logger.Debugf("unable to resume subscription for %q from %d: %v", w.namespace, cursor, err)
+				return ErrSubscriptionClosed
+			}
+			subscription = resumed
+			if in != nil {
+				in = subscription.Changes()
+			}
 		case subChanges, ok := <-in:
 			if !ok {
 				w.logger.Debugf("change channel closed for %q; terminating watcher", w.namespace)
 				return nil
 			}
+			for _, change := range subChanges {
+				if id := change.ID(); id > cursor {
+					cursor = id
+				}
+			}
 
 			// Check with the predicate to determine if we should send a
 			// notification.
@@ -130,6 +215,7 @@ func (w *NamespaceWatcher) loop() error {
 				return errors.Trace(err)
 			}
 			if !allow {
+				w.cursor.Store(cursor)
 				continue
 			}
 
@@ -139,6 +225,7 @@ func (w *NamespaceWatcher) loop() error {
 			out = w.out
 		case out <- changes:
 			// We have dispatched. Tick over to read mode.
+			w.cursor.Store(cursor)
 			in = subscription.Changes()
 			out = nil
 		}
@@ -11,6 +11,7 @@ import (
 
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v3/workertest"
+	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/changestream"
@@ -372,6 +373,199 @@ func (s *namespaceSuite) TestSubscriptionDoneKillsWorker(c *gc.C) {
 	c.Check(err, jc.ErrorIs, ErrSubscriptionClosed)
 }
 
+func (s *namespaceSuite) TestSubscriptionResumedFromCursor(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	done := make(chan struct{})
+	deltas := make(chan []changestream.ChangeEvent)
+	s.sub.EXPECT().Done().Return(done).AnyTimes()
+	s.sub.EXPECT().Changes().Return(deltas).AnyTimes()
+
+	resumed := NewMockSubscription(ctrl)
+	resumedDeltas := make(chan []changestream.ChangeEvent)
+	resumed.EXPECT().Done().Return(make(chan struct{})).AnyTimes()
+	resumed.EXPECT().Changes().Return(resumedDeltas).AnyTimes()
+	resumed.EXPECT().Unsubscribe()
+
+	opt := subscriptionOptionMatcher{changestream.Namespace(
+		"external_controller",
+		changestream.Create|changestream.Update|changestream.Delete,
+	)}
+	s.eventsource.EXPECT().Subscribe(opt).Return(s.sub, nil)
+	s.eventsource.EXPECT().SubscribeFrom(int64(5), opt).Return(resumed, nil)
+
+	w := NewNamespaceWatcher(
+		s.newBaseWatcher(), "external_controller", changestream.All, "SELECT uuid FROM external_controller")
+	defer workertest.CleanKill(c, w)
+
+	select {
+	case changes := <-w.Changes():
+		c.Assert(changes, gc.HasLen, 0)
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for initial watcher changes")
+	}
+
+	select {
+	case deltas <- []changestream.ChangeEvent{changeEvent{
+		id:        5,
+		namespace: "external_controller",
+		changed:   "some-ec-uuid",
+	}}:
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out dispatching change event")
+	}
+	select {
+	case changes := <-w.Changes():
+		c.Check(changes, gc.DeepEquals, []string{"some-ec-uuid"})
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for watcher delta")
+	}
+
+	// The subscription is closed, so it is resumed from the last change
+	// that was received, and the watcher carries on.
+	close(done)
+
+	select {
+	case resumedDeltas <- []changestream.ChangeEvent{changeEvent{
+		id:        6,
+		namespace: "external_controller",
+		changed:   "other-ec-uuid",
+	}}:
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out dispatching resumed change event")
+	}
+	select {
+	case changes := <-w.Changes():
+		c.Check(changes, gc.DeepEquals, []string{"other-ec-uuid"})
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for resumed watcher delta")
+	}
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *namespaceSuite) TestSubscriptionNotResumedAfterGap(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.logger.EXPECT().Debugf(gomock.Any(), gomock.Any()).AnyTimes()
+
+	done := make(chan struct{})
+	deltas := make(chan []changestream.ChangeEvent)
+	s.sub.EXPECT().Done().Return(done).AnyTimes()
+	s.sub.EXPECT().Changes().Return(deltas).AnyTimes()
+	s.sub.EXPECT().Unsubscribe()
+
+	opt := subscriptionOptionMatcher{changestream.Namespace(
+		"external_controller",
+		changestream.Create|changestream.Update|changestream.Delete,
+	)}
+	s.eventsource.EXPECT().Subscribe(opt).Return(s.sub, nil)
+	s.eventsource.EXPECT().SubscribeFrom(int64(5), opt).Return(nil, changestream.ErrChangeLogGap)
+
+	w := NewNamespaceWatcher(
+		s.newBaseWatcher(), "external_controller", changestream.All, "SELECT uuid FROM external_controller")
+	defer workertest.DirtyKill(c, w)
+
+	select {
+	case <-w.Changes():
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for initial watcher changes")
+	}
+	select {
+	case deltas <- []changestream.ChangeEvent{changeEvent{
+		id:        5,
+		namespace: "external_controller",
+		changed:   "some-ec-uuid",
+	}}:
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out dispatching change event")
+	}
+	select {
+	case <-w.Changes():
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for watcher delta")
+	}
+
+	// The changes since the cursor have been pruned, so the watcher must be
+	// restarted to pick up the current state.
+	close(done)
+
+	err := workertest.CheckKilled(c, w)
+	c.Check(err, jc.ErrorIs, ErrSubscriptionClosed)
+}
+
+func (s *namespaceSuite) TestNamespaceWatcherFrom(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	deltas := make(chan []changestream.ChangeEvent)
+	s.sub.EXPECT().Done().Return(make(chan struct{})).AnyTimes()
+	s.sub.EXPECT().Changes().Return(deltas).AnyTimes()
+	s.sub.EXPECT().Unsubscribe()
+
+	opt := subscriptionOptionMatcher{changestream.Namespace(
+		"external_controller",
+		changestream.Create|changestream.Update|changestream.Delete,
+	)}
+	s.eventsource.EXPECT().SubscribeFrom(int64(5), opt).Return(s.sub, nil)
+
+	w, err := NewNamespaceWatcherFrom(s.newBaseWatcher(), 5, "external_controller", changestream.All)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+	c.Check(w.(*NamespaceWatcher).Cursor(), gc.Equals, int64(5))
+
+	// The initial event is empty, and the changes after the cursor follow.
+	select {
+	case changes := <-w.Changes():
+		c.Check(changes, gc.HasLen, 0)
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for initial watcher changes")
+	}
+	select {
+	case deltas <- []changestream.ChangeEvent{changeEvent{
+		id:        6,
+		namespace: "external_controller",
+		changed:   "some-ec-uuid",
+	}}:
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out dispatching change event")
+	}
+	select {
+	case changes := <-w.Changes():
+		c.Check(changes, gc.DeepEquals, []string{"some-ec-uuid"})
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for watcher delta")
+	}
+
+	// The cursor moves on once the change is dispatched.
+	for a := testing.LongAttempt.Start(); a.Next(); {
+		if w.(*NamespaceWatcher).Cursor() == 6 {
+			break
+		}
+		if !a.HasNext() {
+			c.Fatalf("cursor not updated, got %d", w.(*NamespaceWatcher).Cursor())
+		}
+	}
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *namespaceSuite) TestNamespaceWatcherFromGap(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	opt := subscriptionOptionMatcher{changestream.Namespace(
+		"external_controller",
+		changestream.Create|changestream.Update|changestream.Delete,
+	)}
+	s.eventsource.EXPECT().SubscribeFrom(int64(5), opt).Return(nil, changestream.ErrChangeLogGap)
+
+	_, err := NewNamespaceWatcherFrom(s.newBaseWatcher(), 5, "external_controller", changestream.All)
+	c.Check(err, jc.ErrorIs, changestream.ErrChangeLogGap)
+
+	_, err = NewNamespaceWatcherFrom(s.newBaseWatcher(), 0, "external_controller", changestream.All)
+	c.Check(err, gc.ErrorMatches, "cursor 0 not valid")
+}
+
 func (s *namespaceSuite) TestInvalidChangeMask(c *gc.C) {
 	w := NewNamespaceWatcher(s.newBaseWatcher(), "external_controller", 0, "SELECT uuid FROM external_controller")
 	defer workertest.DirtyKill(c, w)
@@ -103,11 +103,16 @@ func (m subscriptionOptionMatcher) String() string {
 }
 
 type changeEvent struct {
+	id         int64
 	changeType changestream.ChangeType
 	namespace  string
 	changed    string
 }
 
+func (e changeEvent) ID() int64 {
+	return e.id
+}
+
 func (e changeEvent) Type() changestream.ChangeType {
 	return e.changeType
 }
@@ -0,0 +1,25 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import (
+	"github.com/juju/errors"
+)
+
+const (
+	// ErrNotFound is returned when the metadata for a path is not found.
+	ErrNotFound = errors.ConstError("object store metadata not found")
+
+	// ErrPathAlreadyExists is returned when a path is already associated
+	// with an object that has a different hash.
+	ErrPathAlreadyExists = errors.ConstError("path already exists with a different hash")
+
+	// ErrRemovalPending is returned when an object is being removed, so it
+	// can't be referenced by a new path, nor claimed for removal again.
+	ErrRemovalPending = errors.ConstError("object removal pending")
+
+	// ErrObjectReferenced is returned when claiming the removal of an object
+	// that is still referenced by a path.
+	ErrObjectReferenced = errors.ConstError("object still referenced")
+)
@@ -0,0 +1,167 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/watcher"
+)
+
+// removalClaimDuration is how long a claim on the removal of an object is
+// held for. Removing an object from the backing store is a single request,
+// so the claim only needs to outlive a slow request; it expires so that a
+// controller going away mid-removal doesn't block the object forever.
+const removalClaimDuration = time.Minute
+
+// State describes retrieval and persistence methods for the object store
+// metadata.
+type State interface {
+	// GetMetadata returns the persistence metadata for the specified path.
+	GetMetadata(ctx context.Context, path string) (objectstore.Metadata, error)
+	// ListMetadata returns the persistence metadata for all paths.
+	ListMetadata(ctx context.Context) ([]objectstore.Metadata, error)
+	// PutMetadata adds a new specified path for the persistence metadata.
+	PutMetadata(ctx context.Context, metadata objectstore.Metadata) error
+	// ReplaceMetadata adds a new specified path for the persistence
+	// metadata, replacing any existing metadata for the path. The hash of
+	// the replaced object is returned.
+	ReplaceMetadata(ctx context.Context, metadata objectstore.Metadata) (string, error)
+	// RemoveMetadata removes the specified path for the persistence metadata.
+	RemoveMetadata(ctx context.Context, path string) error
+	// ObjectReferenced returns whether any path refers to the object with
+	// the specified hash.
+	ObjectReferenced(ctx context.Context, hash string) (bool, error)
+	// ClaimRemoval claims the removal of the object with the specified hash
+	// from the backing store, for the specified duration.
+	ClaimRemoval(ctx context.Context, hash string, duration time.Duration) error
+	// ReleaseRemoval releases the claim on the removal of the object with
+	// the specified hash.
+	ReleaseRemoval(ctx context.Context, hash string) error
+	// InitialWatchStatement returns the table and the initial watch statement
+	// for the persistence metadata.
+	InitialWatchStatement() (string, string)
+}
+
+// WatcherFactory describes methods for creating watchers.
+type WatcherFactory interface {
+	// NewNamespaceWatcher returns a new namespace watcher
+	// for events based on the input change mask.
+	NewNamespaceWatcher(string, changestream.ChangeType, string) (watcher.StringsWatcher, error)
+}
+
+// Service provides the API for working with the object store metadata.
+type Service struct {
+	st             State
+	watcherFactory WatcherFactory
+}
+
+// NewService returns a new service reference wrapping the input state.
+func NewService(st State, watcherFactory WatcherFactory) *Service {
+	return &Service{
+		st:             st,
+		watcherFactory: watcherFactory,
+	}
+}
+
+// GetMetadata returns the persistence metadata for the specified path.
+func (s *Service) GetMetadata(ctx context.Context, path string) (objectstore.Metadata, error) {
+	metadata, err := s.st.GetMetadata(ctx, path)
+	if err != nil {
+		return objectstore.Metadata{}, errors.Annotatef(err, "retrieving metadata %s", path)
+	}
+	return metadata, nil
+}
+
+// ListMetadata returns the persistence metadata for all paths.
+func (s *Service) ListMetadata(ctx context.Context) ([]objectstore.Metadata, error) {
+	metadata, err := s.st.ListMetadata(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "retrieving metadata")
+	}
+	return metadata, nil
+}
+
+// PutMetadata adds a new specified path for the persistence metadata.
+// Objects with the same hash are only recorded once.
+func (s *Service) PutMetadata(ctx context.Context, metadata objectstore.Metadata) error {
+	if err := validateMetadata(metadata); err != nil {
+		return errors.Trace(err)
+	}
+	err := s.st.PutMetadata(ctx, metadata)
+	return errors.Annotatef(err, "adding metadata %s", metadata.Path)
+}
+
+// ReplaceMetadata adds a new specified path for the persistence metadata,
+// atomically replacing any existing metadata for the path. The hash of the
+// replaced object is returned, so that the caller can remove the object
+// once nothing refers to it. An empty hash is returned if the path didn't
+// refer to a different object.
+func (s *Service) ReplaceMetadata(ctx context.Context, metadata objectstore.Metadata) (string, error) {
+	if err := validateMetadata(metadata); err != nil {
+		return "", errors.Trace(err)
+	}
+	replaced, err := s.st.ReplaceMetadata(ctx, metadata)
+	if err != nil {
+		return "", errors.Annotatef(err, "replacing metadata %s", metadata.Path)
+	}
+	return replaced, nil
+}
+
+func validateMetadata(metadata objectstore.Metadata) error {
+	if metadata.Path == "" {
+		return errors.NotValidf("empty path")
+	}
+	if metadata.Hash == "" {
+		return errors.NotValidf("empty hash")
+	}
+	if metadata.Size < 0 {
+		return errors.NotValidf("negative size")
+	}
+	return nil
+}
+
+// RemoveMetadata removes the specified path for the persistence metadata.
+func (s *Service) RemoveMetadata(ctx context.Context, path string) error {
+	err := s.st.RemoveMetadata(ctx, path)
+	return errors.Annotatef(err, "removing metadata %s", path)
+}
+
+// ObjectReferenced returns whether any path refers to the object with the
+// specified hash.
+func (s *Service) ObjectReferenced(ctx context.Context, hash string) (bool, error) {
+	referenced, err := s.st.ObjectReferenced(ctx, hash)
+	if err != nil {
+		return false, errors.Annotatef(err, "checking references to %s", hash)
+	}
+	return referenced, nil
+}
+
+// ClaimRemoval claims the removal of the object with the specified hash
+// from the backing store. Whilst the claim is held, no new path can refer to
+// the object. ErrObjectReferenced is returned if a path still refers to the
+// object, and ErrRemovalPending if another removal is already in progress.
+func (s *Service) ClaimRemoval(ctx context.Context, hash string) error {
+	err := s.st.ClaimRemoval(ctx, hash, removalClaimDuration)
+	return errors.Annotatef(err, "claiming removal of %s", hash)
+}
+
+// ReleaseRemoval releases the claim on the removal of the object with the
+// specified hash, once it has been removed from the backing store.
+func (s *Service) ReleaseRemoval(ctx context.Context, hash string) error {
+	err := s.st.ReleaseRemoval(ctx, hash)
+	return errors.Annotatef(err, "releasing removal of %s", hash)
+}
+
+// Watch returns a watcher that emits the path for changes to the
+// persistence metadata.
+func (s *Service) Watch() (watcher.StringsWatcher, error) {
+	table, stmt := s.st.InitialWatchStatement()
+	return s.watcherFactory.NewNamespaceWatcher(table, changestream.All, stmt)
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+)
+
+type serviceSuite struct {
+	testing.IsolationSuite
+
+	state          *MockState
+	watcherFactory *MockWatcherFactory
+	stringsWatcher *MockStringsWatcher
+}
+
+var _ = gc.Suite(&serviceSuite{})
+
+func (s *serviceSuite) TestGetMetadata(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metadata := objectstore.Metadata{
+		Hash: "hash",
+		Path: "path",
+		Size: 666,
+	}
+	s.state.EXPECT().GetMetadata(gomock.Any(), "path").Return(metadata, nil)
+
+	result, err := NewService(s.state, s.watcherFactory).GetMetadata(context.Background(), "path")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, metadata)
+}
+
+func (s *serviceSuite) TestGetMetadataNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetMetadata(gomock.Any(), "path").Return(objectstore.Metadata{}, objectstoreerrors.ErrNotFound)
+
+	_, err := NewService(s.state, s.watcherFactory).GetMetadata(context.Background(), "path")
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *serviceSuite) TestListMetadata(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metadata := []objectstore.Metadata{{
+		Hash: "hash",
+		Path: "path",
+		Size: 666,
+	}}
+	s.state.EXPECT().ListMetadata(gomock.Any()).Return(metadata, nil)
+
+	result, err := NewService(s.state, s.watcherFactory).ListMetadata(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, metadata)
+}
+
+func (s *serviceSuite) TestPutMetadata(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metadata := objectstore.Metadata{
+		Hash: "hash",
+		Path: "path",
+		Size: 666,
+	}
+	s.state.EXPECT().PutMetadata(gomock.Any(), metadata).Return(nil)
+
+	err := NewService(s.state, s.watcherFactory).PutMetadata(context.Background(), metadata)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestPutMetadataInvalid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	service := NewService(s.state, s.watcherFactory)
+
+	err := service.PutMetadata(context.Background(), objectstore.Metadata{Hash: "hash"})
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+
+	err = service.PutMetadata(context.Background(), objectstore.Metadata{Path: "path"})
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+
+	err = service.PutMetadata(context.Background(), objectstore.Metadata{Hash: "hash", Path: "path", Size: -1})
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestReplaceMetadata(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metadata := objectstore.Metadata{
+		Hash: "hash",
+		Path: "path",
+		Size: 666,
+	}
+	s.state.EXPECT().ReplaceMetadata(gomock.Any(), metadata).Return("other", nil)
+
+	replaced, err := NewService(s.state, s.watcherFactory).ReplaceMetadata(context.Background(), metadata)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(replaced, gc.Equals, "other")
+}
+
+func (s *serviceSuite) TestReplaceMetadataInvalid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewService(s.state, s.watcherFactory).ReplaceMetadata(context.Background(), objectstore.Metadata{Hash: "hash"})
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestRemoveMetadata(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RemoveMetadata(gomock.Any(), "path").Return(nil)
+
+	err := NewService(s.state, s.watcherFactory).RemoveMetadata(context.Background(), "path")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestObjectReferenced(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ObjectReferenced(gomock.Any(), "hash").Return(true, nil)
+
+	referenced, err := NewService(s.state, s.watcherFactory).ObjectReferenced(context.Background(), "hash")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(referenced, jc.IsTrue)
+}
+
+func (s *serviceSuite) TestClaimRemoval(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ClaimRemoval(gomock.Any(), "hash", removalClaimDuration).Return(nil)
+
+	err := NewService(s.state, s.watcherFactory).ClaimRemoval(context.Background(), "hash")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestReleaseRemoval(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ReleaseRemoval(gomock.Any(), "hash").Return(nil)
+
+	err := NewService(s.state, s.watcherFactory).ReleaseRemoval(context.Background(), "hash")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestWatch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	table := "object_store_metadata_path"
+	stmt := "SELECT path FROM object_store_metadata_path"
+	s.state.EXPECT().InitialWatchStatement().Return(table, stmt)
+	s.watcherFactory.EXPECT().NewNamespaceWatcher(table, changestream.All, stmt).Return(s.stringsWatcher, nil)
+
+	w, err := NewService(s.state, s.watcherFactory).Watch()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(w, gc.NotNil)
+}
+
+func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockState(ctrl)
+	s.watcherFactory = NewMockWatcherFactory(ctrl)
+	s.stringsWatcher = NewMockStringsWatcher(ctrl)
+
+	return ctrl
+}
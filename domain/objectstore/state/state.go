@@ -0,0 +1,465 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/errors"
+	"github.com/juju/utils/v3"
+
+	coreDB "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+)
+
+const (
+	// hashTypeSHA384 is the id of the sha-384 hash type in the
+	// object_store_metadata_hash_type table.
+	hashTypeSHA384 = 2
+)
+
+// selectRemovalQuery selects the unexpired removal claim for a hash.
+const selectRemovalQuery = `
+SELECT (hash) AS &dbRemoval.*
+FROM   object_store_metadata_removal
+WHERE  hash = $M.hash
+AND    expiry >= datetime('now')`
+
+// selectHashQuery selects the metadata record for a hash. A metadata record
+// only exists whilst a path refers to it.
+const selectHashQuery = `
+SELECT (uuid, hash) AS &dbMetadata.*
+FROM   object_store_metadata
+WHERE  hash = $M.hash`
+
+// deleteUnreferencedMetadataQuery deletes a metadata record, once no paths
+// refer to it.
+const deleteUnreferencedMetadataQuery = `
+DELETE FROM object_store_metadata
+WHERE  uuid = $M.uuid
+AND    uuid NOT IN (
+    SELECT metadata_uuid
+    FROM   object_store_metadata_path
+)`
+
+// State represents a type for interacting with the underlying state.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new State for interacting with the underlying state.
+func NewState(factory coreDB.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// GetMetadata returns the persistence metadata for the specified path.
+func (s *State) GetMetadata(ctx context.Context, path string) (objectstore.Metadata, error) {
+	db, err := s.DB()
+	if err != nil {
+		return objectstore.Metadata{}, errors.Trace(err)
+	}
+
+	query := `
+SELECT (m.uuid, m.hash, p.path, p.size) AS &dbMetadata.*
+FROM   object_store_metadata AS m
+       INNER JOIN object_store_metadata_path AS p
+       ON         m.uuid = p.metadata_uuid
+WHERE  p.path = $M.path`
+	stmt, err := sqlair.Prepare(query, dbMetadata{}, sqlair.M{})
+	if err != nil {
+		return objectstore.Metadata{}, errors.Annotatef(err, "preparing %q", query)
+	}
+
+	var metadata dbMetadata
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, sqlair.M{"path": path}).Get(&metadata)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return objectstoreerrors.ErrNotFound
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return objectstore.Metadata{}, errors.Annotatef(err, "retrieving metadata %s", path)
+	}
+	return metadata.ToCoreObjectStoreMetadata(), nil
+}
+
+// ListMetadata returns the persistence metadata for all paths.
+func (s *State) ListMetadata(ctx context.Context) ([]objectstore.Metadata, error) {
+	db, err := s.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	query := `
+SELECT (m.uuid, m.hash, p.path, p.size) AS &dbMetadata.*
+FROM   object_store_metadata AS m
+       INNER JOIN object_store_metadata_path AS p
+       ON         m.uuid = p.metadata_uuid
+ORDER BY p.path`
+	stmt, err := sqlair.Prepare(query, dbMetadata{})
+	if err != nil {
+		return nil, errors.Annotatef(err, "preparing %q", query)
+	}
+
+	var rows []dbMetadata
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "retrieving metadata")
+	}
+
+	result := make([]objectstore.Metadata, len(rows))
+	for i, row := range rows {
+		result[i] = row.ToCoreObjectStoreMetadata()
+	}
+	return result, nil
+}
+
+// PutMetadata adds a new specified path for the persistence metadata.
+// Objects with identical hashes share a single metadata record, so that
+// the same blob stored under multiple paths is only recorded once.
+// Putting the same path and hash again is a no-op. If the path is already
+// associated with a different hash, ErrPathAlreadyExists is returned. If the
+// object is being removed, ErrRemovalPending is returned.
+func (s *State) PutMetadata(ctx context.Context, metadata objectstore.Metadata) error {
+	_, err := s.putMetadata(ctx, metadata, false)
+	return errors.Annotatef(err, "adding metadata %s", metadata.Path)
+}
+
+// ReplaceMetadata adds a new specified path for the persistence metadata,
+// replacing any existing metadata for the path in the same transaction. The
+// hash of the replaced object is returned, or an empty string if the path
+// didn't refer to a different object. The metadata record for the replaced
+// object is removed once no paths refer to it. If the new object is being
+// removed, ErrRemovalPending is returned.
+func (s *State) ReplaceMetadata(ctx context.Context, metadata objectstore.Metadata) (string, error) {
+	replaced, err := s.putMetadata(ctx, metadata, true)
+	if err != nil {
+		return "", errors.Annotatef(err, "replacing metadata %s", metadata.Path)
+	}
+	return replaced, nil
+}
+
+// putMetadata adds the path for the persistence metadata. If the path is
+// already associated with a different hash, then it's either replaced,
+// returning the replaced hash, or ErrPathAlreadyExists is returned.
+func (s *State) putMetadata(ctx context.Context, metadata objectstore.Metadata, replace bool) (string, error) {
+	db, err := s.DB()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	metadataUUID, err := utils.NewUUID()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	pathUUID, err := utils.NewUUID()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	selectHashStmt, err := sqlair.Prepare(selectHashQuery, dbMetadata{}, sqlair.M{})
+	if err != nil {
+		return "", errors.Annotatef(err, "preparing %q", selectHashQuery)
+	}
+
+	selectPathQuery := `
+SELECT (m.uuid, m.hash, p.path, p.size) AS &dbMetadata.*
+FROM   object_store_metadata AS m
+       INNER JOIN object_store_metadata_path AS p
+       ON         m.uuid = p.metadata_uuid
+WHERE  p.path = $M.path`
+	selectPathStmt, err := sqlair.Prepare(selectPathQuery, dbMetadata{}, sqlair.M{})
+	if err != nil {
+		return "", errors.Annotatef(err, "preparing %q", selectPathQuery)
+	}
+
+	selectRemovalStmt, err := sqlair.Prepare(selectRemovalQuery, dbRemoval{}, sqlair.M{})
+	if err != nil {
+		return "", errors.Annotatef(err, "preparing %q", selectRemovalQuery)
+	}
+
+	insertMetadataQuery := `
+INSERT INTO object_store_metadata (uuid, hash_type_id, hash)
+VALUES ($M.uuid, $M.hash_type_id, $M.hash)`
+	insertMetadataStmt, err := sqlair.Prepare(insertMetadataQuery, sqlair.M{})
+	if err != nil {
+		return "", errors.Annotatef(err, "preparing %q", insertMetadataQuery)
+	}
+
+	deletePathQuery := `
+DELETE FROM object_store_metadata_path
+WHERE  path = $M.path`
+	deletePathStmt, err := sqlair.Prepare(deletePathQuery, sqlair.M{})
+	if err != nil {
+		return "", errors.Annotatef(err, "preparing %q", deletePathQuery)
+	}
+
+	deleteMetadataStmt, err := sqlair.Prepare(deleteUnreferencedMetadataQuery, sqlair.M{})
+	if err != nil {
+		return "", errors.Annotatef(err, "preparing %q", deleteUnreferencedMetadataQuery)
+	}
+
+	insertPathQuery := `
+INSERT INTO object_store_metadata_path (uuid, metadata_uuid, path, size)
+VALUES ($M.uuid, $M.metadata_uuid, $M.path, $M.size)`
+	insertPathStmt, err := sqlair.Prepare(insertPathQuery, sqlair.M{})
+	if err != nil {
+		return "", errors.Annotatef(err, "preparing %q", insertPathQuery)
+	}
+
+	var replaced string
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		// The transaction may be retried, so reset the result.
+		replaced = ""
+
+		var existingPath dbMetadata
+		err := tx.Query(ctx, selectPathStmt, sqlair.M{"path": metadata.Path}).Get(&existingPath)
+		if err == nil {
+			if existingPath.Hash == metadata.Hash {
+				return nil
+			} else if !replace {
+				return objectstoreerrors.ErrPathAlreadyExists
+			}
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Trace(err)
+		}
+
+		// The object may be in the process of being removed from the backing
+		// store, in which case it can't be referenced until that completes.
+		var removal dbRemoval
+		err = tx.Query(ctx, selectRemovalStmt, sqlair.M{"hash": metadata.Hash}).Get(&removal)
+		if err == nil {
+			return objectstoreerrors.ErrRemovalPending
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Trace(err)
+		}
+
+		if existingPath.UUID != "" {
+			if err := tx.Query(ctx, deletePathStmt, sqlair.M{"path": metadata.Path}).Run(); err != nil {
+				return errors.Annotate(err, "removing replaced metadata path")
+			}
+			err := tx.Query(ctx, deleteMetadataStmt, sqlair.M{"uuid": existingPath.UUID}).Run()
+			if err != nil {
+				return errors.Annotate(err, "removing replaced metadata")
+			}
+			replaced = existingPath.Hash
+		}
+
+		var existingHash dbMetadata
+		err = tx.Query(ctx, selectHashStmt, sqlair.M{"hash": metadata.Hash}).Get(&existingHash)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			err = tx.Query(ctx, insertMetadataStmt, sqlair.M{
+				"uuid":         metadataUUID.String(),
+				"hash_type_id": hashTypeSHA384,
+				"hash":         metadata.Hash,
+			}).Run()
+			if err != nil {
+				return errors.Annotate(err, "inserting metadata")
+			}
+			existingHash.UUID = metadataUUID.String()
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+
+		err = tx.Query(ctx, insertPathStmt, sqlair.M{
+			"uuid":          pathUUID.String(),
+			"metadata_uuid": existingHash.UUID,
+			"path":          metadata.Path,
+			"size":          metadata.Size,
+		}).Run()
+		return errors.Annotate(err, "inserting metadata path")
+	})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return replaced, nil
+}
+
+// RemoveMetadata removes the specified path for the persistence metadata.
+// The metadata record itself is removed once no paths refer to it.
+func (s *State) RemoveMetadata(ctx context.Context, path string) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	selectPathQuery := `
+SELECT (metadata_uuid) AS &dbMetadataPath.*
+FROM   object_store_metadata_path
+WHERE  path = $M.path`
+	selectPathStmt, err := sqlair.Prepare(selectPathQuery, dbMetadataPath{}, sqlair.M{})
+	if err != nil {
+		return errors.Annotatef(err, "preparing %q", selectPathQuery)
+	}
+
+	deletePathQuery := `
+DELETE FROM object_store_metadata_path
+WHERE  path = $M.path`
+	deletePathStmt, err := sqlair.Prepare(deletePathQuery, sqlair.M{})
+	if err != nil {
+		return errors.Annotatef(err, "preparing %q", deletePathQuery)
+	}
+
+	deleteMetadataStmt, err := sqlair.Prepare(deleteUnreferencedMetadataQuery, sqlair.M{})
+	if err != nil {
+		return errors.Annotatef(err, "preparing %q", deleteUnreferencedMetadataQuery)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var existing dbMetadataPath
+		err := tx.Query(ctx, selectPathStmt, sqlair.M{"path": path}).Get(&existing)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return objectstoreerrors.ErrNotFound
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+
+		if err := tx.Query(ctx, deletePathStmt, sqlair.M{"path": path}).Run(); err != nil {
+			return errors.Annotate(err, "removing metadata path")
+		}
+		err = tx.Query(ctx, deleteMetadataStmt, sqlair.M{"uuid": existing.MetadataUUID}).Run()
+		return errors.Annotate(err, "removing metadata")
+	})
+	return errors.Annotatef(err, "removing metadata %s", path)
+}
+
+// ObjectReferenced returns whether any path refers to the object with the
+// specified hash.
+func (s *State) ObjectReferenced(ctx context.Context, hash string) (bool, error) {
+	db, err := s.DB()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	selectHashStmt, err := sqlair.Prepare(selectHashQuery, dbMetadata{}, sqlair.M{})
+	if err != nil {
+		return false, errors.Annotatef(err, "preparing %q", selectHashQuery)
+	}
+
+	var referenced bool
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var existingHash dbMetadata
+		err := tx.Query(ctx, selectHashStmt, sqlair.M{"hash": hash}).Get(&existingHash)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			referenced = false
+			return nil
+		}
+		referenced = err == nil
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return false, errors.Annotatef(err, "checking references to %s", hash)
+	}
+	return referenced, nil
+}
+
+// ClaimRemoval claims the removal of the object with the specified hash
+// from the backing store, for the specified duration. Whilst the claim is
+// held, the object can't be referenced by a new path. If a path still refers
+// to the object, ErrObjectReferenced is returned; if another removal of the
+// object is already in progress, ErrRemovalPending is returned.
+func (s *State) ClaimRemoval(ctx context.Context, hash string, duration time.Duration) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	selectHashStmt, err := sqlair.Prepare(selectHashQuery, dbMetadata{}, sqlair.M{})
+	if err != nil {
+		return errors.Annotatef(err, "preparing %q", selectHashQuery)
+	}
+
+	selectRemovalStmt, err := sqlair.Prepare(selectRemovalQuery, dbRemoval{}, sqlair.M{})
+	if err != nil {
+		return errors.Annotatef(err, "preparing %q", selectRemovalQuery)
+	}
+
+	// Any expired claim is replaced by the new claim.
+	deleteRemovalQuery := `
+DELETE FROM object_store_metadata_removal
+WHERE  hash = $M.hash`
+	deleteRemovalStmt, err := sqlair.Prepare(deleteRemovalQuery, sqlair.M{})
+	if err != nil {
+		return errors.Annotatef(err, "preparing %q", deleteRemovalQuery)
+	}
+
+	insertRemovalQuery := `
+INSERT INTO object_store_metadata_removal (hash, expiry)
+VALUES ($M.hash, datetime('now', $M.duration))`
+	insertRemovalStmt, err := sqlair.Prepare(insertRemovalQuery, sqlair.M{})
+	if err != nil {
+		return errors.Annotatef(err, "preparing %q", insertRemovalQuery)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var existingHash dbMetadata
+		err := tx.Query(ctx, selectHashStmt, sqlair.M{"hash": hash}).Get(&existingHash)
+		if err == nil {
+			return objectstoreerrors.ErrObjectReferenced
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Trace(err)
+		}
+
+		var removal dbRemoval
+		err = tx.Query(ctx, selectRemovalStmt, sqlair.M{"hash": hash}).Get(&removal)
+		if err == nil {
+			return objectstoreerrors.ErrRemovalPending
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Trace(err)
+		}
+
+		if err := tx.Query(ctx, deleteRemovalStmt, sqlair.M{"hash": hash}).Run(); err != nil {
+			return errors.Annotate(err, "removing expired removal claim")
+		}
+		err = tx.Query(ctx, insertRemovalStmt, sqlair.M{
+			"hash":     hash,
+			"duration": fmt.Sprintf("+%d seconds", int64(math.Ceil(duration.Seconds()))),
+		}).Run()
+		return errors.Annotate(err, "inserting removal claim")
+	})
+	return errors.Annotatef(err, "claiming removal of %s", hash)
+}
+
+// ReleaseRemoval releases the claim on the removal of the object with the
+// specified hash, once the object has been removed from the backing store.
+func (s *State) ReleaseRemoval(ctx context.Context, hash string) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	deleteRemovalQuery := `
+DELETE FROM object_store_metadata_removal
+WHERE  hash = $M.hash`
+	deleteRemovalStmt, err := sqlair.Prepare(deleteRemovalQuery, sqlair.M{})
+	if err != nil {
+		return errors.Annotatef(err, "preparing %q", deleteRemovalQuery)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Trace(tx.Query(ctx, deleteRemovalStmt, sqlair.M{"hash": hash}).Run())
+	})
+	return errors.Annotatef(err, "releasing removal of %s", hash)
+}
+
+// InitialWatchStatement returns the table and the initial watch statement
+// for the persistence metadata.
+func (s *State) InitialWatchStatement() (string, string) {
+	return "object_store_metadata_path", "SELECT path FROM object_store_metadata_path"
+}
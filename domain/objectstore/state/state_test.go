@@ -0,0 +1,342 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type stateSuite struct {
+	schematesting.ModelSuite
+}
+
+var _ = gc.Suite(&stateSuite{})
+
+func (s *stateSuite) TestGetMetadataNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.GetMetadata(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *stateSuite) TestPutMetadata(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	metadata := objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	}
+
+	err := st.PutMetadata(context.Background(), metadata)
+	c.Assert(err, jc.ErrorIsNil)
+
+	received, err := st.GetMetadata(context.Background(), "blah-foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(received, gc.DeepEquals, metadata)
+}
+
+func (s *stateSuite) TestPutMetadataIdempotent(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	metadata := objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	}
+
+	err := st.PutMetadata(context.Background(), metadata)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.PutMetadata(context.Background(), metadata)
+	c.Assert(err, jc.ErrorIsNil)
+
+	received, err := st.ListMetadata(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(received, gc.DeepEquals, []objectstore.Metadata{metadata})
+}
+
+func (s *stateSuite) TestPutMetadataDifferentHash(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.PutMetadata(context.Background(), objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.PutMetadata(context.Background(), objectstore.Metadata{
+		Hash: "other",
+		Path: "blah-foo",
+		Size: 42,
+	})
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrPathAlreadyExists)
+}
+
+func (s *stateSuite) TestReplaceMetadata(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.PutMetadata(context.Background(), objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	metadata := objectstore.Metadata{
+		Hash: "other",
+		Path: "blah-foo",
+		Size: 42,
+	}
+	replaced, err := st.ReplaceMetadata(context.Background(), metadata)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(replaced, gc.Equals, "hash")
+
+	received, err := st.ListMetadata(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(received, gc.DeepEquals, []objectstore.Metadata{metadata})
+
+	// The metadata record for the replaced object is no longer referenced.
+	var count int
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM object_store_metadata")
+	c.Assert(row.Scan(&count), jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 1)
+}
+
+func (s *stateSuite) TestReplaceMetadataSharedHash(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	for _, path := range []string{"blah-foo", "blah-bar"} {
+		err := st.PutMetadata(context.Background(), objectstore.Metadata{
+			Hash: "hash",
+			Path: path,
+			Size: 666,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	replaced, err := st.ReplaceMetadata(context.Background(), objectstore.Metadata{
+		Hash: "other",
+		Path: "blah-foo",
+		Size: 42,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(replaced, gc.Equals, "hash")
+
+	// The replaced object is still referenced by the other path.
+	var count int
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM object_store_metadata")
+	c.Assert(row.Scan(&count), jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 2)
+}
+
+func (s *stateSuite) TestReplaceMetadataSameHash(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	metadata := objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	}
+	err := st.PutMetadata(context.Background(), metadata)
+	c.Assert(err, jc.ErrorIsNil)
+
+	replaced, err := st.ReplaceMetadata(context.Background(), metadata)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(replaced, gc.Equals, "")
+}
+
+func (s *stateSuite) TestReplaceMetadataNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	metadata := objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	}
+	replaced, err := st.ReplaceMetadata(context.Background(), metadata)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(replaced, gc.Equals, "")
+
+	received, err := st.GetMetadata(context.Background(), "blah-foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(received, gc.DeepEquals, metadata)
+}
+
+func (s *stateSuite) TestPutMetadataDeduplicatesHash(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	metadata1 := objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	}
+	metadata2 := objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-bar",
+		Size: 666,
+	}
+
+	err := st.PutMetadata(context.Background(), metadata1)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.PutMetadata(context.Background(), metadata2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	var count int
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM object_store_metadata")
+	c.Assert(row.Scan(&count), jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 1)
+
+	received, err := st.ListMetadata(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(received, gc.DeepEquals, []objectstore.Metadata{metadata2, metadata1})
+}
+
+func (s *stateSuite) TestRemoveMetadata(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	metadata1 := objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	}
+	metadata2 := objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-bar",
+		Size: 666,
+	}
+
+	err := st.PutMetadata(context.Background(), metadata1)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.PutMetadata(context.Background(), metadata2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Removing one path keeps the shared metadata around.
+	err = st.RemoveMetadata(context.Background(), "blah-foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = st.GetMetadata(context.Background(), "blah-foo")
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+
+	received, err := st.GetMetadata(context.Background(), "blah-bar")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(received, gc.DeepEquals, metadata2)
+
+	// Removing the last path removes the metadata.
+	err = st.RemoveMetadata(context.Background(), "blah-bar")
+	c.Assert(err, jc.ErrorIsNil)
+
+	var count int
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM object_store_metadata")
+	c.Assert(row.Scan(&count), jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 0)
+}
+
+func (s *stateSuite) TestRemoveMetadataNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.RemoveMetadata(context.Background(), "blah-foo")
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *stateSuite) TestClaimRemoval(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.ClaimRemoval(context.Background(), "hash", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The object can't be referenced, nor claimed again, whilst it's being
+	// removed.
+	err = st.PutMetadata(context.Background(), objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	})
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrRemovalPending)
+	err = st.ClaimRemoval(context.Background(), "hash", time.Minute)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrRemovalPending)
+
+	err = st.ReleaseRemoval(context.Background(), "hash")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.PutMetadata(context.Background(), objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestObjectReferenced(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	referenced, err := st.ObjectReferenced(context.Background(), "hash")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(referenced, jc.IsFalse)
+
+	err = st.PutMetadata(context.Background(), objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	referenced, err = st.ObjectReferenced(context.Background(), "hash")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(referenced, jc.IsTrue)
+
+	err = st.RemoveMetadata(context.Background(), "blah-foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	referenced, err = st.ObjectReferenced(context.Background(), "hash")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(referenced, jc.IsFalse)
+}
+
+func (s *stateSuite) TestClaimRemovalReferenced(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.PutMetadata(context.Background(), objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.ClaimRemoval(context.Background(), "hash", time.Minute)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrObjectReferenced)
+}
+
+func (s *stateSuite) TestClaimRemovalExpired(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.ClaimRemoval(context.Background(), "hash", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.DB().Exec("UPDATE object_store_metadata_removal SET expiry = datetime('now', '-1 seconds')")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// An expired claim no longer prevents the object from being referenced
+	// or claimed again.
+	err = st.ClaimRemoval(context.Background(), "hash", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.DB().Exec("UPDATE object_store_metadata_removal SET expiry = datetime('now', '-1 seconds')")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.PutMetadata(context.Background(), objectstore.Metadata{
+		Hash: "hash",
+		Path: "blah-foo",
+		Size: 666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,43 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import "github.com/juju/juju/core/objectstore"
+
+// dbMetadata represents a single row from the object_store_metadata table
+// joined with the object_store_metadata_path table.
+type dbMetadata struct {
+	// UUID is the uuid of the metadata row.
+	UUID string `db:"uuid"`
+	// Hash is the hash of the object.
+	Hash string `db:"hash"`
+	// Path is the path of the object.
+	Path string `db:"path"`
+	// Size is the size of the object.
+	Size int64 `db:"size"`
+}
+
+// ToCoreObjectStoreMetadata converts the dbMetadata to a
+// core objectstore.Metadata.
+func (m dbMetadata) ToCoreObjectStoreMetadata() objectstore.Metadata {
+	return objectstore.Metadata{
+		Hash: m.Hash,
+		Path: m.Path,
+		Size: m.Size,
+	}
+}
+
+// dbMetadataPath represents a single row from the
+// object_store_metadata_path table.
+type dbMetadataPath struct {
+	// MetadataUUID is the uuid of the metadata row the path refers to.
+	MetadataUUID string `db:"metadata_uuid"`
+}
+
+// dbRemoval represents a single row from the object_store_metadata_removal
+// table.
+type dbRemoval struct {
+	// Hash is the hash of the object being removed.
+	Hash string `db:"hash"`
+}
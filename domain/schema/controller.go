@@ -20,6 +20,7 @@ const (
 	tableCloudCredential
 	tableAutocertCache
 	tableUpgradeInfoControllerNode
+	tableObjectStoreMetadataPath
 )
 
 // ControllerDDL is used to create the controller database schema at bootstrap.
@@ -47,7 +48,11 @@ func ControllerDDL() *schema.Schema {
 		changeLogTriggersForTable("upgrade_info_controller_node", "upgrade_info_uuid", tableUpgradeInfoControllerNode),
 		autocertCacheSchema,
 		objectStoreMetadataSchema,
+		changeLogTriggersForTable("object_store_metadata_path", "path", tableObjectStoreMetadataPath),
 		userSchema,
+		userGroupSchema,
+		userAPITokenSchema,
+		userRoleSchema,
 	}
 
 	schema := schema.New()
@@ -123,7 +128,8 @@ INSERT INTO change_log_namespace VALUES
     (7, 'cloud', 'cloud changes based on the UUID'),
     (8, 'cloud_credential', 'cloud credential changes based on the UUID'),
     (9, 'autocert_cache', 'autocert cache changes based on the UUID'),
-    (10, 'upgrade_info_controller_node', 'upgrade info controller node changes based on the UUID')
+    (10, 'upgrade_info_controller_node', 'upgrade info controller node changes based on the UUID'),
+    (11, 'object_store_metadata_path', 'object store metadata path changes based on the path')
 `)
 }
 
@@ -577,3 +583,117 @@ CREATE TABLE user_activation_key (
     REFERENCES      user_authentication(user_uuid)
 );`)
 }
+
+func userGroupSchema() schema.Patch {
+	return schema.MakePatch(`
+CREATE TABLE user_group (
+    uuid            TEXT PRIMARY KEY,
+    name            TEXT NOT NULL,
+    created_by      TEXT NOT NULL,
+    created_at      TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_user_group_name ON user_group (name);
+
+-- Members are recorded by user name rather than by reference to the user
+-- table, so that external users can be added to groups. A removed user's
+-- memberships are deleted along with them.
+CREATE TABLE user_group_member (
+    group_uuid      TEXT NOT NULL,
+    user_name       TEXT NOT NULL,
+    CONSTRAINT      fk_user_group_member_user_group
+        FOREIGN KEY (group_uuid)
+    REFERENCES      user_group(uuid),
+    PRIMARY KEY (group_uuid, user_name)
+);
+
+CREATE INDEX idx_user_group_member_user_name ON user_group_member (user_name);
+
+CREATE TABLE permission_object_type (
+    id   INT PRIMARY KEY,
+    type TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_permission_object_type_type
+ON permission_object_type (type);
+
+INSERT INTO permission_object_type VALUES
+    (0, 'cloud'),
+    (1, 'controller'),
+    (2, 'model'),
+    (3, 'offer');
+
+CREATE TABLE user_group_permission (
+    group_uuid      TEXT NOT NULL,
+    object_type_id  INT NOT NULL,
+    grant_on        TEXT NOT NULL,
+    access          TEXT NOT NULL,
+    CONSTRAINT      fk_user_group_permission_user_group
+        FOREIGN KEY (group_uuid)
+    REFERENCES      user_group(uuid),
+    CONSTRAINT      fk_user_group_permission_object_type
+        FOREIGN KEY (object_type_id)
+    REFERENCES      permission_object_type(id),
+    PRIMARY KEY (group_uuid, object_type_id, grant_on)
+);`)
+}
+
+func userAPITokenSchema() schema.Patch {
+	return schema.MakePatch(`
+-- API tokens are recorded by user name, as for group members, so that
+-- tokens can be minted for users that are not in the user table.
+CREATE TABLE user_api_token (
+    uuid            TEXT PRIMARY KEY,
+    user_name       TEXT NOT NULL,
+    name            TEXT NOT NULL,
+    token_hash      TEXT NOT NULL,
+    model_uuid      TEXT,
+    max_access      TEXT NOT NULL,
+    created_at      TIMESTAMP NOT NULL,
+    expires_at      TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_user_api_token_user_name_name
+ON user_api_token (user_name, name);
+
+CREATE UNIQUE INDEX idx_user_api_token_token_hash
+ON user_api_token (token_hash);`)
+}
+
+func userRoleSchema() schema.Patch {
+	return schema.MakePatch(`
+CREATE TABLE user_role (
+    uuid            TEXT PRIMARY KEY,
+    name            TEXT NOT NULL,
+    created_by      TEXT NOT NULL,
+    created_at      TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_user_role_name ON user_role (name);
+
+-- A method of '*' allows every method on the facade.
+CREATE TABLE user_role_method (
+    role_uuid       TEXT NOT NULL,
+    facade          TEXT NOT NULL,
+    method          TEXT NOT NULL,
+    CONSTRAINT      fk_user_role_method_user_role
+        FOREIGN KEY (role_uuid)
+    REFERENCES      user_role(uuid),
+    PRIMARY KEY (role_uuid, facade, method)
+);
+
+-- Roles are granted by user name, as for group members, and are always
+-- granted on a model.
+CREATE TABLE user_role_grant (
+    role_uuid       TEXT NOT NULL,
+    user_name       TEXT NOT NULL,
+    model_uuid      TEXT NOT NULL,
+    CONSTRAINT      fk_user_role_grant_user_role
+        FOREIGN KEY (role_uuid)
+    REFERENCES      user_role(uuid),
+    PRIMARY KEY (role_uuid, user_name, model_uuid)
+);
+
+CREATE INDEX idx_user_role_grant_user_name_model_uuid
+ON user_role_grant (user_name, model_uuid);`)
+}
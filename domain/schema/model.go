@@ -9,6 +9,15 @@ import (
 
 const (
 	tableModelConfig tableNamespaceID = iota + 1
+	tableModelObjectStoreMetadataPath
+	tableProviderSpace
+	tableSpace
+	tableApplication
+	tableNetNode
+	tableMachine
+	tableCloudService
+	tableCloudContainer
+	tableUnit
 )
 
 // ModelDDL is used to create model databases.
@@ -19,10 +28,19 @@ func ModelDDL() *schema.Schema {
 		modelConfig,
 		changeLogTriggersForTable("model_config", "key", tableModelConfig),
 		spacesSchema,
+		changeLogTriggersForTable("provider_spaces", "uuid", tableProviderSpace),
+		changeLogTriggersForTable("spaces", "uuid", tableSpace),
 		objectStoreMetadataSchema,
+		changeLogTriggersForTable("object_store_metadata_path", "path", tableModelObjectStoreMetadataPath),
 		applicationSchema,
+		changeLogTriggersForTable("application", "uuid", tableApplication),
 		nodeSchema,
+		changeLogTriggersForTable("net_node", "uuid", tableNetNode),
+		changeLogTriggersForTable("machine", "uuid", tableMachine),
+		changeLogTriggersForTable("cloud_service", "uuid", tableCloudService),
+		changeLogTriggersForTable("cloud_container", "uuid", tableCloudContainer),
 		unitSchema,
+		changeLogTriggersForTable("unit", "uuid", tableUnit),
 	}
 
 	schema := schema.New()
@@ -37,7 +55,16 @@ func changeLogModelNamespace() schema.Patch {
 	// constants above.
 	return schema.MakePatch(`
 INSERT INTO change_log_namespace VALUES
-    (1, 'model_config', 'model config changes based on config key')
+    (1, 'model_config', 'model config changes based on config key'),
+    (2, 'object_store_metadata_path', 'object store metadata path changes based on the path'),
+    (3, 'provider_spaces', 'provider space changes based on the UUID'),
+    (4, 'spaces', 'space changes based on the UUID'),
+    (5, 'application', 'application changes based on the UUID'),
+    (6, 'net_node', 'net node changes based on the UUID'),
+    (7, 'machine', 'machine changes based on the UUID'),
+    (8, 'cloud_service', 'cloud service changes based on the UUID'),
+    (9, 'cloud_container', 'cloud container changes based on the UUID'),
+    (10, 'unit', 'unit changes based on the UUID')
 `)
 }
 
@@ -19,7 +19,8 @@ ON object_store_metadata_hash_type (hash_type);
 
 INSERT INTO object_store_metadata_hash_type VALUES
     (0, 'none'),
-    (1, 'sha-256');
+    (1, 'sha-256'),
+    (2, 'sha-384');
 
 CREATE TABLE object_store_metadata (
     uuid            TEXT PRIMARY KEY,
@@ -44,5 +45,14 @@ CREATE TABLE object_store_metadata_path (
 
 CREATE UNIQUE INDEX idx_object_store_metadata_path ON object_store_metadata_path (path);
 
+-- object_store_metadata_removal records the objects that are being removed
+-- from the backing store, so that they aren't referenced by a new path until
+-- the removal has completed. The expiry ensures that a removal that never
+-- completes doesn't block the object forever.
+CREATE TABLE object_store_metadata_removal (
+    hash            TEXT PRIMARY KEY,
+    expiry          TIMESTAMP NOT NULL
+);
+
 `)
 }
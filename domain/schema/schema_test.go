@@ -92,12 +92,27 @@ func (s *schemaSuite) TestControllerDDLApply(c *gc.C) {
 		"object_store_metadata",
 		"object_store_metadata_path",
 		"object_store_metadata_hash_type",
+		"object_store_metadata_removal",
 
 		// Users
 		"user",
 		"user_authentication",
 		"user_password",
 		"user_activation_key",
+
+		// User groups
+		"user_group",
+		"user_group_member",
+		"user_group_permission",
+		"permission_object_type",
+
+		// User API tokens
+		"user_api_token",
+
+		// User roles
+		"user_role",
+		"user_role_method",
+		"user_role_grant",
 	)
 	c.Assert(readTableNames(c, s.DB()), jc.SameContents, expected.Union(internalTableNames).SortedValues())
 }
@@ -128,6 +143,7 @@ func (s *schemaSuite) TestModelDDLApply(c *gc.C) {
 		"object_store_metadata",
 		"object_store_metadata_path",
 		"object_store_metadata_hash_type",
+		"object_store_metadata_removal",
 
 		"application",
 		"machine",
@@ -139,6 +155,58 @@ func (s *schemaSuite) TestModelDDLApply(c *gc.C) {
 	c.Assert(readTableNames(c, s.DB()), jc.SameContents, expected.Union(internalTableNames).SortedValues())
 }
 
+func (s *schemaSuite) TestModelDDLChangeLogTriggers(c *gc.C) {
+	schema := ModelDDL()
+	_, err := schema.Ensure(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	db := s.DB()
+	for _, stmt := range []string{
+		"INSERT INTO application VALUES ('app-uuid', 'mysql')",
+		"INSERT INTO net_node VALUES ('node-uuid')",
+		"INSERT INTO machine VALUES ('machine-uuid', '0', 'node-uuid')",
+		"INSERT INTO net_node VALUES ('unit-node-uuid')",
+		"INSERT INTO unit VALUES ('unit-uuid', 'mysql/0', 'app-uuid', 'unit-node-uuid')",
+		"UPDATE application SET name = 'mariadb' WHERE uuid = 'app-uuid'",
+		"DELETE FROM unit WHERE uuid = 'unit-uuid'",
+	} {
+		_, err := db.Exec(stmt)
+		c.Assert(err, jc.ErrorIsNil, gc.Commentf(stmt))
+	}
+
+	rows, err := db.Query(`
+SELECT n.namespace, c.edit_type_id, c.changed
+FROM change_log c
+JOIN change_log_namespace n ON c.namespace_id = n.id
+ORDER BY c.id`)
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { _ = rows.Close() }()
+
+	type change struct {
+		namespace string
+		editType  int
+		changed   string
+	}
+	var changes []change
+	for rows.Next() {
+		var ch change
+		err := rows.Scan(&ch.namespace, &ch.editType, &ch.changed)
+		c.Assert(err, jc.ErrorIsNil)
+		changes = append(changes, ch)
+	}
+	c.Assert(rows.Err(), jc.ErrorIsNil)
+
+	c.Check(changes, jc.DeepEquals, []change{
+		{namespace: "application", editType: 1, changed: "app-uuid"},
+		{namespace: "net_node", editType: 1, changed: "node-uuid"},
+		{namespace: "machine", editType: 1, changed: "machine-uuid"},
+		{namespace: "net_node", editType: 1, changed: "unit-node-uuid"},
+		{namespace: "unit", editType: 1, changed: "unit-uuid"},
+		{namespace: "application", editType: 2, changed: "app-uuid"},
+		{namespace: "unit", editType: 4, changed: "unit-uuid"},
+	})
+}
+
 // NewCleanDB returns a new sql.DB reference.
 func (s *schemaSuite) NewCleanDB(c *gc.C) *sql.DB {
 	dir := c.MkDir()
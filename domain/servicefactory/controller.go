@@ -4,6 +4,8 @@
 package servicefactory
 
 import (
+	"github.com/juju/clock"
+
 	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/domain"
@@ -27,6 +29,8 @@ import (
 	modelmanagerstate "github.com/juju/juju/domain/modelmanager/state"
 	upgradeservice "github.com/juju/juju/domain/upgrade/service"
 	upgradestate "github.com/juju/juju/domain/upgrade/state"
+	userservice "github.com/juju/juju/domain/user/service"
+	userstate "github.com/juju/juju/domain/user/state"
 )
 
 // Logger defines the logging interface used by the services.
@@ -149,3 +153,25 @@ func (s *ControllerFactory) Upgrade() *upgradeservice.Service {
 		),
 	)
 }
+
+// UserGroup returns the user group service.
+func (s *ControllerFactory) UserGroup() *userservice.GroupService {
+	return userservice.NewGroupService(
+		userstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		clock.WallClock,
+	)
+}
+
+// APIToken returns the user API token service.
+func (s *ControllerFactory) APIToken() *userservice.TokenService {
+	return userservice.NewTokenService(
+		userstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+	)
+}
+
+// Role returns the user role service.
+func (s *ControllerFactory) Role() *userservice.RoleService {
+	return userservice.NewRoleService(
+		userstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+	)
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/juju/juju/domain"
 	modelconfigservice "github.com/juju/juju/domain/modelconfig/service"
 	modelconfigstate "github.com/juju/juju/domain/modelconfig/state"
+	objectstoreservice "github.com/juju/juju/domain/objectstore/service"
+	objectstorestate "github.com/juju/juju/domain/objectstore/state"
 )
 
 // ModelFactory provides access to the services required by the apiserver.
@@ -29,6 +31,14 @@ func (s *ModelFactory) Config(
 	)
 }
 
+// ObjectStore returns the model's object store metadata service.
+func (s *ModelFactory) ObjectStore() *objectstoreservice.Service {
+	return objectstoreservice.NewService(
+		objectstorestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		domain.NewWatcherFactory(s.modelDB, s.logger.Child("objectstore")),
+	)
+}
+
 // NewModelFactory returns a new registry which uses the provided modelDB
 // function to obtain a model database.
 func NewModelFactory(
@@ -14,7 +14,9 @@ import (
 	modelconfigservice "github.com/juju/juju/domain/modelconfig/service"
 	modeldefaultsservice "github.com/juju/juju/domain/modeldefaults/service"
 	modelmanagerservice "github.com/juju/juju/domain/modelmanager/service"
+	objectstoreservice "github.com/juju/juju/domain/objectstore/service"
 	upgradeservice "github.com/juju/juju/domain/upgrade/service"
+	userservice "github.com/juju/juju/domain/user/service"
 )
 
 // TestingServiceFactory provides access to the services required by the apiserver.
@@ -76,11 +78,31 @@ func (s *TestingServiceFactory) Cloud() *cloudservice.Service {
 	return nil
 }
 
+// ObjectStore returns the object store metadata service.
+func (s *TestingServiceFactory) ObjectStore() *objectstoreservice.Service {
+	return nil
+}
+
 // Upgrade returns the upgrade service.
 func (s *TestingServiceFactory) Upgrade() *upgradeservice.Service {
 	return nil
 }
 
+// UserGroup returns the user group service.
+func (s *TestingServiceFactory) UserGroup() *userservice.GroupService {
+	return nil
+}
+
+// APIToken returns the user API token service.
+func (s *TestingServiceFactory) APIToken() *userservice.TokenService {
+	return nil
+}
+
+// Role returns the user role service.
+func (s *TestingServiceFactory) Role() *userservice.RoleService {
+	return nil
+}
+
 // TODO we need a method here because if we don't have a type here, then
 // anything satisfies the ModelFactory. Once we have model methods here, we
 // can remove this method.
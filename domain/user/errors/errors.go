@@ -25,4 +25,60 @@ const (
 	// AlreadyExists describes an error that occurs when the user being
 	// created already exists.
 	AlreadyExists = errors.ConstError("user already exists")
+
+	// GroupNotFound describes an error that occurs when the group being
+	// requested does not exist.
+	GroupNotFound = errors.ConstError("group not found")
+
+	// GroupAlreadyExists describes an error that occurs when the group being
+	// created already exists.
+	GroupAlreadyExists = errors.ConstError("group already exists")
+
+	// GroupNameNotValid describes an error that occurs when a supplied group
+	// name is not valid.
+	GroupNameNotValid = errors.ConstError("group name not valid")
+
+	// GroupAccessAlreadyGranted describes an error that occurs when a group
+	// is granted access it already has, or less.
+	GroupAccessAlreadyGranted = errors.ConstError("group access already granted")
+
+	// GroupAccessNotFound describes an error that occurs when access is
+	// revoked from a group that has none.
+	GroupAccessNotFound = errors.ConstError("group access not found")
+
+	// APITokenNotFound describes an error that occurs when the API token
+	// being requested does not exist.
+	APITokenNotFound = errors.ConstError("api token not found")
+
+	// APITokenAlreadyExists describes an error that occurs when the API
+	// token being created already exists for the user.
+	APITokenAlreadyExists = errors.ConstError("api token already exists")
+
+	// APITokenNameNotValid describes an error that occurs when a supplied
+	// API token name is not valid.
+	APITokenNameNotValid = errors.ConstError("api token name not valid")
+
+	// APITokenExpired describes an error that occurs when an API token is
+	// used after it has expired.
+	APITokenExpired = errors.ConstError("api token expired")
+
+	// RoleNotFound describes an error that occurs when the role being
+	// requested does not exist.
+	RoleNotFound = errors.ConstError("role not found")
+
+	// RoleAlreadyExists describes an error that occurs when the role being
+	// created already exists.
+	RoleAlreadyExists = errors.ConstError("role already exists")
+
+	// RoleNameNotValid describes an error that occurs when a supplied role
+	// name is not valid.
+	RoleNameNotValid = errors.ConstError("role name not valid")
+
+	// RoleMethodNotValid describes an error that occurs when a method
+	// supplied for a role is not of the form Facade.Method.
+	RoleMethodNotValid = errors.ConstError("role method not valid")
+
+	// RoleGrantNotFound describes an error that occurs when a role is
+	// revoked from a user that hasn't been granted it.
+	RoleGrantNotFound = errors.ConstError("role grant not found")
 )
@@ -0,0 +1,330 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/clock"
+	"github.com/juju/names/v4"
+	"github.com/juju/utils/v3"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+)
+
+// GroupState describes retrieval and persistence methods for user groups
+// and the access granted to them.
+type GroupState interface {
+	// AddGroup adds a new group with the given UUID and its members. If a
+	// group with the same name already exists an error that satisfies
+	// usererrors.GroupAlreadyExists is returned.
+	AddGroup(ctx context.Context, uuid string, group user.Group) error
+
+	// RemoveGroup removes the group, its members and the access granted to
+	// it. If no group exists for the given name an error that satisfies
+	// usererrors.GroupNotFound is returned.
+	RemoveGroup(ctx context.Context, name string) error
+
+	// GetGroup returns the group with the given name. If no group exists
+	// for the name an error that satisfies usererrors.GroupNotFound is
+	// returned.
+	GetGroup(ctx context.Context, name string) (user.Group, error)
+
+	// ListGroups returns all the groups, ordered by name.
+	ListGroups(ctx context.Context) ([]user.Group, error)
+
+	// AddGroupMembers adds the users to the group. If no group exists for
+	// the given name an error that satisfies usererrors.GroupNotFound is
+	// returned.
+	AddGroupMembers(ctx context.Context, name string, members []string) error
+
+	// RemoveGroupMembers removes the users from the group. If no group
+	// exists for the given name an error that satisfies
+	// usererrors.GroupNotFound is returned.
+	RemoveGroupMembers(ctx context.Context, name string, members []string) error
+
+	// GrantGroupAccess grants the group the access to the target. If the
+	// group has that access or greater already an error that satisfies
+	// usererrors.GroupAccessAlreadyGranted is returned.
+	GrantGroupAccess(ctx context.Context, name string, target permission.ID, access permission.Access) error
+
+	// RevokeGroupAccess reduces the access granted to the group on the
+	// target to the remaining access, removing it if that is NoAccess. If
+	// the group has no access to the target an error that satisfies
+	// usererrors.GroupAccessNotFound is returned.
+	RevokeGroupAccess(ctx context.Context, name string, target permission.ID, remaining permission.Access) error
+
+	// RemoveUserMemberships removes the user from all the groups they are
+	// a member of.
+	RemoveUserMemberships(ctx context.Context, userName string) error
+
+	// UserGroupAccess returns the access granted on the target to each of
+	// the groups the user is a member of that has any.
+	UserGroupAccess(ctx context.Context, userName string, target permission.ID) ([]permission.Access, error)
+}
+
+// GroupService provides the API for working with groups of users and the
+// access granted to them. Access granted to a group is granted to each of
+// its members, in addition to any granted to them directly.
+type GroupService struct {
+	st    GroupState
+	clock clock.Clock
+}
+
+// NewGroupService returns a new GroupService for interacting with the
+// underlying group state.
+func NewGroupService(st GroupState, clock clock.Clock) *GroupService {
+	return &GroupService{st: st, clock: clock}
+}
+
+// ValidateGroupName validates that the group name follows the same rules
+// as user names. If it doesn't an error is returned that satisfies
+// usererrors.GroupNameNotValid.
+func ValidateGroupName(name string) error {
+	if !validUserName.MatchString(name) {
+		return fmt.Errorf("%w %q", usererrors.GroupNameNotValid, name)
+	}
+	return nil
+}
+
+// AddGroup adds a new group with the given members.
+//
+// The following error types are possible from this function:
+// - usererrors.GroupNameNotValid: When the group name supplied is not valid.
+// - usererrors.UsernameNotValid: When a member name supplied is not valid.
+// - usererrors.GroupAlreadyExists: If a group with the name already exists.
+func (s *GroupService) AddGroup(ctx context.Context, name, creator string, members ...string) error {
+	if err := ValidateGroupName(name); err != nil {
+		return fmt.Errorf("group %q: %w", name, err)
+	}
+	members, err := canonicalUserNames(members)
+	if err != nil {
+		return fmt.Errorf("group %q: %w", name, err)
+	}
+
+	uuid, err := utils.NewUUID()
+	if err != nil {
+		return fmt.Errorf("adding group %q, generating UUID: %w", name, err)
+	}
+	group := user.Group{
+		Name:        name,
+		Members:     members,
+		CreatorName: creator,
+		CreatedAt:   s.clock.Now().UTC(),
+	}
+	if err := s.st.AddGroup(ctx, uuid.String(), group); err != nil {
+		return fmt.Errorf("adding group %q: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveGroup removes the group and revokes all the access granted to it.
+//
+// The following error types are possible from this function:
+// - usererrors.GroupNameNotValid: When the group name supplied is not valid.
+// - usererrors.GroupNotFound: If no group by the given name exists.
+func (s *GroupService) RemoveGroup(ctx context.Context, name string) error {
+	if err := ValidateGroupName(name); err != nil {
+		return fmt.Errorf("group %q: %w", name, err)
+	}
+	if err := s.st.RemoveGroup(ctx, name); err != nil {
+		return fmt.Errorf("removing group %q: %w", name, err)
+	}
+	return nil
+}
+
+// GetGroup returns the group with the given name.
+//
+// The following error types are possible from this function:
+// - usererrors.GroupNameNotValid: When the group name supplied is not valid.
+// - usererrors.GroupNotFound: If no group by the given name exists.
+func (s *GroupService) GetGroup(ctx context.Context, name string) (user.Group, error) {
+	if err := ValidateGroupName(name); err != nil {
+		return user.Group{}, fmt.Errorf("group %q: %w", name, err)
+	}
+	group, err := s.st.GetGroup(ctx, name)
+	if err != nil {
+		return user.Group{}, fmt.Errorf("getting group %q: %w", name, err)
+	}
+	return group, nil
+}
+
+// ListGroups returns all the groups, ordered by name.
+func (s *GroupService) ListGroups(ctx context.Context) ([]user.Group, error) {
+	groups, err := s.st.ListGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing groups: %w", err)
+	}
+	return groups, nil
+}
+
+// AddGroupMembers adds the users to the group. Users that are already
+// members are ignored.
+//
+// The following error types are possible from this function:
+// - usererrors.GroupNameNotValid: When the group name supplied is not valid.
+// - usererrors.UsernameNotValid: When a member name supplied is not valid.
+// - usererrors.GroupNotFound: If no group by the given name exists.
+func (s *GroupService) AddGroupMembers(ctx context.Context, name string, members ...string) error {
+	if err := ValidateGroupName(name); err != nil {
+		return fmt.Errorf("group %q: %w", name, err)
+	}
+	members, err := canonicalUserNames(members)
+	if err != nil {
+		return fmt.Errorf("group %q: %w", name, err)
+	}
+	if err := s.st.AddGroupMembers(ctx, name, members); err != nil {
+		return fmt.Errorf("adding members to group %q: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveGroupMembers removes the users from the group. Users that aren't
+// members are ignored.
+//
+// The following error types are possible from this function:
+// - usererrors.GroupNameNotValid: When the group name supplied is not valid.
+// - usererrors.UsernameNotValid: When a member name supplied is not valid.
+// - usererrors.GroupNotFound: If no group by the given name exists.
+func (s *GroupService) RemoveGroupMembers(ctx context.Context, name string, members ...string) error {
+	if err := ValidateGroupName(name); err != nil {
+		return fmt.Errorf("group %q: %w", name, err)
+	}
+	members, err := canonicalUserNames(members)
+	if err != nil {
+		return fmt.Errorf("group %q: %w", name, err)
+	}
+	if err := s.st.RemoveGroupMembers(ctx, name, members); err != nil {
+		return fmt.Errorf("removing members from group %q: %w", name, err)
+	}
+	return nil
+}
+
+// GrantGroupAccess grants the group access to the target, which must be
+// greater than the group has already.
+//
+// The following error types are possible from this function:
+// - usererrors.GroupNameNotValid: When the group name supplied is not valid.
+// - errors.NotValid: When the access can't be granted on the target.
+// - usererrors.GroupNotFound: If no group by the given name exists.
+// - usererrors.GroupAccessAlreadyGranted: If the group already has the
+// access, or greater.
+func (s *GroupService) GrantGroupAccess(ctx context.Context, name string, target permission.ID, access permission.Access) error {
+	if err := validateGroupAccess(name, target, access); err != nil {
+		return err
+	}
+
+	if err := s.st.GrantGroupAccess(ctx, name, target, access); err != nil {
+		return fmt.Errorf("granting group %q access: %w", name, err)
+	}
+	return nil
+}
+
+// RevokeGroupAccess revokes access to the target from the group. As for
+// users, the group is left with the access one level below that revoked,
+// if it had any more, so revoking write access on a model leaves read
+// access, while revoking read access removes it all.
+//
+// The following error types are possible from this function:
+// - usererrors.GroupNameNotValid: When the group name supplied is not valid.
+// - errors.NotValid: When the access can't be granted on the target.
+// - usererrors.GroupNotFound: If no group by the given name exists.
+// - usererrors.GroupAccessNotFound: If the group has no access to the
+// target.
+func (s *GroupService) RevokeGroupAccess(ctx context.Context, name string, target permission.ID, access permission.Access) error {
+	if err := validateGroupAccess(name, target, access); err != nil {
+		return err
+	}
+
+	remaining := lowerAccess(target.ObjectType, access)
+	if err := s.st.RevokeGroupAccess(ctx, name, target, remaining); err != nil {
+		return fmt.Errorf("revoking group %q access: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveUserMemberships removes the user from all the groups they are a
+// member of. It is called when the user is removed, so that a user later
+// added with the same name doesn't inherit their memberships.
+//
+// The following error types are possible from this function:
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+func (s *GroupService) RemoveUserMemberships(ctx context.Context, userName string) error {
+	canonical, err := canonicalUserNames([]string{userName})
+	if err != nil {
+		return err
+	}
+	if err := s.st.RemoveUserMemberships(ctx, canonical[0]); err != nil {
+		return fmt.Errorf("removing user %q group memberships: %w", userName, err)
+	}
+	return nil
+}
+
+// UserGroupAccess returns the greatest access to the target granted to
+// any of the groups the user is a member of, or NoAccess if none of them
+// have any.
+//
+// The following error types are possible from this function:
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+// - errors.NotValid: When the target is not valid.
+func (s *GroupService) UserGroupAccess(ctx context.Context, userName string, target permission.ID) (permission.Access, error) {
+	canonical, err := canonicalUserNames([]string{userName})
+	if err != nil {
+		return permission.NoAccess, err
+	}
+	if err := target.Validate(); err != nil {
+		return permission.NoAccess, err
+	}
+	accesses, err := s.st.UserGroupAccess(ctx, canonical[0], target)
+	if err != nil {
+		return permission.NoAccess, fmt.Errorf("getting user %q group access: %w", userName, err)
+	}
+	return target.ObjectType.HighestAccess(accesses...), nil
+}
+
+func validateGroupAccess(name string, target permission.ID, access permission.Access) error {
+	if err := ValidateGroupName(name); err != nil {
+		return fmt.Errorf("group %q: %w", name, err)
+	}
+	if err := target.Validate(); err != nil {
+		return err
+	}
+	return target.ValidateAccess(access)
+}
+
+// lowerAccess returns the access one level below the given access for the
+// type of object.
+func lowerAccess(objectType permission.ObjectType, access permission.Access) permission.Access {
+	switch {
+	case objectType == permission.Controller && access == permission.SuperuserAccess:
+		return permission.LoginAccess
+	case objectType == permission.Model && access == permission.AdminAccess:
+		return permission.WriteAccess
+	case objectType == permission.Model && access == permission.WriteAccess:
+		return permission.ReadAccess
+	case objectType == permission.Cloud && access == permission.AdminAccess:
+		return permission.AddModelAccess
+	case objectType == permission.Offer && access == permission.AdminAccess:
+		return permission.ConsumeAccess
+	case objectType == permission.Offer && access == permission.ConsumeAccess:
+		return permission.ReadAccess
+	}
+	return permission.NoAccess
+}
+
+// canonicalUserNames validates the user names, returning them in the form
+// they're recorded in, without the "@local" domain for local users.
+func canonicalUserNames(userNames []string) ([]string, error) {
+	result := make([]string, len(userNames))
+	for i, name := range userNames {
+		if !names.IsValidUser(name) {
+			return nil, fmt.Errorf("%w %q", usererrors.UsernameNotValid, name)
+		}
+		result[i] = names.NewUserTag(name).Id()
+	}
+	return result, nil
+}
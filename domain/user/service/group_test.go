@@ -0,0 +1,172 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+)
+
+type groupServiceSuite struct {
+	state *MockGroupState
+	clock *testclock.Clock
+}
+
+var _ = gc.Suite(&groupServiceSuite{})
+
+var modelID = permission.ID{ObjectType: permission.Model, Key: "deadbeef-0bad-400d-8000-4b1d0d06f00d"}
+
+func (s *groupServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockGroupState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	return ctrl
+}
+
+func (s *groupServiceSuite) service() *GroupService {
+	return NewGroupService(s.state, s.clock)
+}
+
+func (s *groupServiceSuite) TestAddGroup(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddGroup(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, uuid string, group user.Group) error {
+			c.Check(uuid, gc.Not(gc.Equals), "")
+			c.Check(group.Name, gc.Equals, "engineers")
+			c.Check(group.CreatorName, gc.Equals, "admin")
+			// Local users are recorded without their domain.
+			c.Check(group.Members, jc.DeepEquals, []string{"bob", "mary@external"})
+			c.Check(group.CreatedAt, gc.Equals, s.clock.Now())
+			return nil
+		})
+
+	err := s.service().AddGroup(context.Background(), "engineers", "admin", "bob@local", "mary@external")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *groupServiceSuite) TestAddGroupInvalidNames(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service().AddGroup(context.Background(), "-engineers", "admin")
+	c.Check(err, jc.ErrorIs, usererrors.GroupNameNotValid)
+
+	err = s.service().AddGroup(context.Background(), "engineers", "admin", "bob@")
+	c.Check(err, jc.ErrorIs, usererrors.UsernameNotValid)
+}
+
+func (s *groupServiceSuite) TestAddGroupAlreadyExists(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddGroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(usererrors.GroupAlreadyExists)
+
+	err := s.service().AddGroup(context.Background(), "engineers", "admin")
+	c.Check(err, jc.ErrorIs, usererrors.GroupAlreadyExists)
+}
+
+func (s *groupServiceSuite) TestGroupMembers(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddGroupMembers(gomock.Any(), "engineers", []string{"bob"}).Return(nil)
+	s.state.EXPECT().RemoveGroupMembers(gomock.Any(), "engineers", []string{"jim@external"}).Return(usererrors.GroupNotFound)
+
+	err := s.service().AddGroupMembers(context.Background(), "engineers", "bob")
+	c.Check(err, jc.ErrorIsNil)
+	err = s.service().RemoveGroupMembers(context.Background(), "engineers", "jim@external")
+	c.Check(err, jc.ErrorIs, usererrors.GroupNotFound)
+}
+
+func (s *groupServiceSuite) TestGrantGroupAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GrantGroupAccess(gomock.Any(), "engineers", modelID, permission.ReadAccess).Return(nil)
+
+	err := s.service().GrantGroupAccess(context.Background(), "engineers", modelID, permission.ReadAccess)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *groupServiceSuite) TestGrantGroupAccessAlreadyGranted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GrantGroupAccess(gomock.Any(), "engineers", modelID, permission.ReadAccess).Return(usererrors.GroupAccessAlreadyGranted)
+
+	err := s.service().GrantGroupAccess(context.Background(), "engineers", modelID, permission.ReadAccess)
+	c.Check(err, jc.ErrorIs, usererrors.GroupAccessAlreadyGranted)
+}
+
+func (s *groupServiceSuite) TestGrantGroupAccessNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service().GrantGroupAccess(context.Background(), "engineers", modelID, permission.SuperuserAccess)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+
+	err = s.service().GrantGroupAccess(context.Background(), "engineers", permission.ID{ObjectType: permission.Model}, permission.ReadAccess)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *groupServiceSuite) TestRevokeGroupAccess(c *gc.C) {
+	for _, test := range []struct {
+		revoke    permission.Access
+		remaining permission.Access
+	}{
+		{revoke: permission.AdminAccess, remaining: permission.WriteAccess},
+		{revoke: permission.WriteAccess, remaining: permission.ReadAccess},
+		{revoke: permission.ReadAccess, remaining: permission.NoAccess},
+	} {
+		c.Logf("revoke %q", test.revoke)
+		func() {
+			defer s.setupMocks(c).Finish()
+
+			s.state.EXPECT().RevokeGroupAccess(gomock.Any(), "engineers", modelID, test.remaining).Return(nil)
+
+			err := s.service().RevokeGroupAccess(context.Background(), "engineers", modelID, test.revoke)
+			c.Check(err, jc.ErrorIsNil)
+		}()
+	}
+}
+
+func (s *groupServiceSuite) TestRevokeGroupAccessNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RevokeGroupAccess(gomock.Any(), "engineers", modelID, permission.NoAccess).Return(usererrors.GroupAccessNotFound)
+
+	err := s.service().RevokeGroupAccess(context.Background(), "engineers", modelID, permission.ReadAccess)
+	c.Check(err, jc.ErrorIs, usererrors.GroupAccessNotFound)
+}
+
+func (s *groupServiceSuite) TestRemoveUserMemberships(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Local users are recorded without their domain.
+	s.state.EXPECT().RemoveUserMemberships(gomock.Any(), "bob").Return(nil)
+
+	err := s.service().RemoveUserMemberships(context.Background(), "bob@local")
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *groupServiceSuite) TestUserGroupAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().UserGroupAccess(gomock.Any(), "bob", modelID).Return(
+		[]permission.Access{permission.ReadAccess, permission.AdminAccess, permission.WriteAccess}, nil)
+	s.state.EXPECT().UserGroupAccess(gomock.Any(), "mary@external", modelID).Return(nil, nil)
+
+	access, err := s.service().UserGroupAccess(context.Background(), "bob@local", modelID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.AdminAccess)
+
+	access, err = s.service().UserGroupAccess(context.Background(), "mary@external", modelID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.NoAccess)
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juju/utils/v3"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+)
+
+// RoleState describes retrieval and persistence methods for roles and
+// their grants to users.
+type RoleState interface {
+	// AddRole adds a new role with the given UUID and its methods. If a
+	// role with the same name already exists an error that satisfies
+	// usererrors.RoleAlreadyExists is returned.
+	AddRole(ctx context.Context, uuid string, role user.Role) error
+
+	// RemoveRole removes the role, its methods and its grants to users. If
+	// no role exists for the given name an error that satisfies
+	// usererrors.RoleNotFound is returned.
+	RemoveRole(ctx context.Context, name string) error
+
+	// GetRole returns the role with the given name. If no role exists for
+	// the name an error that satisfies usererrors.RoleNotFound is returned.
+	GetRole(ctx context.Context, name string) (user.Role, error)
+
+	// ListRoles returns all the roles, ordered by name.
+	ListRoles(ctx context.Context) ([]user.Role, error)
+
+	// GrantRole grants the role to the user on the model. If no role
+	// exists for the given name an error that satisfies
+	// usererrors.RoleNotFound is returned.
+	GrantRole(ctx context.Context, name, userName, modelUUID string) error
+
+	// RevokeRole revokes the role from the user on the model. If the user
+	// hasn't been granted the role on the model an error that satisfies
+	// usererrors.RoleGrantNotFound is returned.
+	RevokeRole(ctx context.Context, name, userName, modelUUID string) error
+
+	// RemoveUserRoleGrants revokes every role granted to the user.
+	RemoveUserRoleGrants(ctx context.Context, userName string) error
+
+	// UserRoleMethods returns the methods allowed by the roles granted to
+	// the user on the model.
+	UserRoleMethods(ctx context.Context, userName, modelUUID string) ([]string, error)
+}
+
+// RoleService provides the API for working with roles, which are named sets
+// of facade methods that can be granted to users on a model in addition to
+// their access level.
+type RoleService struct {
+	st RoleState
+}
+
+// NewRoleService returns a new RoleService for interacting with the
+// underlying role state.
+func NewRoleService(st RoleState) *RoleService {
+	return &RoleService{st: st}
+}
+
+// ValidateRoleName validates that the role name follows the same rules as
+// user names and isn't the name of an access level. If it doesn't an error
+// is returned that satisfies usererrors.RoleNameNotValid.
+func ValidateRoleName(name string) error {
+	if !validUserName.MatchString(name) || permission.Access(name).Validate() == nil {
+		return fmt.Errorf("%w %q", usererrors.RoleNameNotValid, name)
+	}
+	return nil
+}
+
+// AddRole adds a new role allowing the given methods, each of the form
+// Facade.Method. The method may be "*" to allow every method on the facade.
+//
+// The following error types are possible from this function:
+// - usererrors.RoleNameNotValid: When the role name supplied is not valid.
+// - usererrors.RoleMethodNotValid: When a method supplied is not valid.
+// - usererrors.RoleAlreadyExists: If a role with the name already exists.
+func (s *RoleService) AddRole(ctx context.Context, name, creator string, methods ...string) error {
+	if err := ValidateRoleName(name); err != nil {
+		return fmt.Errorf("role %q: %w", name, err)
+	}
+	if len(methods) == 0 {
+		return fmt.Errorf("role %q: no methods %w", name, usererrors.RoleMethodNotValid)
+	}
+	for _, method := range methods {
+		if _, _, err := user.ParseRoleMethod(method); err != nil {
+			return fmt.Errorf("role %q: %w %q", name, usererrors.RoleMethodNotValid, method)
+		}
+	}
+
+	uuid, err := utils.NewUUID()
+	if err != nil {
+		return fmt.Errorf("adding role %q, generating UUID: %w", name, err)
+	}
+	role := user.Role{
+		Name:        name,
+		Methods:     methods,
+		CreatorName: creator,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := s.st.AddRole(ctx, uuid.String(), role); err != nil {
+		return fmt.Errorf("adding role %q: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveRole removes the role and revokes it from every user it was
+// granted to.
+//
+// The following error types are possible from this function:
+// - usererrors.RoleNameNotValid: When the role name supplied is not valid.
+// - usererrors.RoleNotFound: If no role by the given name exists.
+func (s *RoleService) RemoveRole(ctx context.Context, name string) error {
+	if err := ValidateRoleName(name); err != nil {
+		return fmt.Errorf("role %q: %w", name, err)
+	}
+	if err := s.st.RemoveRole(ctx, name); err != nil {
+		return fmt.Errorf("removing role %q: %w", name, err)
+	}
+	return nil
+}
+
+// GetRole returns the role with the given name.
+//
+// The following error types are possible from this function:
+// - usererrors.RoleNameNotValid: When the role name supplied is not valid.
+// - usererrors.RoleNotFound: If no role by the given name exists.
+func (s *RoleService) GetRole(ctx context.Context, name string) (user.Role, error) {
+	if err := ValidateRoleName(name); err != nil {
+		return user.Role{}, fmt.Errorf("role %q: %w", name, err)
+	}
+	role, err := s.st.GetRole(ctx, name)
+	if err != nil {
+		return user.Role{}, fmt.Errorf("getting role %q: %w", name, err)
+	}
+	return role, nil
+}
+
+// ListRoles returns all the roles, ordered by name.
+func (s *RoleService) ListRoles(ctx context.Context) ([]user.Role, error) {
+	roles, err := s.st.ListRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing roles: %w", err)
+	}
+	return roles, nil
+}
+
+// GrantRole grants the role to the user on the model. Granting a role the
+// user already has is not an error.
+//
+// The following error types are possible from this function:
+// - usererrors.RoleNameNotValid: When the role name supplied is not valid.
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+// - usererrors.RoleNotFound: If no role by the given name exists.
+func (s *RoleService) GrantRole(ctx context.Context, name, userName, modelUUID string) error {
+	userName, err := s.validateGrant(name, userName, modelUUID)
+	if err != nil {
+		return err
+	}
+	if err := s.st.GrantRole(ctx, name, userName, modelUUID); err != nil {
+		return fmt.Errorf("granting role %q to user %q: %w", name, userName, err)
+	}
+	return nil
+}
+
+// RevokeRole revokes the role from the user on the model.
+//
+// The following error types are possible from this function:
+// - usererrors.RoleNameNotValid: When the role name supplied is not valid.
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+// - usererrors.RoleNotFound: If no role by the given name exists.
+// - usererrors.RoleGrantNotFound: If the user hasn't been granted the role
+// on the model.
+func (s *RoleService) RevokeRole(ctx context.Context, name, userName, modelUUID string) error {
+	userName, err := s.validateGrant(name, userName, modelUUID)
+	if err != nil {
+		return err
+	}
+	if err := s.st.RevokeRole(ctx, name, userName, modelUUID); err != nil {
+		return fmt.Errorf("revoking role %q from user %q: %w", name, userName, err)
+	}
+	return nil
+}
+
+// RemoveUserRoleGrants revokes every role granted to the user on any
+// model. It is called when the user is removed, so that a user later added
+// with the same name doesn't inherit the removed user's roles.
+//
+// The following error types are possible from this function:
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+func (s *RoleService) RemoveUserRoleGrants(ctx context.Context, userName string) error {
+	userNames, err := canonicalUserNames([]string{userName})
+	if err != nil {
+		return err
+	}
+	if err := s.st.RemoveUserRoleGrants(ctx, userNames[0]); err != nil {
+		return fmt.Errorf("removing user %q role grants: %w", userName, err)
+	}
+	return nil
+}
+
+// UserRoleMethods returns the methods, each of the form Facade.Method,
+// allowed by the roles granted to the user on the model.
+//
+// The following error types are possible from this function:
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+func (s *RoleService) UserRoleMethods(ctx context.Context, userName, modelUUID string) ([]string, error) {
+	userNames, err := canonicalUserNames([]string{userName})
+	if err != nil {
+		return nil, err
+	}
+	methods, err := s.st.UserRoleMethods(ctx, userNames[0], modelUUID)
+	if err != nil {
+		return nil, fmt.Errorf("getting user %q role methods: %w", userName, err)
+	}
+	return methods, nil
+}
+
+func (s *RoleService) validateGrant(name, userName, modelUUID string) (string, error) {
+	if err := ValidateRoleName(name); err != nil {
+		return "", fmt.Errorf("role %q: %w", name, err)
+	}
+	if !utils.IsValidUUIDString(modelUUID) {
+		return "", fmt.Errorf("role %q: model UUID %q not valid", name, modelUUID)
+	}
+	userNames, err := canonicalUserNames([]string{userName})
+	if err != nil {
+		return "", fmt.Errorf("role %q: %w", name, err)
+	}
+	return userNames[0], nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+)
+
+type roleServiceSuite struct {
+	state *MockRoleState
+}
+
+var _ = gc.Suite(&roleServiceSuite{})
+
+func (s *roleServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockRoleState(ctrl)
+	return ctrl
+}
+
+func (s *roleServiceSuite) service() *RoleService {
+	return NewRoleService(s.state)
+}
+
+func (s *roleServiceSuite) TestAddRole(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddRole(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, uuid string, role user.Role) error {
+			c.Check(uuid, gc.Not(gc.Equals), "")
+			c.Check(role.Name, gc.Equals, "support")
+			c.Check(role.CreatorName, gc.Equals, "admin")
+			c.Check(role.Methods, jc.DeepEquals, []string{"Action.EnqueueOperation", "Client.*"})
+			c.Check(role.CreatedAt.IsZero(), jc.IsFalse)
+			return nil
+		})
+
+	err := s.service().AddRole(context.Background(), "support", "admin", "Action.EnqueueOperation", "Client.*")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleServiceSuite) TestAddRoleNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service().AddRole(context.Background(), "-support", "admin", "Action.EnqueueOperation")
+	c.Check(err, jc.ErrorIs, usererrors.RoleNameNotValid)
+
+	// Roles can't share their names with access levels.
+	err = s.service().AddRole(context.Background(), "write", "admin", "Action.EnqueueOperation")
+	c.Check(err, jc.ErrorIs, usererrors.RoleNameNotValid)
+
+	err = s.service().AddRole(context.Background(), "support", "admin")
+	c.Check(err, jc.ErrorIs, usererrors.RoleMethodNotValid)
+
+	for _, method := range []string{"Action", "Action.", ".Enqueue", "action.Enqueue", "Action.Enqueue.Operation", "*.*"} {
+		err = s.service().AddRole(context.Background(), "support", "admin", method)
+		c.Check(err, jc.ErrorIs, usererrors.RoleMethodNotValid, gc.Commentf("method %q", method))
+	}
+}
+
+func (s *roleServiceSuite) TestGrantRole(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GrantRole(gomock.Any(), "support", "bob", modelID.Key).Return(usererrors.RoleNotFound)
+
+	err := s.service().GrantRole(context.Background(), "support", "bob@local", modelID.Key)
+	c.Check(err, jc.ErrorIs, usererrors.RoleNotFound)
+
+	err = s.service().GrantRole(context.Background(), "support", "bob", "not-a-uuid")
+	c.Check(err, gc.ErrorMatches, `role "support": model UUID "not-a-uuid" not valid`)
+
+	err = s.service().GrantRole(context.Background(), "support", "not@valid@user", modelID.Key)
+	c.Check(err, jc.ErrorIs, usererrors.UsernameNotValid)
+}
+
+func (s *roleServiceSuite) TestRevokeRole(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RevokeRole(gomock.Any(), "support", "mary@external", modelID.Key).Return(nil)
+
+	err := s.service().RevokeRole(context.Background(), "support", "mary@external", modelID.Key)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleServiceSuite) TestRemoveUserRoleGrants(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RemoveUserRoleGrants(gomock.Any(), "bob").Return(nil)
+
+	err := s.service().RemoveUserRoleGrants(context.Background(), "bob@local")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleServiceSuite) TestUserRoleMethods(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().UserRoleMethods(gomock.Any(), "bob", modelID.Key).Return([]string{"Action.*"}, nil)
+
+	methods, err := s.service().UserRoleMethods(context.Background(), "bob@local", modelID.Key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(methods, jc.DeepEquals, []string{"Action.*"})
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/user/service (interfaces: State)
+// Source: github.com/juju/juju/domain/user/service (interfaces: State,GroupState,TokenState,RoleState)
 
 // Package service is a generated GoMock package.
 package service
@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	permission "github.com/juju/juju/core/permission"
 	user "github.com/juju/juju/core/user"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -133,3 +134,402 @@ func (mr *MockStateMockRecorder) SetPasswordHash(arg0, arg1, arg2, arg3 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPasswordHash", reflect.TypeOf((*MockState)(nil).SetPasswordHash), arg0, arg1, arg2, arg3)
 }
+
+// MockGroupState is a mock of GroupState interface.
+type MockGroupState struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupStateMockRecorder
+}
+
+// MockGroupStateMockRecorder is the mock recorder for MockGroupState.
+type MockGroupStateMockRecorder struct {
+	mock *MockGroupState
+}
+
+// NewMockGroupState creates a new mock instance.
+func NewMockGroupState(ctrl *gomock.Controller) *MockGroupState {
+	mock := &MockGroupState{ctrl: ctrl}
+	mock.recorder = &MockGroupStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupState) EXPECT() *MockGroupStateMockRecorder {
+	return m.recorder
+}
+
+// AddGroup mocks base method.
+func (m *MockGroupState) AddGroup(arg0 context.Context, arg1 string, arg2 user.Group) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGroup indicates an expected call of AddGroup.
+func (mr *MockGroupStateMockRecorder) AddGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroup", reflect.TypeOf((*MockGroupState)(nil).AddGroup), arg0, arg1, arg2)
+}
+
+// AddGroupMembers mocks base method.
+func (m *MockGroupState) AddGroupMembers(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupMembers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGroupMembers indicates an expected call of AddGroupMembers.
+func (mr *MockGroupStateMockRecorder) AddGroupMembers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupMembers", reflect.TypeOf((*MockGroupState)(nil).AddGroupMembers), arg0, arg1, arg2)
+}
+
+// GetGroup mocks base method.
+func (m *MockGroupState) GetGroup(arg0 context.Context, arg1 string) (user.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", arg0, arg1)
+	ret0, _ := ret[0].(user.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *MockGroupStateMockRecorder) GetGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockGroupState)(nil).GetGroup), arg0, arg1)
+}
+
+// GrantGroupAccess mocks base method.
+func (m *MockGroupState) GrantGroupAccess(arg0 context.Context, arg1 string, arg2 permission.ID, arg3 permission.Access) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantGroupAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantGroupAccess indicates an expected call of GrantGroupAccess.
+func (mr *MockGroupStateMockRecorder) GrantGroupAccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantGroupAccess", reflect.TypeOf((*MockGroupState)(nil).GrantGroupAccess), arg0, arg1, arg2, arg3)
+}
+
+// ListGroups mocks base method.
+func (m *MockGroupState) ListGroups(arg0 context.Context) ([]user.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", arg0)
+	ret0, _ := ret[0].([]user.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockGroupStateMockRecorder) ListGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockGroupState)(nil).ListGroups), arg0)
+}
+
+// RemoveGroup mocks base method.
+func (m *MockGroupState) RemoveGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGroup indicates an expected call of RemoveGroup.
+func (mr *MockGroupStateMockRecorder) RemoveGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroup", reflect.TypeOf((*MockGroupState)(nil).RemoveGroup), arg0, arg1)
+}
+
+// RemoveGroupMembers mocks base method.
+func (m *MockGroupState) RemoveGroupMembers(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupMembers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGroupMembers indicates an expected call of RemoveGroupMembers.
+func (mr *MockGroupStateMockRecorder) RemoveGroupMembers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMembers", reflect.TypeOf((*MockGroupState)(nil).RemoveGroupMembers), arg0, arg1, arg2)
+}
+
+// RemoveUserMemberships mocks base method.
+func (m *MockGroupState) RemoveUserMemberships(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserMemberships", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserMemberships indicates an expected call of RemoveUserMemberships.
+func (mr *MockGroupStateMockRecorder) RemoveUserMemberships(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserMemberships", reflect.TypeOf((*MockGroupState)(nil).RemoveUserMemberships), arg0, arg1)
+}
+
+// RevokeGroupAccess mocks base method.
+func (m *MockGroupState) RevokeGroupAccess(arg0 context.Context, arg1 string, arg2 permission.ID, arg3 permission.Access) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeGroupAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeGroupAccess indicates an expected call of RevokeGroupAccess.
+func (mr *MockGroupStateMockRecorder) RevokeGroupAccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeGroupAccess", reflect.TypeOf((*MockGroupState)(nil).RevokeGroupAccess), arg0, arg1, arg2, arg3)
+}
+
+// UserGroupAccess mocks base method.
+func (m *MockGroupState) UserGroupAccess(arg0 context.Context, arg1 string, arg2 permission.ID) ([]permission.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGroupAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]permission.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserGroupAccess indicates an expected call of UserGroupAccess.
+func (mr *MockGroupStateMockRecorder) UserGroupAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGroupAccess", reflect.TypeOf((*MockGroupState)(nil).UserGroupAccess), arg0, arg1, arg2)
+}
+
+// MockTokenState is a mock of TokenState interface.
+type MockTokenState struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenStateMockRecorder
+}
+
+// MockTokenStateMockRecorder is the mock recorder for MockTokenState.
+type MockTokenStateMockRecorder struct {
+	mock *MockTokenState
+}
+
+// NewMockTokenState creates a new mock instance.
+func NewMockTokenState(ctrl *gomock.Controller) *MockTokenState {
+	mock := &MockTokenState{ctrl: ctrl}
+	mock.recorder = &MockTokenStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenState) EXPECT() *MockTokenStateMockRecorder {
+	return m.recorder
+}
+
+// AddAPIToken mocks base method.
+func (m *MockTokenState) AddAPIToken(arg0 context.Context, arg1, arg2 string, arg3 user.APIToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAPIToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAPIToken indicates an expected call of AddAPIToken.
+func (mr *MockTokenStateMockRecorder) AddAPIToken(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAPIToken", reflect.TypeOf((*MockTokenState)(nil).AddAPIToken), arg0, arg1, arg2, arg3)
+}
+
+// GetAPITokenByHash mocks base method.
+func (m *MockTokenState) GetAPITokenByHash(arg0 context.Context, arg1 string) (user.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPITokenByHash", arg0, arg1)
+	ret0, _ := ret[0].(user.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPITokenByHash indicates an expected call of GetAPITokenByHash.
+func (mr *MockTokenStateMockRecorder) GetAPITokenByHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPITokenByHash", reflect.TypeOf((*MockTokenState)(nil).GetAPITokenByHash), arg0, arg1)
+}
+
+// ListAPITokens mocks base method.
+func (m *MockTokenState) ListAPITokens(arg0 context.Context, arg1 string) ([]user.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPITokens", arg0, arg1)
+	ret0, _ := ret[0].([]user.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPITokens indicates an expected call of ListAPITokens.
+func (mr *MockTokenStateMockRecorder) ListAPITokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPITokens", reflect.TypeOf((*MockTokenState)(nil).ListAPITokens), arg0, arg1)
+}
+
+// RemoveAPIToken mocks base method.
+func (m *MockTokenState) RemoveAPIToken(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAPIToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAPIToken indicates an expected call of RemoveAPIToken.
+func (mr *MockTokenStateMockRecorder) RemoveAPIToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAPIToken", reflect.TypeOf((*MockTokenState)(nil).RemoveAPIToken), arg0, arg1, arg2)
+}
+
+// RemoveUserAPITokens mocks base method.
+func (m *MockTokenState) RemoveUserAPITokens(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserAPITokens", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserAPITokens indicates an expected call of RemoveUserAPITokens.
+func (mr *MockTokenStateMockRecorder) RemoveUserAPITokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserAPITokens", reflect.TypeOf((*MockTokenState)(nil).RemoveUserAPITokens), arg0, arg1)
+}
+
+// MockRoleState is a mock of RoleState interface.
+type MockRoleState struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleStateMockRecorder
+}
+
+// MockRoleStateMockRecorder is the mock recorder for MockRoleState.
+type MockRoleStateMockRecorder struct {
+	mock *MockRoleState
+}
+
+// NewMockRoleState creates a new mock instance.
+func NewMockRoleState(ctrl *gomock.Controller) *MockRoleState {
+	mock := &MockRoleState{ctrl: ctrl}
+	mock.recorder = &MockRoleStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleState) EXPECT() *MockRoleStateMockRecorder {
+	return m.recorder
+}
+
+// AddRole mocks base method.
+func (m *MockRoleState) AddRole(arg0 context.Context, arg1 string, arg2 user.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRole indicates an expected call of AddRole.
+func (mr *MockRoleStateMockRecorder) AddRole(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRole", reflect.TypeOf((*MockRoleState)(nil).AddRole), arg0, arg1, arg2)
+}
+
+// GetRole mocks base method.
+func (m *MockRoleState) GetRole(arg0 context.Context, arg1 string) (user.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", arg0, arg1)
+	ret0, _ := ret[0].(user.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockRoleStateMockRecorder) GetRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockRoleState)(nil).GetRole), arg0, arg1)
+}
+
+// GrantRole mocks base method.
+func (m *MockRoleState) GrantRole(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantRole", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantRole indicates an expected call of GrantRole.
+func (mr *MockRoleStateMockRecorder) GrantRole(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantRole", reflect.TypeOf((*MockRoleState)(nil).GrantRole), arg0, arg1, arg2, arg3)
+}
+
+// ListRoles mocks base method.
+func (m *MockRoleState) ListRoles(arg0 context.Context) ([]user.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", arg0)
+	ret0, _ := ret[0].([]user.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockRoleStateMockRecorder) ListRoles(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRoleState)(nil).ListRoles), arg0)
+}
+
+// RemoveRole mocks base method.
+func (m *MockRoleState) RemoveRole(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRole indicates an expected call of RemoveRole.
+func (mr *MockRoleStateMockRecorder) RemoveRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRole", reflect.TypeOf((*MockRoleState)(nil).RemoveRole), arg0, arg1)
+}
+
+// RemoveUserRoleGrants mocks base method.
+func (m *MockRoleState) RemoveUserRoleGrants(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserRoleGrants", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserRoleGrants indicates an expected call of RemoveUserRoleGrants.
+func (mr *MockRoleStateMockRecorder) RemoveUserRoleGrants(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserRoleGrants", reflect.TypeOf((*MockRoleState)(nil).RemoveUserRoleGrants), arg0, arg1)
+}
+
+// RevokeRole mocks base method.
+func (m *MockRoleState) RevokeRole(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRole", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRole indicates an expected call of RevokeRole.
+func (mr *MockRoleStateMockRecorder) RevokeRole(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRole", reflect.TypeOf((*MockRoleState)(nil).RevokeRole), arg0, arg1, arg2, arg3)
+}
+
+// UserRoleMethods mocks base method.
+func (m *MockRoleState) UserRoleMethods(arg0 context.Context, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserRoleMethods", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserRoleMethods indicates an expected call of UserRoleMethods.
+func (mr *MockRoleStateMockRecorder) UserRoleMethods(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRoleMethods", reflect.TypeOf((*MockRoleState)(nil).UserRoleMethods), arg0, arg1, arg2)
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v3"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+)
+
+// apiTokenSecretLength is the number of random bytes in the secret of an
+// API token.
+const apiTokenSecretLength = 32
+
+// TokenState describes retrieval and persistence methods for the API
+// tokens that users can log in with.
+type TokenState interface {
+	// AddAPIToken adds a new API token with the given UUID and hash of its
+	// secret. If the user already has a token with the same name an error
+	// that satisfies usererrors.APITokenAlreadyExists is returned.
+	AddAPIToken(ctx context.Context, uuid, hash string, token user.APIToken) error
+
+	// GetAPITokenByHash returns the API token with the given hash of its
+	// secret. If no token has the hash an error that satisfies
+	// usererrors.APITokenNotFound is returned.
+	GetAPITokenByHash(ctx context.Context, hash string) (user.APIToken, error)
+
+	// ListAPITokens returns the user's API tokens, ordered by name.
+	ListAPITokens(ctx context.Context, userName string) ([]user.APIToken, error)
+
+	// RemoveAPIToken removes the user's API token with the given name. If
+	// the user has no such token an error that satisfies
+	// usererrors.APITokenNotFound is returned.
+	RemoveAPIToken(ctx context.Context, userName, name string) error
+
+	// RemoveUserAPITokens removes all of the user's API tokens.
+	RemoveUserAPITokens(ctx context.Context, userName string) error
+}
+
+// TokenService provides the API for working with the API tokens that users
+// can log in with instead of their passwords. Only a hash of each token's
+// secret is kept, so the secret can't be recovered once it is minted.
+type TokenService struct {
+	st TokenState
+}
+
+// NewTokenService returns a new TokenService for interacting with the
+// underlying token state.
+func NewTokenService(st TokenState) *TokenService {
+	return &TokenService{st: st}
+}
+
+// AddAPIToken mints a new API token for the user, returning its secret.
+// The token's creation time is set by the service.
+//
+// The following error types are possible from this function:
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+// - usererrors.APITokenNameNotValid: When the token name supplied is not
+// valid.
+// - errors.NotValid: When the model, maximum access or expiry of the token
+// is not valid.
+// - usererrors.APITokenAlreadyExists: If the user already has a token with
+// the same name.
+func (s *TokenService) AddAPIToken(ctx context.Context, token user.APIToken) (string, error) {
+	userName, err := canonicalUserNames([]string{token.UserName})
+	if err != nil {
+		return "", fmt.Errorf("api token %q: %w", token.Name, err)
+	}
+	token.UserName = userName[0]
+	token.CreatedAt = time.Now().UTC()
+	if err := validateAPIToken(token); err != nil {
+		return "", fmt.Errorf("api token %q: %w", token.Name, err)
+	}
+
+	uuid, err := utils.NewUUID()
+	if err != nil {
+		return "", fmt.Errorf("adding api token %q, generating UUID: %w", token.Name, err)
+	}
+	secret, err := generateAPITokenSecret()
+	if err != nil {
+		return "", fmt.Errorf("adding api token %q, generating secret: %w", token.Name, err)
+	}
+	if err := s.st.AddAPIToken(ctx, uuid.String(), hashAPITokenSecret(secret), token); err != nil {
+		return "", fmt.Errorf("adding api token %q: %w", token.Name, err)
+	}
+	return secret, nil
+}
+
+// AuthenticateAPIToken returns the API token with the given secret.
+//
+// The following error types are possible from this function:
+// - usererrors.APITokenNotFound: If no token has the secret.
+// - usererrors.APITokenExpired: If the token has expired.
+func (s *TokenService) AuthenticateAPIToken(ctx context.Context, secret string) (user.APIToken, error) {
+	if !strings.HasPrefix(secret, user.APITokenPrefix) {
+		return user.APIToken{}, fmt.Errorf("api token %w", usererrors.APITokenNotFound)
+	}
+	token, err := s.st.GetAPITokenByHash(ctx, hashAPITokenSecret(secret))
+	if err != nil {
+		return user.APIToken{}, fmt.Errorf("authenticating api token: %w", err)
+	}
+	if !time.Now().Before(token.ExpiresAt) {
+		return user.APIToken{}, fmt.Errorf("api token %q for user %q %w", token.Name, token.UserName, usererrors.APITokenExpired)
+	}
+	return token, nil
+}
+
+// ListAPITokens returns the user's API tokens, ordered by name, including
+// those that have expired.
+//
+// The following error types are possible from this function:
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+func (s *TokenService) ListAPITokens(ctx context.Context, userName string) ([]user.APIToken, error) {
+	canonical, err := canonicalUserNames([]string{userName})
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.st.ListAPITokens(ctx, canonical[0])
+	if err != nil {
+		return nil, fmt.Errorf("listing api tokens for user %q: %w", userName, err)
+	}
+	return tokens, nil
+}
+
+// RemoveAPIToken removes the user's API token with the given name, so that
+// it can no longer be used to log in.
+//
+// The following error types are possible from this function:
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+// - usererrors.APITokenNotFound: If the user has no token with the name.
+func (s *TokenService) RemoveAPIToken(ctx context.Context, userName, name string) error {
+	canonical, err := canonicalUserNames([]string{userName})
+	if err != nil {
+		return err
+	}
+	if err := s.st.RemoveAPIToken(ctx, canonical[0], name); err != nil {
+		return fmt.Errorf("removing api token %q: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveUserAPITokens removes all of the user's API tokens. It is called
+// when the user is removed, so that a user later added with the same name
+// can't log in with the removed user's tokens.
+//
+// The following error types are possible from this function:
+// - usererrors.UsernameNotValid: When the user name supplied is not valid.
+func (s *TokenService) RemoveUserAPITokens(ctx context.Context, userName string) error {
+	canonical, err := canonicalUserNames([]string{userName})
+	if err != nil {
+		return err
+	}
+	if err := s.st.RemoveUserAPITokens(ctx, canonical[0]); err != nil {
+		return fmt.Errorf("removing api tokens for user %q: %w", userName, err)
+	}
+	return nil
+}
+
+// validateAPIToken checks that a token being minted is valid. A token
+// scoped to a model can allow at most admin access to it, while other
+// tokens can also allow superuser access to the controller.
+func validateAPIToken(token user.APIToken) error {
+	if !validUserName.MatchString(token.Name) {
+		return fmt.Errorf("%w %q", usererrors.APITokenNameNotValid, token.Name)
+	}
+	if token.ModelUUID != "" && !utils.IsValidUUIDString(token.ModelUUID) {
+		return errors.NotValidf("model UUID %q", token.ModelUUID)
+	}
+	switch token.MaxAccess {
+	case permission.ReadAccess, permission.WriteAccess, permission.AdminAccess:
+	case permission.SuperuserAccess:
+		if token.ModelUUID != "" {
+			return errors.NotValidf("%q access for a model scoped token", token.MaxAccess)
+		}
+	default:
+		return errors.NotValidf("maximum access %q", token.MaxAccess)
+	}
+	if !token.ExpiresAt.After(token.CreatedAt) {
+		return errors.NotValidf("expiry time %s in the past", token.ExpiresAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// generateAPITokenSecret returns a new random secret for an API token.
+func generateAPITokenSecret() (string, error) {
+	var secret [apiTokenSecretLength]byte
+	if _, err := rand.Read(secret[:]); err != nil {
+		return "", err
+	}
+	return user.APITokenPrefix + hex.EncodeToString(secret[:]), nil
+}
+
+// hashAPITokenSecret returns the hash of the secret that is stored in place
+// of it. The secret is random and long enough that it needs no salt.
+func hashAPITokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+)
+
+type tokenServiceSuite struct {
+	state *MockTokenState
+}
+
+var _ = gc.Suite(&tokenServiceSuite{})
+
+func (s *tokenServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockTokenState(ctrl)
+	return ctrl
+}
+
+func (s *tokenServiceSuite) service() *TokenService {
+	return NewTokenService(s.state)
+}
+
+func (s *tokenServiceSuite) TestAddAPIToken(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expires := time.Now().Add(time.Hour)
+	var hash string
+	s.state.EXPECT().AddAPIToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, uuid, h string, token user.APIToken) error {
+			c.Check(uuid, gc.Not(gc.Equals), "")
+			hash = h
+			// Local users are recorded without their domain.
+			c.Check(token.UserName, gc.Equals, "bob")
+			c.Check(token.Name, gc.Equals, "ci")
+			c.Check(token.ModelUUID, gc.Equals, modelID.Key)
+			c.Check(token.MaxAccess, gc.Equals, permission.WriteAccess)
+			c.Check(token.ExpiresAt, gc.Equals, expires)
+			c.Check(token.CreatedAt.IsZero(), jc.IsFalse)
+			return nil
+		})
+
+	secret, err := s.service().AddAPIToken(context.Background(), user.APIToken{
+		Name:      "ci",
+		UserName:  "bob@local",
+		ModelUUID: modelID.Key,
+		MaxAccess: permission.WriteAccess,
+		ExpiresAt: expires,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(strings.HasPrefix(secret, user.APITokenPrefix), jc.IsTrue)
+	// Only the hash of the secret is stored.
+	c.Check(hash, gc.Not(gc.Equals), "")
+	c.Check(strings.Contains(secret, hash), jc.IsFalse)
+	c.Check(hash, gc.Equals, hashAPITokenSecret(secret))
+}
+
+func (s *tokenServiceSuite) TestAddAPITokenNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	valid := user.APIToken{
+		Name:      "ci",
+		UserName:  "bob",
+		MaxAccess: permission.ReadAccess,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	for i, test := range []struct {
+		modify func(*user.APIToken)
+		err    string
+		is     error
+	}{{
+		modify: func(t *user.APIToken) { t.Name = "-ci" },
+		is:     usererrors.APITokenNameNotValid,
+	}, {
+		modify: func(t *user.APIToken) { t.UserName = "not@valid@user" },
+		is:     usererrors.UsernameNotValid,
+	}, {
+		modify: func(t *user.APIToken) { t.ModelUUID = "not-a-uuid" },
+		err:    `api token "ci": model UUID "not-a-uuid" not valid`,
+	}, {
+		modify: func(t *user.APIToken) { t.MaxAccess = permission.ConsumeAccess },
+		err:    `api token "ci": maximum access "consume" not valid`,
+	}, {
+		modify: func(t *user.APIToken) {
+			t.ModelUUID = modelID.Key
+			t.MaxAccess = permission.SuperuserAccess
+		},
+		err: `api token "ci": "superuser" access for a model scoped token not valid`,
+	}, {
+		modify: func(t *user.APIToken) { t.ExpiresAt = time.Now().Add(-time.Hour) },
+		err:    `api token "ci": expiry time .* in the past not valid`,
+	}} {
+		c.Logf("test %d", i)
+		token := valid
+		test.modify(&token)
+		_, err := s.service().AddAPIToken(context.Background(), token)
+		if test.is != nil {
+			c.Check(err, jc.ErrorIs, test.is)
+		} else {
+			c.Check(err, gc.ErrorMatches, test.err)
+			c.Check(err, jc.ErrorIs, errors.NotValid)
+		}
+	}
+}
+
+func (s *tokenServiceSuite) TestAuthenticateAPIToken(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	secret := user.APITokenPrefix + "deadbeef"
+	token := user.APIToken{Name: "ci", UserName: "bob", ExpiresAt: time.Now().Add(time.Hour)}
+	s.state.EXPECT().GetAPITokenByHash(gomock.Any(), hashAPITokenSecret(secret)).Return(token, nil)
+
+	got, err := s.service().AuthenticateAPIToken(context.Background(), secret)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, jc.DeepEquals, token)
+}
+
+func (s *tokenServiceSuite) TestAuthenticateAPITokenExpired(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	secret := user.APITokenPrefix + "deadbeef"
+	token := user.APIToken{Name: "ci", UserName: "bob", ExpiresAt: time.Now().Add(-time.Minute)}
+	s.state.EXPECT().GetAPITokenByHash(gomock.Any(), hashAPITokenSecret(secret)).Return(token, nil)
+
+	_, err := s.service().AuthenticateAPIToken(context.Background(), secret)
+	c.Check(err, jc.ErrorIs, usererrors.APITokenExpired)
+}
+
+func (s *tokenServiceSuite) TestAuthenticateAPITokenNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetAPITokenByHash(gomock.Any(), gomock.Any()).Return(user.APIToken{}, usererrors.APITokenNotFound)
+
+	_, err := s.service().AuthenticateAPIToken(context.Background(), user.APITokenPrefix+"deadbeef")
+	c.Check(err, jc.ErrorIs, usererrors.APITokenNotFound)
+
+	// Secrets without the prefix aren't looked up.
+	_, err = s.service().AuthenticateAPIToken(context.Background(), "deadbeef")
+	c.Check(err, jc.ErrorIs, usererrors.APITokenNotFound)
+}
+
+func (s *tokenServiceSuite) TestListAPITokens(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	tokens := []user.APIToken{{Name: "ci", UserName: "bob"}}
+	s.state.EXPECT().ListAPITokens(gomock.Any(), "bob").Return(tokens, nil)
+
+	got, err := s.service().ListAPITokens(context.Background(), "bob@local")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, jc.DeepEquals, tokens)
+}
+
+func (s *tokenServiceSuite) TestRemoveAPIToken(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RemoveAPIToken(gomock.Any(), "mary@external", "ci").Return(usererrors.APITokenNotFound)
+
+	err := s.service().RemoveAPIToken(context.Background(), "mary@external", "ci")
+	c.Check(err, jc.ErrorIs, usererrors.APITokenNotFound)
+}
+
+func (s *tokenServiceSuite) TestRemoveUserAPITokens(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RemoveUserAPITokens(gomock.Any(), "mary@external").Return(nil)
+
+	err := s.service().RemoveUserAPITokens(context.Background(), "mary@external")
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *tokenServiceSuite) TestRemoveUserAPITokensInvalidName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service().RemoveUserAPITokens(context.Background(), "not valid!")
+	c.Check(err, jc.ErrorIs, usererrors.UsernameNotValid)
+}
@@ -0,0 +1,407 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/internal/database"
+)
+
+// AddGroup adds a new group with the given UUID and its members. If a
+// group with the same name already exists an error that satisfies
+// usererrors.GroupAlreadyExists is returned.
+func (st *State) AddGroup(ctx context.Context, uuid string, group user.Group) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	insertGroupStmt := `
+INSERT INTO user_group (uuid, name, created_by, created_at)
+VALUES (?, ?, ?, ?)
+`
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, insertGroupStmt, uuid, group.Name, group.CreatorName, group.CreatedAt)
+		if database.IsErrConstraintUnique(err) {
+			return fmt.Errorf("group %q %w", group.Name, usererrors.GroupAlreadyExists)
+		} else if err != nil {
+			return fmt.Errorf("adding group %q: %w", group.Name, err)
+		}
+		return errors.Trace(addGroupMembers(ctx, tx, uuid, group.Members))
+	})
+}
+
+// RemoveGroup removes the group, its members and the access granted to
+// it. If no group exists for the given name an error that satisfies
+// usererrors.GroupNotFound is returned.
+func (st *State) RemoveGroup(ctx context.Context, name string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		uuid, err := groupUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, stmt := range []string{
+			"DELETE FROM user_group_permission WHERE group_uuid = ?",
+			"DELETE FROM user_group_member WHERE group_uuid = ?",
+			"DELETE FROM user_group WHERE uuid = ?",
+		} {
+			if _, err := tx.ExecContext(ctx, stmt, uuid); err != nil {
+				return fmt.Errorf("removing group %q: %w", name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetGroup returns the group with the given name. If no group exists for
+// the name an error that satisfies usererrors.GroupNotFound is returned.
+func (st *State) GetGroup(ctx context.Context, name string) (user.Group, error) {
+	db, err := st.DB()
+	if err != nil {
+		return user.Group{}, errors.Trace(err)
+	}
+
+	var groups []user.Group
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		groups, err = loadGroups(ctx, tx, name)
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return user.Group{}, errors.Trace(err)
+	}
+	if len(groups) == 0 {
+		return user.Group{}, fmt.Errorf("group %q %w", name, usererrors.GroupNotFound)
+	}
+	return groups[0], nil
+}
+
+// ListGroups returns all the groups, ordered by name.
+func (st *State) ListGroups(ctx context.Context) ([]user.Group, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var groups []user.Group
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		groups, err = loadGroups(ctx, tx, "")
+		return errors.Trace(err)
+	})
+	return groups, errors.Trace(err)
+}
+
+// AddGroupMembers adds the users to the group. Users that are already
+// members are ignored. If no group exists for the given name an error that
+// satisfies usererrors.GroupNotFound is returned.
+func (st *State) AddGroupMembers(ctx context.Context, name string, members []string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		uuid, err := groupUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Trace(addGroupMembers(ctx, tx, uuid, members))
+	})
+}
+
+// RemoveGroupMembers removes the users from the group. Users that aren't
+// members are ignored. If no group exists for the given name an error
+// that satisfies usererrors.GroupNotFound is returned.
+func (st *State) RemoveGroupMembers(ctx context.Context, name string, members []string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	binds, vals := database.SliceToPlaceholder(members)
+	deleteStmt := fmt.Sprintf(`
+DELETE FROM user_group_member
+WHERE       group_uuid = ?
+AND         user_name IN (%s)
+`, binds)
+
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		uuid, err := groupUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		if _, err := tx.ExecContext(ctx, deleteStmt, append([]any{uuid}, vals...)...); err != nil {
+			return fmt.Errorf("removing members from group %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// GroupAccess returns the access granted to the group on the target. If
+// no group exists for the given name an error that satisfies
+// usererrors.GroupNotFound is returned, and if the group has no access to
+// the target one that satisfies usererrors.GroupAccessNotFound.
+func (st *State) GroupAccess(ctx context.Context, name string, target permission.ID) (permission.Access, error) {
+	db, err := st.DB()
+	if err != nil {
+		return permission.NoAccess, errors.Trace(err)
+	}
+
+	var access permission.Access
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		uuid, err := groupUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		access, err = groupAccess(ctx, tx, uuid, name, target)
+		return errors.Trace(err)
+	})
+	return access, errors.Trace(err)
+}
+
+// GrantGroupAccess grants the group the access to the target, unless it
+// has been granted that access or greater already, in which case an error
+// that satisfies usererrors.GroupAccessAlreadyGranted is returned. If no
+// group exists for the given name an error that satisfies
+// usererrors.GroupNotFound is returned.
+func (st *State) GrantGroupAccess(ctx context.Context, name string, target permission.ID, access permission.Access) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		uuid, err := groupUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		current, err := groupAccess(ctx, tx, uuid, name, target)
+		if err == nil && target.ObjectType.HighestAccess(current, access) == current {
+			return fmt.Errorf("group %q already has %q access or greater: %w", name, current, usererrors.GroupAccessAlreadyGranted)
+		} else if err != nil && !errors.Is(err, usererrors.GroupAccessNotFound) {
+			return errors.Trace(err)
+		}
+		return errors.Trace(setGroupAccess(ctx, tx, uuid, name, target, access))
+	})
+}
+
+// RevokeGroupAccess reduces the access granted to the group on the target
+// to the remaining access, removing it if that is NoAccess. Access which
+// is no greater than the remaining access is left as it is. If no group
+// exists for the given name an error that satisfies
+// usererrors.GroupNotFound is returned, and if the group has no access to
+// the target one that satisfies usererrors.GroupAccessNotFound.
+func (st *State) RevokeGroupAccess(ctx context.Context, name string, target permission.ID, remaining permission.Access) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	deleteStmt := `
+DELETE FROM user_group_permission
+WHERE       group_uuid = ?
+AND         object_type_id = (SELECT id FROM permission_object_type WHERE type = ?)
+AND         grant_on = ?
+`
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		uuid, err := groupUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		current, err := groupAccess(ctx, tx, uuid, name, target)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if target.ObjectType.HighestAccess(current, remaining) == remaining {
+			// The group doesn't have the access being revoked.
+			return nil
+		}
+		if remaining != permission.NoAccess {
+			return errors.Trace(setGroupAccess(ctx, tx, uuid, name, target, remaining))
+		}
+		if _, err := tx.ExecContext(ctx, deleteStmt, uuid, target.ObjectType, target.Key); err != nil {
+			return fmt.Errorf("removing group %q access: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// RemoveUserMemberships removes the user from all the groups they are a
+// member of, so that a user later added with the same name doesn't
+// inherit their memberships.
+func (st *State) RemoveUserMemberships(ctx context.Context, userName string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_group_member WHERE user_name = ?", userName); err != nil {
+			return fmt.Errorf("removing user %q group memberships: %w", userName, err)
+		}
+		return nil
+	})
+}
+
+// UserGroupAccess returns the access granted on the target to each of the
+// groups the user is a member of that has any.
+func (st *State) UserGroupAccess(ctx context.Context, userName string, target permission.ID) ([]permission.Access, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	selectStmt := `
+SELECT  p.access
+FROM    user_group_permission p
+        INNER JOIN permission_object_type t ON p.object_type_id = t.id
+        INNER JOIN user_group_member m ON p.group_uuid = m.group_uuid
+WHERE   m.user_name = ?
+AND     t.type = ?
+AND     p.grant_on = ?
+`
+	var accesses []permission.Access
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, selectStmt, userName, target.ObjectType, target.Key)
+		if err != nil {
+			return fmt.Errorf("fetching user %q group access: %w", userName, err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var access permission.Access
+			if err := rows.Scan(&access); err != nil {
+				return fmt.Errorf("fetching user %q group access: %w", userName, err)
+			}
+			accesses = append(accesses, access)
+		}
+		return errors.Trace(rows.Err())
+	})
+	return accesses, errors.Trace(err)
+}
+
+func groupUUID(ctx context.Context, tx *sql.Tx, name string) (string, error) {
+	var uuid string
+	row := tx.QueryRowContext(ctx, "SELECT uuid FROM user_group WHERE name = ?", name)
+	if err := row.Scan(&uuid); errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("group %q %w", name, usererrors.GroupNotFound)
+	} else if err != nil {
+		return "", fmt.Errorf("fetching group %q: %w", name, err)
+	}
+	return uuid, nil
+}
+
+func groupAccess(ctx context.Context, tx *sql.Tx, uuid, name string, target permission.ID) (permission.Access, error) {
+	selectStmt := `
+SELECT  p.access
+FROM    user_group_permission p
+        INNER JOIN permission_object_type t ON p.object_type_id = t.id
+WHERE   p.group_uuid = ?
+AND     t.type = ?
+AND     p.grant_on = ?
+`
+	var access permission.Access
+	row := tx.QueryRowContext(ctx, selectStmt, uuid, target.ObjectType, target.Key)
+	if err := row.Scan(&access); errors.Is(err, sql.ErrNoRows) {
+		return permission.NoAccess, fmt.Errorf("group %q access to %s %q %w", name, target.ObjectType, target.Key, usererrors.GroupAccessNotFound)
+	} else if err != nil {
+		return permission.NoAccess, fmt.Errorf("fetching group %q access: %w", name, err)
+	}
+	return access, nil
+}
+
+func setGroupAccess(ctx context.Context, tx *sql.Tx, uuid, name string, target permission.ID, access permission.Access) error {
+	upsertStmt := `
+INSERT INTO user_group_permission (group_uuid, object_type_id, grant_on, access)
+SELECT ?, id, ?, ?
+FROM   permission_object_type
+WHERE  type = ?
+ON CONFLICT(group_uuid, object_type_id, grant_on) DO UPDATE
+    SET access = excluded.access
+`
+	res, err := tx.ExecContext(ctx, upsertStmt, uuid, target.Key, access, target.ObjectType)
+	if err != nil {
+		return fmt.Errorf("setting group %q access: %w", name, err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("setting group %q access: %w", name, err)
+	} else if n == 0 {
+		return errors.NotValidf("object type %q", target.ObjectType)
+	}
+	return nil
+}
+
+func addGroupMembers(ctx context.Context, tx *sql.Tx, uuid string, members []string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	insertStmt := fmt.Sprintf(`
+INSERT INTO user_group_member (group_uuid, user_name)
+VALUES %s
+ON CONFLICT DO NOTHING
+`, database.MakeBindArgs(2, len(members)))
+	vals := make([]any, 0, len(members)*2)
+	for _, member := range members {
+		vals = append(vals, uuid, member)
+	}
+	if _, err := tx.ExecContext(ctx, insertStmt, vals...); err != nil {
+		return fmt.Errorf("adding group members: %w", err)
+	}
+	return nil
+}
+
+// loadGroups returns the group with the given name, or all groups if the
+// name is empty.
+func loadGroups(ctx context.Context, tx *sql.Tx, name string) ([]user.Group, error) {
+	selectStmt := `
+SELECT    g.name, g.created_by, g.created_at, m.user_name
+FROM      user_group g
+          LEFT JOIN user_group_member m ON g.uuid = m.group_uuid
+WHERE     ? = '' OR g.name = ?
+ORDER BY  g.name, m.user_name
+`
+	rows, err := tx.QueryContext(ctx, selectStmt, name, name)
+	if err != nil {
+		return nil, fmt.Errorf("fetching groups: %w", err)
+	}
+
+	var groups []user.Group
+	for rows.Next() {
+		var (
+			group  user.Group
+			member sql.NullString
+		)
+		if err := rows.Scan(&group.Name, &group.CreatorName, &group.CreatedAt, &member); err != nil {
+			return nil, fmt.Errorf("fetching groups: %w", stderrors.Join(err, rows.Close()))
+		}
+		if n := len(groups); n == 0 || groups[n-1].Name != group.Name {
+			groups = append(groups, group)
+		}
+		if member.Valid {
+			last := &groups[len(groups)-1]
+			last.Members = append(last.Members, member.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("fetching groups: %w", err)
+	}
+	return groups, nil
+}
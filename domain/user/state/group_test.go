@@ -0,0 +1,217 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/internal/changestream/testing"
+)
+
+type groupSuite struct {
+	testing.ControllerSuite
+
+	state *State
+}
+
+var _ = gc.Suite(&groupSuite{})
+
+var (
+	modelID      = permission.ID{ObjectType: permission.Model, Key: "deadbeef-0bad-400d-8000-4b1d0d06f00d"}
+	controllerID = permission.ID{ObjectType: permission.Controller, Key: "deadbeef-1bad-500d-9000-4b1d0d06f00d"}
+)
+
+func (s *groupSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.state = NewState(s.TxnRunnerFactory())
+}
+
+func (s *groupSuite) addGroup(c *gc.C, name string, members ...string) {
+	err := s.state.AddGroup(context.Background(), name+"-uuid", user.Group{
+		Name:        name,
+		Members:     members,
+		CreatorName: "admin",
+		CreatedAt:   time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *groupSuite) TestAddGroup(c *gc.C) {
+	s.addGroup(c, "engineers", "mary@external", "bob")
+
+	group, err := s.state.GetGroup(context.Background(), "engineers")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(group, jc.DeepEquals, user.Group{
+		Name:        "engineers",
+		Members:     []string{"bob", "mary@external"},
+		CreatorName: "admin",
+		CreatedAt:   time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	})
+
+	err = s.state.AddGroup(context.Background(), "other-uuid", user.Group{Name: "engineers"})
+	c.Check(err, jc.ErrorIs, usererrors.GroupAlreadyExists)
+}
+
+func (s *groupSuite) TestGetGroupNotFound(c *gc.C) {
+	_, err := s.state.GetGroup(context.Background(), "engineers")
+	c.Check(err, jc.ErrorIs, usererrors.GroupNotFound)
+}
+
+func (s *groupSuite) TestListGroups(c *gc.C) {
+	s.addGroup(c, "operators", "jim")
+	s.addGroup(c, "engineers")
+
+	groups, err := s.state.ListGroups(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(groups, gc.HasLen, 2)
+	c.Check(groups[0].Name, gc.Equals, "engineers")
+	c.Check(groups[0].Members, gc.HasLen, 0)
+	c.Check(groups[1].Name, gc.Equals, "operators")
+	c.Check(groups[1].Members, jc.DeepEquals, []string{"jim"})
+}
+
+func (s *groupSuite) TestGroupMembers(c *gc.C) {
+	s.addGroup(c, "engineers", "bob")
+	ctx := context.Background()
+
+	err := s.state.AddGroupMembers(ctx, "engineers", []string{"bob", "jim", "mary@external"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.RemoveGroupMembers(ctx, "engineers", []string{"bob", "sam"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	group, err := s.state.GetGroup(ctx, "engineers")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(group.Members, jc.DeepEquals, []string{"jim", "mary@external"})
+
+	err = s.state.AddGroupMembers(ctx, "operators", []string{"bob"})
+	c.Check(err, jc.ErrorIs, usererrors.GroupNotFound)
+	err = s.state.RemoveGroupMembers(ctx, "operators", []string{"bob"})
+	c.Check(err, jc.ErrorIs, usererrors.GroupNotFound)
+}
+
+func (s *groupSuite) TestGroupAccess(c *gc.C) {
+	s.addGroup(c, "engineers")
+	ctx := context.Background()
+
+	_, err := s.state.GroupAccess(ctx, "engineers", modelID)
+	c.Check(err, jc.ErrorIs, usererrors.GroupAccessNotFound)
+
+	err = s.state.GrantGroupAccess(ctx, "engineers", modelID, permission.ReadAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.GrantGroupAccess(ctx, "engineers", modelID, permission.WriteAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	access, err := s.state.GroupAccess(ctx, "engineers", modelID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.WriteAccess)
+
+	err = s.state.GrantGroupAccess(ctx, "engineers", modelID, permission.ReadAccess)
+	c.Check(err, gc.ErrorMatches, `group "engineers" already has "write" access or greater: group access already granted`)
+	c.Check(err, jc.ErrorIs, usererrors.GroupAccessAlreadyGranted)
+
+	// Access to one object doesn't leak to another.
+	_, err = s.state.GroupAccess(ctx, "engineers", controllerID)
+	c.Check(err, jc.ErrorIs, usererrors.GroupAccessNotFound)
+
+	err = s.state.GrantGroupAccess(ctx, "operators", modelID, permission.ReadAccess)
+	c.Check(err, jc.ErrorIs, usererrors.GroupNotFound)
+}
+
+func (s *groupSuite) TestRevokeGroupAccess(c *gc.C) {
+	s.addGroup(c, "engineers")
+	ctx := context.Background()
+
+	err := s.state.GrantGroupAccess(ctx, "engineers", modelID, permission.WriteAccess)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Revoking access the group doesn't have leaves its access alone.
+	err = s.state.RevokeGroupAccess(ctx, "engineers", modelID, permission.WriteAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	access, err := s.state.GroupAccess(ctx, "engineers", modelID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.WriteAccess)
+
+	err = s.state.RevokeGroupAccess(ctx, "engineers", modelID, permission.ReadAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	access, err = s.state.GroupAccess(ctx, "engineers", modelID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.ReadAccess)
+
+	err = s.state.RevokeGroupAccess(ctx, "engineers", modelID, permission.NoAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.state.GroupAccess(ctx, "engineers", modelID)
+	c.Check(err, jc.ErrorIs, usererrors.GroupAccessNotFound)
+	err = s.state.RevokeGroupAccess(ctx, "engineers", modelID, permission.NoAccess)
+	c.Check(err, jc.ErrorIs, usererrors.GroupAccessNotFound)
+}
+
+func (s *groupSuite) TestRemoveUserMemberships(c *gc.C) {
+	s.addGroup(c, "engineers", "bob", "jim")
+	s.addGroup(c, "operators", "bob")
+	ctx := context.Background()
+
+	err := s.state.RemoveUserMemberships(ctx, "bob")
+	c.Assert(err, jc.ErrorIsNil)
+
+	groups, err := s.state.ListGroups(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(groups, gc.HasLen, 2)
+	c.Check(groups[0].Members, jc.DeepEquals, []string{"jim"})
+	c.Check(groups[1].Members, gc.HasLen, 0)
+}
+
+func (s *groupSuite) TestUserGroupAccess(c *gc.C) {
+	s.addGroup(c, "engineers", "bob", "mary@external")
+	s.addGroup(c, "operators", "bob")
+	s.addGroup(c, "admins", "jim")
+	ctx := context.Background()
+
+	for group, access := range map[string]permission.Access{
+		"engineers": permission.ReadAccess,
+		"operators": permission.WriteAccess,
+		"admins":    permission.AdminAccess,
+	} {
+		err := s.state.GrantGroupAccess(ctx, group, modelID, access)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	accesses, err := s.state.UserGroupAccess(ctx, "bob", modelID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(accesses, jc.SameContents, []permission.Access{permission.ReadAccess, permission.WriteAccess})
+
+	accesses, err = s.state.UserGroupAccess(ctx, "mary@external", modelID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(accesses, jc.DeepEquals, []permission.Access{permission.ReadAccess})
+
+	accesses, err = s.state.UserGroupAccess(ctx, "bob", controllerID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(accesses, gc.HasLen, 0)
+}
+
+func (s *groupSuite) TestRemoveGroup(c *gc.C) {
+	s.addGroup(c, "engineers", "bob")
+	ctx := context.Background()
+	err := s.state.GrantGroupAccess(ctx, "engineers", modelID, permission.ReadAccess)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.RemoveGroup(ctx, "engineers")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.state.GetGroup(ctx, "engineers")
+	c.Check(err, jc.ErrorIs, usererrors.GroupNotFound)
+	accesses, err := s.state.UserGroupAccess(ctx, "bob", modelID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(accesses, gc.HasLen, 0)
+
+	// The name can be reused.
+	s.addGroup(c, "engineers")
+
+	err = s.state.RemoveGroup(ctx, "operators")
+	c.Check(err, jc.ErrorIs, usererrors.GroupNotFound)
+}
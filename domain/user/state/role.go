@@ -0,0 +1,287 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/internal/database"
+)
+
+// AddRole adds a new role with the given UUID and its methods. If a role
+// with the same name already exists an error that satisfies
+// usererrors.RoleAlreadyExists is returned.
+func (st *State) AddRole(ctx context.Context, uuid string, role user.Role) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	insertRoleStmt := `
+INSERT INTO user_role (uuid, name, created_by, created_at)
+VALUES (?, ?, ?, ?)
+`
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, insertRoleStmt, uuid, role.Name, role.CreatorName, role.CreatedAt)
+		if database.IsErrConstraintUnique(err) {
+			return fmt.Errorf("role %q %w", role.Name, usererrors.RoleAlreadyExists)
+		} else if err != nil {
+			return fmt.Errorf("adding role %q: %w", role.Name, err)
+		}
+		if len(role.Methods) == 0 {
+			return nil
+		}
+
+		insertMethodsStmt := fmt.Sprintf(`
+INSERT INTO user_role_method (role_uuid, facade, method)
+VALUES %s
+ON CONFLICT DO NOTHING
+`, database.MakeBindArgs(3, len(role.Methods)))
+		vals := make([]any, 0, len(role.Methods)*3)
+		for _, roleMethod := range role.Methods {
+			facade, method, _ := strings.Cut(roleMethod, ".")
+			vals = append(vals, uuid, facade, method)
+		}
+		if _, err := tx.ExecContext(ctx, insertMethodsStmt, vals...); err != nil {
+			return fmt.Errorf("adding role %q methods: %w", role.Name, err)
+		}
+		return nil
+	})
+}
+
+// RemoveRole removes the role, its methods and its grants to users. If no
+// role exists for the given name an error that satisfies
+// usererrors.RoleNotFound is returned.
+func (st *State) RemoveRole(ctx context.Context, name string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		uuid, err := roleUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, stmt := range []string{
+			"DELETE FROM user_role_grant WHERE role_uuid = ?",
+			"DELETE FROM user_role_method WHERE role_uuid = ?",
+			"DELETE FROM user_role WHERE uuid = ?",
+		} {
+			if _, err := tx.ExecContext(ctx, stmt, uuid); err != nil {
+				return fmt.Errorf("removing role %q: %w", name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetRole returns the role with the given name. If no role exists for the
+// name an error that satisfies usererrors.RoleNotFound is returned.
+func (st *State) GetRole(ctx context.Context, name string) (user.Role, error) {
+	db, err := st.DB()
+	if err != nil {
+		return user.Role{}, errors.Trace(err)
+	}
+
+	var roles []user.Role
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		roles, err = loadRoles(ctx, tx, name)
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return user.Role{}, errors.Trace(err)
+	}
+	if len(roles) == 0 {
+		return user.Role{}, fmt.Errorf("role %q %w", name, usererrors.RoleNotFound)
+	}
+	return roles[0], nil
+}
+
+// ListRoles returns all the roles, ordered by name.
+func (st *State) ListRoles(ctx context.Context) ([]user.Role, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var roles []user.Role
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		roles, err = loadRoles(ctx, tx, "")
+		return errors.Trace(err)
+	})
+	return roles, errors.Trace(err)
+}
+
+// GrantRole grants the role to the user on the model. Granting a role the
+// user already has is not an error. If no role exists for the given name
+// an error that satisfies usererrors.RoleNotFound is returned.
+func (st *State) GrantRole(ctx context.Context, name, userName, modelUUID string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	insertStmt := `
+INSERT INTO user_role_grant (role_uuid, user_name, model_uuid)
+VALUES (?, ?, ?)
+ON CONFLICT DO NOTHING
+`
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		uuid, err := roleUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if _, err := tx.ExecContext(ctx, insertStmt, uuid, userName, modelUUID); err != nil {
+			return fmt.Errorf("granting role %q to user %q: %w", name, userName, err)
+		}
+		return nil
+	})
+}
+
+// RevokeRole revokes the role from the user on the model. If no role
+// exists for the given name an error that satisfies usererrors.RoleNotFound
+// is returned, and if the user hasn't been granted the role on the model
+// one that satisfies usererrors.RoleGrantNotFound.
+func (st *State) RevokeRole(ctx context.Context, name, userName, modelUUID string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	deleteStmt := `
+DELETE FROM user_role_grant
+WHERE       role_uuid = ?
+AND         user_name = ?
+AND         model_uuid = ?
+`
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		uuid, err := roleUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		res, err := tx.ExecContext(ctx, deleteStmt, uuid, userName, modelUUID)
+		if err != nil {
+			return fmt.Errorf("revoking role %q from user %q: %w", name, userName, err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return fmt.Errorf("revoking role %q from user %q: %w", name, userName, err)
+		} else if n == 0 {
+			return fmt.Errorf("role %q for user %q on model %q %w", name, userName, modelUUID, usererrors.RoleGrantNotFound)
+		}
+		return nil
+	})
+}
+
+// RemoveUserRoleGrants revokes every role granted to the user on any
+// model, so that a user later added with the same name doesn't inherit
+// them.
+func (st *State) RemoveUserRoleGrants(ctx context.Context, userName string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_role_grant WHERE user_name = ?", userName); err != nil {
+			return fmt.Errorf("removing user %q role grants: %w", userName, err)
+		}
+		return nil
+	})
+}
+
+// UserRoleMethods returns the methods, of the form Facade.Method, allowed
+// by the roles granted to the user on the model, ordered and without
+// duplicates.
+func (st *State) UserRoleMethods(ctx context.Context, userName, modelUUID string) ([]string, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	selectStmt := `
+SELECT DISTINCT  m.facade, m.method
+FROM             user_role_method m
+                 INNER JOIN user_role_grant g ON m.role_uuid = g.role_uuid
+WHERE            g.user_name = ?
+AND              g.model_uuid = ?
+ORDER BY         m.facade, m.method
+`
+	var methods []string
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, selectStmt, userName, modelUUID)
+		if err != nil {
+			return fmt.Errorf("fetching user %q role methods: %w", userName, err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var facade, method string
+			if err := rows.Scan(&facade, &method); err != nil {
+				return fmt.Errorf("fetching user %q role methods: %w", userName, err)
+			}
+			methods = append(methods, facade+"."+method)
+		}
+		return errors.Trace(rows.Err())
+	})
+	return methods, errors.Trace(err)
+}
+
+func roleUUID(ctx context.Context, tx *sql.Tx, name string) (string, error) {
+	var uuid string
+	row := tx.QueryRowContext(ctx, "SELECT uuid FROM user_role WHERE name = ?", name)
+	if err := row.Scan(&uuid); errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("role %q %w", name, usererrors.RoleNotFound)
+	} else if err != nil {
+		return "", fmt.Errorf("fetching role %q: %w", name, err)
+	}
+	return uuid, nil
+}
+
+// loadRoles returns the role with the given name, or all roles if the
+// name is empty.
+func loadRoles(ctx context.Context, tx *sql.Tx, name string) ([]user.Role, error) {
+	selectStmt := `
+SELECT    r.name, r.created_by, r.created_at, m.facade, m.method
+FROM      user_role r
+          LEFT JOIN user_role_method m ON r.uuid = m.role_uuid
+WHERE     ? = '' OR r.name = ?
+ORDER BY  r.name, m.facade, m.method
+`
+	rows, err := tx.QueryContext(ctx, selectStmt, name, name)
+	if err != nil {
+		return nil, fmt.Errorf("fetching roles: %w", err)
+	}
+
+	var roles []user.Role
+	for rows.Next() {
+		var (
+			role           user.Role
+			facade, method sql.NullString
+		)
+		if err := rows.Scan(&role.Name, &role.CreatorName, &role.CreatedAt, &facade, &method); err != nil {
+			return nil, fmt.Errorf("fetching roles: %w", stderrors.Join(err, rows.Close()))
+		}
+		if n := len(roles); n == 0 || roles[n-1].Name != role.Name {
+			roles = append(roles, role)
+		}
+		if facade.Valid {
+			last := &roles[len(roles)-1]
+			last.Methods = append(last.Methods, facade.String+"."+method.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("fetching roles: %w", err)
+	}
+	return roles, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/internal/changestream/testing"
+)
+
+type roleSuite struct {
+	testing.ControllerSuite
+
+	state *State
+}
+
+var _ = gc.Suite(&roleSuite{})
+
+func (s *roleSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.state = NewState(s.TxnRunnerFactory())
+}
+
+func (s *roleSuite) addRole(c *gc.C, name string, methods ...string) {
+	err := s.state.AddRole(context.Background(), name+"-uuid", user.Role{
+		Name:        name,
+		Methods:     methods,
+		CreatorName: "admin",
+		CreatedAt:   time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleSuite) TestAddRole(c *gc.C) {
+	s.addRole(c, "support", "Action.EnqueueOperation", "Action.*", "Action.EnqueueOperation")
+
+	role, err := s.state.GetRole(context.Background(), "support")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(role, jc.DeepEquals, user.Role{
+		Name:        "support",
+		Methods:     []string{"Action.*", "Action.EnqueueOperation"},
+		CreatorName: "admin",
+		CreatedAt:   time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	})
+
+	err = s.state.AddRole(context.Background(), "other-uuid", user.Role{Name: "support"})
+	c.Check(err, jc.ErrorIs, usererrors.RoleAlreadyExists)
+}
+
+func (s *roleSuite) TestGetRoleNotFound(c *gc.C) {
+	_, err := s.state.GetRole(context.Background(), "support")
+	c.Check(err, jc.ErrorIs, usererrors.RoleNotFound)
+}
+
+func (s *roleSuite) TestListRoles(c *gc.C) {
+	s.addRole(c, "support", "Action.EnqueueOperation")
+	s.addRole(c, "auditor")
+
+	roles, err := s.state.ListRoles(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(roles, gc.HasLen, 2)
+	c.Check(roles[0].Name, gc.Equals, "auditor")
+	c.Check(roles[0].Methods, gc.HasLen, 0)
+	c.Check(roles[1].Name, gc.Equals, "support")
+	c.Check(roles[1].Methods, jc.DeepEquals, []string{"Action.EnqueueOperation"})
+}
+
+func (s *roleSuite) TestGrantRevokeRole(c *gc.C) {
+	s.addRole(c, "support", "Action.EnqueueOperation", "Client.FullStatus")
+	s.addRole(c, "operator", "Action.*", "Client.FullStatus")
+	modelUUID := modelID.Key
+
+	err := s.state.GrantRole(context.Background(), "support", "bob", modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.GrantRole(context.Background(), "support", "bob", modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.GrantRole(context.Background(), "operator", "bob", modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.GrantRole(context.Background(), "auditor", "bob", modelUUID)
+	c.Check(err, jc.ErrorIs, usererrors.RoleNotFound)
+
+	methods, err := s.state.UserRoleMethods(context.Background(), "bob", modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(methods, jc.DeepEquals, []string{"Action.*", "Action.EnqueueOperation", "Client.FullStatus"})
+
+	methods, err = s.state.UserRoleMethods(context.Background(), "bob", controllerID.Key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(methods, gc.HasLen, 0)
+
+	err = s.state.RevokeRole(context.Background(), "operator", "bob", modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.RevokeRole(context.Background(), "operator", "bob", modelUUID)
+	c.Check(err, jc.ErrorIs, usererrors.RoleGrantNotFound)
+
+	methods, err = s.state.UserRoleMethods(context.Background(), "bob", modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(methods, jc.DeepEquals, []string{"Action.EnqueueOperation", "Client.FullStatus"})
+}
+
+func (s *roleSuite) TestRemoveRole(c *gc.C) {
+	s.addRole(c, "support", "Action.EnqueueOperation")
+	err := s.state.GrantRole(context.Background(), "support", "bob", modelID.Key)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.RemoveRole(context.Background(), "support")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.state.GetRole(context.Background(), "support")
+	c.Check(err, jc.ErrorIs, usererrors.RoleNotFound)
+	methods, err := s.state.UserRoleMethods(context.Background(), "bob", modelID.Key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(methods, gc.HasLen, 0)
+
+	err = s.state.RemoveRole(context.Background(), "support")
+	c.Check(err, jc.ErrorIs, usererrors.RoleNotFound)
+}
+
+func (s *roleSuite) TestRemoveUserRoleGrants(c *gc.C) {
+	s.addRole(c, "support", "Action.EnqueueOperation")
+	for _, grant := range []struct{ user, model string }{
+		{"bob", modelID.Key},
+		{"bob", controllerID.Key},
+		{"mary@external", modelID.Key},
+	} {
+		err := s.state.GrantRole(context.Background(), "support", grant.user, grant.model)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	err := s.state.RemoveUserRoleGrants(context.Background(), "bob")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A user re-created with the same name has none of the removed user's
+	// roles, while other users keep theirs.
+	for _, modelUUID := range []string{modelID.Key, controllerID.Key} {
+		methods, err := s.state.UserRoleMethods(context.Background(), "bob", modelUUID)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(methods, gc.HasLen, 0)
+	}
+	methods, err := s.state.UserRoleMethods(context.Background(), "mary@external", modelID.Key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(methods, jc.DeepEquals, []string{"Action.EnqueueOperation"})
+}
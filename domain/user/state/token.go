@@ -0,0 +1,159 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/internal/database"
+)
+
+// AddAPIToken adds a new API token with the given UUID and hash of its
+// secret. If the user already has a token with the same name an error
+// that satisfies usererrors.APITokenAlreadyExists is returned.
+func (st *State) AddAPIToken(ctx context.Context, uuid, hash string, token user.APIToken) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	insertStmt := `
+INSERT INTO user_api_token (uuid, user_name, name, token_hash, model_uuid, max_access, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+	modelUUID := sql.NullString{String: token.ModelUUID, Valid: token.ModelUUID != ""}
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, insertStmt,
+			uuid, token.UserName, token.Name, hash, modelUUID, token.MaxAccess, token.CreatedAt, token.ExpiresAt)
+		if database.IsErrConstraintUnique(err) {
+			return fmt.Errorf("api token %q for user %q %w", token.Name, token.UserName, usererrors.APITokenAlreadyExists)
+		} else if err != nil {
+			return fmt.Errorf("adding api token %q for user %q: %w", token.Name, token.UserName, err)
+		}
+		return nil
+	})
+}
+
+// GetAPITokenByHash returns the API token with the given hash of its
+// secret. If no token has the hash an error that satisfies
+// usererrors.APITokenNotFound is returned.
+func (st *State) GetAPITokenByHash(ctx context.Context, hash string) (user.APIToken, error) {
+	db, err := st.DB()
+	if err != nil {
+		return user.APIToken{}, errors.Trace(err)
+	}
+
+	var tokens []user.APIToken
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		tokens, err = loadAPITokens(ctx, tx, "token_hash = ?", hash)
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return user.APIToken{}, errors.Trace(err)
+	}
+	if len(tokens) == 0 {
+		return user.APIToken{}, fmt.Errorf("api token %w", usererrors.APITokenNotFound)
+	}
+	return tokens[0], nil
+}
+
+// ListAPITokens returns the user's API tokens, ordered by name.
+func (st *State) ListAPITokens(ctx context.Context, userName string) ([]user.APIToken, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var tokens []user.APIToken
+	err = db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		tokens, err = loadAPITokens(ctx, tx, "user_name = ?", userName)
+		return errors.Trace(err)
+	})
+	return tokens, errors.Trace(err)
+}
+
+// RemoveAPIToken removes the user's API token with the given name. If the
+// user has no such token an error that satisfies
+// usererrors.APITokenNotFound is returned.
+func (st *State) RemoveAPIToken(ctx context.Context, userName, name string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	deleteStmt := `
+DELETE FROM user_api_token
+WHERE       user_name = ?
+AND         name = ?
+`
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, deleteStmt, userName, name)
+		if err != nil {
+			return fmt.Errorf("removing api token %q for user %q: %w", name, userName, err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return fmt.Errorf("removing api token %q for user %q: %w", name, userName, err)
+		} else if n == 0 {
+			return fmt.Errorf("api token %q for user %q %w", name, userName, usererrors.APITokenNotFound)
+		}
+		return nil
+	})
+}
+
+// RemoveUserAPITokens removes all of the user's API tokens, so that a user
+// later added with the same name doesn't inherit them.
+func (st *State) RemoveUserAPITokens(ctx context.Context, userName string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_api_token WHERE user_name = ?", userName); err != nil {
+			return fmt.Errorf("removing api tokens for user %q: %w", userName, err)
+		}
+		return nil
+	})
+}
+
+// loadAPITokens returns the API tokens matching the condition, ordered by
+// user and token name.
+func loadAPITokens(ctx context.Context, tx *sql.Tx, condition string, args ...any) ([]user.APIToken, error) {
+	selectStmt := fmt.Sprintf(`
+SELECT    user_name, name, model_uuid, max_access, created_at, expires_at
+FROM      user_api_token
+WHERE     %s
+ORDER BY  user_name, name
+`, condition)
+	rows, err := tx.QueryContext(ctx, selectStmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fetching api tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []user.APIToken
+	for rows.Next() {
+		var (
+			token     user.APIToken
+			modelUUID sql.NullString
+		)
+		if err := rows.Scan(&token.UserName, &token.Name, &modelUUID, &token.MaxAccess, &token.CreatedAt, &token.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("fetching api tokens: %w", err)
+		}
+		token.ModelUUID = modelUUID.String
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("fetching api tokens: %w", err)
+	}
+	return tokens, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usererrors "github.com/juju/juju/domain/user/errors"
+	"github.com/juju/juju/internal/changestream/testing"
+)
+
+type tokenSuite struct {
+	testing.ControllerSuite
+
+	state *State
+}
+
+var _ = gc.Suite(&tokenSuite{})
+
+func (s *tokenSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.state = NewState(s.TxnRunnerFactory())
+}
+
+func (s *tokenSuite) token(userName, name, modelUUID string) user.APIToken {
+	created := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	return user.APIToken{
+		Name:      name,
+		UserName:  userName,
+		ModelUUID: modelUUID,
+		MaxAccess: permission.WriteAccess,
+		CreatedAt: created,
+		ExpiresAt: created.Add(24 * time.Hour),
+	}
+}
+
+func (s *tokenSuite) TestAddAPIToken(c *gc.C) {
+	token := s.token("bob", "ci", modelID.Key)
+	err := s.state.AddAPIToken(context.Background(), "ci-uuid", "ci-hash", token)
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err := s.state.GetAPITokenByHash(context.Background(), "ci-hash")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, jc.DeepEquals, token)
+
+	err = s.state.AddAPIToken(context.Background(), "other-uuid", "other-hash", s.token("bob", "ci", ""))
+	c.Check(err, jc.ErrorIs, usererrors.APITokenAlreadyExists)
+
+	// Other users can use the same token names.
+	err = s.state.AddAPIToken(context.Background(), "other-uuid", "other-hash", s.token("mary@external", "ci", ""))
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *tokenSuite) TestGetAPITokenByHashNotFound(c *gc.C) {
+	_, err := s.state.GetAPITokenByHash(context.Background(), "missing")
+	c.Check(err, jc.ErrorIs, usererrors.APITokenNotFound)
+}
+
+func (s *tokenSuite) TestListAPITokens(c *gc.C) {
+	for _, token := range []user.APIToken{
+		s.token("bob", "deploy", modelID.Key),
+		s.token("bob", "ci", ""),
+		s.token("mary@external", "release", ""),
+	} {
+		err := s.state.AddAPIToken(context.Background(), token.Name+"-uuid", token.UserName+token.Name, token)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	tokens, err := s.state.ListAPITokens(context.Background(), "bob")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tokens, jc.DeepEquals, []user.APIToken{
+		s.token("bob", "ci", ""),
+		s.token("bob", "deploy", modelID.Key),
+	})
+
+	tokens, err = s.state.ListAPITokens(context.Background(), "jim")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tokens, gc.HasLen, 0)
+}
+
+func (s *tokenSuite) TestRemoveAPIToken(c *gc.C) {
+	err := s.state.AddAPIToken(context.Background(), "ci-uuid", "ci-hash", s.token("bob", "ci", ""))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.RemoveAPIToken(context.Background(), "mary@external", "ci")
+	c.Check(err, jc.ErrorIs, usererrors.APITokenNotFound)
+
+	err = s.state.RemoveAPIToken(context.Background(), "bob", "ci")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.state.GetAPITokenByHash(context.Background(), "ci-hash")
+	c.Check(err, jc.ErrorIs, usererrors.APITokenNotFound)
+}
+
+func (s *tokenSuite) TestRemoveUserAPITokens(c *gc.C) {
+	for _, token := range []user.APIToken{
+		s.token("bob", "deploy", modelID.Key),
+		s.token("bob", "ci", ""),
+		s.token("mary@external", "release", ""),
+	} {
+		err := s.state.AddAPIToken(context.Background(), token.Name+"-uuid", token.UserName+token.Name, token)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	err := s.state.RemoveUserAPITokens(context.Background(), "bob")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A user re-created with the same name has no tokens, and the removed
+	// user's tokens no longer authenticate.
+	tokens, err := s.state.ListAPITokens(context.Background(), "bob")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tokens, gc.HasLen, 0)
+	_, err = s.state.GetAPITokenByHash(context.Background(), "bobci")
+	c.Check(err, jc.ErrorIs, usererrors.APITokenNotFound)
+
+	tokens, err = s.state.ListAPITokens(context.Background(), "mary@external")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tokens, gc.HasLen, 1)
+}
@@ -52,6 +52,22 @@ func (f *WatcherFactory) NewNamespaceWatcher(
 	return eventsource.NewNamespaceWatcher(base, namespace, changeMask, initialStateQuery), nil
 }
 
+// NewNamespaceWatcherFrom returns a new namespace watcher that resumes
+// from the cursor reported by a previous namespace watcher. If the changes
+// after the cursor have been pruned, an error satisfying
+// changestream.ErrChangeLogGap is returned.
+func (f *WatcherFactory) NewNamespaceWatcherFrom(
+	cursor int64, namespace string, changeMask changestream.ChangeType,
+) (watcher.StringsWatcher, error) {
+	base, err := f.newBaseWatcher()
+	if err != nil {
+		return nil, errors.Annotate(err, "creating base watcher")
+	}
+
+	w, err := eventsource.NewNamespaceWatcherFrom(base, cursor, namespace, changeMask)
+	return w, errors.Trace(err)
+}
+
 // NewNamespacePredicateWatcher returns a new namespace watcher
 // for events based on the input change mask and predicate.
 func (f *WatcherFactory) NewNamespacePredicateWatcher(
@@ -31,6 +31,7 @@ import (
 	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/internal/charmhub"
 	"github.com/juju/juju/internal/feature"
+	"github.com/juju/juju/internal/logfwd/jsonhttp"
 	"github.com/juju/juju/internal/logfwd/syslog"
 	"github.com/juju/juju/juju/osenv"
 	jujuversion "github.com/juju/juju/version"
@@ -213,6 +214,18 @@ const (
 	// forwarding.
 	LogFwdSyslogClientKey = "syslog-client-key"
 
+	// LogFwdHTTPURL sets the URL of the HTTP(S) endpoint that log records
+	// are forwarded to as newline-delimited JSON.
+	LogFwdHTTPURL = "logforward-http-url"
+
+	// LogFwdHTTPCACert sets the certificate of the CA that signed the
+	// HTTP log forwarding endpoint's certificate.
+	LogFwdHTTPCACert = "logforward-http-ca-cert"
+
+	// LogFwdHTTPToken sets the bearer token used to authenticate with
+	// the HTTP log forwarding endpoint.
+	LogFwdHTTPToken = "logforward-http-token"
+
 	// AutomaticallyRetryHooks determines whether the uniter will
 	// automatically retry a hook that has failed
 	AutomaticallyRetryHooks = "automatically-retry-hooks"
@@ -725,6 +738,12 @@ func Validate(cfg, old *Config) error {
 		}
 	}
 
+	if lfCfg, ok := cfg.LogFwdHTTP(); ok {
+		if err := lfCfg.Validate(); err != nil {
+			return errors.Annotate(err, "invalid HTTP log forwarding config")
+		}
+	}
+
 	if uuid := cfg.UUID(); !utils.IsValidUUIDString(uuid) {
 		return errors.Errorf("uuid: expected UUID, got string(%q)", uuid)
 	}
@@ -1275,6 +1294,9 @@ func (c *Config) LogFwdSyslog() (*syslog.RawConfig, bool) {
 	if s, ok := c.defined[LogFwdSyslogHost]; ok && s != "" {
 		partial = true
 		lfCfg.Host = s.(string)
+	} else if c.asString(LogFwdHTTPURL) != "" {
+		// Log forwarding only targets the HTTP endpoint.
+		lfCfg.Enabled = false
 	}
 
 	if s, ok := c.defined[LogFwdSyslogCACert]; ok && s != "" {
@@ -1298,6 +1320,42 @@ func (c *Config) LogFwdSyslog() (*syslog.RawConfig, bool) {
 	return &lfCfg, true
 }
 
+// LogFwdHTTP returns the HTTP (newline-delimited JSON) log forwarding
+// config.
+func (c *Config) LogFwdHTTP() (*jsonhttp.RawConfig, bool) {
+	partial := false
+	var lfCfg jsonhttp.RawConfig
+
+	if s, ok := c.defined[LogForwardEnabled]; ok {
+		partial = true
+		lfCfg.Enabled = s.(bool)
+	}
+
+	if s, ok := c.defined[LogFwdHTTPURL]; ok && s != "" {
+		partial = true
+		lfCfg.URL = s.(string)
+	} else {
+		// Log forwarding only targets syslog, or nothing at all, in
+		// which case the syslog config reports the missing target.
+		lfCfg.Enabled = false
+	}
+
+	if s, ok := c.defined[LogFwdHTTPCACert]; ok && s != "" {
+		partial = true
+		lfCfg.CACert = s.(string)
+	}
+
+	if s, ok := c.defined[LogFwdHTTPToken]; ok && s != "" {
+		partial = true
+		lfCfg.Token = s.(string)
+	}
+
+	if !partial {
+		return nil, false
+	}
+	return &lfCfg, true
+}
+
 // FirewallMode returns whether the firewall should
 // manage ports per machine, globally, or not at all.
 // (FwInstance, FwGlobal, or FwNone).
@@ -1817,6 +1875,9 @@ var alwaysOptional = schema.Defaults{
 	LogFwdSyslogCACert:     schema.Omit,
 	LogFwdSyslogClientCert: schema.Omit,
 	LogFwdSyslogClientKey:  schema.Omit,
+	LogFwdHTTPURL:          schema.Omit,
+	LogFwdHTTPCACert:       schema.Omit,
+	LogFwdHTTPToken:        schema.Omit,
 	LoggingOutputKey:       schema.Omit,
 
 	// Storage related config.
@@ -2287,7 +2348,7 @@ global or per instance security groups.`,
 		Group:       environschema.EnvironGroup,
 	},
 	LogForwardEnabled: {
-		Description: `Whether syslog forwarding is enabled.`,
+		Description: `Whether log forwarding (to syslog and/or HTTP) is enabled.`,
 		Type:        environschema.Tbool,
 		Group:       environschema.EnvironGroup,
 	},
@@ -2311,6 +2372,21 @@ global or per instance security groups.`,
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	LogFwdHTTPURL: {
+		Description: `The URL of an HTTP(S) endpoint that log records are pushed to as newline-delimited JSON.`,
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	LogFwdHTTPCACert: {
+		Description: `The certificate of the CA that signed the HTTP log forwarding endpoint certificate, in PEM format.`,
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	LogFwdHTTPToken: {
+		Description: `The bearer token used when pushing log records to the HTTP log forwarding endpoint.`,
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
 	"ssl-hostname-verification": {
 		Description: "Whether SSL hostname verification is enabled (default true)",
 		Type:        environschema.Tbool,
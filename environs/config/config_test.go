@@ -585,6 +585,30 @@ var configTests = []configTest{
 			"syslog-client-key":  serverKey2,
 		}),
 		err: `invalid syslog forwarding config: validating TLS config: parsing client key pair: (crypto/)?tls: private key does not match public key`,
+	}, {
+		about:       "Invalid HTTP log forwarding url",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logforward-enabled":  true,
+			"logforward-http-url": "tcp://10.0.0.1:3100",
+		}),
+		err: `invalid HTTP log forwarding config: URL scheme "tcp" not valid`,
+	}, {
+		about:       "Invalid HTTP log forwarding ca cert",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logforward-enabled":      true,
+			"logforward-http-url":     "https://10.0.0.1:3100/loki/api/v1/push",
+			"logforward-http-ca-cert": invalidCACert,
+		}),
+		err: `invalid HTTP log forwarding config: validating TLS config: parsing CA certificate: x509: malformed certificate`,
+	}, {
+		about:       "Log forwarding enabled without a target",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logforward-enabled": true,
+		}),
+		err: `invalid syslog forwarding config: Host "" not valid`,
 	}, {
 		about:       "net-bond-reconfigure-delay value",
 		useDefaults: config.UseDefaults,
@@ -615,6 +639,26 @@ var configTests = []configTest{
 			"syslog-client-cert": testing.ServerCert,
 			"syslog-client-key":  testing.ServerKey,
 		}),
+	}, {
+		about:       "Valid HTTP log forwarding config values",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logforward-enabled":      true,
+			"logforward-http-url":     "https://10.0.0.1:3100/loki/api/v1/push",
+			"logforward-http-ca-cert": testing.CACert,
+			"logforward-http-token":   "s3cr3t",
+		}),
+	}, {
+		about:       "Valid syslog and HTTP log forwarding config values",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logforward-enabled":  true,
+			"syslog-host":         "localhost:1234",
+			"syslog-ca-cert":      testing.CACert,
+			"syslog-client-cert":  testing.ServerCert,
+			"syslog-client-key":   testing.ServerKey,
+			"logforward-http-url": "https://10.0.0.1:3100/loki/api/v1/push",
+		}),
 	}, {
 		about:       "Valid container-inherit-properties",
 		useDefaults: config.UseDefaults,
@@ -751,10 +795,13 @@ func (test configTest) check(c *gc.C) {
 	keys, _ := test.attrs["authorized-keys"].(string)
 	c.Assert(cfg.AuthorizedKeys(), gc.Equals, keys)
 
+	syslogHost, _ := test.attrs["syslog-host"].(string)
+	httpURL, _ := test.attrs["logforward-http-url"].(string)
+
 	lfCfg, hasLogCfg := cfg.LogFwdSyslog()
 	if v, ok := test.attrs["logforward-enabled"].(bool); ok {
 		c.Assert(hasLogCfg, jc.IsTrue)
-		c.Assert(lfCfg.Enabled, gc.Equals, v)
+		c.Assert(lfCfg.Enabled, gc.Equals, v && (syslogHost != "" || httpURL == ""))
 	}
 	if v, ok := test.attrs["syslog-ca-cert"].(string); v != "" {
 		c.Assert(hasLogCfg, jc.IsTrue)
@@ -778,6 +825,24 @@ func (test configTest) check(c *gc.C) {
 		c.Check(lfCfg.ClientKey, gc.Equals, "")
 	}
 
+	httpCfg, hasHTTPCfg := cfg.LogFwdHTTP()
+	if v, ok := test.attrs["logforward-enabled"].(bool); ok {
+		c.Assert(hasHTTPCfg, jc.IsTrue)
+		c.Assert(httpCfg.Enabled, gc.Equals, v && httpURL != "")
+	}
+	if httpURL != "" {
+		c.Assert(hasHTTPCfg, jc.IsTrue)
+		c.Assert(httpCfg.URL, gc.Equals, httpURL)
+	}
+	if v, _ := test.attrs["logforward-http-ca-cert"].(string); v != "" {
+		c.Assert(hasHTTPCfg, jc.IsTrue)
+		c.Assert(httpCfg.CACert, gc.Equals, v)
+	}
+	if v, _ := test.attrs["logforward-http-token"].(string); v != "" {
+		c.Assert(hasHTTPCfg, jc.IsTrue)
+		c.Assert(httpCfg.Token, gc.Equals, v)
+	}
+
 	if v, ok := test.attrs["ssl-hostname-verification"]; ok {
 		c.Assert(cfg.SSLHostnameVerification(), gc.Equals, v)
 	}
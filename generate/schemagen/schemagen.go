@@ -253,6 +253,10 @@ func (c context) ControllerDB() (changestream.WatchableDB, error) {
 	return nil, nil
 }
 
+func (c context) ModelDB(string) (changestream.WatchableDB, error) {
+	return nil, nil
+}
+
 func (c context) DBDeleter() coredatabase.DBDeleter {
 	return nil
 }
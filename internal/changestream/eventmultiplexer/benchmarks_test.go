@@ -232,6 +232,10 @@ func (s stream) Dying() <-chan struct{} {
 	return s.dying
 }
 
+func (s stream) Replay(context.Context, int64, int64) ([]changestream.ChangeEvent, error) {
+	return nil, nil
+}
+
 type term struct {
 	changes ChangeSet
 }
@@ -5,6 +5,9 @@ package eventmultiplexer
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -41,6 +44,11 @@ type Stream interface {
 
 	// Dying returns a channel that is closed when the stream is dying.
 	Dying() <-chan struct{}
+
+	// Replay returns the changes with ids after the cursor, up to and
+	// including the upper bound. If any of the changes after the cursor
+	// have been pruned, then changestream.ErrChangeLogGap is returned.
+	Replay(ctx context.Context, cursor, upper int64) ([]changestream.ChangeEvent, error)
 }
 
 // MetricsCollector represents the metrics methods called.
@@ -82,6 +90,18 @@ type EventMultiplexer struct {
 	subscriptionsCount uint64
 	dispatchErrorCount int
 
+	// lastChangeID is the highest change log id that has been received
+	// from the stream. It's the upper bound for replaying changes to new
+	// subscriptions. It is only written to in the main loop, but can be
+	// read atomically for reporting.
+	lastChangeID int64
+
+	// reportSubscriptions holds the active subscriptions for reporting.
+	// Reporting can't be serialized through the main loop, as the loop is
+	// blocked whilst dispatching, which is exactly when a report is needed.
+	reportMutex         sync.Mutex
+	reportSubscriptions map[uint64]*subscription
+
 	// (un)subscription related channels to serialize adding and removing
 	// subscriptions. This allows the queue to be lock less.
 	subscriptionCh   chan requestSubscription
@@ -107,6 +127,8 @@ func New(stream Stream, clock clock.Clock, metrics MetricsCollector, logger Logg
 		unsubscriptionCh: make(chan uint64),
 
 		reportsCh: make(chan reportRequest),
+
+		reportSubscriptions: make(map[uint64]*subscription),
 	}
 
 	if err := catacomb.Invoke(catacomb.Plan{
@@ -122,14 +144,60 @@ func New(stream Stream, clock clock.Clock, metrics MetricsCollector, logger Logg
 // Subscribe creates a new subscription to the event queue. Options can be
 // provided to allow filter during the dispatching phase.
 func (e *EventMultiplexer) Subscribe(opts ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	return e.subscribe(requestSubscription{
+		opts: opts,
+	})
+}
+
+// SubscribeFrom creates a new subscription to the event queue, which first
+// receives the changes after the given change log cursor. Only once the
+// replayed changes have been received are new changes dispatched to the
+// subscription. Options can be provided to allow filter during both the
+// replay and the dispatching phase.
+func (e *EventMultiplexer) SubscribeFrom(cursor int64, opts ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	if cursor < 0 {
+		return nil, errors.NotValidf("negative cursor %d", cursor)
+	}
+
+	// The changes are replayed here, rather than in the main loop, so that
+	// the dispatching of changes to other subscriptions isn't held up by
+	// reading the change log. If more changes are received whilst replaying,
+	// the subscription is rejected as stale and only those changes need to
+	// be replayed before trying again.
+	var replayed ChangeSet
+	for {
+		upper := atomic.LoadInt64(&e.lastChangeID)
+		changes, err := e.replay(cursor, upper, opts)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		replayed = append(replayed, changes...)
+
+		if upper > cursor {
+			cursor = upper
+		}
+		sub, err := e.subscribe(requestSubscription{
+			opts:     opts,
+			cursor:   cursor,
+			upper:    upper,
+			replay:   true,
+			replayed: replayed,
+		})
+		if errors.Is(err, errReplayStale) {
+			continue
+		}
+		return sub, errors.Trace(err)
+	}
+}
+
+func (e *EventMultiplexer) subscribe(request requestSubscription) (changestream.Subscription, error) {
 	result := make(chan requestSubscriptionResult)
+	request.result = result
+
 	select {
 	case <-e.catacomb.Dying():
 		return nil, database.ErrEventMultiplexerDying
-	case e.subscriptionCh <- requestSubscription{
-		opts:   opts,
-		result: result,
-	}:
+	case e.subscriptionCh <- request:
 	}
 
 	select {
@@ -182,6 +250,24 @@ func (e *EventMultiplexer) Report() map[string]any {
 	}
 }
 
+// SubscriptionReport returns a report for every active subscription, sorted
+// by subscription id, along with the id of the last change that was received
+// from the stream. Unlike Report, this doesn't block on the main loop, so
+// can be used to diagnose subscriptions that aren't consuming changes.
+func (e *EventMultiplexer) SubscriptionReport() ([]changestream.SubscriptionReport, int64) {
+	e.reportMutex.Lock()
+	reports := make([]changestream.SubscriptionReport, 0, len(e.reportSubscriptions))
+	for _, sub := range e.reportSubscriptions {
+		reports = append(reports, sub.report())
+	}
+	e.reportMutex.Unlock()
+
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].ID < reports[j].ID
+	})
+	return reports, atomic.LoadInt64(&e.lastChangeID)
+}
+
 func (e *EventMultiplexer) unsubscribe(subscriptionID uint64) {
 	select {
 	case <-e.catacomb.Dying():
@@ -202,6 +288,10 @@ func (e *EventMultiplexer) loop() error {
 		}
 		e.subscriptions = nil
 		e.subscriptionsByNS = nil
+
+		e.reportMutex.Lock()
+		e.reportSubscriptions = make(map[uint64]*subscription)
+		e.reportMutex.Unlock()
 	}()
 
 	for {
@@ -228,12 +318,21 @@ func (e *EventMultiplexer) loop() error {
 
 			changeSet := make(map[*subscription]ChangeSet)
 			for _, change := range term.Changes() {
+				if id := change.ID(); id > e.lastChangeID {
+					atomic.StoreInt64(&e.lastChangeID, id)
+				}
+
 				subs := e.gatherSubscriptions(change)
 				if len(subs) == 0 {
 					continue
 				}
 
 				for _, sub := range subs {
+					// Don't dispatch changes that the subscription has
+					// already seen, when it was created from a cursor.
+					if sub.cursor > 0 && change.ID() <= sub.cursor {
+						continue
+					}
 					changeSet[sub] = append(changeSet[sub], change)
 				}
 			}
@@ -260,12 +359,25 @@ func (e *EventMultiplexer) loop() error {
 			term.Done(false, e.catacomb.Dying())
 
 		case request := <-e.subscriptionCh:
+			// If changes have been received since the replay was read, then
+			// the subscription would miss them, so it must replay them first.
+			if request.replay && e.lastChangeID != request.upper {
+				select {
+				case <-e.catacomb.Dying():
+					return e.catacomb.ErrDying()
+				case request.result <- requestSubscriptionResult{
+					err: errReplayStale,
+				}:
+					continue
+				}
+			}
+
 			// Get a new subscription count without using any mutexes.
 			subID := atomic.AddUint64(&e.subscriptionsCount, 1)
 
 			e.metrics.SubscriptionsInc()
 
-			sub := newSubscription(subID, func() { e.unsubscribe(subID) })
+			sub := newReplaySubscription(subID, request.cursor, request.replayed, func() { e.unsubscribe(subID) })
 
 			if err := e.catacomb.Add(sub); err != nil {
 				e.metrics.SubscriptionsDec()
@@ -303,9 +415,17 @@ func (e *EventMultiplexer) loop() error {
 						filter:         opt.Filter(),
 					})
 					sub.topics[namespace] = struct{}{}
+					sub.topicReports = append(sub.topicReports, changestream.TopicReport{
+						Namespace:  namespace,
+						ChangeMask: changeMaskString(opt.ChangeMask()),
+					})
 				}
 			}
 
+			e.reportMutex.Lock()
+			e.reportSubscriptions[sub.id] = sub
+			e.reportMutex.Unlock()
+
 			select {
 			case <-e.catacomb.Dying():
 				return e.catacomb.ErrDying()
@@ -343,6 +463,10 @@ func (e *EventMultiplexer) loop() error {
 			delete(e.subscriptions, subscriptionID)
 			delete(e.subscriptionsAll, subscriptionID)
 
+			e.reportMutex.Lock()
+			delete(e.reportSubscriptions, subscriptionID)
+			e.reportMutex.Unlock()
+
 			// If the subscription errors out on a close, we don't want that
 			// to bring down the entire multiplexer. Instead, just log it out
 			// and continue.
@@ -365,10 +489,62 @@ func (e *EventMultiplexer) loop() error {
 	}
 }
 
+// changeMaskString returns a human readable form of the change mask.
+func changeMaskString(mask changestream.ChangeType) string {
+	var types []string
+	if mask&changestream.Create != 0 {
+		types = append(types, "create")
+	}
+	if mask&changestream.Update != 0 {
+		types = append(types, "update")
+	}
+	if mask&changestream.Delete != 0 {
+		types = append(types, "delete")
+	}
+	return strings.Join(types, "|")
+}
+
 type reporter interface {
 	Report() map[string]interface{}
 }
 
+// replay returns the changes after the cursor, up to and including the upper
+// bound, that match the subscription options.
+func (e *EventMultiplexer) replay(cursor, upper int64, opts []changestream.SubscriptionOption) (ChangeSet, error) {
+	changes, err := e.stream.Replay(e.catacomb.Context(context.Background()), cursor, upper)
+	if err != nil {
+		return nil, errors.Annotatef(err, "replaying changes from cursor %d", cursor)
+	}
+
+	var replayed ChangeSet
+	for _, change := range changes {
+		if matchesOptions(change, opts) {
+			replayed = append(replayed, change)
+		}
+	}
+	return replayed, nil
+}
+
+// matchesOptions returns true if the change would be dispatched to a
+// subscription with the given options.
+func matchesOptions(change changestream.ChangeEvent, opts []changestream.SubscriptionOption) bool {
+	if len(opts) == 0 {
+		return true
+	}
+	for _, opt := range opts {
+		if opt.Namespace() != change.Namespace() {
+			continue
+		}
+		if (change.Type() & opt.ChangeMask()) == 0 {
+			continue
+		}
+		if opt.Filter()(change) {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *EventMultiplexer) gatherSubscriptions(ch changestream.ChangeEvent) []*subscription {
 	subs := make(map[uint64]*subscription)
 
@@ -4,9 +4,11 @@
 package eventmultiplexer
 
 import (
+	"context"
 	"sync"
 	"time"
 
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v3/workertest"
 	"go.uber.org/mock/gomock"
@@ -1009,6 +1011,338 @@ func (s *eventMultiplexerSuite) TestReportWithTopicRemovalAfterUnsubscribe(c *gc
 	workertest.CleanKill(c, queue)
 }
 
+func (s *eventMultiplexerSuite) TestSubscribeFromReplaysChanges(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAnyLogs(c)
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	// Dispatch a term without any subscriptions, so that the last change id
+	// is known to the multiplexer.
+	s.expectEmptyTerm(c, changeEvent{
+		id:      1,
+		ctype:   changestream.Create,
+		ns:      "topic",
+		changed: "1",
+	}, changeEvent{
+		id:      2,
+		ctype:   changestream.Create,
+		ns:      "topic",
+		changed: "2",
+	})
+	select {
+	case <-s.dispatchTerm(c, terms):
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for term")
+	}
+	s.waitForLastChangeID(c, queue, 2)
+
+	s.stream.EXPECT().Replay(gomock.Any(), int64(1), int64(2)).Return([]changestream.ChangeEvent{
+		changeEvent{
+			id:      2,
+			ctype:   changestream.Create,
+			ns:      "topic",
+			changed: "2",
+		},
+		changeEvent{
+			id:      2,
+			ctype:   changestream.Create,
+			ns:      "other",
+			changed: "a",
+		},
+	}, nil)
+
+	s.metrics.EXPECT().SubscriptionsInc()
+	s.metrics.EXPECT().SubscriptionsDec()
+	s.clock.EXPECT().Now().MinTimes(1)
+	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
+
+	sub, err := queue.SubscribeFrom(1, changestream.Namespace("topic", changestream.Create))
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.expectTerm(c, changeEvent{
+		id:      3,
+		ctype:   changestream.Create,
+		ns:      "topic",
+		changed: "3",
+	})
+	s.dispatchTerm(c, terms)
+
+	// The replayed changes are always received before any new changes.
+	var changes []changestream.ChangeEvent
+	select {
+	case changes = <-sub.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for replayed event")
+	}
+	c.Assert(changes, gc.HasLen, 1)
+	c.Check(changes[0].Namespace(), gc.Equals, "topic")
+	c.Check(changes[0].Changed(), gc.Equals, "2")
+
+	select {
+	case changes = <-sub.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for event")
+	}
+	c.Assert(changes, gc.HasLen, 1)
+	c.Check(changes[0].ID(), gc.Equals, int64(3))
+	c.Check(changes[0].Changed(), gc.Equals, "3")
+
+	s.unsubscribe(c, sub)
+}
+
+func (s *eventMultiplexerSuite) TestSubscribeFromReplaysStaleChanges(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAnyLogs(c)
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	s.expectEmptyTerm(c, changeEvent{
+		id:      2,
+		ctype:   changestream.Create,
+		ns:      "topic",
+		changed: "2",
+	})
+	select {
+	case <-s.dispatchTerm(c, terms):
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for term")
+	}
+	s.waitForLastChangeID(c, queue, 2)
+
+	// Another change is received whilst replaying, so the subscription is
+	// stale and that change must also be replayed.
+	gomock.InOrder(
+		s.stream.EXPECT().Replay(gomock.Any(), int64(1), int64(2)).DoAndReturn(func(context.Context, int64, int64) ([]changestream.ChangeEvent, error) {
+			s.expectEmptyTerm(c, changeEvent{
+				id:      3,
+				ctype:   changestream.Create,
+				ns:      "topic",
+				changed: "3",
+			})
+			<-s.dispatchTerm(c, terms)
+			s.waitForLastChangeID(c, queue, 3)
+
+			return []changestream.ChangeEvent{changeEvent{
+				id:      2,
+				ctype:   changestream.Create,
+				ns:      "topic",
+				changed: "2",
+			}}, nil
+		}),
+		s.stream.EXPECT().Replay(gomock.Any(), int64(2), int64(3)).Return([]changestream.ChangeEvent{changeEvent{
+			id:      3,
+			ctype:   changestream.Create,
+			ns:      "topic",
+			changed: "3",
+		}}, nil),
+	)
+
+	s.metrics.EXPECT().SubscriptionsInc()
+	s.metrics.EXPECT().SubscriptionsDec()
+
+	sub, err := queue.SubscribeFrom(1, changestream.Namespace("topic", changestream.Create))
+	c.Assert(err, jc.ErrorIsNil)
+
+	var changes []changestream.ChangeEvent
+	select {
+	case changes = <-sub.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for replayed event")
+	}
+	c.Assert(changes, gc.HasLen, 2)
+	c.Check(changes[0].Changed(), gc.Equals, "2")
+	c.Check(changes[1].Changed(), gc.Equals, "3")
+
+	s.unsubscribe(c, sub)
+}
+
+func (s *eventMultiplexerSuite) TestSubscribeFromSkipsSeenChanges(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAnyLogs(c)
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	s.stream.EXPECT().Replay(gomock.Any(), int64(5), int64(0)).Return(nil, nil)
+
+	s.metrics.EXPECT().SubscriptionsInc()
+	s.metrics.EXPECT().SubscriptionsDec()
+	s.clock.EXPECT().Now().MinTimes(1)
+	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
+
+	sub, err := queue.SubscribeFrom(5, changestream.Namespace("topic", changestream.Create))
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.expectTerm(c, changeEvent{
+		id:      4,
+		ctype:   changestream.Create,
+		ns:      "topic",
+		changed: "4",
+	}, changeEvent{
+		id:      6,
+		ctype:   changestream.Create,
+		ns:      "topic",
+		changed: "6",
+	})
+	s.dispatchTerm(c, terms)
+
+	var changes []changestream.ChangeEvent
+	select {
+	case changes = <-sub.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for event")
+	}
+	c.Assert(changes, gc.HasLen, 1)
+	c.Check(changes[0].Changed(), gc.Equals, "6")
+
+	s.unsubscribe(c, sub)
+}
+
+func (s *eventMultiplexerSuite) TestSubscribeFromChangeLogGap(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAnyLogs(c)
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).AnyTimes()
+
+	queue, err := New(s.stream, s.clock, s.metrics, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	s.stream.EXPECT().Replay(gomock.Any(), int64(1), int64(0)).Return(nil, changestream.ErrChangeLogGap)
+
+	_, err = queue.SubscribeFrom(1, changestream.Namespace("topic", changestream.Create))
+	c.Assert(err, jc.ErrorIs, changestream.ErrChangeLogGap)
+}
+
+func (s *eventMultiplexerSuite) TestSubscribeFromNegativeCursor(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAnyLogs(c)
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).AnyTimes()
+
+	queue, err := New(s.stream, s.clock, s.metrics, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	_, err = queue.SubscribeFrom(-1)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+}
+
+func (s *eventMultiplexerSuite) TestSubscriptionReport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAnyLogs(c)
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	s.metrics.EXPECT().SubscriptionsInc().Times(2)
+	s.metrics.EXPECT().SubscriptionsDec().Times(2)
+	s.clock.EXPECT().Now().MinTimes(1)
+	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
+
+	sub0, err := queue.Subscribe(
+		changestream.Namespace("topic", changestream.Create|changestream.Update),
+		changestream.Namespace("other", changestream.Delete),
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	sub1, err := queue.Subscribe()
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Only consume the change from the first subscription, so the second
+	// subscription has the change queued.
+	s.expectTerm(c, changeEvent{
+		id:      4,
+		ctype:   changestream.Create,
+		ns:      "topic",
+		changed: "1",
+	})
+	s.dispatchTerm(c, terms)
+
+	select {
+	case <-sub0.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for event")
+	}
+
+	var (
+		reports      []changestream.SubscriptionReport
+		lastChangeID int64
+	)
+	for a := testing.LongAttempt.Start(); a.Next(); {
+		reports, lastChangeID = queue.SubscriptionReport()
+		if len(reports) == 2 && reports[1].QueueDepth == 1 {
+			break
+		}
+	}
+	c.Check(lastChangeID, gc.Equals, int64(4))
+	c.Check(reports, jc.DeepEquals, []changestream.SubscriptionReport{{
+		ID: 1,
+		Topics: []changestream.TopicReport{{
+			Namespace:  "topic",
+			ChangeMask: "create|update",
+		}, {
+			Namespace:  "other",
+			ChangeMask: "delete",
+		}},
+		LastChangeID: 4,
+	}, {
+		ID:         2,
+		QueueDepth: 1,
+	}})
+
+	select {
+	case <-sub1.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for event")
+	}
+
+	s.unsubscribe(c, sub0)
+	s.unsubscribe(c, sub1)
+}
+
+func (s *eventMultiplexerSuite) waitForLastChangeID(c *gc.C, queue *EventMultiplexer, id int64) {
+	for a := testing.LongAttempt.Start(); a.Next(); {
+		if _, last := queue.SubscriptionReport(); last == id {
+			return
+		}
+	}
+	c.Fatalf("timed out waiting for change %d", id)
+}
+
 func (s *eventMultiplexerSuite) unsubscribe(c *gc.C, sub changestream.Subscription) {
 	sub.Unsubscribe()
 
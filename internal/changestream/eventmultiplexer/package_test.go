@@ -98,10 +98,16 @@ func (s *baseSuite) dispatchTerm(c *gc.C, terms chan<- changestream.Term) <-chan
 }
 
 type changeEvent struct {
+	id          int64
 	ctype       changestream.ChangeType
 	ns, changed string
 }
 
+// ID returns the change log id of the change.
+func (c changeEvent) ID() int64 {
+	return c.id
+}
+
 // Type returns the type of change (create, update, delete).
 func (c changeEvent) Type() changestream.ChangeType {
 	return c.ctype
@@ -5,6 +5,7 @@
 package eventmultiplexer
 
 import (
+	context "context"
 	reflect "reflect"
 
 	changestream "github.com/juju/juju/core/changestream"
@@ -48,6 +49,21 @@ func (mr *MockStreamMockRecorder) Dying() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dying", reflect.TypeOf((*MockStream)(nil).Dying))
 }
 
+// Replay mocks base method.
+func (m *MockStream) Replay(arg0 context.Context, arg1, arg2 int64) ([]changestream.ChangeEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]changestream.ChangeEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockStreamMockRecorder) Replay(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockStream)(nil).Replay), arg0, arg1, arg2)
+}
+
 // Terms mocks base method.
 func (m *MockStream) Terms() <-chan changestream.Term {
 	m.ctrl.T.Helper()
@@ -5,9 +5,10 @@ package eventmultiplexer
 
 import (
 	"context"
-	"errors"
+	"sync/atomic"
 	"time"
 
+	"github.com/juju/errors"
 	"gopkg.in/tomb.v2"
 
 	"github.com/juju/juju/core/changestream"
@@ -21,8 +22,22 @@ const (
 	DefaultSignalTimeout = time.Second * 10
 )
 
+// errReplayStale is returned when subscribing from a cursor, if changes
+// have been received from the stream since the replayed changes were read.
+const errReplayStale = errors.ConstError("replayed changes are stale")
+
 type requestSubscription struct {
-	opts   []changestream.SubscriptionOption
+	opts []changestream.SubscriptionOption
+
+	// cursor is the change log id of the last change that the subscription
+	// has seen, either before subscribing or through the replayed changes.
+	cursor int64
+	// upper is the id of the last change received from the stream when the
+	// replayed changes were read.
+	upper    int64
+	replay   bool
+	replayed ChangeSet
+
 	result chan requestSubscriptionResult
 }
 
@@ -38,16 +53,42 @@ type subscription struct {
 	id   uint64
 
 	topics        map[string]struct{}
+	topicReports  []changestream.TopicReport
 	changes       chan ChangeSet
 	unsubscribeFn func()
+
+	// cursor is the change log id of the last change that the subscription
+	// has seen. Changes up to and including the cursor are not dispatched.
+	cursor int64
+
+	// replayed holds the changes after the cursor that need to be sent to
+	// the subscription, before any new changes are dispatched.
+	replayed ChangeSet
+	// replayDone is closed once the replayed changes have been received.
+	replayDone chan struct{}
+
+	// queued is the number of changes that have been dispatched, but not
+	// yet received by the subscriber. lastChangeID is the id of the last
+	// change that was received. Both are only used for reporting.
+	queued       int64
+	lastChangeID int64
 }
 
 func newSubscription(id uint64, unsubscribeFn func()) *subscription {
+	return newReplaySubscription(id, 0, nil, unsubscribeFn)
+}
+
+func newReplaySubscription(id uint64, cursor int64, replayed ChangeSet, unsubscribeFn func()) *subscription {
 	sub := &subscription{
 		id:            id,
 		changes:       make(chan ChangeSet),
 		topics:        make(map[string]struct{}),
 		unsubscribeFn: unsubscribeFn,
+		cursor:        cursor,
+		replayed:      replayed,
+		replayDone:    make(chan struct{}),
+		queued:        int64(len(replayed)),
+		lastChangeID:  cursor,
 	}
 
 	sub.tomb.Go(sub.loop)
@@ -91,6 +132,19 @@ func (s *subscription) Wait() error {
 }
 
 func (s *subscription) loop() error {
+	// Send any replayed changes first, there is no timeout for the replayed
+	// changes, as they're not part of a term.
+	if len(s.replayed) > 0 {
+		select {
+		case <-s.tomb.Dying():
+			return tomb.ErrDying
+		case s.changes <- s.replayed:
+			s.received(s.replayed)
+			atomic.AddInt64(&s.queued, -int64(len(s.replayed)))
+		}
+	}
+	close(s.replayDone)
+
 	<-s.tomb.Dying()
 	return tomb.ErrDying
 }
@@ -99,25 +153,59 @@ func (s *subscription) dispatch(ctx context.Context, changes ChangeSet) error {
 	ctx, cancel := context.WithTimeout(ctx, DefaultSignalTimeout)
 	defer cancel()
 
-	select {
-	case <-s.tomb.Dying():
-		return tomb.ErrDying
-
-	case <-ctx.Done():
-		// If the context was timed out, which means that nothing was pulling
-		// the change off from the channel. Then in this scenario it better that
-		// the listener is unsubscribed from any future events and will be
-		// notified via the done channel. The listener will still have the
-		// opportunity to resubscribe in the future. They're just no longer
-		// par-taking in this term whilst they're unresponsive.
-		if err := ctx.Err(); err != nil && errors.Is(err, context.DeadlineExceeded) {
-			s.Unsubscribe()
+	atomic.AddInt64(&s.queued, int64(len(changes)))
+	defer atomic.AddInt64(&s.queued, -int64(len(changes)))
+
+	// Changes can only be sent once the replayed changes have been
+	// received, which ensures that the changes are received in order.
+	var out chan ChangeSet
+	replayDone := s.replayDone
+	for {
+		select {
+		case <-s.tomb.Dying():
+			return tomb.ErrDying
+
+		case <-ctx.Done():
+			// If the context was timed out, which means that nothing was pulling
+			// the change off from the channel. Then in this scenario it better that
+			// the listener is unsubscribed from any future events and will be
+			// notified via the done channel. The listener will still have the
+			// opportunity to resubscribe in the future. They're just no longer
+			// par-taking in this term whilst they're unresponsive.
+			if err := ctx.Err(); err != nil && errors.Is(err, context.DeadlineExceeded) {
+				s.Unsubscribe()
+			}
+			return nil
+
+		case <-replayDone:
+			replayDone = nil
+			out = s.changes
+
+		case out <- changes:
+			s.received(changes)
+			return nil
 		}
+	}
+}
 
-	case s.changes <- changes:
+// received records the last change that was received by the subscriber.
+func (s *subscription) received(changes ChangeSet) {
+	for _, change := range changes {
+		if id := change.ID(); id > atomic.LoadInt64(&s.lastChangeID) {
+			atomic.StoreInt64(&s.lastChangeID, id)
+		}
+	}
+}
 
+// report returns the current state of the subscription.
+func (s *subscription) report() changestream.SubscriptionReport {
+	return changestream.SubscriptionReport{
+		ID:           s.id,
+		Topics:       s.topicReports,
+		Cursor:       s.cursor,
+		QueueDepth:   atomic.LoadInt64(&s.queued),
+		LastChangeID: atomic.LoadInt64(&s.lastChangeID),
 	}
-	return nil
 }
 
 // close closes the active channel, which will signal to the consumer that the
@@ -433,6 +433,11 @@ type changeEvent struct {
 	createdAt  string
 }
 
+// ID returns the change log id of the event.
+func (e changeEvent) ID() int64 {
+	return e.id
+}
+
 // Type returns the type of change (create, update, delete).
 func (e changeEvent) Type() changestream.ChangeType {
 	return changestream.ChangeType(e.changeType)
@@ -459,30 +464,163 @@ func (s *Stream) readChanges() ([]changeEvent, error) {
 
 	var changes []changeEvent
 	err := s.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
-		rows, err := tx.QueryContext(ctx, selectQuery, s.upperBound())
+		var err error
+		changes, err = queryChanges(ctx, tx, selectQuery, s.upperBound())
+		return errors.Trace(err)
+	})
+	return changes, errors.Trace(err)
+}
+
+const (
+	// Replaying changes coalesces the changes in the same way as a term, but
+	// the changes are bounded by an upper id as well as a lower id.
+	replayQuery = `
+SELECT MAX(c.id), c.edit_type_id, n.namespace, changed, created_at
+	FROM change_log c
+		JOIN change_log_edit_type t ON c.edit_type_id = t.id
+		JOIN change_log_namespace n ON c.namespace_id = n.id
+	WHERE c.id > ? AND c.id <= ?
+	GROUP BY c.namespace_id, c.changed
+	ORDER BY c.id;
+`
+
+	lowestChangeQuery = `SELECT MIN(id) FROM change_log;`
+
+	witnessLowerBoundQuery = `SELECT MIN(lower_bound) FROM change_log_witness;`
+)
+
+// Replay returns the changes with ids after the cursor, up to and including
+// the upper bound. The changes are coalesced in the same way as the changes
+// in a term.
+// If any of the changes after the cursor have been pruned from the change
+// log, then changestream.ErrChangeLogGap is returned.
+func (s *Stream) Replay(ctx context.Context, cursor, upper int64) ([]changestream.ChangeEvent, error) {
+	var changes []changeEvent
+	err := s.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if err := checkChangeLogGap(ctx, tx, cursor); err != nil {
+			return errors.Trace(err)
+		}
+		if upper <= cursor {
+			changes = nil
+			return nil
+		}
+
+		var err error
+		changes, err = queryChanges(ctx, tx, replayQuery, cursor, upper)
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	results := make([]changestream.ChangeEvent, len(changes))
+	for i, change := range changes {
+		results[i] = change
+	}
+	return results, nil
+}
+
+const (
+	latestChangeQuery = `SELECT MAX(id) FROM change_log;`
+	witnessesQuery    = `
+SELECT controller_id, lower_bound, upper_bound, updated_at
+FROM change_log_witness
+ORDER BY controller_id;
+`
+)
+
+// ChangeLogReport returns the id of the latest change in the change log,
+// along with the bounds of the change log that have been witnessed by each
+// controller. If the change log is empty, the latest change id is -1.
+func (s *Stream) ChangeLogReport(ctx context.Context) (int64, []changestream.WitnessReport, error) {
+	var (
+		latest    int64
+		witnesses []changestream.WitnessReport
+	)
+	err := s.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var id sql.NullInt64
+		if err := tx.QueryRowContext(ctx, latestChangeQuery).Scan(&id); err != nil {
+			return errors.Annotate(err, "querying for latest change")
+		}
+		latest = -1
+		if id.Valid {
+			latest = id.Int64
+		}
+
+		rows, err := tx.QueryContext(ctx, witnessesQuery)
 		if err != nil {
-			return errors.Annotate(err, "querying for changes")
+			return errors.Annotate(err, "querying for witnesses")
 		}
 		defer rows.Close()
 
-		dest := func(i int) []interface{} {
-			changes = append(changes, changeEvent{})
-			return []interface{}{
-				&changes[i].id,
-				&changes[i].changeType,
-				&changes[i].namespace,
-				&changes[i].changed,
-				&changes[i].createdAt,
+		witnesses = nil
+		for rows.Next() {
+			var witness changestream.WitnessReport
+			if err := rows.Scan(&witness.ControllerID, &witness.LowerBound, &witness.UpperBound, &witness.UpdatedAt); err != nil {
+				return errors.Trace(err)
 			}
+			witnesses = append(witnesses, witness)
 		}
-		for i := 0; rows.Next(); i++ {
-			if err := rows.Scan(dest(i)...); err != nil {
-				return errors.Annotate(err, "scanning change")
-			}
+		return errors.Trace(rows.Err())
+	})
+	if err != nil {
+		return -1, nil, errors.Trace(err)
+	}
+	return latest, witnesses, nil
+}
+
+// checkChangeLogGap returns changestream.ErrChangeLogGap if any of the
+// changes after the cursor have been pruned from the change log. The change
+// log is only ever pruned from the front, so it is enough to check that the
+// change directly after the cursor is still present. If the change log is
+// empty, the lowest lower bound of the witnesses bounds what might have been
+// pruned, as the pruner only removes changes every controller has witnessed.
+func checkChangeLogGap(ctx context.Context, tx *sql.Tx, cursor int64) error {
+	var lowest sql.NullInt64
+	if err := tx.QueryRowContext(ctx, lowestChangeQuery).Scan(&lowest); err != nil {
+		return errors.Annotate(err, "querying for lowest change")
+	}
+	if lowest.Valid {
+		if cursor+1 < lowest.Int64 {
+			return errors.Annotatef(changestream.ErrChangeLogGap, "cursor %d, lowest change %d", cursor, lowest.Int64)
 		}
 		return nil
-	})
-	return changes, errors.Trace(err)
+	}
+
+	var lowerBound sql.NullInt64
+	if err := tx.QueryRowContext(ctx, witnessLowerBoundQuery).Scan(&lowerBound); err != nil {
+		return errors.Annotate(err, "querying for witness lower bound")
+	}
+	if lowerBound.Valid && cursor < lowerBound.Int64 {
+		return errors.Annotatef(changestream.ErrChangeLogGap, "cursor %d, witness lower bound %d", cursor, lowerBound.Int64)
+	}
+	return nil
+}
+
+func queryChanges(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]changeEvent, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Annotate(err, "querying for changes")
+	}
+	defer rows.Close()
+
+	var changes []changeEvent
+	dest := func(i int) []interface{} {
+		changes = append(changes, changeEvent{})
+		return []interface{}{
+			&changes[i].id,
+			&changes[i].changeType,
+			&changes[i].namespace,
+			&changes[i].changed,
+			&changes[i].createdAt,
+		}
+	}
+	for i := 0; rows.Next(); i++ {
+		if err := rows.Scan(dest(i)...); err != nil {
+			return nil, errors.Annotate(err, "scanning change")
+		}
+	}
+	return changes, errors.Trace(rows.Err())
 }
 
 const (
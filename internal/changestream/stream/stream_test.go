@@ -1492,6 +1492,143 @@ func (s *streamSuite) TestCreateWatermarkTwice(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *streamSuite) TestReplay(c *gc.C) {
+	stream := s.newStream()
+
+	s.insertNamespace(c, 1000, "foo")
+
+	changes := make([]change, 5)
+	for i := 0; i < 5; i++ {
+		ch := change{
+			id:   1000,
+			uuid: utils.MustNewUUID().String(),
+		}
+		s.insertChange(c, ch)
+		changes[i] = ch
+	}
+
+	results, err := stream.Replay(context.Background(), 1, 4)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(results, gc.HasLen, 3)
+	for i := range results {
+		c.Check(results[i].ID(), gc.Equals, int64(i+2))
+		c.Check(results[i].Namespace(), gc.Equals, "foo")
+		c.Check(results[i].Changed(), gc.Equals, changes[i+1].uuid)
+	}
+}
+
+func (s *streamSuite) TestReplayWithNoNewChanges(c *gc.C) {
+	stream := s.newStream()
+
+	s.insertNamespace(c, 1000, "foo")
+	s.insertChange(c, change{
+		id:   1000,
+		uuid: utils.MustNewUUID().String(),
+	})
+
+	results, err := stream.Replay(context.Background(), 1, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results, gc.HasLen, 0)
+}
+
+func (s *streamSuite) TestReplayWithPrunedChanges(c *gc.C) {
+	stream := s.newStream()
+
+	s.insertNamespace(c, 1000, "foo")
+	for i := 0; i < 5; i++ {
+		s.insertChange(c, change{
+			id:   1000,
+			uuid: utils.MustNewUUID().String(),
+		})
+	}
+
+	_, err := s.DB().Exec("DELETE FROM change_log WHERE id <= 3")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The change directly after the cursor is still present.
+	results, err := stream.Replay(context.Background(), 3, 5)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results, gc.HasLen, 2)
+
+	_, err = stream.Replay(context.Background(), 1, 5)
+	c.Assert(err, jc.ErrorIs, changestream.ErrChangeLogGap)
+}
+
+func (s *streamSuite) TestReplayWithEmptyChangeLog(c *gc.C) {
+	stream := s.newStream()
+
+	err := stream.createWatermark()
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := stream.Replay(context.Background(), 0, 0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results, gc.HasLen, 0)
+
+	// Once the witness has moved past the cursor, the changes after the
+	// cursor may have been pruned.
+	_, err = s.DB().Exec("UPDATE change_log_witness SET lower_bound = 5, upper_bound = 5")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = stream.Replay(context.Background(), 2, 5)
+	c.Assert(err, jc.ErrorIs, changestream.ErrChangeLogGap)
+
+	results, err = stream.Replay(context.Background(), 5, 5)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results, gc.HasLen, 0)
+}
+
+func (s *streamSuite) TestReplayWithEmptyChangeLogMultipleWitnesses(c *gc.C) {
+	stream := s.newStream()
+
+	err := stream.createWatermark()
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Changes are only pruned up to the lowest witnessed lower bound, so
+	// a cursor between the witnesses' bounds has no gap.
+	_, err = s.DB().Exec("UPDATE change_log_witness SET lower_bound = 5, upper_bound = 5")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO change_log_witness (controller_id, lower_bound, upper_bound, updated_at)
+VALUES ('other', 3, 3, DATETIME('now'))`)
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := stream.Replay(context.Background(), 4, 5)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results, gc.HasLen, 0)
+
+	_, err = stream.Replay(context.Background(), 2, 5)
+	c.Assert(err, jc.ErrorIs, changestream.ErrChangeLogGap)
+}
+
+func (s *streamSuite) TestChangeLogReport(c *gc.C) {
+	stream := s.newStream()
+
+	latest, witnesses, err := stream.ChangeLogReport(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(latest, gc.Equals, int64(-1))
+	c.Check(witnesses, gc.HasLen, 0)
+
+	err = stream.createWatermark()
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.insertNamespace(c, 1000, "foo")
+	for i := 0; i < 3; i++ {
+		s.insertChange(c, change{
+			id:   1000,
+			uuid: utils.MustNewUUID().String(),
+		})
+	}
+
+	latest, witnesses, err = stream.ChangeLogReport(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(latest, gc.Equals, int64(3))
+	c.Assert(witnesses, gc.HasLen, 1)
+	c.Check(witnesses[0].ControllerID, gc.Equals, stream.id)
+	c.Check(witnesses[0].LowerBound, gc.Equals, int64(-1))
+	c.Check(witnesses[0].UpperBound, gc.Equals, int64(-1))
+}
+
 func (s *streamSuite) newStream() *Stream {
 	return &Stream{
 		db:         s.TxnRunner(),
@@ -91,6 +91,12 @@ func (w *TestWatchableDB) Subscribe(opts ...changestream.SubscriptionOption) (ch
 	return w.mux.Subscribe(opts...)
 }
 
+// SubscribeFrom returns a subscription for the input options, which first
+// receives the changes after the given change log cursor.
+func (w *TestWatchableDB) SubscribeFrom(cursor int64, opts ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	return w.mux.SubscribeFrom(cursor, opts...)
+}
+
 // Kill stops the test change stream.
 func (h *TestWatchableDB) Kill() {
 	h.catacomb.Kill(nil)
@@ -0,0 +1,231 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+
+	coredatabase "github.com/juju/juju/core/database"
+)
+
+// dumpTimeFormat is the format used to write time values into a dump. It is
+// understood by both SQLite date functions and the Dqlite driver.
+const dumpTimeFormat = "2006-01-02 15:04:05.999999999-07:00"
+
+// schemaObject represents a row in the sqlite_master table.
+type schemaObject struct {
+	objType string
+	name    string
+	sql     string
+}
+
+// Dump writes a SQL text representation of the database accessed via the
+// input runner to the input writer. The schema and all data are read within
+// a single transaction, so the result is a consistent snapshot of the
+// database, even while it is being written to by other clients.
+//
+// The output is a series of statements, terminated by semicolons, that
+// recreate the database when executed against an empty one. Tables and
+// their rows are written first, followed by indexes, views and triggers,
+// so that triggers do not fire when the data is loaded. Foreign key checks
+// are deferred until the loading transaction commits.
+func Dump(ctx context.Context, runner coredatabase.TxnRunner, w io.Writer) error {
+	// The transaction may be retried, so the dump is spooled to a
+	// temporary file that each attempt starts afresh, and only copied to
+	// the writer once the transaction has succeeded.
+	spool, err := os.CreateTemp("", "juju-dump-")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = spool.Close()
+		_ = os.Remove(spool.Name())
+	}()
+
+	buf := bufio.NewWriter(spool)
+	err = runner.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if err := resetSpool(spool, buf); err != nil {
+			return errors.Trace(err)
+		}
+
+		objects, err := readSchemaObjects(ctx, tx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		if _, err := buf.WriteString("PRAGMA defer_foreign_keys=ON;\n"); err != nil {
+			return errors.Trace(err)
+		}
+
+		var deferred []schemaObject
+		for _, obj := range objects {
+			if obj.objType != "table" {
+				deferred = append(deferred, obj)
+				continue
+			}
+			if _, err := fmt.Fprintf(buf, "%s;\n", obj.sql); err != nil {
+				return errors.Trace(err)
+			}
+			if err := dumpTableRows(ctx, tx, obj.name, buf); err != nil {
+				return errors.Annotatef(err, "dumping table %q", obj.name)
+			}
+		}
+
+		// AUTOINCREMENT sequences are stored in an internal table that is
+		// created on demand, so it is not part of the schema objects.
+		if hasSequences, err := hasSequenceTable(ctx, tx); err != nil {
+			return errors.Trace(err)
+		} else if hasSequences {
+			if _, err := buf.WriteString("DELETE FROM sqlite_sequence;\n"); err != nil {
+				return errors.Trace(err)
+			}
+			if err := dumpTableRows(ctx, tx, "sqlite_sequence", buf); err != nil {
+				return errors.Annotate(err, "dumping sequences")
+			}
+		}
+
+		for _, obj := range deferred {
+			if _, err := fmt.Fprintf(buf, "%s;\n", obj.sql); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := buf.Flush(); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	_, err = io.Copy(w, spool)
+	return errors.Trace(err)
+}
+
+// resetSpool discards anything written to the spool by a previous
+// attempt at the dump transaction.
+func resetSpool(spool *os.File, buf *bufio.Writer) error {
+	buf.Reset(spool)
+	if err := spool.Truncate(0); err != nil {
+		return errors.Trace(err)
+	}
+	_, err := spool.Seek(0, io.SeekStart)
+	return errors.Trace(err)
+}
+
+func readSchemaObjects(ctx context.Context, tx *sql.Tx) ([]schemaObject, error) {
+	rows, err := tx.QueryContext(ctx, `
+SELECT type, name, sql
+FROM   sqlite_master
+WHERE  sql IS NOT NULL
+AND    name NOT LIKE 'sqlite_%'
+ORDER BY rowid`)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var objects []schemaObject
+	for rows.Next() {
+		var obj schemaObject
+		if err := rows.Scan(&obj.objType, &obj.name, &obj.sql); err != nil {
+			return nil, errors.Trace(err)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, errors.Trace(rows.Err())
+}
+
+func hasSequenceTable(ctx context.Context, tx *sql.Tx) (bool, error) {
+	var count int
+	row := tx.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'sqlite_sequence'")
+	if err := row.Scan(&count); err != nil {
+		return false, errors.Trace(err)
+	}
+	return count > 0, nil
+}
+
+func dumpTableRows(ctx context.Context, tx *sql.Tx, table string, w io.Writer) error {
+	quoted := quoteIdentifier(table)
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %s", quoted))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	values := make([]any, len(cols))
+	dest := make([]any, len(cols))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	literals := make([]string, len(cols))
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return errors.Trace(err)
+		}
+		for i, v := range values {
+			if literals[i], err = sqlLiteral(v); err != nil {
+				return errors.Annotatef(err, "column %q", cols[i])
+			}
+		}
+		if _, err := fmt.Fprintf(w, "INSERT INTO %s VALUES(%s);\n", quoted, strings.Join(literals, ",")); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(rows.Err())
+}
+
+// sqlLiteral returns the SQL literal representation of a value scanned
+// from the database.
+func sqlLiteral(v any) (string, error) {
+	switch t := v.(type) {
+	case nil:
+		return "NULL", nil
+	case int64:
+		return strconv.FormatInt(t, 10), nil
+	case float64:
+		return strconv.FormatFloat(t, 'g', -1, 64), nil
+	case bool:
+		if t {
+			return "1", nil
+		}
+		return "0", nil
+	case string:
+		return quoteString(t), nil
+	case []byte:
+		return "X'" + hex.EncodeToString(t) + "'", nil
+	case time.Time:
+		return quoteString(t.Format(dumpTimeFormat)), nil
+	default:
+		return "", errors.NotSupportedf("value of type %T", v)
+	}
+}
+
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
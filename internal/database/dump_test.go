@@ -0,0 +1,144 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/database/testing"
+)
+
+type dumpSuite struct {
+	testing.DqliteSuite
+}
+
+var _ = gc.Suite(&dumpSuite{})
+
+func (s *dumpSuite) TestDump(c *gc.C) {
+	db := s.DB()
+	_, err := db.Exec(`
+CREATE TABLE band (
+    id   INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    logo BLOB
+);
+CREATE TABLE album (
+    band_id INT NOT NULL,
+    title   TEXT,
+    CONSTRAINT fk_album_band
+        FOREIGN KEY (band_id)
+        REFERENCES band(id)
+);
+CREATE INDEX idx_album_band ON album (band_id);
+CREATE TRIGGER trg_band_insert AFTER INSERT ON band
+BEGIN
+    INSERT INTO album VALUES (NEW.id, NULL);
+END;
+INSERT INTO band (name, logo) VALUES ('Blood Incantation', X'cafe');
+INSERT INTO album VALUES (1, 'Hidden History of the Human Race');
+INSERT INTO band (name) VALUES ('Tomb Mold''s Fans');
+`)
+	c.Assert(err, jc.ErrorIsNil)
+
+	var buf bytes.Buffer
+	err = Dump(context.Background(), &txnRunner{db: db}, &buf)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(buf.String(), gc.Equals, `PRAGMA defer_foreign_keys=ON;
+CREATE TABLE band (
+    id   INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    logo BLOB
+);
+INSERT INTO "band" VALUES(1,'Blood Incantation',X'cafe');
+INSERT INTO "band" VALUES(2,'Tomb Mold''s Fans',NULL);
+CREATE TABLE album (
+    band_id INT NOT NULL,
+    title   TEXT,
+    CONSTRAINT fk_album_band
+        FOREIGN KEY (band_id)
+        REFERENCES band(id)
+);
+INSERT INTO "album" VALUES(1,NULL);
+INSERT INTO "album" VALUES(1,'Hidden History of the Human Race');
+INSERT INTO "album" VALUES(2,NULL);
+DELETE FROM sqlite_sequence;
+INSERT INTO "sqlite_sequence" VALUES('band',2);
+CREATE INDEX idx_album_band ON album (band_id);
+CREATE TRIGGER trg_band_insert AFTER INSERT ON band
+BEGIN
+    INSERT INTO album VALUES (NEW.id, NULL);
+END;
+`)
+}
+
+func (s *dumpSuite) TestDumpEmpty(c *gc.C) {
+	var buf bytes.Buffer
+	err := Dump(context.Background(), &txnRunner{db: s.DB()}, &buf)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(buf.String(), gc.Equals, "PRAGMA defer_foreign_keys=ON;\n")
+}
+
+func (s *dumpSuite) TestDumpRetried(c *gc.C) {
+	db := s.DB()
+	_, err := db.Exec(`
+CREATE TABLE band (name TEXT PRIMARY KEY);
+INSERT INTO band VALUES ('Blood Incantation');
+`)
+	c.Assert(err, jc.ErrorIsNil)
+
+	var buf bytes.Buffer
+	err = Dump(context.Background(), &retryingRunner{txnRunner: txnRunner{db: db}}, &buf)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Only the rows from the successful attempt are written.
+	c.Check(buf.String(), gc.Equals, `PRAGMA defer_foreign_keys=ON;
+CREATE TABLE band (name TEXT PRIMARY KEY);
+INSERT INTO "band" VALUES('Blood Incantation');
+`)
+}
+
+func (s *dumpSuite) TestDumpRestoresData(c *gc.C) {
+	db := s.DB()
+	_, err := db.Exec(`
+CREATE TABLE band (name TEXT PRIMARY KEY, formed DATETIME);
+INSERT INTO band VALUES ('Blood Incantation', '2011-01-02 03:04:05+00:00');
+`)
+	c.Assert(err, jc.ErrorIsNil)
+
+	var buf bytes.Buffer
+	err = Dump(context.Background(), &txnRunner{db: db}, &buf)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, target := s.OpenDB(c)
+	err = StdTxn(context.Background(), target, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, buf.String())
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var name, formed string
+	row := target.QueryRow("SELECT name, formed FROM band")
+	c.Assert(row.Scan(&name, &formed), jc.ErrorIsNil)
+	c.Check(name, gc.Equals, "Blood Incantation")
+	c.Check(formed, gc.Equals, "2011-01-02T03:04:05Z")
+}
+
+// retryingRunner runs each transaction twice, as a runner retrying after
+// a transient error would.
+type retryingRunner struct {
+	txnRunner
+}
+
+func (r *retryingRunner) StdTxn(ctx context.Context, fn func(context.Context, *sql.Tx) error) error {
+	if err := r.txnRunner.StdTxn(ctx, fn); err != nil {
+		return err
+	}
+	return r.txnRunner.StdTxn(ctx, fn)
+}
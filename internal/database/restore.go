@@ -0,0 +1,104 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net"
+
+	"github.com/juju/errors"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/internal/database/app"
+	"github.com/juju/juju/internal/database/pragma"
+)
+
+// Load executes a database snapshot written by Dump against the database
+// accessed via the input runner. The snapshot is applied within a single
+// transaction, so either all or none of it is loaded.
+// The target database is expected to be empty.
+func Load(ctx context.Context, runner coredatabase.TxnRunner, r io.Reader) error {
+	dump, err := io.ReadAll(r)
+	if err != nil {
+		return errors.Annotate(err, "reading database snapshot")
+	}
+	return runner.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, string(dump))
+		return errors.Trace(err)
+	})
+}
+
+// BootstrapRestoreConcern is a BootstrapConcern type that recreates the
+// database with the input namespace from a snapshot written by Dump, instead
+// of creating it from the schema DDL. The provided BootstrapInit is run once
+// the snapshot has been loaded.
+func BootstrapRestoreConcern(namespace string, dump io.Reader, bootstrapInit BootstrapInit) BootstrapConcern {
+	return func(ctx context.Context, logger Logger, dqlite *app.App) error {
+		db, err := dqlite.Open(ctx, namespace)
+		if err != nil {
+			return errors.Annotatef(err, "opening database for namespace %q", namespace)
+		}
+
+		if err := pragma.SetPragma(ctx, db, pragma.ForeignKeysPragma, true); err != nil {
+			return errors.Annotatef(err, "setting foreign keys pragma for namespace %q", namespace)
+		}
+
+		defer func() {
+			if err := db.Close(); err != nil {
+				logger.Errorf("closing database with namespace %q: %v", namespace, err)
+			}
+		}()
+
+		runner := &txnRunner{db: db}
+
+		if err := Load(ctx, runner, dump); err != nil {
+			return errors.Annotatef(err, "loading database with namespace %q", namespace)
+		}
+
+		if err := bootstrapInit(ctx, runner, dqlite); err != nil {
+			return errors.Annotatef(err, "running bootstrap init for database with namespace %q", namespace)
+		}
+		return nil
+	}
+}
+
+// RestoreControllerNodeInit returns a BootstrapInit that associates the local
+// Dqlite node with the controller with the input ID in a restored controller
+// database. All other controllers have their Dqlite node details cleared, so
+// that they join the new cluster as new nodes.
+func RestoreControllerNodeInit(controllerID string) BootstrapInit {
+	return func(ctx context.Context, runner coredatabase.TxnRunner, dqlite *app.App) error {
+		bindAddress := dqliteBootstrapBindIP
+		if host, _, err := net.SplitHostPort(dqlite.Address()); err == nil {
+			bindAddress = host
+		}
+
+		return runner.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+UPDATE controller_node
+SET    dqlite_node_id = NULL, bind_address = NULL
+WHERE  controller_id != ?`, controllerID); err != nil {
+				return errors.Trace(err)
+			}
+
+			result, err := tx.ExecContext(ctx, `
+UPDATE controller_node
+SET    dqlite_node_id = ?, bind_address = ?
+WHERE  controller_id = ?`, dqlite.ID(), bindAddress, controllerID)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if affected != 1 {
+				return errors.NotFoundf("controller %q in restored database", controllerID)
+			}
+			return nil
+		})
+	}
+}
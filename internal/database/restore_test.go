@@ -0,0 +1,103 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/internal/database/app"
+)
+
+type restoreSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&restoreSuite{})
+
+func (s *restoreSuite) TestBootstrapRestore(c *gc.C) {
+	// Bootstrap a controller database with a second controller node,
+	// then take a snapshot of it.
+	var dump bytes.Buffer
+	snapshot := func(ctx context.Context, db database.TxnRunner) error {
+		err := db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `
+INSERT INTO controller_node (controller_id, dqlite_node_id, bind_address)
+VALUES ('1', '1234', '10.0.0.1')`)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		return Dump(ctx, db, &dump)
+	}
+	err := BootstrapDqlite(
+		context.Background(), &testNodeManager{c: c}, stubLogger{}, true, BootstrapControllerConcern(snapshot))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Restore the snapshot onto a new node as controller "1".
+	var nodes [][3]sql.NullString
+	check := func(ctx context.Context, db database.TxnRunner, _ *app.App) error {
+		return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			rows, err := tx.QueryContext(ctx, `
+SELECT controller_id, dqlite_node_id, bind_address
+FROM   controller_node
+ORDER BY controller_id`)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = rows.Close() }()
+
+			for rows.Next() {
+				var node [3]sql.NullString
+				if err := rows.Scan(&node[0], &node[1], &node[2]); err != nil {
+					return err
+				}
+				nodes = append(nodes, node)
+			}
+			return rows.Err()
+		})
+	}
+	restoreInit := func(ctx context.Context, db database.TxnRunner, dqlite *app.App) error {
+		if err := RestoreControllerNodeInit("1")(ctx, db, dqlite); err != nil {
+			return err
+		}
+		return check(ctx, db, dqlite)
+	}
+
+	err = BootstrapDqlite(
+		context.Background(), &testNodeManager{c: c}, stubLogger{}, true,
+		BootstrapRestoreConcern(database.ControllerNS, strings.NewReader(dump.String()), restoreInit))
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(nodes, gc.HasLen, 2)
+	c.Check(nodes[0][0].String, gc.Equals, "0")
+	c.Check(nodes[0][1].Valid, jc.IsFalse)
+	c.Check(nodes[0][2].Valid, jc.IsFalse)
+	c.Check(nodes[1][0].String, gc.Equals, "1")
+	c.Check(nodes[1][1].Valid, jc.IsTrue)
+	c.Check(nodes[1][2].String, gc.Equals, "127.0.0.1")
+}
+
+func (s *restoreSuite) TestBootstrapRestoreUnknownController(c *gc.C) {
+	var dump bytes.Buffer
+	snapshot := func(ctx context.Context, db database.TxnRunner) error {
+		return Dump(ctx, db, &dump)
+	}
+	err := BootstrapDqlite(
+		context.Background(), &testNodeManager{c: c}, stubLogger{}, true, BootstrapControllerConcern(snapshot))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = BootstrapDqlite(
+		context.Background(), &testNodeManager{c: c}, stubLogger{}, true,
+		BootstrapRestoreConcern(database.ControllerNS, &dump, RestoreControllerNodeInit("7")))
+	c.Assert(err, gc.ErrorMatches, `.*controller "7" in restored database not found`)
+}
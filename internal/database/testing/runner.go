@@ -81,6 +81,12 @@ func (constWatchableDB) Subscribe(opts ...changestream.SubscriptionOption) (chan
 	return constSubscription{}, nil
 }
 
+// SubscribeFrom returns a subscription that can receive events from
+// a change stream, starting after the input change log cursor.
+func (constWatchableDB) SubscribeFrom(cursor int64, opts ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	return constSubscription{}, nil
+}
+
 type constSubscription struct{}
 
 // Changes returns the channel that the subscription will receive events on.
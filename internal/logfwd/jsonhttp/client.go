@@ -0,0 +1,245 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jsonhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/retry"
+
+	"github.com/juju/juju/internal/logfwd"
+)
+
+const (
+	// DefaultBatchSize is the maximum number of records sent in a
+	// single request.
+	DefaultBatchSize = 100
+
+	// DefaultRetryAttempts is the number of times a single batch is
+	// attempted before Send gives up.
+	DefaultRetryAttempts = 5
+
+	// DefaultRetryDelay is the initial delay between attempts. It
+	// doubles on each attempt, up to maxRetryDelay.
+	DefaultRetryDelay = time.Second
+
+	maxRetryDelay  = 30 * time.Second
+	requestTimeout = 30 * time.Second
+
+	contentType = "application/x-ndjson"
+)
+
+// Doer exposes the underlying functionality needed by Client.
+// It is satisfied by *http.Client.
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Client sends log records as newline-delimited JSON to a remote
+// HTTP(S) endpoint.
+type Client struct {
+	// URL is the endpoint records are POSTed to.
+	URL string
+
+	// Token is the optional bearer token sent with each request.
+	Token string
+
+	// Doer performs the HTTP requests.
+	Doer Doer
+
+	// Clock is used to wait between retries.
+	Clock clock.Clock
+
+	// BatchSize is the maximum number of records sent per request.
+	BatchSize int
+
+	// RetryAttempts is the number of times each batch is attempted.
+	RetryAttempts int
+
+	// RetryDelay is the initial delay between attempts.
+	RetryDelay time.Duration
+}
+
+// Open validates the config and returns a new client for the
+// configured endpoint.
+func Open(cfg RawConfig) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	tlsCfg, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, errors.Annotate(err, "constructing TLS config")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsCfg != nil {
+		transport.TLSClientConfig = tlsCfg
+	}
+
+	doer := &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+	}
+	client, err := OpenForDoer(cfg, doer, clock.WallClock)
+	return client, errors.Trace(err)
+}
+
+// OpenForDoer validates the config and returns a new client which
+// uses the given Doer to send requests.
+func OpenForDoer(cfg RawConfig, doer Doer, clock clock.Clock) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if cfg.URL == "" {
+		return nil, errors.NotValidf("empty URL")
+	}
+
+	return &Client{
+		URL:           cfg.URL,
+		Token:         cfg.Token,
+		Doer:          doer,
+		Clock:         clock,
+		BatchSize:     DefaultBatchSize,
+		RetryAttempts: DefaultRetryAttempts,
+		RetryDelay:    DefaultRetryDelay,
+	}, nil
+}
+
+// Close releases any idle connections held by the client.
+func (client *Client) Close() error {
+	if c, ok := client.Doer.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+	return nil
+}
+
+// Send sends the records to the remote endpoint, in batches of at
+// most BatchSize records. Each batch is retried with an exponential
+// backoff if the request fails with a network error, a 429 or a 5xx
+// response. Any other failure is returned immediately.
+//
+// Batches are sent in order, so if an error is returned then some of
+// the leading records may already have been delivered; callers that
+// resend them will produce duplicates, which is preferable to losing
+// records.
+func (client *Client) Send(records []logfwd.Record) error {
+	values := make([]interface{}, len(records))
+	for i, rec := range records {
+		values[i] = messageFromRecord(rec)
+	}
+	return errors.Trace(client.SendValues(values))
+}
+
+// SendValues sends arbitrary values to the remote endpoint, each
+// encoded as a single line of JSON. Batching and retries are the same
+// as for Send.
+func (client *Client) SendValues(values []interface{}) error {
+	batchSize := client.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	for len(values) > 0 {
+		n := batchSize
+		if n > len(values) {
+			n = len(values)
+		}
+		if err := client.sendBatch(values[:n]); err != nil {
+			return errors.Trace(err)
+		}
+		values = values[n:]
+	}
+	return nil
+}
+
+func (client *Client) sendBatch(values []interface{}) error {
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for i, v := range values {
+		if err := enc.Encode(v); err != nil {
+			return errors.Annotatef(err, "encoding value %d", i)
+		}
+	}
+
+	attempts := client.RetryAttempts
+	if attempts <= 0 {
+		attempts = 1
+	}
+	delay := client.RetryDelay
+	if delay <= 0 {
+		delay = DefaultRetryDelay
+	}
+	err := retry.Call(retry.CallArgs{
+		Attempts:    attempts,
+		Delay:       delay,
+		BackoffFunc: retry.ExpBackoff(delay, maxRetryDelay, 2.0, true),
+		Clock:       client.Clock,
+		Func: func() error {
+			return client.post(body.Bytes())
+		},
+		IsFatalError: func(err error) bool {
+			_, ok := errors.Cause(err).(*retryableError)
+			return !ok
+		},
+	})
+	if retry.IsAttemptsExceeded(err) {
+		err = retry.LastError(err)
+	}
+	if e, ok := errors.Cause(err).(*retryableError); ok {
+		err = e.err
+	}
+	return errors.Trace(err)
+}
+
+func (client *Client) post(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, client.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if client.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+client.Token)
+	}
+
+	resp, err := client.Doer.Do(req)
+	if err != nil {
+		return &retryableError{err: errors.Annotate(err, "sending log records")}
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = errors.Errorf("sending log records: %s", responseError(resp))
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return &retryableError{err: err}
+	}
+	return err
+}
+
+func responseError(resp *http.Response) string {
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	if len(bytes.TrimSpace(msg)) == 0 {
+		return resp.Status
+	}
+	return fmt.Sprintf("%s: %s", resp.Status, bytes.TrimSpace(msg))
+}
+
+// retryableError marks a failure that is worth retrying.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jsonhttp_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version/v2"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/logfwd"
+	"github.com/juju/juju/internal/logfwd/jsonhttp"
+)
+
+type ClientSuite struct {
+	testing.IsolationSuite
+
+	mu       sync.Mutex
+	requests []request
+	statuses []int
+	server   *httptest.Server
+}
+
+type request struct {
+	header http.Header
+	lines  []map[string]interface{}
+}
+
+var _ = gc.Suite(&ClientSuite{})
+
+func (s *ClientSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.requests = nil
+	s.statuses = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var lines []map[string]interface{}
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var line map[string]interface{}
+			c.Check(json.Unmarshal(scanner.Bytes(), &line), jc.ErrorIsNil)
+			lines = append(lines, line)
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, request{header: r.Header, lines: lines})
+		status := http.StatusNoContent
+		if len(s.statuses) > 0 {
+			status, s.statuses = s.statuses[0], s.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	s.AddCleanup(func(*gc.C) { s.server.Close() })
+}
+
+func (s *ClientSuite) open(c *gc.C, token string) *jsonhttp.Client {
+	client, err := jsonhttp.OpenForDoer(jsonhttp.RawConfig{
+		Enabled: true,
+		URL:     s.server.URL,
+		Token:   token,
+	}, s.server.Client(), clock.WallClock)
+	c.Assert(err, jc.ErrorIsNil)
+	client.RetryDelay = time.Millisecond
+	return client
+}
+
+func (s *ClientSuite) TestSend(c *gc.C) {
+	client := s.open(c, "s3cr3t")
+
+	err := client.Send(newRecords(2))
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.requests, gc.HasLen, 1)
+	req := s.requests[0]
+	c.Check(req.header.Get("Content-Type"), gc.Equals, "application/x-ndjson")
+	c.Check(req.header.Get("Authorization"), gc.Equals, "Bearer s3cr3t")
+	c.Check(req.lines, jc.DeepEquals, []map[string]interface{}{{
+		"id":               float64(1),
+		"timestamp":        "2026-10-18T10:00:01Z",
+		"level":            "info",
+		"controller-uuid":  "9f484882-2f18-4fd2-967d-db9663db7bea",
+		"model-uuid":       "deadbeef-2f18-4fd2-967d-db9663db7bea",
+		"hostname":         "machine-99.deadbeef-2f18-4fd2-967d-db9663db7bea",
+		"origin-type":      "machine",
+		"origin-name":      "99",
+		"software-name":    "jujud-machine-agent",
+		"software-version": "1.2.3",
+		"module":           "juju.worker.logger",
+		"location":         "logger.go:42",
+		"message":          "message 1",
+	}, {
+		"id":               float64(2),
+		"timestamp":        "2026-10-18T10:00:02Z",
+		"level":            "info",
+		"controller-uuid":  "9f484882-2f18-4fd2-967d-db9663db7bea",
+		"model-uuid":       "deadbeef-2f18-4fd2-967d-db9663db7bea",
+		"hostname":         "machine-99.deadbeef-2f18-4fd2-967d-db9663db7bea",
+		"origin-type":      "machine",
+		"origin-name":      "99",
+		"software-name":    "jujud-machine-agent",
+		"software-version": "1.2.3",
+		"module":           "juju.worker.logger",
+		"location":         "logger.go:42",
+		"message":          "message 2",
+	}})
+}
+
+func (s *ClientSuite) TestSendWithoutToken(c *gc.C) {
+	client := s.open(c, "")
+
+	err := client.Send(newRecords(1))
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.requests, gc.HasLen, 1)
+	c.Check(s.requests[0].header.Get("Authorization"), gc.Equals, "")
+}
+
+func (s *ClientSuite) TestSendBatches(c *gc.C) {
+	client := s.open(c, "")
+	client.BatchSize = 2
+
+	err := client.Send(newRecords(5))
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.requests, gc.HasLen, 3)
+	var ids []float64
+	for i, req := range s.requests {
+		c.Check(len(req.lines) <= 2, jc.IsTrue, gc.Commentf("request %d", i))
+		for _, line := range req.lines {
+			ids = append(ids, line["id"].(float64))
+		}
+	}
+	c.Check(ids, jc.DeepEquals, []float64{1, 2, 3, 4, 5})
+}
+
+func (s *ClientSuite) TestSendRetriesServerErrors(c *gc.C) {
+	s.statuses = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+	client := s.open(c, "")
+
+	err := client.Send(newRecords(1))
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.requests, gc.HasLen, 3)
+}
+
+func (s *ClientSuite) TestSendRetriesExhausted(c *gc.C) {
+	s.statuses = []int{500, 500, 500}
+	client := s.open(c, "")
+	client.RetryAttempts = 3
+
+	err := client.Send(newRecords(1))
+	c.Assert(err, gc.ErrorMatches, `sending log records: 500 Internal Server Error`)
+
+	c.Check(s.requests, gc.HasLen, 3)
+}
+
+func (s *ClientSuite) TestSendClientErrorNotRetried(c *gc.C) {
+	s.statuses = []int{http.StatusBadRequest}
+	client := s.open(c, "")
+
+	err := client.Send(newRecords(1))
+	c.Assert(err, gc.ErrorMatches, `sending log records: 400 Bad Request`)
+
+	c.Check(s.requests, gc.HasLen, 1)
+}
+
+func (s *ClientSuite) TestOpenForDoerRequiresURL(c *gc.C) {
+	_, err := jsonhttp.OpenForDoer(jsonhttp.RawConfig{}, s.server.Client(), clock.WallClock)
+	c.Assert(err, gc.ErrorMatches, `empty URL not valid`)
+}
+
+func newRecords(n int) []logfwd.Record {
+	origin := logfwd.Origin{
+		ControllerUUID: "9f484882-2f18-4fd2-967d-db9663db7bea",
+		ModelUUID:      "deadbeef-2f18-4fd2-967d-db9663db7bea",
+		Hostname:       "machine-99.deadbeef-2f18-4fd2-967d-db9663db7bea",
+		Type:           logfwd.OriginTypeMachine,
+		Name:           "99",
+		Software: logfwd.Software{
+			PrivateEnterpriseNumber: 28978,
+			Name:                    "jujud-machine-agent",
+			Version:                 version.MustParse("1.2.3"),
+		},
+	}
+	start := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+
+	var records []logfwd.Record
+	for i := 1; i <= n; i++ {
+		records = append(records, logfwd.Record{
+			ID:        int64(i),
+			Origin:    origin,
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			Level:     loggo.INFO,
+			Location: logfwd.SourceLocation{
+				Module:   "juju.worker.logger",
+				Filename: "logger.go",
+				Line:     42,
+			},
+			Message: fmt.Sprintf("message %d", i),
+		})
+	}
+	return records
+}
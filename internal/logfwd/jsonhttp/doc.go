@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package jsonhttp holds the tools needed to perform log forwarding
+// from Juju to a remote HTTP(S) endpoint that accepts newline-delimited
+// JSON (e.g. Loki or Elasticsearch style push APIs).
+package jsonhttp
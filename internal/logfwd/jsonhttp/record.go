@@ -0,0 +1,56 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jsonhttp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juju/juju/internal/logfwd"
+)
+
+// message is the JSON representation of a single log record as it is
+// sent to the remote endpoint.
+type message struct {
+	ID              int64     `json:"id"`
+	Timestamp       time.Time `json:"timestamp"`
+	Level           string    `json:"level"`
+	ControllerUUID  string    `json:"controller-uuid"`
+	ModelUUID       string    `json:"model-uuid"`
+	Hostname        string    `json:"hostname,omitempty"`
+	OriginType      string    `json:"origin-type"`
+	OriginName      string    `json:"origin-name,omitempty"`
+	SoftwareName    string    `json:"software-name,omitempty"`
+	SoftwareVersion string    `json:"software-version,omitempty"`
+	Module          string    `json:"module,omitempty"`
+	Location        string    `json:"location,omitempty"`
+	Message         string    `json:"message"`
+}
+
+func messageFromRecord(rec logfwd.Record) message {
+	msg := message{
+		ID:             rec.ID,
+		Timestamp:      rec.Timestamp.UTC(),
+		Level:          strings.ToLower(rec.Level.String()),
+		ControllerUUID: rec.Origin.ControllerUUID,
+		ModelUUID:      rec.Origin.ModelUUID,
+		Hostname:       rec.Origin.Hostname,
+		OriginType:     rec.Origin.Type.String(),
+		OriginName:     rec.Origin.Name,
+		SoftwareName:   rec.Origin.Software.Name,
+		Module:         rec.Location.Module,
+		Message:        rec.Message,
+	}
+	if rec.Origin.Software.Name != "" {
+		msg.SoftwareVersion = rec.Origin.Software.Version.String()
+	}
+	if rec.Location.Filename != "" {
+		msg.Location = rec.Location.Filename
+		if rec.Location.Line > 0 {
+			msg.Location = fmt.Sprintf("%s:%d", rec.Location.Filename, rec.Location.Line)
+		}
+	}
+	return msg
+}
@@ -3,8 +3,136 @@
 
 package objectstore
 
-import "context"
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+
+	"github.com/juju/juju/core/objectstore"
+)
 
 // ObjectStoreWorkerFunc is the function signature for creating a new object
 // store worker.
-type ObjectStoreWorkerFunc func(context.Context, string, MongoSession, Logger) (TrackedObjectStore, error)
+type ObjectStoreWorkerFunc func(context.Context, objectstore.BackendType, string, ...Option) (TrackedObjectStore, error)
+
+// Option is the function signature for the options to create a new object
+// store.
+type Option func(*options)
+
+// WithRootDir is the option to set the root directory to use, for object
+// stores that are backed by the local filesystem.
+func WithRootDir(rootDir string) Option {
+	return func(o *options) {
+		o.rootDir = rootDir
+	}
+}
+
+// WithMongoSession is the option to set the mongo session to use, for the
+// state object store.
+func WithMongoSession(session MongoSession) Option {
+	return func(o *options) {
+		o.mongoSession = session
+	}
+}
+
+// WithS3Client is the option to set the S3 client to use, for the S3 object
+// store.
+func WithS3Client(client S3Client) Option {
+	return func(o *options) {
+		o.s3Client = client
+	}
+}
+
+// WithS3Bucket is the option to set the bucket to use, for the S3 object
+// store.
+func WithS3Bucket(bucket string) Option {
+	return func(o *options) {
+		o.s3Bucket = bucket
+	}
+}
+
+// WithMetadataService is the option to set the metadata service to use.
+func WithMetadataService(metadataService MetadataService) Option {
+	return func(o *options) {
+		o.metadataService = metadataService
+	}
+}
+
+// WithBlobRetriever is the option to set the blob retriever to use, for
+// object stores that need to retrieve objects that are missing locally.
+func WithBlobRetriever(retriever BlobRetriever) Option {
+	return func(o *options) {
+		o.blobRetriever = retriever
+	}
+}
+
+// WithClock is the option to set the clock to use.
+func WithClock(clock clock.Clock) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+// WithLogger is the option to set the logger to use.
+func WithLogger(logger Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+type options struct {
+	rootDir         string
+	mongoSession    MongoSession
+	s3Client        S3Client
+	s3Bucket        string
+	metadataService MetadataService
+	blobRetriever   BlobRetriever
+	clock           clock.Clock
+	logger          Logger
+}
+
+func defaultOptions() *options {
+	return &options{
+		clock:  clock.WallClock,
+		logger: loggo.GetLogger("juju.objectstore"),
+	}
+}
+
+// ObjectStoreFactory creates a new object store for the given backend type.
+func ObjectStoreFactory(ctx context.Context, backendType objectstore.BackendType, namespace string, opts ...Option) (TrackedObjectStore, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	switch backendType {
+	case objectstore.StateBackend:
+		if o.mongoSession == nil {
+			return nil, errors.NotValidf("nil mongo session")
+		}
+		return NewStateObjectStore(ctx, namespace, o.mongoSession, o.metadataService, o.logger)
+	case objectstore.FileBackend:
+		return NewFileObjectStore(ctx, FileObjectStoreConfig{
+			RootDir:         o.rootDir,
+			Namespace:       namespace,
+			MetadataService: o.metadataService,
+			BlobRetriever:   o.blobRetriever,
+			Clock:           o.clock,
+			Logger:          o.logger,
+		})
+	case objectstore.S3Backend:
+		return NewS3ObjectStore(ctx, S3ObjectStoreConfig{
+			RootDir:         o.rootDir,
+			Namespace:       namespace,
+			Client:          o.s3Client,
+			Bucket:          o.s3Bucket,
+			MetadataService: o.metadataService,
+			Clock:           o.clock,
+			Logger:          o.logger,
+		})
+	default:
+		return nil, errors.NotValidf("backend type %q", backendType)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/objectstore"
+)
+
+type factorySuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&factorySuite{})
+
+func (s *factorySuite) TestFileBackend(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	store, err := ObjectStoreFactory(context.Background(), objectstore.FileBackend, "inferi",
+		WithRootDir(c.MkDir()),
+		WithMetadataService(NewMockMetadataService(ctrl)),
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	workertest.CleanKill(c, store)
+}
+
+func (s *factorySuite) TestStateBackendRequiresMongoSession(c *gc.C) {
+	_, err := ObjectStoreFactory(context.Background(), objectstore.StateBackend, "inferi")
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *factorySuite) TestUnknownBackend(c *gc.C) {
+	_, err := ObjectStoreFactory(context.Background(), objectstore.BackendType("blah"), "inferi")
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *factorySuite) TestS3BackendRequiresClient(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	_, err := ObjectStoreFactory(context.Background(), objectstore.S3Backend, "inferi",
+		WithRootDir(c.MkDir()),
+		WithS3Bucket("juju"),
+		WithMetadataService(NewMockMetadataService(ctrl)),
+	)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
@@ -0,0 +1,511 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3/catacomb"
+
+	"github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+)
+
+const (
+	// defaultFileDirectory is the default directory name, within the root
+	// directory, used for storing objects.
+	defaultFileDirectory = "objectstore"
+
+	// tmpDirectory is the name of the directory, within the namespace
+	// directory, used for staging objects before they are moved into place.
+	tmpDirectory = "tmp"
+
+	// replicationRetryDelay is the delay before retrying to retrieve
+	// objects that are missing locally, after a failed attempt.
+	replicationRetryDelay = 30 * time.Second
+)
+
+// FileObjectStoreConfig is the configuration for the file object store.
+type FileObjectStoreConfig struct {
+	// RootDir is the root directory under which the objects are stored.
+	RootDir string
+	// Namespace is the namespace of the object store, usually the model
+	// UUID.
+	Namespace string
+	// MetadataService is used to map paths to the stored objects.
+	MetadataService MetadataService
+	// BlobRetriever is used to retrieve objects that are referenced by the
+	// metadata, but are missing on the local filesystem. This is optional;
+	// if it is nil, missing objects are not retrieved.
+	BlobRetriever BlobRetriever
+	// Clock is used for scheduling retries of failed retrievals.
+	Clock clock.Clock
+	// Logger is used for logging.
+	Logger Logger
+}
+
+// Validate ensures that the config values are valid.
+func (c FileObjectStoreConfig) Validate() error {
+	if c.RootDir == "" {
+		return errors.NotValidf("empty RootDir")
+	}
+	if c.Namespace == "" {
+		return errors.NotValidf("empty Namespace")
+	}
+	if c.MetadataService == nil {
+		return errors.NotValidf("nil MetadataService")
+	}
+	if c.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if c.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+type fileObjectStore struct {
+	catacomb catacomb.Catacomb
+
+	path            string
+	namespace       string
+	metadataService MetadataService
+	blobRetriever   BlobRetriever
+	clock           clock.Clock
+	logger          Logger
+
+	// mutex serialises the changes to the objects on disk and their
+	// metadata, so that an object is never removed whilst it is being
+	// referenced by a new path.
+	mutex sync.Mutex
+}
+
+// NewFileObjectStore returns a new object store worker that stores objects
+// on the local filesystem. Objects are content addressed by the SHA-384 hash
+// of their contents, so identical objects stored at different paths are only
+// held once on disk. The mapping between paths and objects is recorded with
+// the metadata service.
+//
+// If a blob retriever is supplied, the metadata is watched and any object
+// that is referenced by the metadata, but isn't held locally, is retrieved
+// and verified against its recorded hash. This allows objects written by
+// other controllers to be replicated to this one.
+func NewFileObjectStore(ctx context.Context, cfg FileObjectStoreConfig) (TrackedObjectStore, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	path := filepath.Join(cfg.RootDir, defaultFileDirectory, cfg.Namespace)
+	// Anything left in the tmp directory was being staged when the store
+	// last stopped, and will never be moved into place.
+	if err := os.RemoveAll(filepath.Join(path, tmpDirectory)); err != nil {
+		return nil, errors.Annotatef(err, "removing staged objects in %q", path)
+	}
+	if err := os.MkdirAll(filepath.Join(path, tmpDirectory), 0700); err != nil {
+		return nil, errors.Annotatef(err, "creating object store directory %q", path)
+	}
+
+	s := &fileObjectStore{
+		path:            path,
+		namespace:       cfg.Namespace,
+		metadataService: cfg.MetadataService,
+		blobRetriever:   cfg.BlobRetriever,
+		clock:           cfg.Clock,
+		logger:          cfg.Logger,
+	}
+
+	// An object is moved into place before its metadata is written, so
+	// if the store stopped in between, the object is left on disk with
+	// nothing referring to it.
+	if err := s.pruneUnreferenced(ctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if err := catacomb.Invoke(catacomb.Plan{
+		Site: &s.catacomb,
+		Work: s.loop,
+	}); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return s, nil
+}
+
+// Get returns an io.ReadCloser for data at path, namespaced to the
+// model. If the object isn't held locally and a blob retriever is
+// configured, it is retrieved from a peer controller first.
+func (t *fileObjectStore) Get(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	return t.get(ctx, path, t.blobRetriever != nil)
+}
+
+// GetLocal returns an io.ReadCloser for data at path, namespaced to the
+// model, only if it is held locally. It is used to serve requests from
+// peer controllers, which must never be forwarded on to another peer.
+func (t *fileObjectStore) GetLocal(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	return t.get(ctx, path, false)
+}
+
+func (t *fileObjectStore) get(ctx context.Context, path string, retrieve bool) (io.ReadCloser, int64, error) {
+	metadata, err := t.metadataService.GetMetadata(ctx, path)
+	if errors.Is(err, objectstoreerrors.ErrNotFound) {
+		return nil, -1, errors.NotFoundf("object at path %q", path)
+	} else if err != nil {
+		return nil, -1, errors.Annotatef(err, "retrieving metadata for %q", path)
+	}
+
+	file, err := os.Open(t.filePath(metadata.Hash))
+	if os.IsNotExist(err) && retrieve {
+		// The object hasn't been replicated yet, so retrieve it from a peer
+		// rather than waiting for the replication to catch up.
+		if err := t.retrieve(ctx, metadata); err != nil {
+			return nil, -1, errors.Trace(err)
+		}
+		file, err = os.Open(t.filePath(metadata.Hash))
+	}
+	if os.IsNotExist(err) {
+		return nil, -1, errors.NotFoundf("object at path %q", path)
+	} else if err != nil {
+		return nil, -1, errors.Annotatef(err, "opening object at path %q", path)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, -1, errors.Annotatef(err, "retrieving size of object at path %q", path)
+	}
+	if info.Size() != metadata.Size {
+		_ = file.Close()
+		return nil, -1, errors.Errorf("size mismatch for object at path %q: expected %d, got %d", path, metadata.Size, info.Size())
+	}
+
+	return file, info.Size(), nil
+}
+
+// Put stores data from reader at path, namespaced to the model.
+func (t *fileObjectStore) Put(ctx context.Context, path string, r io.Reader, size int64) error {
+	return t.put(ctx, path, r, size, "")
+}
+
+// PutAndCheckHash stores data from reader at path, namespaced to the model.
+// It also ensures the stored data has the correct hash.
+func (t *fileObjectStore) PutAndCheckHash(ctx context.Context, path string, r io.Reader, size int64, hash string) error {
+	if hash == "" {
+		return errors.NotValidf("empty hash")
+	}
+	return t.put(ctx, path, r, size, hash)
+}
+
+// Remove removes data at path, namespaced to the model.
+func (t *fileObjectStore) Remove(ctx context.Context, path string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	metadata, err := t.metadataService.GetMetadata(ctx, path)
+	if errors.Is(err, objectstoreerrors.ErrNotFound) {
+		return errors.NotFoundf("object at path %q", path)
+	} else if err != nil {
+		return errors.Annotatef(err, "retrieving metadata for %q", path)
+	}
+
+	if err := t.metadataService.RemoveMetadata(ctx, path); err != nil {
+		return errors.Annotatef(err, "removing metadata for %q", path)
+	}
+	return errors.Trace(t.pruneObject(ctx, metadata.Hash))
+}
+
+// Kill implements the worker.Worker interface.
+func (s *fileObjectStore) Kill() {
+	s.catacomb.Kill(nil)
+}
+
+// Wait implements the worker.Worker interface.
+func (s *fileObjectStore) Wait() error {
+	return s.catacomb.Wait()
+}
+
+func (t *fileObjectStore) loop() error {
+	if t.blobRetriever == nil {
+		<-t.catacomb.Dying()
+		return t.catacomb.ErrDying()
+	}
+
+	// The initial event of the watcher contains all the paths, so any
+	// objects that went missing whilst we weren't running are retrieved.
+	watcher, err := t.metadataService.Watch()
+	if err != nil {
+		return errors.Annotate(err, "watching metadata")
+	}
+	if err := t.catacomb.Add(watcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	ctx, cancel := t.scopedContext()
+	defer cancel()
+
+	pending := set.NewStrings()
+	var retry <-chan time.Time
+	for {
+		select {
+		case <-t.catacomb.Dying():
+			return t.catacomb.ErrDying()
+
+		case paths, ok := <-watcher.Changes():
+			if !ok {
+				return errors.New("metadata watcher closed")
+			}
+			for _, path := range paths {
+				pending.Add(path)
+			}
+
+		case <-retry:
+		}
+
+		for _, path := range pending.SortedValues() {
+			if err := t.replicate(ctx, path); err != nil {
+				t.logger.Warningf("unable to retrieve object at path %q: %v", path, err)
+				continue
+			}
+			pending.Remove(path)
+		}
+
+		retry = nil
+		if !pending.IsEmpty() {
+			retry = t.clock.After(replicationRetryDelay)
+		}
+	}
+}
+
+// replicate ensures that the object referenced by the metadata at path is
+// held locally, retrieving it if it's missing. If the path has been removed,
+// any objects that are no longer referenced are removed locally.
+func (t *fileObjectStore) replicate(ctx context.Context, path string) error {
+	metadata, err := t.metadataService.GetMetadata(ctx, path)
+	if errors.Is(err, objectstoreerrors.ErrNotFound) {
+		// The path has since been removed, possibly by another controller,
+		// in which case the object it referred to may now be unused.
+		return errors.Trace(t.pruneUnreferenced(ctx))
+	} else if err != nil {
+		return errors.Annotatef(err, "retrieving metadata for %q", path)
+	}
+
+	if _, err := os.Stat(t.filePath(metadata.Hash)); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return errors.Annotatef(err, "checking object at path %q", path)
+	}
+
+	t.logger.Debugf("object at path %q is missing locally, retrieving", path)
+	return errors.Trace(t.retrieve(ctx, metadata))
+}
+
+// retrieve retrieves the object referenced by the metadata from a peer, and
+// moves it into place once it has been verified against the metadata.
+func (t *fileObjectStore) retrieve(ctx context.Context, metadata objectstore.Metadata) error {
+	path := metadata.Path
+	reader, size, err := t.blobRetriever.RetrieveBlob(ctx, t.namespace, path)
+	if err != nil {
+		return errors.Annotatef(err, "retrieving object at path %q", path)
+	}
+	defer reader.Close()
+
+	if size != metadata.Size {
+		return errors.NotValidf("size mismatch for %q: expected %d, got %d", path, metadata.Size, size)
+	}
+
+	tmpFileName, hash, err := t.stage(path, reader, size)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = os.Remove(tmpFileName)
+	}()
+
+	// Ensure that the retrieved object is the one that is recorded in the
+	// metadata, so that corrupted or stale objects are never served.
+	if hash != metadata.Hash {
+		return errors.NotValidf("hash mismatch for %q", path)
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	// The path may have been removed or replaced whilst the object was being
+	// retrieved, in which case the object is no longer required.
+	current, err := t.metadataService.GetMetadata(ctx, path)
+	if errors.Is(err, objectstoreerrors.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Annotatef(err, "retrieving metadata for %q", path)
+	}
+	if current.Hash != hash {
+		return nil
+	}
+
+	if err := os.Rename(tmpFileName, t.filePath(hash)); err != nil {
+		return errors.Annotatef(err, "moving object at path %q into place", path)
+	}
+	t.logger.Debugf("retrieved object at path %q", path)
+	return nil
+}
+
+func (t *fileObjectStore) put(ctx context.Context, path string, r io.Reader, size int64, checkHash string) error {
+	tmpFileName, hash, err := t.stage(path, r, size)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		// The temporary file is either moved into place, in which case
+		// removing it is a no-op, or it needs to be cleaned up.
+		_ = os.Remove(tmpFileName)
+	}()
+
+	if checkHash != "" && checkHash != hash {
+		return errors.NotValidf("hash mismatch for %q", path)
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	// If the object already exists, then it has the same contents, as the
+	// file name is the hash of the contents. The rename is atomic, so any
+	// concurrent readers either see the old or the new file.
+	if err := os.Rename(tmpFileName, t.filePath(hash)); err != nil {
+		return errors.Annotatef(err, "moving object at path %q into place", path)
+	}
+
+	metadata := objectstore.Metadata{
+		Path: path,
+		Hash: hash,
+		Size: size,
+	}
+	replaced, err := t.metadataService.ReplaceMetadata(ctx, metadata)
+	if err != nil {
+		// Don't leave the object behind if nothing else refers to it.
+		if pruneErr := t.pruneObject(ctx, hash); pruneErr != nil {
+			t.logger.Warningf("removing object at path %q: %v", path, pruneErr)
+		}
+		return errors.Annotatef(err, "putting metadata for %q", path)
+	}
+	if replaced == "" {
+		return nil
+	}
+	// The path was replaced with a different object, so remove the old
+	// object once nothing else refers to it.
+	return errors.Trace(t.pruneObject(ctx, replaced))
+}
+
+// stage writes the contents of the reader to a temporary file in the tmp
+// directory, so that a partially written object is never visible at its
+// final location. It returns the name of the temporary file, which the
+// caller is responsible for removing, along with the SHA-384 hash of its
+// contents.
+func (t *fileObjectStore) stage(path string, r io.Reader, size int64) (_ string, _ string, err error) {
+	tmpFile, err := os.CreateTemp(filepath.Join(t.path, tmpDirectory), "object-")
+	if err != nil {
+		return "", "", errors.Annotatef(err, "creating temporary file for %q", path)
+	}
+	defer func() {
+		_ = tmpFile.Close()
+		if err != nil {
+			_ = os.Remove(tmpFile.Name())
+		}
+	}()
+
+	hasher := sha512.New384()
+	written, err := io.Copy(tmpFile, io.TeeReader(r, hasher))
+	if err != nil {
+		return "", "", errors.Annotatef(err, "writing object at path %q", path)
+	}
+	if written != size {
+		return "", "", errors.NotValidf("size mismatch for %q: expected %d, got %d", path, size, written)
+	}
+
+	if err := tmpFile.Sync(); err != nil {
+		return "", "", errors.Annotatef(err, "syncing object at path %q", path)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return "", "", errors.Annotatef(err, "closing object at path %q", path)
+	}
+	return tmpFile.Name(), hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// scopedContext returns a context that is in the scope of the worker
+// lifetime. It returns a cancellable context that is cancelled when the
+// action has completed.
+func (t *fileObjectStore) scopedContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return t.catacomb.Context(ctx), cancel
+}
+
+// pruneObject removes the object with the given hash from disk, if no path
+// refers to it. It must be called with the mutex held.
+func (t *fileObjectStore) pruneObject(ctx context.Context, hash string) error {
+	referenced, err := t.metadataService.ObjectReferenced(ctx, hash)
+	if err != nil {
+		return errors.Annotatef(err, "checking references to object %q", hash)
+	} else if referenced {
+		return nil
+	}
+
+	if err := os.Remove(t.filePath(hash)); err != nil && !os.IsNotExist(err) {
+		return errors.Annotatef(err, "removing object %q", hash)
+	}
+	return nil
+}
+
+// pruneUnreferenced removes any objects from disk that no path refers to.
+// This cleans up objects whose paths were removed by another controller,
+// and objects left behind when the store stopped part way through a put.
+func (t *fileObjectStore) pruneUnreferenced(ctx context.Context) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	entries, err := os.ReadDir(t.path)
+	if err != nil {
+		return errors.Annotatef(err, "reading object store directory %q", t.path)
+	}
+	var hashes []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			hashes = append(hashes, entry.Name())
+		}
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	metadata, err := t.metadataService.ListMetadata(ctx)
+	if err != nil {
+		return errors.Annotate(err, "retrieving metadata")
+	}
+	referenced := set.NewStrings()
+	for _, m := range metadata {
+		referenced.Add(m.Hash)
+	}
+
+	for _, hash := range hashes {
+		if referenced.Contains(hash) {
+			continue
+		}
+		if err := os.Remove(t.filePath(hash)); err != nil && !os.IsNotExist(err) {
+			return errors.Annotatef(err, "removing object %q", hash)
+		}
+		t.logger.Debugf("removed unreferenced object %q", hash)
+	}
+	return nil
+}
+
+func (t *fileObjectStore) filePath(hash string) string {
+	return filepath.Join(t.path, hash)
+}
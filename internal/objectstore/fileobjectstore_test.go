@@ -0,0 +1,678 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	jujutesting "github.com/juju/juju/testing"
+)
+
+type fileObjectStoreSuite struct {
+	testing.IsolationSuite
+
+	rootDir         string
+	metadataService *MockMetadataService
+	blobRetriever   *MockBlobRetriever
+}
+
+var _ = gc.Suite(&fileObjectStoreSuite{})
+
+func (s *fileObjectStoreSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.rootDir = c.MkDir()
+}
+
+func (s *fileObjectStoreSuite) TestValidateConfig(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c)
+	c.Check(cfg.Validate(), jc.ErrorIsNil)
+
+	cfg.RootDir = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.Namespace = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.MetadataService = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.Clock = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.Logger = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+}
+
+func (s *fileObjectStoreSuite) TestPut(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}).Return("", nil)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.Put(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)))
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, err := os.ReadFile(s.objectPath(hash))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, content)
+
+	// Ensure that the staging directory has been cleaned up.
+	entries, err := os.ReadDir(filepath.Join(s.rootDir, defaultFileDirectory, "inferi", tmpDirectory))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entries, gc.HasLen, 0)
+}
+
+func (s *fileObjectStoreSuite) TestPutSizeMismatch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.Put(context.Background(), "foo", bytes.NewBufferString("some content"), 42)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+
+	_, err = os.Stat(s.objectPath(s.hash("some content")))
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+}
+
+func (s *fileObjectStoreSuite) TestPutAndCheckHash(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}).Return("", nil)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.PutAndCheckHash(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)), hash)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = os.Stat(s.objectPath(hash))
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *fileObjectStoreSuite) TestPutAndCheckHashMismatch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.PutAndCheckHash(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)), "blah")
+	c.Assert(err, gc.ErrorMatches, `hash mismatch for "foo" not valid`)
+
+	_, err = os.Stat(s.objectPath(s.hash(content)))
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+}
+
+func (s *fileObjectStoreSuite) TestPutReplacesExistingPath(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	oldContent := "old content"
+	oldHash := s.hash(oldContent)
+	newContent := "new content"
+	newHash := s.hash(newContent)
+
+	newMetadata := objectstore.Metadata{
+		Path: "foo",
+		Hash: newHash,
+		Size: int64(len(newContent)),
+	}
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	s.writeObject(c, oldContent)
+
+	gomock.InOrder(
+		s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), newMetadata).Return(oldHash, nil),
+		s.metadataService.EXPECT().ObjectReferenced(gomock.Any(), oldHash).Return(false, nil),
+	)
+
+	err := store.Put(context.Background(), "foo", bytes.NewBufferString(newContent), int64(len(newContent)))
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = os.Stat(s.objectPath(newHash))
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = os.Stat(s.objectPath(oldHash))
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+}
+
+func (s *fileObjectStoreSuite) TestGet(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}, nil)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	s.writeObject(c, content)
+
+	r, size, err := store.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	defer r.Close()
+
+	c.Check(size, gc.Equals, int64(len(content)))
+	data, err := io.ReadAll(r)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, content)
+}
+
+func (s *fileObjectStoreSuite) TestGetNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{}, objectstoreerrors.ErrNotFound)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	_, _, err := store.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *fileObjectStoreSuite) TestGetRetrievesMissingObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	metadata := objectstore.Metadata{
+		Path: "foo",
+		Hash: s.hash(content),
+		Size: int64(len(content)),
+	}
+
+	s.metadataService.EXPECT().Watch().Return(watchertest.NewMockStringsWatcher(make(chan []string)), nil)
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(metadata, nil).Times(2)
+	s.blobRetriever.EXPECT().RetrieveBlob(gomock.Any(), "inferi", "foo").Return(io.NopCloser(bytes.NewBufferString(content)), int64(len(content)), nil)
+
+	cfg := s.newConfig(c)
+	cfg.BlobRetriever = s.blobRetriever
+	store, err := NewFileObjectStore(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, store)
+
+	r, size, err := store.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	defer r.Close()
+
+	c.Check(size, gc.Equals, int64(len(content)))
+	data, err := io.ReadAll(r)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, content)
+}
+
+func (s *fileObjectStoreSuite) TestGetMissingObjectNotRetrieved(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: s.hash("some content"),
+		Size: 12,
+	}, nil)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	_, _, err := store.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *fileObjectStoreSuite) TestGetMissingFromAllPeers(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.metadataService.EXPECT().Watch().DoAndReturn(func() (watcher.StringsWatcher, error) {
+		return watchertest.NewMockStringsWatcher(make(chan []string)), nil
+	}).Times(2)
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: s.hash("some content"),
+		Size: 12,
+	}, nil).AnyTimes()
+
+	// Each store retrieves missing objects from the other, in the same way
+	// that the peer controllers serve object requests. Neither store holds
+	// the object, so each must only be asked once.
+	var storeA, storeB TrackedObjectStore
+	retrieverA := NewMockBlobRetriever(ctrl)
+	retrieverA.EXPECT().RetrieveBlob(gomock.Any(), "inferi", "foo").DoAndReturn(func(ctx context.Context, _, path string) (io.ReadCloser, int64, error) {
+		return storeB.GetLocal(ctx, path)
+	})
+	retrieverB := NewMockBlobRetriever(ctrl)
+	retrieverB.EXPECT().RetrieveBlob(gomock.Any(), "inferi", "foo").DoAndReturn(func(ctx context.Context, _, path string) (io.ReadCloser, int64, error) {
+		return storeA.GetLocal(ctx, path)
+	})
+
+	var err error
+	cfg := s.newConfig(c)
+	cfg.RootDir = c.MkDir()
+	cfg.BlobRetriever = retrieverA
+	storeA, err = NewFileObjectStore(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, storeA)
+
+	cfg = s.newConfig(c)
+	cfg.RootDir = c.MkDir()
+	cfg.BlobRetriever = retrieverB
+	storeB, err = NewFileObjectStore(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, storeB)
+
+	_, _, err = storeA.Get(context.Background(), "foo")
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+	_, _, err = storeB.Get(context.Background(), "foo")
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *fileObjectStoreSuite) TestRemove(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}, nil)
+	s.metadataService.EXPECT().RemoveMetadata(gomock.Any(), "foo").Return(nil)
+	s.metadataService.EXPECT().ObjectReferenced(gomock.Any(), hash).Return(false, nil)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	s.writeObject(c, content)
+
+	err := store.Remove(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = os.Stat(s.objectPath(hash))
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+}
+
+func (s *fileObjectStoreSuite) TestRemoveSharedObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}, nil)
+	s.metadataService.EXPECT().RemoveMetadata(gomock.Any(), "foo").Return(nil)
+	s.metadataService.EXPECT().ObjectReferenced(gomock.Any(), hash).Return(true, nil)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	s.writeObject(c, content)
+
+	err := store.Remove(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The object is still referenced by "bar", so it must not be removed.
+	_, err = os.Stat(s.objectPath(hash))
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *fileObjectStoreSuite) TestRemoveNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{}, objectstoreerrors.ErrNotFound)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.Remove(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *fileObjectStoreSuite) TestReplicateMissingObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+	metadata := objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}
+
+	changes := make(chan []string, 1)
+	changes <- []string{"foo"}
+	s.metadataService.EXPECT().Watch().Return(watchertest.NewMockStringsWatcher(changes), nil)
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(metadata, nil).Times(2)
+
+	done := make(chan struct{})
+	s.blobRetriever.EXPECT().RetrieveBlob(gomock.Any(), "inferi", "foo").DoAndReturn(func(context.Context, string, string) (io.ReadCloser, int64, error) {
+		defer close(done)
+		return io.NopCloser(bytes.NewBufferString(content)), int64(len(content)), nil
+	})
+
+	cfg := s.newConfig(c)
+	cfg.BlobRetriever = s.blobRetriever
+	store, err := NewFileObjectStore(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, store)
+
+	select {
+	case <-done:
+	case <-time.After(jujutesting.LongWait):
+		c.Fatalf("timed out waiting for object to be retrieved")
+	}
+
+	s.waitForObject(c, hash)
+	data, err := os.ReadFile(s.objectPath(hash))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, content)
+}
+
+func (s *fileObjectStoreSuite) TestReplicateExistingObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	changes := make(chan []string, 1)
+	changes <- []string{"foo"}
+	s.metadataService.EXPECT().Watch().Return(watchertest.NewMockStringsWatcher(changes), nil)
+
+	done := make(chan struct{})
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").DoAndReturn(func(context.Context, string) (objectstore.Metadata, error) {
+		defer close(done)
+		return objectstore.Metadata{
+			Path: "foo",
+			Hash: hash,
+			Size: int64(len(content)),
+		}, nil
+	})
+	s.metadataService.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}}, nil)
+
+	// The object is already held locally, so it isn't retrieved.
+	err := os.MkdirAll(filepath.Join(s.rootDir, defaultFileDirectory, "inferi"), 0700)
+	c.Assert(err, jc.ErrorIsNil)
+	s.writeObject(c, content)
+
+	cfg := s.newConfig(c)
+	cfg.BlobRetriever = s.blobRetriever
+	store, err := NewFileObjectStore(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, store)
+
+	select {
+	case <-done:
+	case <-time.After(jujutesting.LongWait):
+		c.Fatalf("timed out waiting for metadata to be checked")
+	}
+}
+
+func (s *fileObjectStoreSuite) TestReplicateHashMismatch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+	corrupted := "some kontent"
+
+	changes := make(chan []string, 1)
+	changes <- []string{"foo"}
+	s.metadataService.EXPECT().Watch().Return(watchertest.NewMockStringsWatcher(changes), nil)
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}, nil)
+
+	done := make(chan struct{})
+	s.blobRetriever.EXPECT().RetrieveBlob(gomock.Any(), "inferi", "foo").DoAndReturn(func(context.Context, string, string) (io.ReadCloser, int64, error) {
+		return &closeNotifier{
+			Reader: bytes.NewBufferString(corrupted),
+			closed: done,
+		}, int64(len(corrupted)), nil
+	})
+
+	// The retry is never triggered, as the clock isn't advanced.
+	cfg := s.newConfig(c)
+	cfg.BlobRetriever = s.blobRetriever
+	cfg.Clock = testclock.NewClock(time.Now())
+	store, err := NewFileObjectStore(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, store)
+
+	select {
+	case <-done:
+	case <-time.After(jujutesting.LongWait):
+		c.Fatalf("timed out waiting for object to be retrieved")
+	}
+
+	// The corrupted object must never be moved into place.
+	workertest.CleanKill(c, store)
+	_, err = os.Stat(s.objectPath(hash))
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+	entries, err := os.ReadDir(filepath.Join(s.rootDir, defaultFileDirectory, "inferi", tmpDirectory))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entries, gc.HasLen, 0)
+}
+
+func (s *fileObjectStoreSuite) TestReplicateRemovedPath(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	removed := "some content"
+	kept := "other content"
+
+	changes := make(chan []string, 1)
+	changes <- []string{"foo"}
+	s.metadataService.EXPECT().Watch().Return(watchertest.NewMockStringsWatcher(changes), nil)
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{}, objectstoreerrors.ErrNotFound)
+	// The path is still referenced when the store starts, but has been
+	// removed by another controller by the time it's replicated.
+	s.metadataService.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		Path: "foo",
+		Hash: s.hash(removed),
+		Size: int64(len(removed)),
+	}, {
+		Path: "bar",
+		Hash: s.hash(kept),
+		Size: int64(len(kept)),
+	}}, nil)
+	s.metadataService.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		Path: "bar",
+		Hash: s.hash(kept),
+		Size: int64(len(kept)),
+	}}, nil)
+
+	// The path was removed by another controller, so the object it referred
+	// to is removed locally, whilst still referenced objects are kept.
+	err := os.MkdirAll(filepath.Join(s.rootDir, defaultFileDirectory, "inferi"), 0700)
+	c.Assert(err, jc.ErrorIsNil)
+	s.writeObject(c, removed)
+	s.writeObject(c, kept)
+
+	cfg := s.newConfig(c)
+	cfg.BlobRetriever = s.blobRetriever
+	store, err := NewFileObjectStore(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, store)
+
+	for a := jujutesting.LongAttempt.Start(); a.Next(); {
+		if _, err := os.Stat(s.objectPath(s.hash(removed))); os.IsNotExist(err) {
+			break
+		}
+	}
+	_, err = os.Stat(s.objectPath(s.hash(removed)))
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+	_, err = os.Stat(s.objectPath(s.hash(kept)))
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *fileObjectStoreSuite) TestStartRemovesOrphanedObjects(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	orphaned := "some content"
+	kept := "other content"
+
+	s.metadataService.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		Path: "bar",
+		Hash: s.hash(kept),
+		Size: int64(len(kept)),
+	}}, nil)
+
+	// The store stopped whilst putting an object, after it was moved into
+	// place but before its metadata was written, and whilst staging another.
+	tmpDir := filepath.Join(s.rootDir, defaultFileDirectory, "inferi", tmpDirectory)
+	err := os.MkdirAll(tmpDir, 0700)
+	c.Assert(err, jc.ErrorIsNil)
+	s.writeObject(c, orphaned)
+	s.writeObject(c, kept)
+	err = os.WriteFile(filepath.Join(tmpDir, "object-123"), []byte("partial"), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	_, err = os.Stat(s.objectPath(s.hash(orphaned)))
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+	_, err = os.Stat(s.objectPath(s.hash(kept)))
+	c.Check(err, jc.ErrorIsNil)
+	entries, err := os.ReadDir(tmpDir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entries, gc.HasLen, 0)
+}
+
+func (s *fileObjectStoreSuite) TestPutMetadataFailureRemovesObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}).Return("", errors.New("boom"))
+	s.metadataService.EXPECT().ObjectReferenced(gomock.Any(), hash).Return(false, nil)
+
+	store := s.newFileObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.Put(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)))
+	c.Assert(err, gc.ErrorMatches, `putting metadata for "foo": boom`)
+
+	_, err = os.Stat(s.objectPath(hash))
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+}
+
+func (s *fileObjectStoreSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.metadataService = NewMockMetadataService(ctrl)
+	s.blobRetriever = NewMockBlobRetriever(ctrl)
+
+	return ctrl
+}
+
+func (s *fileObjectStoreSuite) newConfig(c *gc.C) FileObjectStoreConfig {
+	return FileObjectStoreConfig{
+		RootDir:         s.rootDir,
+		Namespace:       "inferi",
+		MetadataService: s.metadataService,
+		Clock:           clock.WallClock,
+		Logger:          jujutesting.NewCheckLogger(c),
+	}
+}
+
+func (s *fileObjectStoreSuite) newFileObjectStore(c *gc.C) TrackedObjectStore {
+	store, err := NewFileObjectStore(context.Background(), s.newConfig(c))
+	c.Assert(err, jc.ErrorIsNil)
+	return store
+}
+
+func (s *fileObjectStoreSuite) writeObject(c *gc.C, content string) {
+	err := os.WriteFile(s.objectPath(s.hash(content)), []byte(content), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *fileObjectStoreSuite) waitForObject(c *gc.C, hash string) {
+	for a := jujutesting.LongAttempt.Start(); a.Next(); {
+		if _, err := os.Stat(s.objectPath(hash)); err == nil {
+			return
+		}
+	}
+	c.Fatalf("timed out waiting for object %q", hash)
+}
+
+func (s *fileObjectStoreSuite) objectPath(hash string) string {
+	return filepath.Join(s.rootDir, defaultFileDirectory, "inferi", hash)
+}
+
+func (s *fileObjectStoreSuite) hash(content string) string {
+	hasher := sha512.New384()
+	_, _ = hasher.Write([]byte(content))
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// closeNotifier is an io.ReadCloser that closes the channel once it has been
+// closed.
+type closeNotifier struct {
+	io.Reader
+	closed chan struct{}
+}
+
+func (c *closeNotifier) Close() error {
+	close(c.closed)
+	return nil
+}
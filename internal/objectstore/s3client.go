@@ -0,0 +1,92 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/logging"
+	"github.com/juju/errors"
+)
+
+const (
+	// defaultS3Region is the region used when talking to an S3 compatible
+	// endpoint. Most S3 compatible stores ignore the region, but it is
+	// required for signing the requests.
+	defaultS3Region = "us-east-1"
+)
+
+// S3Client describes the subset of the S3 API that is used by the S3 object
+// store.
+type S3Client interface {
+	// CreateBucket creates a new bucket.
+	CreateBucket(context.Context, *s3.CreateBucketInput, ...func(*s3.Options)) (*s3.CreateBucketOutput, error)
+	// GetObject retrieves an object.
+	GetObject(context.Context, *s3.GetObjectInput, ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	// HeadObject retrieves the metadata of an object, without its contents.
+	HeadObject(context.Context, *s3.HeadObjectInput, ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	// PutObject adds an object in a single request.
+	PutObject(context.Context, *s3.PutObjectInput, ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	// DeleteObject removes an object.
+	DeleteObject(context.Context, *s3.DeleteObjectInput, ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	// CreateMultipartUpload initiates a multipart upload.
+	CreateMultipartUpload(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	// UploadPart uploads a part of a multipart upload.
+	UploadPart(context.Context, *s3.UploadPartInput, ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	// CompleteMultipartUpload completes a multipart upload by assembling the
+	// uploaded parts.
+	CompleteMultipartUpload(context.Context, *s3.CompleteMultipartUploadInput, ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	// AbortMultipartUpload aborts a multipart upload, freeing any uploaded
+	// parts.
+	AbortMultipartUpload(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+}
+
+// S3Credentials are the static credentials used to access an S3 compatible
+// endpoint.
+type S3Credentials struct {
+	AccessKey string
+	SecretKey string
+	Session   string
+}
+
+// NewS3Client returns a new S3 client for the given S3 compatible endpoint.
+// Path style addressing is used, as that is what most S3 compatible stores
+// (MinIO, Ceph RGW) expect.
+func NewS3Client(endpoint string, creds S3Credentials, logger Logger) (S3Client, error) {
+	if endpoint == "" {
+		return nil, errors.NotValidf("empty endpoint")
+	}
+	if creds.AccessKey == "" || creds.SecretKey == "" {
+		return nil, errors.NotValidf("empty credentials")
+	}
+
+	return s3.New(s3.Options{
+		Region:           defaultS3Region,
+		EndpointResolver: s3.EndpointResolverFromURL(endpoint),
+		Credentials: aws.NewCredentialsCache(
+			credentials.NewStaticCredentialsProvider(creds.AccessKey, creds.SecretKey, creds.Session),
+		),
+		Logger:       &s3Logger{logger: logger},
+		UsePathStyle: true,
+	}), nil
+}
+
+type s3Logger struct {
+	logger Logger
+}
+
+// Logf logs the message at the level of the given classification.
+func (l *s3Logger) Logf(classification logging.Classification, format string, v ...interface{}) {
+	switch classification {
+	case logging.Warn:
+		l.logger.Warningf(format, v...)
+	case logging.Debug:
+		l.logger.Debugf(format, v...)
+	default:
+		l.logger.Tracef(format, v...)
+	}
+}
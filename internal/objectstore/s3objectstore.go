@@ -0,0 +1,464 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+)
+
+const (
+	// defaultS3PartSize is the size of each part when uploading objects
+	// using a multipart upload. Objects smaller than this are uploaded in a
+	// single request.
+	defaultS3PartSize = 64 << 20
+
+	// minS3PartSize is the minimum size of a part, other than the last part,
+	// that S3 accepts in a multipart upload.
+	minS3PartSize = 5 << 20
+
+	// s3RemovalPendingAttempts is the number of times an object is uploaded
+	// whilst another controller is removing the same object, before giving
+	// up.
+	s3RemovalPendingAttempts = 5
+
+	// s3RemovalPendingDelay is the delay between the attempts to upload an
+	// object that another controller is removing.
+	s3RemovalPendingDelay = time.Second
+)
+
+// S3ObjectStoreConfig is the configuration for the S3 object store.
+type S3ObjectStoreConfig struct {
+	// RootDir is the root directory under which objects are staged, before
+	// they are uploaded.
+	RootDir string
+	// Namespace is the namespace of the object store, usually the model
+	// UUID.
+	Namespace string
+	// Client is the S3 client used to talk to the S3 compatible endpoint.
+	Client S3Client
+	// Bucket is the bucket in which the objects are stored.
+	Bucket string
+	// PartSize is the size of each part of a multipart upload. If it is
+	// zero, a default part size is used.
+	PartSize int64
+	// MetadataService is used to map paths to the stored objects.
+	MetadataService MetadataService
+	// Clock is used for delaying uploads of objects that are being removed.
+	Clock clock.Clock
+	// Logger is used for logging.
+	Logger Logger
+}
+
+// Validate ensures that the config values are valid.
+func (c S3ObjectStoreConfig) Validate() error {
+	if c.RootDir == "" {
+		return errors.NotValidf("empty RootDir")
+	}
+	if c.Namespace == "" {
+		return errors.NotValidf("empty Namespace")
+	}
+	if c.Client == nil {
+		return errors.NotValidf("nil Client")
+	}
+	if c.Bucket == "" {
+		return errors.NotValidf("empty Bucket")
+	}
+	if c.PartSize != 0 && c.PartSize < minS3PartSize {
+		return errors.NotValidf("PartSize %d less than %d", c.PartSize, minS3PartSize)
+	}
+	if c.MetadataService == nil {
+		return errors.NotValidf("nil MetadataService")
+	}
+	if c.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if c.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+type s3ObjectStore struct {
+	tomb tomb.Tomb
+
+	stagingPath     string
+	namespace       string
+	client          S3Client
+	bucket          string
+	partSize        int64
+	metadataService MetadataService
+	clock           clock.Clock
+	logger          Logger
+
+	// mutex serialises the changes to the objects in the bucket and their
+	// metadata made by this controller. Changes made by other controllers
+	// sharing the bucket are guarded by claiming the removal of an object
+	// with the metadata service.
+	mutex sync.Mutex
+}
+
+// NewS3ObjectStore returns a new object store worker that stores objects in
+// an S3 compatible object store. Objects are content addressed by the
+// SHA-384 hash of their contents, under a key prefixed by the namespace, so
+// identical objects stored at different paths are only held once. Objects
+// are staged on the local filesystem, so that their hash can be computed and
+// verified before they're uploaded; the staged copy is removed once the
+// upload has completed. Large objects are uploaded using a multipart upload.
+func NewS3ObjectStore(ctx context.Context, cfg S3ObjectStoreConfig) (TrackedObjectStore, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stagingPath := filepath.Join(cfg.RootDir, defaultFileDirectory, cfg.Namespace, tmpDirectory)
+	if err := os.MkdirAll(stagingPath, 0700); err != nil {
+		return nil, errors.Annotatef(err, "creating object store staging directory %q", stagingPath)
+	}
+
+	if err := ensureBucket(ctx, cfg.Client, cfg.Bucket); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	partSize := cfg.PartSize
+	if partSize == 0 {
+		partSize = defaultS3PartSize
+	}
+
+	s := &s3ObjectStore{
+		stagingPath:     stagingPath,
+		namespace:       cfg.Namespace,
+		client:          cfg.Client,
+		bucket:          cfg.Bucket,
+		partSize:        partSize,
+		metadataService: cfg.MetadataService,
+		clock:           cfg.Clock,
+		logger:          cfg.Logger,
+	}
+
+	s.tomb.Go(s.loop)
+
+	return s, nil
+}
+
+// Get returns an io.ReadCloser for data at path, namespaced to the
+// model.
+func (t *s3ObjectStore) Get(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	metadata, err := t.metadataService.GetMetadata(ctx, path)
+	if errors.Is(err, objectstoreerrors.ErrNotFound) {
+		return nil, -1, errors.NotFoundf("object at path %q", path)
+	} else if err != nil {
+		return nil, -1, errors.Annotatef(err, "retrieving metadata for %q", path)
+	}
+
+	obj, err := t.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(t.objectKey(metadata.Hash)),
+	})
+	if isS3NotFound(err) {
+		return nil, -1, errors.NotFoundf("object at path %q", path)
+	} else if err != nil {
+		return nil, -1, errors.Annotatef(err, "retrieving object at path %q", path)
+	}
+
+	if obj.ContentLength != metadata.Size {
+		_ = obj.Body.Close()
+		return nil, -1, errors.Errorf("size mismatch for object at path %q: expected %d, got %d", path, metadata.Size, obj.ContentLength)
+	}
+
+	return obj.Body, obj.ContentLength, nil
+}
+
+// GetLocal returns an io.ReadCloser for data at path, namespaced to the
+// model. The objects are held in the shared bucket, so every controller
+// holds every object.
+func (t *s3ObjectStore) GetLocal(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	return t.Get(ctx, path)
+}
+
+// Put stores data from reader at path, namespaced to the model.
+func (t *s3ObjectStore) Put(ctx context.Context, path string, r io.Reader, size int64) error {
+	return t.put(ctx, path, r, size, "")
+}
+
+// PutAndCheckHash stores data from reader at path, namespaced to the model.
+// It also ensures the stored data has the correct hash.
+func (t *s3ObjectStore) PutAndCheckHash(ctx context.Context, path string, r io.Reader, size int64, hash string) error {
+	if hash == "" {
+		return errors.NotValidf("empty hash")
+	}
+	return t.put(ctx, path, r, size, hash)
+}
+
+// Remove removes data at path, namespaced to the model.
+func (t *s3ObjectStore) Remove(ctx context.Context, path string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	metadata, err := t.metadataService.GetMetadata(ctx, path)
+	if errors.Is(err, objectstoreerrors.ErrNotFound) {
+		return errors.NotFoundf("object at path %q", path)
+	} else if err != nil {
+		return errors.Annotatef(err, "retrieving metadata for %q", path)
+	}
+
+	if err := t.metadataService.RemoveMetadata(ctx, path); err != nil {
+		return errors.Annotatef(err, "removing metadata for %q", path)
+	}
+	return errors.Trace(t.pruneObject(ctx, metadata.Hash))
+}
+
+// Kill implements the worker.Worker interface.
+func (s *s3ObjectStore) Kill() {
+	s.tomb.Kill(nil)
+}
+
+// Wait implements the worker.Worker interface.
+func (s *s3ObjectStore) Wait() error {
+	return s.tomb.Wait()
+}
+
+func (t *s3ObjectStore) loop() error {
+	<-t.tomb.Dying()
+	return tomb.ErrDying
+}
+
+func (t *s3ObjectStore) put(ctx context.Context, path string, r io.Reader, size int64, checkHash string) error {
+	tmpFile, err := os.CreateTemp(t.stagingPath, "object-")
+	if err != nil {
+		return errors.Annotatef(err, "creating temporary file for %q", path)
+	}
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	hasher := sha512.New384()
+	written, err := io.Copy(tmpFile, io.TeeReader(r, hasher))
+	if err != nil {
+		return errors.Annotatef(err, "writing object at path %q", path)
+	}
+	if written != size {
+		return errors.NotValidf("size mismatch for %q: expected %d, got %d", path, size, written)
+	}
+	hash := hex.EncodeToString(hasher.Sum(nil))
+	if checkHash != "" && checkHash != hash {
+		return errors.NotValidf("hash mismatch for %q", path)
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	// Another controller may be removing the same object, in which case the
+	// metadata can't refer to it until that completes. The object may have
+	// been removed after it was uploaded, so it's uploaded again each time.
+	for attempt := 1; ; attempt++ {
+		err = t.putObject(ctx, path, tmpFile, size, hash)
+		if !errors.Is(err, objectstoreerrors.ErrRemovalPending) || attempt == s3RemovalPendingAttempts {
+			return errors.Trace(err)
+		}
+		t.logger.Debugf("object at path %q is being removed, retrying", path)
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-t.clock.After(s3RemovalPendingDelay):
+		}
+	}
+}
+
+// putObject uploads the staged file and records the metadata for it. It
+// must be called with the mutex held.
+func (t *s3ObjectStore) putObject(ctx context.Context, path string, tmpFile *os.File, size int64, hash string) error {
+	// If the object already exists, then it has the same contents, as the
+	// key is the hash of the contents, so it's safe to overwrite it.
+	if err := t.upload(ctx, t.objectKey(hash), tmpFile, size); err != nil {
+		return errors.Annotatef(err, "uploading object at path %q", path)
+	}
+
+	metadata := objectstore.Metadata{
+		Path: path,
+		Hash: hash,
+		Size: size,
+	}
+	replaced, err := t.metadataService.ReplaceMetadata(ctx, metadata)
+	if err != nil {
+		return errors.Annotatef(err, "putting metadata for %q", path)
+	}
+
+	// Another controller may have removed the object after it was uploaded,
+	// but before the metadata referred to it. Now that it's referenced, it
+	// can't be claimed for removal again, so uploading it once more is safe.
+	_, err = t.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(t.objectKey(hash)),
+	})
+	if isS3NotFound(err) {
+		t.logger.Debugf("object at path %q was removed whilst being put, uploading again", path)
+		err = t.upload(ctx, t.objectKey(hash), tmpFile, size)
+	}
+	if err != nil {
+		return errors.Annotatef(err, "ensuring object at path %q exists", path)
+	}
+
+	if replaced == "" {
+		return nil
+	}
+	// The path was replaced with a different object, so remove the old
+	// object once nothing else refers to it.
+	return errors.Trace(t.pruneObject(ctx, replaced))
+}
+
+// upload uploads the staged file to the given key. Files larger than the
+// part size are uploaded using a multipart upload.
+func (t *s3ObjectStore) upload(ctx context.Context, key string, file *os.File, size int64) error {
+	if size <= t.partSize {
+		_, err := t.client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:        aws.String(t.bucket),
+			Key:           aws.String(key),
+			Body:          io.NewSectionReader(file, 0, size),
+			ContentLength: size,
+		})
+		return errors.Trace(err)
+	}
+
+	upload, err := t.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return errors.Annotate(err, "creating multipart upload")
+	}
+
+	parts, err := t.uploadParts(ctx, key, upload.UploadId, file, size)
+	if err != nil {
+		// Abort the upload, so that the uploaded parts don't linger in the
+		// bucket. Use a fresh context, as the original may have been
+		// cancelled.
+		if _, abortErr := t.client.AbortMultipartUpload(context.Background(), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(t.bucket),
+			Key:      aws.String(key),
+			UploadId: upload.UploadId,
+		}); abortErr != nil {
+			t.logger.Warningf("aborting multipart upload of %q: %v", key, abortErr)
+		}
+		return errors.Trace(err)
+	}
+
+	_, err = t.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   aws.String(t.bucket),
+		Key:      aws.String(key),
+		UploadId: upload.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+	})
+	return errors.Annotate(err, "completing multipart upload")
+}
+
+func (t *s3ObjectStore) uploadParts(ctx context.Context, key string, uploadID *string, file *os.File, size int64) ([]types.CompletedPart, error) {
+	var parts []types.CompletedPart
+	for offset, partNumber := int64(0), int32(1); offset < size; offset, partNumber = offset+t.partSize, partNumber+1 {
+		partSize := t.partSize
+		if remaining := size - offset; remaining < partSize {
+			partSize = remaining
+		}
+
+		t.logger.Tracef("uploading part %d of %q (%d bytes)", partNumber, key, partSize)
+		part, err := t.client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(t.bucket),
+			Key:           aws.String(key),
+			UploadId:      uploadID,
+			PartNumber:    partNumber,
+			Body:          io.NewSectionReader(file, offset, partSize),
+			ContentLength: partSize,
+		})
+		if err != nil {
+			return nil, errors.Annotatef(err, "uploading part %d", partNumber)
+		}
+		parts = append(parts, types.CompletedPart{
+			ETag:       part.ETag,
+			PartNumber: partNumber,
+		})
+	}
+	return parts, nil
+}
+
+// pruneObject removes the object with the given hash from the bucket, if no
+// path refers to it. The removal is claimed with the metadata service first,
+// so that another controller sharing the bucket can't reference the object
+// whilst it is being removed. It must be called with the mutex held.
+func (t *s3ObjectStore) pruneObject(ctx context.Context, hash string) error {
+	err := t.metadataService.ClaimRemoval(ctx, hash)
+	if errors.Is(err, objectstoreerrors.ErrObjectReferenced) || errors.Is(err, objectstoreerrors.ErrRemovalPending) {
+		// The object is still in use, or is already being removed by
+		// another controller.
+		return nil
+	} else if err != nil {
+		return errors.Annotatef(err, "claiming removal of object %q", hash)
+	}
+	defer func() {
+		if err := t.metadataService.ReleaseRemoval(ctx, hash); err != nil {
+			t.logger.Warningf("releasing removal of object %q: %v", hash, err)
+		}
+	}()
+
+	_, err = t.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(t.bucket),
+		Key:    aws.String(t.objectKey(hash)),
+	})
+	if err != nil && !isS3NotFound(err) {
+		return errors.Annotatef(err, "removing object %q", hash)
+	}
+	return nil
+}
+
+func (t *s3ObjectStore) objectKey(hash string) string {
+	return t.namespace + "/" + hash
+}
+
+// ensureBucket creates the bucket if it doesn't already exist. A bucket
+// that already exists, but is owned by another account, is an error.
+func ensureBucket(ctx context.Context, client S3Client, bucket string) error {
+	_, err := client.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	var alreadyOwned *types.BucketAlreadyOwnedByYou
+	if err == nil || errors.As(err, &alreadyOwned) {
+		return nil
+	}
+	return errors.Annotatef(err, "creating bucket %q", bucket)
+}
+
+func isS3NotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	var (
+		noSuchKey *types.NoSuchKey
+		notFound  *types.NotFound
+	)
+	if errors.As(err, &noSuchKey) || errors.As(err, &notFound) {
+		return true
+	}
+	// Responses to HEAD requests don't have a body, so the error isn't
+	// always mapped to one of the typed errors.
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "NotFound"
+}
@@ -0,0 +1,501 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	jujutesting "github.com/juju/juju/testing"
+)
+
+type s3ObjectStoreSuite struct {
+	testing.IsolationSuite
+
+	server          *s3Server
+	metadataService *MockMetadataService
+}
+
+var _ = gc.Suite(&s3ObjectStoreSuite{})
+
+func (s *s3ObjectStoreSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.server = newS3Server()
+	s.AddCleanup(func(*gc.C) { s.server.Close() })
+}
+
+func (s *s3ObjectStoreSuite) TestValidateConfig(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c)
+	c.Check(cfg.Validate(), jc.ErrorIsNil)
+
+	cfg.RootDir = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.Namespace = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.Client = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.Bucket = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.PartSize = 1024
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.MetadataService = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.Clock = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.newConfig(c)
+	cfg.Logger = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+}
+
+func (s *s3ObjectStoreSuite) TestNewCreatesBucket(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	store := s.newS3ObjectStore(c)
+	workertest.CleanKill(c, store)
+
+	// Starting a second store against the same bucket must not fail.
+	store = s.newS3ObjectStore(c)
+	workertest.CleanKill(c, store)
+}
+
+func (s *s3ObjectStoreSuite) TestNewForeignBucket(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.server.addForeignBucket("juju")
+
+	_, err := NewS3ObjectStore(context.Background(), s.newConfig(c))
+	c.Assert(err, gc.ErrorMatches, `creating bucket "juju": .*BucketAlreadyExists.*`)
+}
+
+func (s *s3ObjectStoreSuite) TestPut(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}).Return("", nil)
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.Put(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)))
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, ok := s.server.object("juju", "inferi/"+hash)
+	c.Assert(ok, jc.IsTrue)
+	c.Check(string(data), gc.Equals, content)
+	c.Check(s.server.completedMultipartUploads(), gc.Equals, 0)
+}
+
+func (s *s3ObjectStoreSuite) TestPutRemovalPending(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+	metadata := objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}
+
+	clk := testclock.NewClock(time.Now())
+	cfg := s.newConfig(c)
+	cfg.Clock = clk
+	store, err := NewS3ObjectStore(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, store)
+
+	// Another controller removes the object after it has been uploaded, so
+	// it must be uploaded again once the removal has completed.
+	gomock.InOrder(
+		s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), metadata).DoAndReturn(func(context.Context, objectstore.Metadata) (string, error) {
+			s.server.removeObject("juju", "inferi/"+hash)
+			return "", objectstoreerrors.ErrRemovalPending
+		}),
+		s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), metadata).Return("", nil),
+	)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- store.Put(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)))
+	}()
+
+	c.Assert(clk.WaitAdvance(s3RemovalPendingDelay, jujutesting.LongWait, 1), jc.ErrorIsNil)
+	select {
+	case err := <-done:
+		c.Assert(err, jc.ErrorIsNil)
+	case <-time.After(jujutesting.LongWait):
+		c.Fatalf("timed out waiting for put")
+	}
+
+	data, ok := s.server.object("juju", "inferi/"+hash)
+	c.Assert(ok, jc.IsTrue)
+	c.Check(string(data), gc.Equals, content)
+}
+
+func (s *s3ObjectStoreSuite) TestPutObjectRemovedBeforeMetadata(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	// Another controller removes the object after it has been uploaded, but
+	// before the metadata refers to it, so it must be uploaded again.
+	s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}).DoAndReturn(func(context.Context, objectstore.Metadata) (string, error) {
+		s.server.removeObject("juju", "inferi/"+hash)
+		return "", nil
+	})
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.Put(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)))
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, ok := s.server.object("juju", "inferi/"+hash)
+	c.Assert(ok, jc.IsTrue)
+	c.Check(string(data), gc.Equals, content)
+}
+
+func (s *s3ObjectStoreSuite) TestPutMultipart(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Ensure that the content spans multiple parts, with a short last part.
+	content := bytes.Repeat([]byte("a"), 2*minS3PartSize+42)
+	hash := s.hash(string(content))
+
+	s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}).Return("", nil)
+
+	cfg := s.newConfig(c)
+	cfg.PartSize = minS3PartSize
+	store, err := NewS3ObjectStore(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, store)
+
+	err = store.Put(context.Background(), "foo", bytes.NewReader(content), int64(len(content)))
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, ok := s.server.object("juju", "inferi/"+hash)
+	c.Assert(ok, jc.IsTrue)
+	c.Check(bytes.Equal(data, content), jc.IsTrue)
+	c.Check(s.server.completedMultipartUploads(), gc.Equals, 1)
+}
+
+func (s *s3ObjectStoreSuite) TestPutSizeMismatch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.Put(context.Background(), "foo", bytes.NewBufferString("some content"), 42)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+
+	_, ok := s.server.object("juju", "inferi/"+s.hash("some content"))
+	c.Check(ok, jc.IsFalse)
+}
+
+func (s *s3ObjectStoreSuite) TestPutAndCheckHash(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}).Return("", nil)
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.PutAndCheckHash(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)), hash)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, ok := s.server.object("juju", "inferi/"+hash)
+	c.Check(ok, jc.IsTrue)
+}
+
+func (s *s3ObjectStoreSuite) TestPutAndCheckHashMismatch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.PutAndCheckHash(context.Background(), "foo", bytes.NewBufferString(content), int64(len(content)), "blah")
+	c.Assert(err, gc.ErrorMatches, `hash mismatch for "foo" not valid`)
+
+	_, ok := s.server.object("juju", "inferi/"+s.hash(content))
+	c.Check(ok, jc.IsFalse)
+}
+
+func (s *s3ObjectStoreSuite) TestPutReplacesExistingPath(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	oldContent := "old content"
+	oldHash := s.hash(oldContent)
+	newContent := "new content"
+	newHash := s.hash(newContent)
+
+	newMetadata := objectstore.Metadata{
+		Path: "foo",
+		Hash: newHash,
+		Size: int64(len(newContent)),
+	}
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	s.server.putObject("juju", "inferi/"+oldHash, []byte(oldContent))
+
+	gomock.InOrder(
+		s.metadataService.EXPECT().ReplaceMetadata(gomock.Any(), newMetadata).Return(oldHash, nil),
+		s.metadataService.EXPECT().ClaimRemoval(gomock.Any(), oldHash).Return(nil),
+		s.metadataService.EXPECT().ReleaseRemoval(gomock.Any(), oldHash).Return(nil),
+	)
+
+	err := store.Put(context.Background(), "foo", bytes.NewBufferString(newContent), int64(len(newContent)))
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, ok := s.server.object("juju", "inferi/"+newHash)
+	c.Check(ok, jc.IsTrue)
+	_, ok = s.server.object("juju", "inferi/"+oldHash)
+	c.Check(ok, jc.IsFalse)
+}
+
+func (s *s3ObjectStoreSuite) TestGet(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}, nil)
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	s.server.putObject("juju", "inferi/"+hash, []byte(content))
+
+	r, size, err := store.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	defer r.Close()
+
+	c.Check(size, gc.Equals, int64(len(content)))
+	data, err := io.ReadAll(r)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, content)
+}
+
+func (s *s3ObjectStoreSuite) TestGetNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{}, objectstoreerrors.ErrNotFound)
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	_, _, err := store.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *s3ObjectStoreSuite) TestGetMissingObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: s.hash(content),
+		Size: int64(len(content)),
+	}, nil)
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	_, _, err := store.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *s3ObjectStoreSuite) TestRemove(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}, nil)
+	s.metadataService.EXPECT().RemoveMetadata(gomock.Any(), "foo").Return(nil)
+	gomock.InOrder(
+		s.metadataService.EXPECT().ClaimRemoval(gomock.Any(), hash).Return(nil),
+		s.metadataService.EXPECT().ReleaseRemoval(gomock.Any(), hash).Return(nil),
+	)
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	s.server.putObject("juju", "inferi/"+hash, []byte(content))
+
+	err := store.Remove(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, ok := s.server.object("juju", "inferi/"+hash)
+	c.Check(ok, jc.IsFalse)
+}
+
+func (s *s3ObjectStoreSuite) TestRemoveSharedObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}, nil)
+	s.metadataService.EXPECT().RemoveMetadata(gomock.Any(), "foo").Return(nil)
+	s.metadataService.EXPECT().ClaimRemoval(gomock.Any(), hash).Return(objectstoreerrors.ErrObjectReferenced)
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	s.server.putObject("juju", "inferi/"+hash, []byte(content))
+
+	err := store.Remove(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The object is still referenced by "bar", so it must not be removed.
+	_, ok := s.server.object("juju", "inferi/"+hash)
+	c.Check(ok, jc.IsTrue)
+}
+
+func (s *s3ObjectStoreSuite) TestRemoveRemovalPending(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content"
+	hash := s.hash(content)
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		Path: "foo",
+		Hash: hash,
+		Size: int64(len(content)),
+	}, nil)
+	s.metadataService.EXPECT().RemoveMetadata(gomock.Any(), "foo").Return(nil)
+	s.metadataService.EXPECT().ClaimRemoval(gomock.Any(), hash).Return(objectstoreerrors.ErrRemovalPending)
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	s.server.putObject("juju", "inferi/"+hash, []byte(content))
+
+	// Another controller is removing the object, so it's left to them.
+	err := store.Remove(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, ok := s.server.object("juju", "inferi/"+hash)
+	c.Check(ok, jc.IsTrue)
+}
+
+func (s *s3ObjectStoreSuite) TestRemoveNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.metadataService.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{}, objectstoreerrors.ErrNotFound)
+
+	store := s.newS3ObjectStore(c)
+	defer workertest.CleanKill(c, store)
+
+	err := store.Remove(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *s3ObjectStoreSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.metadataService = NewMockMetadataService(ctrl)
+
+	return ctrl
+}
+
+func (s *s3ObjectStoreSuite) newConfig(c *gc.C) S3ObjectStoreConfig {
+	logger := jujutesting.NewCheckLogger(c)
+	client, err := NewS3Client(s.server.URL, S3Credentials{
+		AccessKey: "access",
+		SecretKey: "secret",
+	}, logger)
+	c.Assert(err, jc.ErrorIsNil)
+
+	return S3ObjectStoreConfig{
+		RootDir:         c.MkDir(),
+		Namespace:       "inferi",
+		Client:          client,
+		Bucket:          "juju",
+		MetadataService: s.metadataService,
+		Clock:           clock.WallClock,
+		Logger:          logger,
+	}
+}
+
+func (s *s3ObjectStoreSuite) newS3ObjectStore(c *gc.C) TrackedObjectStore {
+	store, err := NewS3ObjectStore(context.Background(), s.newConfig(c))
+	c.Assert(err, jc.ErrorIsNil)
+	return store
+}
+
+func (s *s3ObjectStoreSuite) hash(content string) string {
+	hasher := sha512.New384()
+	_, _ = hasher.Write([]byte(content))
+	return hex.EncodeToString(hasher.Sum(nil))
+}
@@ -0,0 +1,259 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// s3Server is a minimal in-memory stand-in for an S3 compatible object
+// store, such as MinIO. It only supports path style addressing and the
+// operations used by the S3 object store.
+type s3Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	buckets  map[string]map[string][]byte
+	foreign  map[string]bool
+	uploads  map[string]*s3Upload
+	uploadID int
+
+	// multipartUploads counts the number of completed multipart uploads.
+	multipartUploads int
+}
+
+type s3Upload struct {
+	bucket string
+	key    string
+	parts  map[int][]byte
+}
+
+func newS3Server() *s3Server {
+	s := &s3Server{
+		buckets: make(map[string]map[string][]byte),
+		foreign: make(map[string]bool),
+		uploads: make(map[string]*s3Upload),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// object returns the contents of the object with the given key.
+func (s *s3Server) object(bucket, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, ok := s.buckets[bucket][key]
+	return data, ok
+}
+
+// completedMultipartUploads returns the number of completed multipart
+// uploads.
+func (s *s3Server) completedMultipartUploads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.multipartUploads
+}
+
+// addForeignBucket adds a bucket that is owned by another account.
+func (s *s3Server) addForeignBucket(bucket string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.foreign[bucket] = true
+}
+
+// putObject stores an object directly, bypassing the API.
+func (s *s3Server) putObject(bucket, key string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.buckets[bucket] == nil {
+		s.buckets[bucket] = make(map[string][]byte)
+	}
+	s.buckets[bucket][key] = data
+}
+
+// removeObject removes an object directly, bypassing the API.
+func (s *s3Server) removeObject(bucket, key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.buckets[bucket], key)
+}
+
+func (s *s3Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	if key == "" {
+		if r.Method != http.MethodPut {
+			writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+			return
+		}
+		if s.foreign[bucket] {
+			writeS3Error(w, http.StatusConflict, "BucketAlreadyExists")
+			return
+		}
+		if _, ok := s.buckets[bucket]; ok {
+			writeS3Error(w, http.StatusConflict, "BucketAlreadyOwnedByYou")
+			return
+		}
+		s.buckets[bucket] = make(map[string][]byte)
+		return
+	}
+
+	objects, ok := s.buckets[bucket]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.uploadID++
+		id := strconv.Itoa(s.uploadID)
+		s.uploads[id] = &s3Upload{
+			bucket: bucket,
+			key:    key,
+			parts:  make(map[int][]byte),
+		}
+		writeS3XML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: bucket, Key: key, UploadId: id})
+
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		upload, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		partNumber, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "InvalidArgument")
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeS3Error(w, http.StatusInternalServerError, "InternalError")
+			return
+		}
+		upload.parts[partNumber] = data
+		w.Header().Set("ETag", etag(data))
+
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		upload, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		var complete struct {
+			Parts []struct {
+				PartNumber int
+				ETag       string
+			} `xml:"Part"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+		sort.Slice(complete.Parts, func(i, j int) bool {
+			return complete.Parts[i].PartNumber < complete.Parts[j].PartNumber
+		})
+		var data []byte
+		for _, part := range complete.Parts {
+			partData, ok := upload.parts[part.PartNumber]
+			if !ok || etag(partData) != part.ETag {
+				writeS3Error(w, http.StatusBadRequest, "InvalidPart")
+				return
+			}
+			data = append(data, partData...)
+		}
+		objects[upload.key] = data
+		delete(s.uploads, query.Get("uploadId"))
+		s.multipartUploads++
+		writeS3XML(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: bucket, Key: key, ETag: etag(data)})
+
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(s.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeS3Error(w, http.StatusInternalServerError, "InternalError")
+			return
+		}
+		objects[key] = data
+		w.Header().Set("ETag", etag(data))
+
+	case r.Method == http.MethodGet:
+		data, ok := objects[key]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("ETag", etag(data))
+		_, _ = w.Write(data)
+
+	case r.Method == http.MethodHead:
+		data, ok := objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("ETag", etag(data))
+
+	case r.Method == http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func writeS3XML(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{Code: code, Message: code})
+}
+
+func etag(data []byte) string {
+	sum := md5.Sum(data)
+	return fmt.Sprintf("%q", hex.EncodeToString(sum[:]))
+}
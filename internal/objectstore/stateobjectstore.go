@@ -5,13 +5,18 @@ package objectstore
 
 import (
 	"context"
+	"crypto/sha512"
+	"encoding/hex"
 	"io"
 
+	"github.com/juju/errors"
 	"github.com/juju/mgo/v3"
 	"github.com/juju/worker/v3"
 	"gopkg.in/tomb.v2"
 
 	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/watcher"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
 	"github.com/juju/juju/internal/objectstore/state"
 )
 
@@ -32,6 +37,44 @@ type MongoSession interface {
 	MongoSession() *mgo.Session
 }
 
+// MetadataService is the interface that is used to record and look up the
+// metadata of the objects held in the object store.
+type MetadataService interface {
+	// GetMetadata returns the persistence metadata for the specified path.
+	GetMetadata(ctx context.Context, path string) (objectstore.Metadata, error)
+	// ListMetadata returns the persistence metadata for all paths.
+	ListMetadata(ctx context.Context) ([]objectstore.Metadata, error)
+	// ReplaceMetadata adds a new specified path for the persistence
+	// metadata, replacing any existing metadata for the path. The hash of
+	// the replaced object is returned, or an empty string if the path didn't
+	// refer to a different object.
+	ReplaceMetadata(ctx context.Context, metadata objectstore.Metadata) (string, error)
+	// RemoveMetadata removes the specified path for the persistence metadata.
+	RemoveMetadata(ctx context.Context, path string) error
+	// ObjectReferenced returns whether any path refers to the object with
+	// the specified hash.
+	ObjectReferenced(ctx context.Context, hash string) (bool, error)
+	// ClaimRemoval claims the removal of the object with the specified hash
+	// from the backing store, so that no new path can refer to it until the
+	// claim is released.
+	ClaimRemoval(ctx context.Context, hash string) error
+	// ReleaseRemoval releases the claim on the removal of the object with
+	// the specified hash.
+	ReleaseRemoval(ctx context.Context, hash string) error
+	// Watch returns a watcher that emits the paths of the persistence
+	// metadata as they change.
+	Watch() (watcher.StringsWatcher, error)
+}
+
+// BlobRetriever is the interface that is used to retrieve objects that are
+// missing from the local object store, from another source such as a peer
+// controller.
+type BlobRetriever interface {
+	// RetrieveBlob returns an io.ReadCloser for the object at path, in the
+	// given namespace, along with its size.
+	RetrieveBlob(ctx context.Context, namespace, path string) (io.ReadCloser, int64, error)
+}
+
 // TrackedObjectStore is a ObjectStore that is also a worker, to ensure the
 // lifecycle of the objectStore is managed.
 type TrackedObjectStore interface {
@@ -44,16 +87,21 @@ type stateObjectStore struct {
 	namespace string
 	logger    Logger
 
-	session MongoSession
+	session         MongoSession
+	metadataService MetadataService
 }
 
-// NewObjectStoreWorker returns a new object store worker based on the state
-// storage.
-func NewStateObjectStore(ctx context.Context, namespace string, mongoSession MongoSession, logger Logger) (TrackedObjectStore, error) {
+// NewStateObjectStore returns a new object store worker based on the state
+// storage. The metadata of every object written is recorded with the
+// metadata service. The metadata service may be nil when the object store
+// is used outside of a controller agent, for example during bootstrap, in
+// which case no metadata is recorded.
+func NewStateObjectStore(ctx context.Context, namespace string, mongoSession MongoSession, metadataService MetadataService, logger Logger) (TrackedObjectStore, error) {
 	s := &stateObjectStore{
-		namespace: namespace,
-		session:   mongoSession,
-		logger:    logger,
+		namespace:       namespace,
+		session:         mongoSession,
+		metadataService: metadataService,
+		logger:          logger,
 	}
 
 	s.tomb.Go(s.loop)
@@ -69,11 +117,23 @@ func (t *stateObjectStore) Get(ctx context.Context, path string) (io.ReadCloser,
 	return store.Get(path)
 }
 
+// GetLocal returns an io.ReadCloser for data at path, namespaced to the
+// model. The objects are held in the shared database, so every controller
+// holds every object.
+func (t *stateObjectStore) GetLocal(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	return t.Get(ctx, path)
+}
+
 // Put stores data from reader at path, namespaced to the model.
 func (t *stateObjectStore) Put(ctx context.Context, path string, r io.Reader, size int64) error {
 	session := t.session.MongoSession()
 	store := state.NewStorage(t.namespace, session)
-	return store.Put(path, r, size)
+
+	hasher := sha512.New384()
+	if err := store.Put(path, io.TeeReader(r, hasher), size); err != nil {
+		return err
+	}
+	return t.putMetadata(ctx, path, hex.EncodeToString(hasher.Sum(nil)), size)
 }
 
 // Put stores data from reader at path, namespaced to the model.
@@ -81,14 +141,49 @@ func (t *stateObjectStore) Put(ctx context.Context, path string, r io.Reader, si
 func (t *stateObjectStore) PutAndCheckHash(ctx context.Context, path string, r io.Reader, size int64, hash string) error {
 	session := t.session.MongoSession()
 	store := state.NewStorage(t.namespace, session)
-	return store.PutAndCheckHash(path, r, size, hash)
+
+	hasher := sha512.New384()
+	if err := store.PutAndCheckHash(path, io.TeeReader(r, hasher), size, hash); err != nil {
+		return err
+	}
+	return t.putMetadata(ctx, path, hex.EncodeToString(hasher.Sum(nil)), size)
 }
 
 // Remove removes data at path, namespaced to the model.
 func (t *stateObjectStore) Remove(ctx context.Context, path string) error {
 	session := t.session.MongoSession()
 	store := state.NewStorage(t.namespace, session)
-	return store.Remove(path)
+	if err := store.Remove(path); err != nil {
+		return err
+	}
+
+	if t.metadataService == nil {
+		return nil
+	}
+	// Objects written before metadata was recorded will not have any, so
+	// there is nothing to remove.
+	err := t.metadataService.RemoveMetadata(ctx, path)
+	if err != nil && !errors.Is(err, objectstoreerrors.ErrNotFound) {
+		return errors.Annotatef(err, "removing metadata for %q", path)
+	}
+	return nil
+}
+
+// putMetadata records the metadata for the object stored at path. The
+// underlying storage replaces any existing object at the same path, so any
+// existing metadata for a different object is replaced as well.
+func (t *stateObjectStore) putMetadata(ctx context.Context, path, hash string, size int64) error {
+	if t.metadataService == nil {
+		return nil
+	}
+
+	metadata := objectstore.Metadata{
+		Path: path,
+		Hash: hash,
+		Size: size,
+	}
+	_, err := t.metadataService.ReplaceMetadata(ctx, metadata)
+	return errors.Annotatef(err, "putting metadata for %q", path)
 }
 
 // Kill implements the worker.Worker interface.
@@ -3,7 +3,10 @@
 
 package apiserver
 
-import "github.com/juju/juju/internal/pubsub/common"
+import (
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/internal/pubsub/common"
+)
 
 // DetailsTopic is the topic name for the published message when the details
 // of the api servers change. This message is normally published by the
@@ -87,6 +90,33 @@ type PresenceResponse struct {
 	Connections []APIConnection `yaml:"connections"`
 }
 
+// AuditLogQueryTopic is used to ask every API server for the records
+// in its audit log.
+// data: `AuditLogQuery`
+const AuditLogQueryTopic = "apiserver.auditlog-query"
+
+// AuditLogQuery asks for the audit records matching the filter. The
+// RequestID is echoed in the responses.
+type AuditLogQuery struct {
+	RequestID string          `yaml:"request-id"`
+	Filter    auditlog.Filter `yaml:"filter"`
+}
+
+// AuditLogRecordsTopic is used by each API server to respond to an
+// audit log query.
+// data: `AuditLogRecords`
+const AuditLogRecordsTopic = "apiserver.auditlog-records"
+
+// AuditLogRecords contains the matching audit records from the API
+// server identified by Origin, oldest first.
+type AuditLogRecords struct {
+	Origin    string            `yaml:"origin"`
+	RequestID string            `yaml:"request-id"`
+	Records   []auditlog.Record `yaml:"records"`
+	Truncated bool              `yaml:"truncated,omitempty"`
+	Error     string            `yaml:"error,omitempty"`
+}
+
 // OriginTarget represents the data for the connect and disconnect
 // topics.
 type OriginTarget common.OriginTarget
@@ -0,0 +1,112 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package notice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/canonical/pebble/client"
+	"github.com/juju/errors"
+)
+
+// Client talks to the notices API of a Pebble daemon over its unix socket.
+type Client struct {
+	doer *http.Client
+}
+
+// New returns a client for the Pebble daemon listening on the socket.
+func New(socketPath string) *Client {
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			if _, err := os.Stat(socketPath); errors.Is(err, os.ErrNotExist) {
+				return nil, &client.SocketNotFoundError{Err: err, Path: socketPath}
+			} else if err != nil {
+				return nil, fmt.Errorf("cannot stat %q: %w", socketPath, err)
+			}
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", socketPath)
+		},
+	}
+	return &Client{doer: &http.Client{Transport: transport}}
+}
+
+// CloseIdleConnections closes any connections that are not in use.
+func (c *Client) CloseIdleConnections() {
+	c.doer.CloseIdleConnections()
+}
+
+// WaitNotices returns the notices that match the filters, waiting up to
+// serverTimeout for at least one to occur. It returns an empty list if
+// none occurred in that time. A NotSupported error is returned if the
+// Pebble daemon doesn't have the notices API.
+func (c *Client) WaitNotices(ctx context.Context, serverTimeout time.Duration, opts *NoticesOptions) ([]*Notice, error) {
+	query := url.Values{}
+	if opts != nil {
+		for _, t := range opts.Types {
+			query.Add("types", string(t))
+		}
+		for _, key := range opts.Keys {
+			query.Add("keys", key)
+		}
+		if !opts.After.IsZero() {
+			query.Set("after", opts.After.Format(time.RFC3339Nano))
+		}
+	}
+	if serverTimeout > 0 {
+		query.Set("timeout", serverTimeout.String())
+	}
+	u := url.URL{Scheme: "http", Host: "localhost", Path: "/v1/notices", RawQuery: query.Encode()}
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	rsp, err := c.doer.Do(req)
+	if err != nil {
+		var socketNotFound *client.SocketNotFoundError
+		if errors.As(err, &socketNotFound) {
+			return nil, socketNotFound
+		}
+		return nil, errors.Trace(err)
+	}
+	defer rsp.Body.Close()
+
+	var result response
+	if err := json.NewDecoder(rsp.Body).Decode(&result); err != nil {
+		return nil, errors.Annotatef(err, "decoding notices response (%s)", rsp.Status)
+	}
+	if rsp.StatusCode == http.StatusNotFound {
+		return nil, errors.NotSupportedf("pebble notices")
+	}
+	if result.Type == "error" {
+		var rspErr struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(result.Result, &rspErr); err != nil || rspErr.Message == "" {
+			return nil, errors.Errorf("server error: %q", rsp.Status)
+		}
+		return nil, errors.New(rspErr.Message)
+	}
+	if result.Type != "sync" {
+		return nil, errors.Errorf("expected sync response, got %q", result.Type)
+	}
+	var notices []*Notice
+	if err := json.Unmarshal(result.Result, &notices); err != nil {
+		return nil, errors.Annotate(err, "decoding notices")
+	}
+	return notices, nil
+}
+
+// response is the envelope of every Pebble API response.
+type response struct {
+	Type       string          `json:"type"`
+	StatusCode int             `json:"status-code"`
+	Result     json.RawMessage `json:"result"`
+}
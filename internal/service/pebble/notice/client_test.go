@@ -0,0 +1,118 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package notice_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	stdtesting "testing"
+	"time"
+
+	"github.com/canonical/pebble/client"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/service/pebble/notice"
+)
+
+func Test(t *stdtesting.T) {
+	gc.TestingT(t)
+}
+
+type clientSuite struct {
+	testing.IsolationSuite
+
+	socketPath string
+	requests   []*http.Request
+	status     int
+	body       string
+}
+
+var _ = gc.Suite(&clientSuite{})
+
+func (s *clientSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.socketPath = filepath.Join(c.MkDir(), "pebble.socket")
+	s.requests = nil
+	s.status = http.StatusOK
+	s.body = `{"type":"sync","status-code":200,"status":"OK","result":[]}`
+
+	listener, err := net.Listen("unix", s.socketPath)
+	c.Assert(err, jc.ErrorIsNil)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests = append(s.requests, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(s.status)
+		fmt.Fprint(w, s.body)
+	})}
+	go func() { _ = server.Serve(listener) }()
+	s.AddCleanup(func(*gc.C) { _ = server.Close() })
+}
+
+func (s *clientSuite) TestWaitNotices(c *gc.C) {
+	s.body = `{"type":"sync","status-code":200,"status":"OK","result":[{
+		"id": "7",
+		"type": "custom",
+		"key": "example.com/db-ready",
+		"first-occurred": "2026-10-18T12:00:00Z",
+		"last-occurred": "2026-10-18T12:01:00Z",
+		"last-repeated": "2026-10-18T12:01:00Z",
+		"occurrences": 2,
+		"last-data": {"a": "b"}
+	}]}`
+	after := time.Date(2026, 10, 18, 11, 0, 0, 0, time.UTC)
+
+	cl := notice.New(s.socketPath)
+	defer cl.CloseIdleConnections()
+	notices, err := cl.WaitNotices(context.Background(), 30*time.Second, &notice.NoticesOptions{
+		Types: []notice.NoticeType{notice.CustomNotice},
+		After: after,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(notices, gc.HasLen, 1)
+	c.Check(*notices[0], jc.DeepEquals, notice.Notice{
+		ID:            "7",
+		Type:          notice.CustomNotice,
+		Key:           "example.com/db-ready",
+		FirstOccurred: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		LastOccurred:  time.Date(2026, 10, 18, 12, 1, 0, 0, time.UTC),
+		LastRepeated:  time.Date(2026, 10, 18, 12, 1, 0, 0, time.UTC),
+		Occurrences:   2,
+		LastData:      map[string]string{"a": "b"},
+	})
+
+	c.Assert(s.requests, gc.HasLen, 1)
+	c.Check(s.requests[0].URL.Path, gc.Equals, "/v1/notices")
+	query := s.requests[0].URL.Query()
+	c.Check(query.Get("types"), gc.Equals, "custom")
+	c.Check(query.Get("after"), gc.Equals, "2026-10-18T11:00:00Z")
+	c.Check(query.Get("timeout"), gc.Equals, "30s")
+}
+
+func (s *clientSuite) TestWaitNoticesError(c *gc.C) {
+	s.status = http.StatusBadRequest
+	s.body = `{"type":"error","status-code":400,"status":"Bad Request","result":{"message":"invalid after"}}`
+
+	_, err := notice.New(s.socketPath).WaitNotices(context.Background(), 0, nil)
+	c.Assert(err, gc.ErrorMatches, "invalid after")
+}
+
+func (s *clientSuite) TestWaitNoticesNotSupported(c *gc.C) {
+	s.status = http.StatusNotFound
+	s.body = `{"type":"error","status-code":404,"status":"Not Found","result":{"message":"not found"}}`
+
+	_, err := notice.New(s.socketPath).WaitNotices(context.Background(), 0, nil)
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *clientSuite) TestWaitNoticesSocketNotFound(c *gc.C) {
+	_, err := notice.New(filepath.Join(c.MkDir(), "missing.socket")).WaitNotices(context.Background(), 0, nil)
+	var socketNotFound *client.SocketNotFoundError
+	c.Assert(errors.As(err, &socketNotFound), jc.IsTrue)
+}
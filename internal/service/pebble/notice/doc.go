@@ -0,0 +1,9 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package notice provides a client for the Pebble notices API, which the
+// version of github.com/canonical/pebble/client that Juju uses predates.
+//
+// The types are a subset of those in github.com/canonical/pebble/client,
+// copied directly from that repository.
+package notice
@@ -0,0 +1,44 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package notice
+
+import (
+	"time"
+)
+
+// NoticeType is the type of a notice.
+type NoticeType string
+
+const (
+	// CustomNotice is a notice recorded by a client, with a key in the
+	// format "domain.com/key".
+	CustomNotice NoticeType = "custom"
+)
+
+// Notice is a notification whose identity is the combination of Type and
+// Key that has occurred Occurrences number of times.
+type Notice struct {
+	ID            string            `json:"id"`
+	UserID        *uint32           `json:"user-id"`
+	Type          NoticeType        `json:"type"`
+	Key           string            `json:"key"`
+	FirstOccurred time.Time         `json:"first-occurred"`
+	LastOccurred  time.Time         `json:"last-occurred"`
+	LastRepeated  time.Time         `json:"last-repeated"`
+	Occurrences   int               `json:"occurrences"`
+	LastData      map[string]string `json:"last-data,omitempty"`
+}
+
+// NoticesOptions holds the filter options for the notices API.
+type NoticesOptions struct {
+	// Types, if not empty, includes only notices whose type is one of these.
+	Types []NoticeType
+
+	// Keys, if not empty, includes only notices whose key is one of these.
+	Keys []string
+
+	// After, if set, includes only notices that were last repeated after
+	// this time.
+	After time.Time
+}
@@ -14,7 +14,9 @@ import (
 	modelconfigservice "github.com/juju/juju/domain/modelconfig/service"
 	modeldefaultsservice "github.com/juju/juju/domain/modeldefaults/service"
 	modelmanagerservice "github.com/juju/juju/domain/modelmanager/service"
+	objectstoreservice "github.com/juju/juju/domain/objectstore/service"
 	upgradeservice "github.com/juju/juju/domain/upgrade/service"
+	userservice "github.com/juju/juju/domain/user/service"
 )
 
 // ControllerServiceFactory provides access to the services required by the
@@ -40,6 +42,12 @@ type ControllerServiceFactory interface {
 	Cloud() *cloudservice.Service
 	// Upgrade returns the upgrade service.
 	Upgrade() *upgradeservice.Service
+	// UserGroup returns the user group service.
+	UserGroup() *userservice.GroupService
+	// APIToken returns the user API token service.
+	APIToken() *userservice.TokenService
+	// Role returns the user role service.
+	Role() *userservice.RoleService
 }
 
 // ModelServiceFactory provides access to the services required by the
@@ -47,6 +55,8 @@ type ControllerServiceFactory interface {
 type ModelServiceFactory interface {
 	// Config returns the modelconfig service.
 	Config(modelconfigservice.ModelDefaultsProvider) *modelconfigservice.Service
+	// ObjectStore returns the object store metadata service.
+	ObjectStore() *objectstoreservice.Service
 }
 
 // ServiceFactory provides access to the services required by the apiserver.
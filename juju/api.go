@@ -216,8 +216,12 @@ func connectionInfo(args NewAPIConnectionParams) (*api.Info, *jujuclient.Control
 	if args.AccountDetails.Password != "" {
 		// If a password is available, we always use that.
 		// If no password is recorded, we'll attempt to
-		// authenticate using macaroons.
+		// authenticate using an API token, an OIDC token or macaroons.
 		apiInfo.Password = account.Password
+	} else if account.APIToken != "" {
+		apiInfo.Token = account.APIToken
+	} else if account.OIDCToken != "" {
+		apiInfo.Token = account.OIDCToken
 	} else {
 		// Optionally the account may have macaroons to use.
 		apiInfo.Macaroons = account.Macaroons
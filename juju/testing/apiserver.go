@@ -671,7 +671,7 @@ func (s *stubObjectStoreGetter) GetObjectStore(ctx context.Context, namespace st
 		return nil, err
 	}
 
-	return internalobjectstore.NewStateObjectStore(ctx, namespace, state, loggo.GetLogger("juju.worker.objectstore"))
+	return internalobjectstore.NewStateObjectStore(ctx, namespace, state, nil, loggo.GetLogger("juju.worker.objectstore"))
 }
 
 type stubObjectStore struct{}
@@ -680,6 +680,10 @@ func (s *stubObjectStore) Get(context.Context, string) (io.ReadCloser, int64, er
 	return io.NopCloser(bytes.NewBufferString("")), 0, nil
 }
 
+func (s *stubObjectStore) GetLocal(context.Context, string) (io.ReadCloser, int64, error) {
+	return io.NopCloser(bytes.NewBufferString("")), 0, nil
+}
+
 func (s *stubObjectStore) Put(ctx context.Context, path string, r io.Reader, length int64) error {
 	return nil
 }
@@ -700,6 +704,10 @@ func (stubWatchableDB) Subscribe(...changestream.SubscriptionOption) (changestre
 	return nil, nil
 }
 
+func (stubWatchableDB) SubscribeFrom(int64, ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	return nil, nil
+}
+
 // These mocks are used in place of real components when creating server config.
 
 type noopSysLogger struct{}
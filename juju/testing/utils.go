@@ -81,7 +81,7 @@ func AddCharm(st *state.State, curl string, ch charm.Charm, force bool) (*state.
 		return nil, err
 	}
 
-	stor, err := objectstore.NewStateObjectStore(context.Background(), st.ModelUUID(), st, testing.NoopLogger{})
+	stor, err := objectstore.NewStateObjectStore(context.Background(), st.ModelUUID(), st, nil, testing.NoopLogger{})
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +104,7 @@ func AddCharm(st *state.State, curl string, ch charm.Charm, force bool) (*state.
 
 func NewObjectStore(c *gc.C, modelUUID string, st *state.State) coreobjectstore.ObjectStore {
 	// This will be removed when the worker object store is enabled by default.
-	store, err := objectstore.NewStateObjectStore(context.Background(), modelUUID, st, testing.NewCheckLogger(c))
+	store, err := objectstore.NewStateObjectStore(context.Background(), modelUUID, st, nil, testing.NewCheckLogger(c))
 	c.Assert(err, jc.ErrorIsNil)
 	return store
 }
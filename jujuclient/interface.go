@@ -97,6 +97,16 @@ type AccountDetails struct {
 	// LastKnownAccess is the last known access level for the account.
 	LastKnownAccess string `yaml:"last-known-access,omitempty"`
 
+	// OIDCToken, if set, is an ID token from an OpenID Connect provider
+	// trusted by the controller, obtained by "juju login --oidc". It is
+	// used for the account login until it expires.
+	OIDCToken string `yaml:"oidc-token,omitempty"`
+
+	// APIToken, if set, is an API token minted by "juju add-token",
+	// obtained by "juju login --token". It is used for the account login
+	// until it expires or is removed.
+	APIToken string `yaml:"api-token,omitempty"`
+
 	// Macaroons, if set, are used for the account login.
 	// They are only set when using the MemStore implementation,
 	// and are used by embedded commands. The are not written to disk.
@@ -33,7 +33,7 @@ func ValidateAccountDetails(details AccountDetails) error {
 		return errors.Trace(err)
 	}
 	// It is valid for a password to be blank, because the client
-	// may use macaroons instead.
+	// may use macaroons or an OIDC token instead.
 	return nil
 }
 
@@ -57,10 +57,20 @@ type CAASApplicationProvisioningInfo struct {
 	CharmModifiedVersion int                          `json:"charm-modified-version,omitempty"`
 	CharmURL             string                       `json:"charm-url,omitempty"`
 	Trust                bool                         `json:"trust,omitempty"`
+	MinAvailable         int                          `json:"min-available,omitempty"`
+	Autoscaling          *CAASApplicationAutoscaling  `json:"autoscaling,omitempty"`
 	Scale                int                          `json:"scale,omitempty"`
 	Error                *Error                       `json:"error,omitempty"`
 }
 
+// CAASApplicationAutoscaling holds the autoscaling policy of a caas application.
+type CAASApplicationAutoscaling struct {
+	MinUnits     int `json:"min-units"`
+	MaxUnits     int `json:"max-units"`
+	CPUTarget    int `json:"cpu-target,omitempty"`
+	MemoryTarget int `json:"memory-target,omitempty"`
+}
+
 // DockerImageInfo holds the details for a Docker resource type.
 type DockerImageInfo struct {
 	// RegistryPath holds the path of the Docker image (including host and sha256) in a docker registry.
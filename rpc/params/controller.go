@@ -148,3 +148,75 @@ type DashboardConnectionInfo struct {
 	SSHConnection   *DashboardConnectionSSHTunnel `json:"ssh-connection"`
 	Error           *Error                        `json:"error,omitempty"`
 }
+
+// AuditRecordsArgs holds the filter for Controller.AuditRecords. Empty
+// fields match everything.
+type AuditRecordsArgs struct {
+	From           *time.Time `json:"from,omitempty"`
+	To             *time.Time `json:"to,omitempty"`
+	Who            string     `json:"who,omitempty"`
+	Model          string     `json:"model,omitempty"`
+	ConversationID string     `json:"conversation-id,omitempty"`
+	Methods        []string   `json:"methods,omitempty"`
+	Limit          int        `json:"limit,omitempty"`
+}
+
+// AuditRecordsResult holds the audit records from all of the
+// controllers, oldest first.
+type AuditRecordsResult struct {
+	Records []AuditRecord `json:"records"`
+
+	// Controllers holds the IDs of the controllers that responded.
+	Controllers []string `json:"controllers"`
+
+	// Missing holds the number of controllers that didn't respond in
+	// time, or failed to read their audit log.
+	Missing int `json:"missing,omitempty"`
+
+	// Truncated is true if more records matched than the limit.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// AuditRecord holds a single audit log entry, and the controller whose
+// audit log it came from. Only one of Conversation, Request and Errors
+// is set.
+type AuditRecord struct {
+	ControllerID   string               `json:"controller-id"`
+	ConversationID string               `json:"conversation-id"`
+	Conversation   *AuditConversation   `json:"conversation,omitempty"`
+	Request        *AuditRequest        `json:"request,omitempty"`
+	Errors         *AuditResponseErrors `json:"errors,omitempty"`
+}
+
+// AuditConversation describes an API connection from a client.
+type AuditConversation struct {
+	Who          string `json:"who"`
+	What         string `json:"what"`
+	When         string `json:"when"`
+	ModelName    string `json:"model-name"`
+	ModelUUID    string `json:"model-uuid"`
+	ConnectionID string `json:"connection-id"`
+}
+
+// AuditRequest describes an API call made in a conversation.
+type AuditRequest struct {
+	RequestID uint64 `json:"request-id"`
+	When      string `json:"when"`
+	Facade    string `json:"facade"`
+	Method    string `json:"method"`
+	Version   int    `json:"version"`
+	Args      string `json:"args,omitempty"`
+}
+
+// AuditResponseErrors describes the errors returned by an API call.
+type AuditResponseErrors struct {
+	RequestID uint64       `json:"request-id"`
+	When      string       `json:"when"`
+	Errors    []AuditError `json:"errors"`
+}
+
+// AuditError describes an error returned by an API call.
+type AuditError struct {
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
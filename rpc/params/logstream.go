@@ -49,4 +49,17 @@ type LogStreamConfig struct {
 
 	// MaxLookbackRecords is the maximum number of log records to stream from the past.
 	MaxLookbackRecords int `schema:"maxlookbackrecords" url:"maxlookbackrecords,omitempty"`
+
+	// StartTime and EndTime, if set, bound the time of the streamed log
+	// records. They must be RFC3339 time strings. A StartTime earlier than
+	// the sink's bookmark is ignored.
+	StartTime string `schema:"starttime" url:"starttime,omitempty"`
+	EndTime   string `schema:"endtime" url:"endtime,omitempty"`
+
+	// IncludeMessage and ExcludeMessage hold regular expressions matched
+	// against the message text. If IncludeMessage is set, only records
+	// matching one of its patterns are streamed. Records matching any
+	// ExcludeMessage pattern are not streamed.
+	IncludeMessage []string `schema:"includemessage" url:"includemessage,omitempty"`
+	ExcludeMessage []string `schema:"excludemessage" url:"excludemessage,omitempty"`
 }
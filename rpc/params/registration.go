@@ -58,3 +58,23 @@ type SecretKeyLoginResponsePayload struct {
 	// be used to connect to the controller.
 	ProxyConfig *Proxy `json:"proxy-config"`
 }
+
+// OIDCLoginInfo is the response from the /oidc-login endpoint, listing
+// the OpenID Connect providers users can log in with.
+type OIDCLoginInfo struct {
+	Issuers []OIDCIssuerInfo `json:"issuers"`
+}
+
+// OIDCIssuerInfo holds the details a client needs to get an ID token
+// from an OpenID Connect provider to log in with.
+type OIDCIssuerInfo struct {
+	// URL is the issuer identifier, below which the provider's
+	// discovery document is found.
+	URL string `json:"url"`
+
+	// ClientID is the client to request the ID token for.
+	ClientID string `json:"client-id"`
+
+	// Scopes are the scopes to request.
+	Scopes []string `json:"scopes,omitempty"`
+}
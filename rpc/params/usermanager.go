@@ -67,3 +67,192 @@ type AddUserResult struct {
 	SecretKey []byte `json:"secret-key,omitempty"`
 	Error     *Error `json:"error,omitempty"`
 }
+
+// AddGroups holds the parameters for adding new user groups.
+type AddGroups struct {
+	Groups []AddGroup `json:"groups"`
+}
+
+// AddGroup stores the parameters to add one user group.
+type AddGroup struct {
+	Name    string   `json:"name"`
+	Members []string `json:"members,omitempty"`
+}
+
+// GroupNames holds the names of user groups.
+type GroupNames struct {
+	Names []string `json:"names"`
+}
+
+// GroupMembersAction is an action that can be performed on the members of
+// a user group.
+type GroupMembersAction string
+
+// Actions that can be performed on the members of a user group.
+const (
+	AddGroupMembers    GroupMembersAction = "add"
+	RemoveGroupMembers GroupMembersAction = "remove"
+)
+
+// ModifyGroupMembersRequest holds the parameters for changing the members
+// of user groups.
+type ModifyGroupMembersRequest struct {
+	Changes []ModifyGroupMembers `json:"changes"`
+}
+
+// ModifyGroupMembers holds the parameters for changing the members of one
+// user group. Members are user names.
+type ModifyGroupMembers struct {
+	Group   string             `json:"group"`
+	Action  GroupMembersAction `json:"action"`
+	Members []string           `json:"members"`
+}
+
+// UserGroup holds information about a user group.
+type UserGroup struct {
+	Name        string    `json:"name"`
+	Members     []string  `json:"members"`
+	CreatedBy   string    `json:"created-by"`
+	DateCreated time.Time `json:"date-created"`
+}
+
+// UserGroupResult holds the result of a UserGroups call.
+type UserGroupResult struct {
+	Result *UserGroup `json:"result,omitempty"`
+	Error  *Error     `json:"error,omitempty"`
+}
+
+// UserGroupResults holds the results of a UserGroups call.
+type UserGroupResults struct {
+	Results []UserGroupResult `json:"results"`
+}
+
+// GroupAccessAction is an action that can be performed on the access
+// granted to a user group.
+type GroupAccessAction string
+
+// Actions that can be performed on the access granted to a user group.
+const (
+	GrantGroupAccess  GroupAccessAction = "grant"
+	RevokeGroupAccess GroupAccessAction = "revoke"
+)
+
+// ModifyGroupAccessRequest holds the parameters for granting and revoking
+// the access of user groups.
+type ModifyGroupAccessRequest struct {
+	Changes []ModifyGroupAccess `json:"changes"`
+}
+
+// ModifyGroupAccess holds the parameters for granting or revoking the access
+// of one user group to a controller, model or cloud.
+type ModifyGroupAccess struct {
+	Group     string            `json:"group"`
+	Action    GroupAccessAction `json:"action"`
+	Access    string            `json:"access"`
+	TargetTag string            `json:"target-tag"`
+}
+
+// AddAPITokens holds the parameters for minting API tokens for the
+// logged in user.
+type AddAPITokens struct {
+	Tokens []AddAPIToken `json:"tokens"`
+}
+
+// AddAPIToken holds the parameters for minting one API token. A token
+// with a model tag can only be used with that model.
+type AddAPIToken struct {
+	Name      string    `json:"name"`
+	ModelTag  string    `json:"model-tag,omitempty"`
+	MaxAccess string    `json:"max-access"`
+	Expires   time.Time `json:"expires"`
+}
+
+// AddAPITokenResult holds the secret of a newly minted API token, or an
+// error.
+type AddAPITokenResult struct {
+	Token string `json:"token,omitempty"`
+	Error *Error `json:"error,omitempty"`
+}
+
+// AddAPITokenResults holds the results of an AddAPITokens call.
+type AddAPITokenResults struct {
+	Results []AddAPITokenResult `json:"results"`
+}
+
+// APITokenNames holds the names of API tokens. The tokens belong to the
+// user with the given tag, or to the logged in user if it is empty.
+type APITokenNames struct {
+	UserTag string   `json:"user-tag,omitempty"`
+	Names   []string `json:"names"`
+}
+
+// APIToken holds information about an API token. The token's secret is
+// only ever returned when it is minted.
+type APIToken struct {
+	Name        string    `json:"name"`
+	ModelTag    string    `json:"model-tag,omitempty"`
+	MaxAccess   string    `json:"max-access"`
+	DateCreated time.Time `json:"date-created"`
+	Expires     time.Time `json:"expires"`
+}
+
+// APITokensResult holds the result of an APITokens call.
+type APITokensResult struct {
+	Tokens []APIToken `json:"tokens"`
+}
+
+// AddRoles holds the parameters for adding roles.
+type AddRoles struct {
+	Roles []AddRole `json:"roles"`
+}
+
+// AddRole holds the parameters to add one role. Methods are of the form
+// Facade.Method, where the method may be "*" to allow every method on the
+// facade.
+type AddRole struct {
+	Name    string   `json:"name"`
+	Methods []string `json:"methods"`
+}
+
+// RoleNames holds the names of roles.
+type RoleNames struct {
+	Names []string `json:"names"`
+}
+
+// Role holds information about a role.
+type Role struct {
+	Name        string    `json:"name"`
+	Methods     []string  `json:"methods"`
+	CreatedBy   string    `json:"created-by"`
+	DateCreated time.Time `json:"date-created"`
+}
+
+// RolesResult holds the result of a Roles call.
+type RolesResult struct {
+	Roles []Role `json:"roles"`
+}
+
+// RoleGrantAction is an action that can be performed on the roles granted
+// to a user.
+type RoleGrantAction string
+
+// Actions that can be performed on the roles granted to a user.
+const (
+	GrantRole  RoleGrantAction = "grant"
+	RevokeRole RoleGrantAction = "revoke"
+)
+
+// ModifyRoleGrantsRequest holds the parameters for granting and revoking
+// roles.
+type ModifyRoleGrantsRequest struct {
+	Changes []ModifyRoleGrant `json:"changes"`
+}
+
+// ModifyRoleGrant holds the parameters for granting a role to, or revoking
+// a role from, one user on a model.
+type ModifyRoleGrant struct {
+	Role     string          `json:"role"`
+	Action   RoleGrantAction `json:"action"`
+	UserTag  string          `json:"user-tag"`
+	ModelTag string          `json:"model-tag"`
+}
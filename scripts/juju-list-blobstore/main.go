@@ -614,7 +614,7 @@ func resourceDocID(modelUUID, resourceID string) string {
 // readApplicationsAndUnits figures out what CharmURLs are referenced by apps and units
 func (checker *ModelChecker) readApplicationsAndUnits() {
 	st := checker.model.State()
-	store, err := objectstore.NewStateObjectStore(context.TODO(), st.ModelUUID(), st, loggo.GetLogger("objectstore"))
+	store, err := objectstore.NewStateObjectStore(context.TODO(), st.ModelUUID(), st, nil, loggo.GetLogger("objectstore"))
 	checkErr(err, "NewStateObjectStore")
 
 	resourcesCollection := checker.session.DB("juju").C(resourcesC)
@@ -1232,7 +1232,7 @@ func (m *Model) AllActionIDsHasActionNotifications() ([]string, error) {
 
 func NewObjectStore(c *gc.C, st *State) objectstore.ObjectStore {
 	// This will be removed when the worker object store is enabled by default.
-	store, err := internalobjectstore.NewStateObjectStore(context.Background(), st.ModelUUID(), st, coretesting.NewCheckLogger(c))
+	store, err := internalobjectstore.NewStateObjectStore(context.Background(), st.ModelUUID(), st, nil, coretesting.NewCheckLogger(c))
 	c.Assert(err, jc.ErrorIsNil)
 	return store
 }
@@ -456,6 +456,15 @@ type LogTailerState interface {
 func NewLogTailer(
 	st LogTailerState, params corelogger.LogTailerParams, opLog *mgo.Collection,
 ) (corelogger.LogTailer, error) {
+	includeMessage, err := compileMessagePattern(params.IncludeMessage)
+	if err != nil {
+		return nil, errors.Annotate(err, "include message")
+	}
+	excludeMessage, err := compileMessagePattern(params.ExcludeMessage)
+	if err != nil {
+		return nil, errors.Annotate(err, "exclude message")
+	}
+
 	session := st.MongoSession().Copy()
 
 	if opLog == nil {
@@ -471,6 +480,8 @@ func NewLogTailer(
 		logCh:           make(chan *corelogger.LogRecord),
 		recentIds:       newRecentIdTracker(maxRecentLogIds),
 		maxInitialLines: maxInitialLines,
+		includeMessage:  includeMessage,
+		excludeMessage:  excludeMessage,
 	}
 	t.tomb.Go(func() error {
 		defer close(t.logCh)
@@ -493,6 +504,14 @@ type logTailer struct {
 	lastTime        time.Time
 	recentIds       *recentIdTracker
 	maxInitialLines int
+
+	// includeMessage and excludeMessage filter the records by their
+	// message text. The user supplied patterns are matched here with Go's
+	// linear time regexp engine rather than passed to Mongo's backtracking
+	// one, so that no pattern can be made to run for excessive time, and
+	// patterns mean the same as they do when validated by the API server.
+	includeMessage *regexp.Regexp
+	excludeMessage *regexp.Regexp
 }
 
 // Logs implements the LogTailer interface.
@@ -546,9 +565,13 @@ func (t *logTailer) processReversed(query *mgo.Query) error {
 		return errors.Errorf("too many lines requested (%d) maximum is %d",
 			t.params.InitialLines, maxInitialLines)
 	}
-	iter := query.Sort("-t", "-_id").
-		Limit(t.params.InitialLines).
-		Iter()
+	// Records filtered by message aren't counted towards the initial
+	// lines, so the query can't be limited to them.
+	query = query.Sort("-t", "-_id")
+	if t.includeMessage == nil && t.excludeMessage == nil {
+		query = query.Limit(t.params.InitialLines)
+	}
+	iter := query.Iter()
 	defer iter.Close()
 	queue := make([]logDoc, t.params.InitialLines)
 	cur := t.params.InitialLines
@@ -560,6 +583,9 @@ func (t *logTailer) processReversed(query *mgo.Query) error {
 			return errors.Trace(tomb.ErrDying)
 		default:
 		}
+		if !t.messageMatches(doc.Message) {
+			continue
+		}
 		cur--
 		queue[cur] = doc
 		if cur == 0 {
@@ -616,6 +642,9 @@ func (t *logTailer) processCollection() error {
 	iter := query.Sort("t", "_id").Iter()
 	defer iter.Close()
 	for iter.Next(&doc) {
+		if !t.messageMatches(doc.Message) {
+			continue
+		}
 		rec, err := logDocToRecord(t.modelUUID, &doc)
 		if err != nil {
 			if deserialisationFailures == 0 {
@@ -696,6 +725,9 @@ func (t *logTailer) tailOplog() error {
 				}
 				continue
 			}
+			if !t.messageMatches(doc.Message) {
+				continue
+			}
 			rec, err := logDocToRecord(t.modelUUID, doc)
 			if err != nil {
 				if deserialisationFailures == 0 {
@@ -720,8 +752,15 @@ func (t *logTailer) tailOplog() error {
 
 func (t *logTailer) paramsToSelector(params corelogger.LogTailerParams, prefix string) bson.D {
 	sel := bson.D{}
-	if !params.StartTime.IsZero() {
-		sel = append(sel, bson.DocElem{"t", bson.M{"$gte": params.StartTime.UnixNano()}})
+	if !params.StartTime.IsZero() || !params.EndTime.IsZero() {
+		timeSel := bson.M{}
+		if !params.StartTime.IsZero() {
+			timeSel["$gte"] = params.StartTime.UnixNano()
+		}
+		if !params.EndTime.IsZero() {
+			timeSel["$lte"] = params.EndTime.UnixNano()
+		}
+		sel = append(sel, bson.DocElem{"t", timeSel})
 	}
 	if params.MinLevel > loggo.UNSPECIFIED {
 		sel = append(sel, bson.DocElem{"v", bson.M{"$gte": int(params.MinLevel)}})
@@ -778,6 +817,36 @@ func makeModulePattern(modules []string) string {
 	return `^(` + strings.Join(patterns, "|") + `)(\..+)?$`
 }
 
+// compileMessagePattern returns a regular expression that matches any of
+// the input patterns, or nil if there are none.
+func compileMessagePattern(patterns []string) (*regexp.Regexp, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	// The patterns are regular expressions supplied by the user, so
+	// they are grouped rather than escaped.
+	var groups []string
+	for _, pattern := range patterns {
+		// Check each pattern on its own, so that one can't close
+		// the group it's put in.
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, errors.Trace(err)
+		}
+		groups = append(groups, `(?:`+pattern+`)`)
+	}
+	re, err := regexp.Compile(strings.Join(groups, "|"))
+	return re, errors.Trace(err)
+}
+
+// messageMatches reports whether a record with the input message passes
+// the tailer's message filters.
+func (t *logTailer) messageMatches(message string) bool {
+	if t.includeMessage != nil && !t.includeMessage.MatchString(message) {
+		return false
+	}
+	return t.excludeMessage == nil || !t.excludeMessage.MatchString(message)
+}
+
 func newRecentIdTracker(maxLen int) *recentIdTracker {
 	return &recentIdTracker{
 		ids: deque.NewWithMaxLen(maxLen),
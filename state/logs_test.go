@@ -345,6 +345,36 @@ func (s *LogTailerSuite) TestTimeFiltering(c *gc.C) {
 
 }
 
+func (s *LogTailerSuite) TestEndTimeFiltering(c *gc.C) {
+	threshT := coretesting.NonZeroTime()
+	want := logTemplate{Message: "want"}
+	s.writeLogsT(c, s.otherUUID, threshT.Add(-5*time.Second), threshT, 5, want)
+
+	// Add 5 logs that shouldn't be returned.
+	s.writeLogsT(c,
+		s.otherUUID,
+		threshT.Add(time.Millisecond), threshT.Add(5*time.Second), 5,
+		logTemplate{Message: "dont want"},
+	)
+
+	tailer, err := state.NewLogTailer(s.otherState, corelogger.LogTailerParams{
+		EndTime: threshT,
+		NoTail:  true,
+	}, s.oplogColl)
+	c.Assert(err, jc.ErrorIsNil)
+	defer tailer.Stop()
+	s.assertTailer(c, tailer, 5, want)
+
+	select {
+	case _, ok := <-tailer.Logs():
+		if ok {
+			c.Fatal("shouldn't be any further logs")
+		}
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for logs channel to close")
+	}
+}
+
 func (s *LogTailerSuite) TestOplogTransition(c *gc.C) {
 	// Ensure that logs aren't repeated as the log tailer moves from
 	// reading from the logs collection to tailing the oplog.
@@ -761,6 +791,68 @@ func (s *LogTailerSuite) TestIncludeExcludeLabels(c *gc.C) {
 	s.checkLogTailerFiltering(c, s.otherState, params, writeLogs, assert)
 }
 
+func (s *LogTailerSuite) TestIncludeMessage(c *gc.C) {
+	timeout := logTemplate{Message: "connection timed out"}
+	refused := logTemplate{Message: "connection refused"}
+	other := logTemplate{Message: "all is well"}
+	writeLogs := func() {
+		s.writeLogs(c, s.otherUUID, 1, other)
+		s.writeLogs(c, s.otherUUID, 1, timeout)
+		s.writeLogs(c, s.otherUUID, 1, other)
+		s.writeLogs(c, s.otherUUID, 1, refused)
+	}
+	params := corelogger.LogTailerParams{
+		IncludeMessage: []string{"timed? ?out", "^connection refused$"},
+	}
+	assert := func(tailer corelogger.LogTailer) {
+		s.assertTailer(c, tailer, 1, timeout)
+		s.assertTailer(c, tailer, 1, refused)
+	}
+	s.checkLogTailerFiltering(c, s.otherState, params, writeLogs, assert)
+}
+
+func (s *LogTailerSuite) TestIncludeExcludeMessage(c *gc.C) {
+	timeout := logTemplate{Message: "connection timed out"}
+	refused := logTemplate{Message: "connection refused"}
+	other := logTemplate{Message: "all is well"}
+	writeLogs := func() {
+		s.writeLogs(c, s.otherUUID, 1, other)
+		s.writeLogs(c, s.otherUUID, 1, timeout)
+		s.writeLogs(c, s.otherUUID, 1, refused)
+	}
+	params := corelogger.LogTailerParams{
+		IncludeMessage: []string{"connection"},
+		ExcludeMessage: []string{"refused"},
+	}
+	assert := func(tailer corelogger.LogTailer) {
+		s.assertTailer(c, tailer, 1, timeout)
+	}
+	s.checkLogTailerFiltering(c, s.otherState, params, writeLogs, assert)
+}
+
+func (s *LogTailerSuite) TestInitialLinesWithMessageFilter(c *gc.C) {
+	expected := logTemplate{Message: "want"}
+	s.writeLogs(c, s.otherUUID, 3, expected)
+	s.writeLogs(c, s.otherUUID, 5, logTemplate{Message: "dont want"})
+
+	tailer, err := state.NewLogTailer(s.otherState, corelogger.LogTailerParams{
+		InitialLines:   3,
+		IncludeMessage: []string{"^want$"},
+	}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	defer tailer.Stop()
+
+	// The filtered out records don't count towards the initial lines.
+	s.assertTailer(c, tailer, 3, expected)
+}
+
+func (s *LogTailerSuite) TestInvalidMessagePattern(c *gc.C) {
+	_, err := state.NewLogTailer(s.otherState, corelogger.LogTailerParams{
+		ExcludeMessage: []string{"(?<=x)"},
+	}, nil)
+	c.Assert(err, gc.ErrorMatches, `exclude message: error parsing regexp: .*`)
+}
+
 func (s *LogTailerSuite) checkLogTailerFiltering(
 	c *gc.C,
 	st *state.State,
@@ -888,7 +888,7 @@ func (factory *Factory) currentCfg(c *gc.C) *config.Config {
 
 func NewObjectStore(c *gc.C, st *state.State) objectstore.ObjectStore {
 	// This will be removed when the worker object store is enabled by default.
-	store, err := internalobjectstore.NewStateObjectStore(context.Background(), st.ModelUUID(), st, testing.NewCheckLogger(c))
+	store, err := internalobjectstore.NewStateObjectStore(context.Background(), st.ModelUUID(), st, nil, testing.NewCheckLogger(c))
 	c.Assert(err, jc.ErrorIsNil)
 	return store
 }
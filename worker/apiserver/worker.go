@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"github.com/juju/names/v4"
 	"github.com/juju/pubsub/v2"
 	"github.com/juju/worker/v3"
 
@@ -176,6 +177,7 @@ func NewWorker(ctx context.Context, config Config) (worker.Worker, error) {
 		Mux:                           config.Mux,
 		LocalMacaroonAuthenticator:    config.LocalMacaroonAuthenticator,
 		JWTAuthenticator:              jwtAuthenticator,
+		OIDCAuthenticator:             gatherOIDCAuthenticator(controllerConfig, config.Clock),
 		UpgradeComplete:               config.UpgradeComplete,
 		PublicDNSName:                 controllerConfig.AutocertDNSName(),
 		AllowModelAccess:              controllerConfig.AllowModelAccess(),
@@ -210,6 +212,20 @@ func gatherJWTAuthenticator(controllerConfig jujucontroller.Config) (jwt.Authent
 	return jwtAuthenticator, nil
 }
 
+// gatherOIDCAuthenticator builds the authenticator for ID tokens from the
+// OpenID Connect providers in the controller config, if there are any.
+func gatherOIDCAuthenticator(controllerConfig jujucontroller.Config, clk clock.Clock) *jwt.OIDCAuthenticator {
+	issuers := controllerConfig.OIDCIssuers()
+	if len(issuers) == 0 {
+		return nil
+	}
+	return jwt.NewOIDCAuthenticator(context.Background(), jwt.OIDCConfig{
+		ControllerTag: names.NewControllerTag(controllerConfig.ControllerUUID()),
+		Issuers:       issuers,
+		Clock:         clk,
+	})
+}
+
 func newServerShim(ctx context.Context, config apiserver.ServerConfig) (worker.Worker, error) {
 	return apiserver.NewServer(ctx, config)
 }
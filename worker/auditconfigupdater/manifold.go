@@ -4,7 +4,11 @@
 package auditconfigupdater
 
 import (
+	"path/filepath"
+
+	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"github.com/juju/loggo"
 	"github.com/juju/worker/v3"
 	"github.com/juju/worker/v3/dependency"
 
@@ -15,6 +19,8 @@ import (
 	workerstate "github.com/juju/juju/worker/state"
 )
 
+var logger = loggo.GetLogger("juju.worker.auditconfigupdater")
+
 // ManifoldConfig holds the information needed to run an
 // auditconfigupdater in a dependency.Engine.
 type ManifoldConfig struct {
@@ -40,12 +46,17 @@ func (config ManifoldConfig) Validate() error {
 // Manifold returns a dependency.Manifold to run an
 // auditconfigupdater.
 func Manifold(config ManifoldConfig) dependency.Manifold {
+	// Forwarding outlives each worker, since the API server keeps using
+	// the audit logs they create.
+	forwarding := &forwarding{}
 	return dependency.Manifold{
 		Inputs: []string{
 			config.AgentName,
 			config.StateName,
 		},
-		Start:  config.start,
+		Start: func(context dependency.Context) (worker.Worker, error) {
+			return config.start(context, forwarding)
+		},
 		Output: output,
 	}
 }
@@ -55,7 +66,7 @@ var ConfigSourceFromState = func(st *state.State) ConfigSource {
 	return st
 }
 
-func (config ManifoldConfig) start(context dependency.Context) (_ worker.Worker, err error) {
+func (config ManifoldConfig) start(context dependency.Context, forwarding *forwarding) (_ worker.Worker, err error) {
 	if err := config.Validate(); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -82,7 +93,7 @@ func (config ManifoldConfig) start(context dependency.Context) (_ worker.Worker,
 	logDir := agent.CurrentConfig().LogDir()
 
 	logFactory := func(cfg auditlog.Config) auditlog.AuditLog {
-		return auditlog.NewLogFile(logDir, cfg.MaxSizeMB, cfg.MaxBackups)
+		return newAuditLog(logDir, cfg, forwarding)
 	}
 	configSrc := ConfigSourceFromState(st)
 	auditConfig, err := initialConfig(configSrc)
@@ -100,6 +111,41 @@ func (config ManifoldConfig) start(context dependency.Context) (_ worker.Worker,
 	return common.NewCleanupWorker(w, func() { _ = stTracker.Done() }), nil
 }
 
+// newAuditLog returns the audit log file in logDir, also forwarding
+// records to a remote endpoint if one is configured. Records are
+// spooled in logDir until the endpoint has accepted them. Forwarding
+// follows later changes to the controller config, and is shared with
+// the audit logs previously returned for the same forwarding.
+func newAuditLog(logDir string, cfg auditlog.Config, forwarding *forwarding) auditlog.AuditLog {
+	target := newAuditTarget(
+		auditlog.NewLogFile(logDir, cfg.MaxSizeMB, cfg.MaxBackups),
+		forwarding,
+		func(cfg auditlog.Config) (auditlog.AuditLog, error) {
+			return newForwarder(logDir, cfg)
+		},
+	)
+	target.ReconfigureForwarding(cfg)
+	return target
+}
+
+func newForwarder(logDir string, cfg auditlog.Config) (auditlog.AuditLog, error) {
+	sender, err := newHTTPSender(cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	forwarder, err := auditlog.NewForwarder(auditlog.ForwarderConfig{
+		Sender:         sender,
+		SpoolDir:       filepath.Join(logDir, "audit-spool"),
+		MaxSpoolSizeMB: cfg.MaxSpoolSizeMB,
+		Clock:          clock.WallClock,
+	})
+	if err != nil {
+		_ = sender.Close()
+		return nil, errors.Trace(err)
+	}
+	return forwarder, nil
+}
+
 type withCurrentConfig interface {
 	CurrentConfig() auditlog.Config
 }
@@ -131,6 +177,10 @@ func initialConfig(source ConfigSource) (auditlog.Config, error) {
 		MaxSizeMB:      cfg.AuditLogMaxSizeMB(),
 		MaxBackups:     cfg.AuditLogMaxBackups(),
 		ExcludeMethods: cfg.AuditLogExcludeMethods(),
+		ForwardCACert:  cfg.AuditLogForwardCACert(),
+		ForwardToken:   cfg.AuditLogForwardToken(),
+		MaxSpoolSizeMB: cfg.AuditLogSpoolMaxSizeMB(),
 	}
+	result.ForwardURL, _ = cfg.AuditLogForwardURL()
 	return result, nil
 }
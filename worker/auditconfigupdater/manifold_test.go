@@ -4,6 +4,13 @@
 package auditconfigupdater_test
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/testing"
@@ -15,9 +22,11 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/agent"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/watcher/watchertest"
+	coretesting "github.com/juju/juju/testing"
 	"github.com/juju/juju/worker/auditconfigupdater"
 )
 
@@ -130,11 +139,215 @@ func (s *manifoldSuite) TestStart(c *gc.C) {
 		ExcludeMethods: set.NewStrings("This.Method"),
 		MaxSizeMB:      10,
 		MaxBackups:     10,
+		MaxSpoolSizeMB: controller.DefaultAuditLogSpoolMaxSizeMB,
 	})
 
 	c.Assert(args[2], gc.NotNil)
 }
 
+func (s *manifoldSuite) TestStartWithForwarding(c *gc.C) {
+	received := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		received <- req.Header.Get("Authorization") + " " + string(body)
+	}))
+	defer server.Close()
+
+	s.cfgSource.cfg["audit-log-forward-url"] = server.URL
+	s.cfgSource.cfg["audit-log-forward-token"] = "sekrit"
+	w, err := s.manifold.Start(s.context)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	auditConfig := s.stub.Calls()[0].Args[1].(auditlog.Config)
+	c.Assert(auditConfig.ForwardURL, gc.Equals, server.URL)
+	c.Assert(auditConfig.ForwardToken, gc.Equals, "sekrit")
+	target := auditConfig.Target
+	c.Assert(target, gc.NotNil)
+	defer target.Close()
+
+	err = target.AddConversation(auditlog.Conversation{ConversationID: "abc"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case got := <-received:
+		c.Assert(got, jc.HasPrefix, "Bearer sekrit ")
+		c.Assert(got, jc.Contains, `"conversation-id":"abc"`)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for forwarded record")
+	}
+
+	logBytes, err := os.ReadFile(filepath.Join(s.agent.conf.logDir, "audit.log"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(logBytes), jc.Contains, `"conversation-id":"abc"`)
+}
+
+func (s *manifoldSuite) TestReconfigureForwarding(c *gc.C) {
+	newServer := func(received chan<- string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			body, _ := io.ReadAll(req.Body)
+			received <- req.Header.Get("Authorization") + " " + string(body)
+		}))
+	}
+	receivedOld := make(chan string, 10)
+	oldServer := newServer(receivedOld)
+	defer oldServer.Close()
+	receivedNew := make(chan string, 10)
+	newSrv := newServer(receivedNew)
+	defer newSrv.Close()
+
+	s.cfgSource.cfg["audit-log-forward-url"] = oldServer.URL
+	w, err := s.manifold.Start(s.context)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	auditConfig := s.stub.Calls()[0].Args[1].(auditlog.Config)
+	target := auditConfig.Target
+	defer target.Close()
+
+	// The API server holds on to the target, so changing where records
+	// are forwarded must not replace it.
+	reconfigurer, ok := target.(interface {
+		ReconfigureForwarding(auditlog.Config)
+	})
+	c.Assert(ok, jc.IsTrue)
+	auditConfig.ForwardURL = newSrv.URL
+	auditConfig.ForwardToken = "sekrit"
+	reconfigurer.ReconfigureForwarding(auditConfig)
+
+	err = target.AddConversation(auditlog.Conversation{ConversationID: "abc"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case got := <-receivedNew:
+		c.Assert(got, jc.HasPrefix, "Bearer sekrit ")
+		c.Assert(got, jc.Contains, `"conversation-id":"abc"`)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for forwarded record")
+	}
+	select {
+	case got := <-receivedOld:
+		c.Fatalf("record forwarded to old endpoint: %s", got)
+	default:
+	}
+
+	// Removing the endpoint stops forwarding but keeps the audit log.
+	auditConfig.ForwardURL = ""
+	reconfigurer.ReconfigureForwarding(auditConfig)
+	err = target.AddConversation(auditlog.Conversation{ConversationID: "def"})
+	c.Assert(err, jc.ErrorIsNil)
+	select {
+	case got := <-receivedNew:
+		c.Fatalf("record forwarded after forwarding disabled: %s", got)
+	case <-time.After(coretesting.ShortWait):
+	}
+
+	logBytes, err := os.ReadFile(filepath.Join(s.agent.conf.logDir, "audit.log"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(logBytes), jc.Contains, `"conversation-id":"def"`)
+}
+
+func (s *manifoldSuite) TestReconfigureDoesNotBlockAuditing(c *gc.C) {
+	sending := make(chan struct{}, 10)
+	release := make(chan struct{})
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		sending <- struct{}{}
+		<-release
+	}))
+	defer slowServer.Close()
+	received := make(chan string, 10)
+	newSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		received <- string(body)
+	}))
+	defer newSrv.Close()
+
+	s.cfgSource.cfg["audit-log-forward-url"] = slowServer.URL
+	w, err := s.manifold.Start(s.context)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	auditConfig := s.stub.Calls()[0].Args[1].(auditlog.Config)
+	target := auditConfig.Target
+	defer target.Close()
+	err = target.AddConversation(auditlog.Conversation{ConversationID: "abc"})
+	c.Assert(err, jc.ErrorIsNil)
+	select {
+	case <-sending:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for send")
+	}
+
+	// The old forwarder can't stop until its send completes, but
+	// that mustn't hold up audited requests.
+	reconfigured := make(chan struct{})
+	go func() {
+		defer close(reconfigured)
+		auditConfig.ForwardURL = newSrv.URL
+		target.(interface {
+			ReconfigureForwarding(auditlog.Config)
+		}).ReconfigureForwarding(auditConfig)
+	}()
+	added := make(chan error)
+	go func() {
+		added <- target.AddConversation(auditlog.Conversation{ConversationID: "def"})
+	}()
+	select {
+	case err := <-added:
+		c.Assert(err, jc.ErrorIsNil)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("audit blocked by reconfiguration")
+	}
+
+	close(release)
+	select {
+	case <-reconfigured:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for reconfiguration")
+	}
+	select {
+	case got := <-received:
+		c.Assert(got, jc.Contains, `"conversation-id":"def"`)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for forwarded record")
+	}
+}
+
+func (s *manifoldSuite) TestForwardingSurvivesRestart(c *gc.C) {
+	received := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		received <- string(body)
+	}))
+	defer server.Close()
+
+	s.cfgSource.cfg["audit-log-forward-url"] = server.URL
+	w, err := s.manifold.Start(s.context)
+	c.Assert(err, jc.ErrorIsNil)
+	oldTarget := s.stub.Calls()[0].Args[1].(auditlog.Config).Target
+	defer oldTarget.Close()
+	workertest.CleanKill(c, w)
+
+	// API connections opened before the restart keep using the old
+	// target, so its records must still be forwarded.
+	w, err = s.manifold.Start(s.context)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+	newTarget := s.stub.Calls()[1].Args[1].(auditlog.Config).Target
+	defer newTarget.Close()
+
+	for _, target := range []auditlog.AuditLog{oldTarget, newTarget} {
+		err = target.AddConversation(auditlog.Conversation{ConversationID: "abc"})
+		c.Assert(err, jc.ErrorIsNil)
+		select {
+		case got := <-received:
+			c.Assert(got, jc.Contains, `"conversation-id":"abc"`)
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out waiting for forwarded record")
+		}
+	}
+}
+
 func (s *manifoldSuite) TestStartWithAuditingDisabled(c *gc.C) {
 	s.cfgSource.cfg["auditing-enabled"] = false
 	w, err := s.manifold.Start(s.context)
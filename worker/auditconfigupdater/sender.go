@@ -0,0 +1,49 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditconfigupdater
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/internal/logfwd/jsonhttp"
+)
+
+// httpSender is an auditlog.Sender which posts records to an HTTP
+// endpoint as newline-delimited JSON.
+type httpSender struct {
+	client *jsonhttp.Client
+}
+
+// newHTTPSender returns a sender for the forwarding endpoint in the
+// audit config.
+func newHTTPSender(cfg auditlog.Config) (auditlog.Sender, error) {
+	client, err := jsonhttp.Open(jsonhttp.RawConfig{
+		Enabled: true,
+		URL:     cfg.ForwardURL,
+		CACert:  cfg.ForwardCACert,
+		Token:   cfg.ForwardToken,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// The forwarder retries from its spool, so there's no point in
+	// the client also retrying.
+	client.RetryAttempts = 1
+	return &httpSender{client: client}, nil
+}
+
+// Send is part of the auditlog.Sender interface.
+func (s *httpSender) Send(records []auditlog.Record) error {
+	values := make([]interface{}, len(records))
+	for i, r := range records {
+		values[i] = r
+	}
+	return errors.Trace(s.client.SendValues(values))
+}
+
+// Close is part of the auditlog.Sender interface.
+func (s *httpSender) Close() error {
+	return errors.Trace(s.client.Close())
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditconfigupdater
+
+import (
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/auditlog"
+)
+
+// forwardConfig holds the audit config that determines where, and how,
+// records are forwarded.
+type forwardConfig struct {
+	url            string
+	caCert         string
+	token          string
+	maxSpoolSizeMB int
+}
+
+func forwardConfigOf(cfg auditlog.Config) forwardConfig {
+	return forwardConfig{
+		url:            cfg.ForwardURL,
+		caCert:         cfg.ForwardCACert,
+		token:          cfg.ForwardToken,
+		maxSpoolSizeMB: cfg.MaxSpoolSizeMB,
+	}
+}
+
+// forwarding owns the forwarder for an audit log spool. It is shared by
+// every target created by a manifold, so that when the worker restarts
+// the new target takes over the forwarder rather than closing it under
+// the API connections still holding the old target.
+type forwarding struct {
+	// reconfigMu serialises reconfiguration, so that mu need not be
+	// held while an old forwarder finishes an in-flight send.
+	reconfigMu sync.Mutex
+
+	mu        sync.RWMutex
+	refs      int
+	forward   forwardConfig
+	forwarder auditlog.AuditLog
+
+	// While the forwarder is being replaced, records are queued in
+	// pending and spooled by the new forwarder once it is ready.
+	switching bool
+	pendingMu sync.Mutex
+	pending   []func(auditlog.AuditLog) error
+}
+
+// reconfigure replaces the forwarder if the forwarding config has
+// changed. Records not yet delivered by the old forwarder remain in the
+// spool and are sent by the new one.
+func (f *forwarding) reconfigure(cfg auditlog.Config, newForwarder func(auditlog.Config) (auditlog.AuditLog, error)) {
+	forward := forwardConfigOf(cfg)
+
+	f.reconfigMu.Lock()
+	defer f.reconfigMu.Unlock()
+
+	f.mu.Lock()
+	if (f.forwarder != nil && forward == f.forward) || (f.forwarder == nil && forward.url == "") {
+		f.mu.Unlock()
+		return
+	}
+	old := f.forwarder
+	f.forwarder = nil
+	f.forward = forward
+	f.switching = true
+	f.mu.Unlock()
+
+	// The old forwarder must let go of the spool before the new one
+	// takes it over. Closing it waits for any send in progress, so it
+	// is done without holding mu, which would block audited requests.
+	if old != nil {
+		if err := old.Close(); err != nil {
+			logger.Warningf("closing audit log forwarder: %v", err)
+		}
+	}
+	var forwarder auditlog.AuditLog
+	if forward.url != "" {
+		var err error
+		if forwarder, err = newForwarder(cfg); err != nil {
+			// Config validation should prevent this; carry on with
+			// the local audit log rather than losing auditing
+			// altogether.
+			logger.Errorf("cannot forward audit records to %q: %v", forward.url, err)
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.forwarder = forwarder
+	f.switching = false
+	pending := f.pending
+	f.pending = nil
+	if forwarder == nil {
+		return
+	}
+	for _, add := range pending {
+		if err := add(forwarder); err != nil {
+			logger.Warningf("spooling audit record: %v", err)
+		}
+	}
+}
+
+// add passes the record added by the supplied func to the forwarder,
+// if there is one.
+func (f *forwarding) add(add func(auditlog.AuditLog) error) error {
+	// Holding the read lock stops the forwarder being closed while a
+	// record is being spooled.
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.switching {
+		f.pendingMu.Lock()
+		defer f.pendingMu.Unlock()
+		f.pending = append(f.pending, add)
+		return nil
+	}
+	if f.forwarder == nil {
+		return nil
+	}
+	return errors.Trace(add(f.forwarder))
+}
+
+// acquire records a new target using the forwarding.
+func (f *forwarding) acquire() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.refs++
+}
+
+// release records that a target has been closed. The forwarder is
+// closed along with the last target using it.
+func (f *forwarding) release() error {
+	f.reconfigMu.Lock()
+	defer f.reconfigMu.Unlock()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.refs--; f.refs > 0 || f.forwarder == nil {
+		return nil
+	}
+	err := f.forwarder.Close()
+	f.forwarder = nil
+	f.forward = forwardConfig{}
+	return errors.Trace(err)
+}
+
+// auditTarget is the audit log handed to the API server. It writes
+// records to the local audit log file and, if an endpoint is
+// configured, forwards them. The API server keeps hold of the target
+// for the life of each connection, so forwarding is reconfigured in
+// place rather than by replacing the target.
+type auditTarget struct {
+	file         auditlog.AuditLog
+	forwarding   *forwarding
+	newForwarder func(auditlog.Config) (auditlog.AuditLog, error)
+}
+
+func newAuditTarget(file auditlog.AuditLog, forwarding *forwarding, newForwarder func(auditlog.Config) (auditlog.AuditLog, error)) *auditTarget {
+	forwarding.acquire()
+	return &auditTarget{
+		file:         file,
+		forwarding:   forwarding,
+		newForwarder: newForwarder,
+	}
+}
+
+// ReconfigureForwarding replaces the forwarder if the forwarding config
+// has changed.
+func (t *auditTarget) ReconfigureForwarding(cfg auditlog.Config) {
+	t.forwarding.reconfigure(cfg, t.newForwarder)
+}
+
+// AddConversation implements auditlog.AuditLog.
+func (t *auditTarget) AddConversation(c auditlog.Conversation) error {
+	return t.each(func(log auditlog.AuditLog) error {
+		return log.AddConversation(c)
+	})
+}
+
+// AddRequest implements auditlog.AuditLog.
+func (t *auditTarget) AddRequest(r auditlog.Request) error {
+	return t.each(func(log auditlog.AuditLog) error {
+		return log.AddRequest(r)
+	})
+}
+
+// AddResponse implements auditlog.AuditLog.
+func (t *auditTarget) AddResponse(r auditlog.ResponseErrors) error {
+	return t.each(func(log auditlog.AuditLog) error {
+		return log.AddResponse(r)
+	})
+}
+
+// Close implements auditlog.AuditLog.
+func (t *auditTarget) Close() error {
+	err := t.file.Close()
+	if releaseErr := t.forwarding.release(); err == nil {
+		err = releaseErr
+	}
+	return errors.Trace(err)
+}
+
+func (t *auditTarget) each(f func(auditlog.AuditLog) error) error {
+	err := f(t.file)
+	if fwdErr := t.forwarding.add(f); err == nil {
+		err = fwdErr
+	}
+	return errors.Trace(err)
+}
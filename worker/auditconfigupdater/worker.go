@@ -27,6 +27,12 @@ type ConfigSource interface {
 // config.
 type AuditLogFactory func(auditlog.Config) auditlog.AuditLog
 
+// forwardingReconfigurer is implemented by audit logs that can change
+// where records are forwarded without being replaced.
+type forwardingReconfigurer interface {
+	ReconfigureForwarding(auditlog.Config)
+}
+
 // New returns a worker that will keep an up-to-date audit log config.
 func New(source ConfigSource, initial auditlog.Config, logFactory AuditLogFactory) (worker.Worker, error) {
 	u := &updater{
@@ -95,7 +101,11 @@ func (u *updater) newConfig() (auditlog.Config, error) {
 		MaxSizeMB:      cfg.AuditLogMaxSizeMB(),
 		MaxBackups:     cfg.AuditLogMaxBackups(),
 		ExcludeMethods: cfg.AuditLogExcludeMethods(),
+		ForwardCACert:  cfg.AuditLogForwardCACert(),
+		ForwardToken:   cfg.AuditLogForwardToken(),
+		MaxSpoolSizeMB: cfg.AuditLogSpoolMaxSizeMB(),
 	}
+	result.ForwardURL, _ = cfg.AuditLogForwardURL()
 	if result.Enabled && u.current.Target == nil {
 		result.Target = u.logFactory(result)
 	} else {
@@ -103,6 +113,9 @@ func (u *updater) newConfig() (auditlog.Config, error) {
 		// disabling and enabling auditing - we'll still stop logging
 		// because enabled is false.
 		result.Target = u.current.Target
+		if r, ok := result.Target.(forwardingReconfigurer); ok {
+			r.ReconfigureForwarding(result)
+		}
 	}
 	return result, nil
 }
@@ -189,6 +189,45 @@ func (s *updaterSuite) TestChangingCaptureArgs(c *gc.C) {
 	})
 }
 
+func (s *updaterSuite) TestChangingForwarding(c *gc.C) {
+	configChanged := make(chan struct{}, 1)
+	target := &reconfigurableAuditLog{}
+	initial := auditlog.Config{
+		Enabled: true,
+		Target:  target,
+	}
+	cfg := makeControllerConfig(true, false)
+	source := configSource{
+		watcher: watchertest.NewNotifyWatcher(configChanged),
+		cfg:     cfg,
+	}
+
+	w, err := auditconfigupdater.New(&source, initial, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	cfg = makeControllerConfig(true, false)
+	cfg["audit-log-forward-url"] = "https://audit.example.com/records"
+	cfg["audit-log-forward-token"] = "sekrit"
+	source.setConfig(cfg)
+	configChanged <- ding
+
+	newConfig := waitForConfig(c, w, func(cfg auditlog.Config) bool {
+		return cfg.ForwardURL != ""
+	})
+	c.Assert(newConfig.Target, gc.Equals, auditlog.AuditLog(target))
+	c.Assert(target.configs(), jc.DeepEquals, []auditlog.Config{{
+		Enabled:        true,
+		ExcludeMethods: set.NewStrings(),
+		MaxSizeMB:      controller.DefaultAuditLogMaxSizeMB,
+		MaxBackups:     controller.DefaultAuditLogMaxBackups,
+		MaxSpoolSizeMB: controller.DefaultAuditLogSpoolMaxSizeMB,
+		ForwardURL:     "https://audit.example.com/records",
+		ForwardToken:   "sekrit",
+		Target:         target,
+	}})
+}
+
 func makeControllerConfig(auditEnabled bool, captureArgs bool, methods ...string) controller.Config {
 	result := map[string]interface{}{
 		"other-setting":             "something",
@@ -236,3 +275,22 @@ func (s *configSource) setConfig(cfg controller.Config) {
 	defer s.mu.Unlock()
 	s.cfg = cfg
 }
+
+type reconfigurableAuditLog struct {
+	apitesting.FakeAuditLog
+
+	mu        sync.Mutex
+	reconfigs []auditlog.Config
+}
+
+func (l *reconfigurableAuditLog) ReconfigureForwarding(cfg auditlog.Config) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.reconfigs = append(l.reconfigs, cfg)
+}
+
+func (l *reconfigurableAuditLog) configs() []auditlog.Config {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.reconfigs
+}
@@ -293,6 +293,7 @@ func (a *appWorker) loop() error {
 
 	for {
 		shouldRefresh := true
+		reconcileScale := false
 		select {
 		case _, ok := <-appScaleWatcher.Changes():
 			if !ok {
@@ -411,12 +412,22 @@ func (a *appWorker) loop() error {
 			if err != nil {
 				return errors.Trace(err)
 			}
+			reconcileScale = true
 		case <-a.clock.After(10 * time.Second):
-			// Force refresh of application status.
+			// Force refresh of application status, and of the autoscaler's
+			// recommendation, which follows utilisation rather than replicas.
+			reconcileScale = true
 		}
 		if done {
 			return nil
 		}
+		if reconcileScale && a.lastApplied.Autoscaling != nil && !a.statusOnly {
+			// Replicas recommended by the autoscaler become the application's scale.
+			err = a.ops.ReconcileAutoscaledScale(a.name, app, a.facade, a.unitFacade, a.logger)
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
 		if shouldRefresh {
 			if err = a.ops.RefreshApplicationStatus(a.name, app, appLife, a.facade, a.logger); err != nil {
 				return errors.Annotatef(err, "refreshing application status for %q", a.name)
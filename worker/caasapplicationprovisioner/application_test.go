@@ -19,6 +19,7 @@ import (
 
 	"github.com/juju/juju/caas"
 	caasmocks "github.com/juju/juju/caas/mocks"
+	"github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/watcher"
@@ -170,7 +171,10 @@ func (s *ApplicationWorkerSuite) TestWorker(c *gc.C) {
 		facade.EXPECT().Life("test").Return(life.Alive, nil),
 		facade.EXPECT().ProvisioningState("test").Return(nil, nil),
 		facade.EXPECT().WatchProvisioningInfo("test").Return(watchertest.NewMockNotifyWatcher(provisioningInfoChan), nil),
-		ops.EXPECT().AppAlive("test", app, gomock.Any(), gomock.Any(), facade, clk, s.logger).Return(nil),
+		ops.EXPECT().AppAlive("test", app, gomock.Any(), gomock.Any(), facade, clk, s.logger).DoAndReturn(func(_, _, _ any, lastApplied *caas.ApplicationConfig, _, _, _ any) error {
+			lastApplied.Autoscaling = &application.AutoscalingPolicy{MinUnits: 1, MaxUnits: 5, CPUTarget: 80}
+			return nil
+		}),
 		app.EXPECT().Watch().Return(watchertest.NewMockNotifyWatcher(appChan), nil),
 		app.EXPECT().WatchReplicas().DoAndReturn(func() (watcher.NotifyWatcher, error) {
 			scaleChan <- struct{}{}
@@ -206,9 +210,10 @@ func (s *ApplicationWorkerSuite) TestWorker(c *gc.C) {
 			return nil, nil
 		}),
 		// appReplicasChan fired
-		ops.EXPECT().UpdateState("test", app, gomock.Any(), broker, facade, unitFacade, s.logger).DoAndReturn(func(_, _, _, _, _, _, _ any) (map[string]status.StatusInfo, error) {
+		ops.EXPECT().UpdateState("test", app, gomock.Any(), broker, facade, unitFacade, s.logger).Return(nil, nil),
+		ops.EXPECT().ReconcileAutoscaledScale("test", app, facade, unitFacade, s.logger).DoAndReturn(func(_, _, _, _, _ any) error {
 			provisioningInfoChan <- struct{}{}
-			return nil, nil
+			return nil
 		}),
 
 		// provisioningInfoChan fired
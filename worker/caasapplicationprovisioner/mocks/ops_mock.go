@@ -123,6 +123,20 @@ func (mr *MockApplicationOpsMockRecorder) EnsureTrust(arg0, arg1, arg2, arg3 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureTrust", reflect.TypeOf((*MockApplicationOps)(nil).EnsureTrust), arg0, arg1, arg2, arg3)
 }
 
+// ReconcileAutoscaledScale mocks base method.
+func (m *MockApplicationOps) ReconcileAutoscaledScale(arg0 string, arg1 caas.Application, arg2 caasapplicationprovisioner.CAASProvisionerFacade, arg3 caasapplicationprovisioner.CAASUnitProvisionerFacade, arg4 caasapplicationprovisioner.Logger) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileAutoscaledScale", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileAutoscaledScale indicates an expected call of ReconcileAutoscaledScale.
+func (mr *MockApplicationOpsMockRecorder) ReconcileAutoscaledScale(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileAutoscaledScale", reflect.TypeOf((*MockApplicationOps)(nil).ReconcileAutoscaledScale), arg0, arg1, arg2, arg3, arg4)
+}
+
 // ReconcileDeadUnitScale mocks base method.
 func (m *MockApplicationOps) ReconcileDeadUnitScale(arg0 string, arg1 caas.Application, arg2 caasapplicationprovisioner.CAASProvisionerFacade, arg3 caasapplicationprovisioner.Logger) error {
 	m.ctrl.T.Helper()
@@ -55,6 +55,9 @@ type ApplicationOps interface {
 
 	EnsureScale(appName string, app caas.Application, appLife life.Value,
 		facade CAASProvisionerFacade, unitFacade CAASUnitProvisionerFacade, logger Logger) error
+
+	ReconcileAutoscaledScale(appName string, app caas.Application,
+		facade CAASProvisionerFacade, unitFacade CAASUnitProvisionerFacade, logger Logger) error
 }
 
 type applicationOps struct {
@@ -110,6 +113,11 @@ func (applicationOps) EnsureScale(appName string, app caas.Application, appLife
 	return ensureScale(appName, app, appLife, facade, unitFacade, logger)
 }
 
+func (applicationOps) ReconcileAutoscaledScale(appName string, app caas.Application,
+	facade CAASProvisionerFacade, unitFacade CAASUnitProvisionerFacade, logger Logger) error {
+	return reconcileAutoscaledScale(appName, app, facade, unitFacade, logger)
+}
+
 type Tomb interface {
 	Dying() <-chan struct{}
 	ErrDying() error
@@ -200,6 +208,8 @@ func appAlive(appName string, app caas.Application, password string, lastApplied
 		Containers:           containers,
 		CharmModifiedVersion: provisionInfo.CharmModifiedVersion,
 		Trust:                provisionInfo.Trust,
+		MinAvailable:         provisionInfo.MinAvailable,
+		Autoscaling:          provisionInfo.Autoscaling,
 		InitialScale:         provisionInfo.Scale,
 	}
 	reason := "unchanged"
@@ -628,6 +638,64 @@ func ensureScale(appName string, app caas.Application, appLife life.Value,
 	return nil
 }
 
+// reconcileAutoscaledScale records the number of replicas recommended by
+// the substrate's autoscaler as the application's scale. The autoscaler
+// only scales up itself, and new pods can only be introduced as units once
+// the scale covers them. Scaling down is left to ensureScale, which tears
+// down the units before their pods are removed.
+func reconcileAutoscaledScale(appName string, app caas.Application,
+	facade CAASProvisionerFacade, unitFacade CAASUnitProvisionerFacade, logger Logger) error {
+	ps, err := facade.ProvisioningState(appName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if ps != nil && ps.Scaling {
+		// Wait for the scale requested through Juju to be applied.
+		return nil
+	}
+
+	appState, err := app.State()
+	if errors.Is(err, errors.NotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	replicas := appState.AutoscaledReplicas
+	if replicas == 0 {
+		return nil
+	}
+	scale, err := unitFacade.ApplicationScale(appName)
+	if err != nil {
+		return errors.Annotatef(err, "fetching application %q desired scale", appName)
+	}
+	if replicas == scale {
+		return nil
+	}
+
+	svc, err := app.Service()
+	if errors.Is(err, errors.NotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	logger.Infof("recording autoscaled application %q scale %d", appName, replicas)
+	err = unitFacade.UpdateApplicationService(params.UpdateApplicationServiceArg{
+		ApplicationTag: names.NewApplicationTag(appName).String(),
+		ProviderId:     svc.Id,
+		Addresses:      params.FromProviderAddresses(svc.Addresses...),
+		Scale:          &replicas,
+	})
+	if errors.Is(err, errors.Forbidden) {
+		// A scale requested through Juju takes precedence until it
+		// has been applied.
+		logger.Debugf("not recording autoscaled application %q scale %d: %v", appName, replicas, err)
+		return nil
+	} else if errors.Is(err, errors.NotFound) {
+		return nil
+	}
+	return errors.Annotatef(err, "setting application %q scale to %d", appName, replicas)
+}
+
 func setApplicationStatus(appName string, s status.Status, reason string, data map[string]interface{},
 	facade CAASProvisionerFacade, logger Logger) error {
 	logger.Tracef("updating application %q status to %q, %q, %v", appName, s, reason, data)
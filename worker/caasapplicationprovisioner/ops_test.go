@@ -18,6 +18,7 @@ import (
 	api "github.com/juju/juju/api/controller/caasapplicationprovisioner"
 	"github.com/juju/juju/caas"
 	caasmocks "github.com/juju/juju/caas/mocks"
+	"github.com/juju/juju/core/application"
 	corebase "github.com/juju/juju/core/base"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
@@ -429,6 +430,95 @@ func (s *OpsSuite) TestEnsureScaleDyingDead(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *OpsSuite) assertReconcileAutoscaledScale(c *gc.C, replicas, scale int, err error) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	app := caasmocks.NewMockApplication(ctrl)
+	facade := mocks.NewMockCAASProvisionerFacade(ctrl)
+	unitFacade := mocks.NewMockCAASUnitProvisionerFacade(ctrl)
+
+	gomock.InOrder(
+		facade.EXPECT().ProvisioningState("test").Return(nil, nil),
+		app.EXPECT().State().Return(caas.ApplicationState{DesiredReplicas: scale, AutoscaledReplicas: replicas}, nil),
+		unitFacade.EXPECT().ApplicationScale("test").Return(scale, nil),
+		app.EXPECT().Service().Return(&caas.Service{Id: "provider-id"}, nil),
+		unitFacade.EXPECT().UpdateApplicationService(params.UpdateApplicationServiceArg{
+			ApplicationTag: names.NewApplicationTag("test").String(),
+			ProviderId:     "provider-id",
+			Scale:          &replicas,
+		}).Return(err),
+	)
+
+	err = caasapplicationprovisioner.AppOps.ReconcileAutoscaledScale("test", app, facade, unitFacade, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *OpsSuite) TestReconcileAutoscaledScaleUp(c *gc.C) {
+	s.assertReconcileAutoscaledScale(c, 4, 3, nil)
+}
+
+func (s *OpsSuite) TestReconcileAutoscaledScaleDown(c *gc.C) {
+	s.assertReconcileAutoscaledScale(c, 2, 3, nil)
+}
+
+func (s *OpsSuite) TestReconcileAutoscaledScaleProtected(c *gc.C) {
+	// A scale requested through Juju isn't overwritten.
+	s.assertReconcileAutoscaledScale(c, 2, 3, errors.Forbiddenf("scale 3 not applied"))
+}
+
+func (s *OpsSuite) TestReconcileAutoscaledScaleUnchanged(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	app := caasmocks.NewMockApplication(ctrl)
+	facade := mocks.NewMockCAASProvisionerFacade(ctrl)
+	unitFacade := mocks.NewMockCAASUnitProvisionerFacade(ctrl)
+
+	gomock.InOrder(
+		facade.EXPECT().ProvisioningState("test").Return(nil, nil),
+		app.EXPECT().State().Return(caas.ApplicationState{DesiredReplicas: 3, AutoscaledReplicas: 3}, nil),
+		unitFacade.EXPECT().ApplicationScale("test").Return(3, nil),
+	)
+
+	err := caasapplicationprovisioner.AppOps.ReconcileAutoscaledScale("test", app, facade, unitFacade, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *OpsSuite) TestReconcileAutoscaledScaleNotAutoscaled(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	app := caasmocks.NewMockApplication(ctrl)
+	facade := mocks.NewMockCAASProvisionerFacade(ctrl)
+	unitFacade := mocks.NewMockCAASUnitProvisionerFacade(ctrl)
+
+	gomock.InOrder(
+		facade.EXPECT().ProvisioningState("test").Return(nil, nil),
+		app.EXPECT().State().Return(caas.ApplicationState{DesiredReplicas: 3}, nil),
+	)
+
+	err := caasapplicationprovisioner.AppOps.ReconcileAutoscaledScale("test", app, facade, unitFacade, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *OpsSuite) TestReconcileAutoscaledScaleWhileScaling(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	app := caasmocks.NewMockApplication(ctrl)
+	facade := mocks.NewMockCAASProvisionerFacade(ctrl)
+	unitFacade := mocks.NewMockCAASUnitProvisionerFacade(ctrl)
+
+	facade.EXPECT().ProvisioningState("test").Return(&params.CAASApplicationProvisioningState{
+		Scaling:     true,
+		ScaleTarget: 5,
+	}, nil)
+
+	err := caasapplicationprovisioner.AppOps.ReconcileAutoscaledScale("test", app, facade, unitFacade, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *OpsSuite) TestAppAlive(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -463,9 +553,11 @@ func (s *OpsSuite) TestAppAlive(c *gc.C) {
 		Tags: map[string]string{
 			"tag": "tag-value",
 		},
-		Trust:       true,
-		Scale:       10,
-		Constraints: constraints.MustParse("mem=1G"),
+		Trust:        true,
+		MinAvailable: 2,
+		Autoscaling:  &application.AutoscalingPolicy{MinUnits: 1, MaxUnits: 5, CPUTarget: 80},
+		Scale:        10,
+		Constraints:  constraints.MustParse("mem=1G"),
 		Filesystems: []storage.KubernetesFilesystemParams{{
 			StorageName: "data",
 			Size:        100,
@@ -524,6 +616,8 @@ func (s *OpsSuite) TestAppAlive(c *gc.C) {
 		}},
 		Devices:      []devices.KubernetesDeviceParams{},
 		Trust:        true,
+		MinAvailable: 2,
+		Autoscaling:  &application.AutoscalingPolicy{MinUnits: 1, MaxUnits: 5, CPUTarget: 80},
 		InitialScale: 10,
 	}
 	gomock.InOrder(
@@ -4,14 +4,21 @@
 package caasfirewaller
 
 import (
+	"context"
+	"reflect"
 	"strings"
 
+	"github.com/juju/collections/set"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v3"
 	"github.com/juju/worker/v3/catacomb"
 
 	"github.com/juju/juju/caas"
+	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
+	"github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/core/watcher"
 )
 
@@ -26,9 +33,14 @@ type applicationWorker struct {
 	broker         CAASBroker
 	portMutator    PortMutator
 	serviceUpdater ServiceUpdater
+	policyUpdater  NetworkPolicyUpdater
+	ingressUpdater IngressUpdater
 
-	appWatcher   watcher.NotifyWatcher
-	portsWatcher watcher.StringsWatcher
+	appWatcher         watcher.NotifyWatcher
+	appConfigWatcher   watcher.NotifyWatcher
+	modelConfigWatcher watcher.NotifyWatcher
+	portsWatcher       watcher.StringsWatcher
+	relationsWatcher   watcher.StringsWatcher
 
 	lifeGetter LifeGetter
 
@@ -37,6 +49,24 @@ type applicationWorker struct {
 
 	currentPorts network.GroupedPortRanges
 
+	// appConfig and ingressClass hold the latest application config
+	// and the ingress class from the model config.
+	appConfig    config.ConfigAttributes
+	ingressClass string
+
+	// ingressCIDRs and relatedApps hold the latest expose settings and
+	// relations, which together with currentPorts make up the network
+	// policy for the application. currentPolicy is the policy last applied,
+	// nil if there is none, and policyReconciled is set once any policy
+	// left behind by a previous run has been reconciled.
+	ingressCIDRs     []string
+	relatedApps      []string
+	currentPolicy    *caas.NetworkPolicyParam
+	policyReconciled bool
+
+	// currentIngress is the ingress last applied, nil if there is none.
+	currentIngress *caas.IngressParam
+
 	logger Logger
 }
 
@@ -87,6 +117,22 @@ func (w *applicationWorker) setUp() (err error) {
 		return errors.Trace(err)
 	}
 
+	w.appConfigWatcher, err = w.firewallerAPI.WatchApplicationConfig(w.appName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(w.appConfigWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	w.modelConfigWatcher, err = w.firewallerAPI.WatchForModelConfigChanges()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(w.modelConfigWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
 	w.portsWatcher, err = w.firewallerAPI.WatchOpenedPorts()
 	if err != nil {
 		return errors.Trace(err)
@@ -95,14 +141,38 @@ func (w *applicationWorker) setUp() (err error) {
 		return errors.Trace(err)
 	}
 
+	w.relationsWatcher, err = w.firewallerAPI.WatchRelations(w.appName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(w.relationsWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
 	// TODO(sidecar): support deployment other than statefulset
 	app := w.broker.Application(w.appName, caas.DeploymentStateful)
 	w.portMutator = app
 	w.serviceUpdater = app
+	w.policyUpdater = app
+	w.ingressUpdater = app
 
+	if w.appConfig, err = w.firewallerAPI.ApplicationConfig(w.appName); err != nil {
+		return errors.Annotatef(err, "failed to get initial config for application")
+	}
+	if w.ingressClass, err = w.modelIngressClass(); err != nil {
+		return errors.Annotatef(err, "failed to get initial model config")
+	}
 	if w.currentPorts, err = w.firewallerAPI.GetOpenedPorts(w.appName); err != nil {
 		return errors.Annotatef(err, "failed to get initial openned ports for application")
 	}
+	// Fetch the expose settings and relations up front so the first
+	// network policy applied is complete, whichever watcher fires first.
+	if _, w.ingressCIDRs, err = w.exposeInfo(); err != nil {
+		return errors.Annotatef(err, "failed to get initial expose settings for application")
+	}
+	if w.relatedApps, err = w.firewallerAPI.RelatedApplications(w.appName); err != nil {
+		return errors.Annotatef(err, "failed to get initial related applications for application")
+	}
 
 	return nil
 }
@@ -136,6 +206,20 @@ func (w *applicationWorker) loop() (err error) {
 				}
 				return errors.Trace(err)
 			}
+		case _, ok := <-w.appConfigWatcher.Changes():
+			if !ok {
+				return errors.New("application config watcher closed")
+			}
+			if err := w.onApplicationConfigChanged(); err != nil {
+				return errors.Trace(err)
+			}
+		case _, ok := <-w.modelConfigWatcher.Changes():
+			if !ok {
+				return errors.New("model config watcher closed")
+			}
+			if err := w.onModelConfigChanged(); err != nil {
+				return errors.Trace(err)
+			}
 		case _, ok := <-w.portsWatcher.Changes():
 			if !ok {
 				return errors.New("application watcher closed")
@@ -147,6 +231,13 @@ func (w *applicationWorker) loop() (err error) {
 			if err := w.onPortChanged(); err != nil {
 				return errors.Trace(err)
 			}
+		case _, ok := <-w.relationsWatcher.Changes():
+			if !ok {
+				return errors.New("relations watcher closed")
+			}
+			if err := w.onRelationsChanged(); err != nil {
+				return errors.Trace(err)
+			}
 		}
 	}
 }
@@ -188,9 +279,170 @@ func (w *applicationWorker) onPortChanged() error {
 	}
 
 	w.currentPorts = changedPortRanges
+	return errors.Trace(w.updateIngressAndNetworkPolicy())
+}
+
+func (w *applicationWorker) onApplicationConfigChanged() (err error) {
+	if w.appConfig, err = w.firewallerAPI.ApplicationConfig(w.appName); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(w.updateIngressAndNetworkPolicy())
+}
+
+// updateIngressAndNetworkPolicy updates the ingress, unless it is yet to be
+// set up by the first application change, and then the network policy,
+// which admits traffic to the port the ingress routes to.
+func (w *applicationWorker) updateIngressAndNetworkPolicy() error {
+	if !w.initial {
+		if err := w.updateIngress(w.previouslyExposed); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(w.updateNetworkPolicy())
+}
+
+func (w *applicationWorker) onModelConfigChanged() (err error) {
+	if w.ingressClass, err = w.modelIngressClass(); err != nil {
+		return errors.Trace(err)
+	}
+	if w.initial {
+		// The ingress is set up by the first application change.
+		return nil
+	}
+	return errors.Trace(w.updateIngress(w.previouslyExposed))
+}
+
+// modelIngressClass returns the ingress class set in the model config.
+func (w *applicationWorker) modelIngressClass() (string, error) {
+	modelConfig, err := w.firewallerAPI.ModelConfig(context.TODO())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	className, _ := modelConfig.AllAttrs()[k8sconstants.IngressClassKey].(string)
+	return className, nil
+}
+
+func (w *applicationWorker) onRelationsChanged() (err error) {
+	if w.relatedApps, err = w.firewallerAPI.RelatedApplications(w.appName); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(w.updateNetworkPolicy())
+}
+
+// exposeInfo returns whether the application is exposed and the CIDRs
+// allowed to reach its opened ports. Expose settings are merged across
+// endpoints, and spaces are ignored as they have no meaning on k8s.
+func (w *applicationWorker) exposeInfo() (bool, []string, error) {
+	exposed, exposedEndpoints, err := w.firewallerAPI.ExposeInfo(w.appName)
+	if err != nil || !exposed {
+		return false, nil, errors.Trace(err)
+	}
+	if len(exposedEndpoints) == 0 {
+		return true, []string{firewall.AllNetworksIPV4CIDR, firewall.AllNetworksIPV6CIDR}, nil
+	}
+	cidrs := set.NewStrings()
+	for _, exposeDetails := range exposedEndpoints {
+		cidrs = cidrs.Union(set.NewStrings(exposeDetails.ExposeToCIDRs...))
+	}
+	return true, cidrs.SortedValues(), nil
+}
+
+// updateNetworkPolicy applies the network policy derived from the
+// application's relations, expose settings and opened ports if one is
+// enabled in the application config, and removes it otherwise. Nothing is
+// done if the policy is the same as the one last applied.
+func (w *applicationWorker) updateNetworkPolicy() error {
+	var param *caas.NetworkPolicyParam
+	if w.appConfig.GetBool(application.NetworkPolicyConfigOptionName, false) {
+		ports := w.currentPorts.UniquePortRanges()
+		network.SortPortRanges(ports)
+		param = &caas.NetworkPolicyParam{
+			RelatedApplications: w.relatedApps,
+			IngressCIDRs:        w.ingressCIDRs,
+			Ports:               ports,
+		}
+		if w.currentIngress != nil {
+			param.IngressPort = w.currentIngress.Port
+		}
+	}
+	if w.policyReconciled && reflect.DeepEqual(param, w.currentPolicy) {
+		w.logger.Debugf("no network policy changes for app %q", w.appName)
+		return nil
+	}
+	if param == nil {
+		if err := w.policyUpdater.DeleteNetworkPolicy(); err != nil {
+			return errors.Annotatef(err, "cannot delete network policy for application %q", w.appName)
+		}
+	} else if err := w.policyUpdater.UpdateNetworkPolicy(*param); err != nil {
+		return errors.Annotatef(err, "cannot update network policy for application %q", w.appName)
+	}
+	w.currentPolicy = param
+	w.policyReconciled = true
 	return nil
 }
 
+// updateIngress creates or updates the application's ingress if it is
+// exposed and has an ingress host configured, and removes it otherwise.
+func (w *applicationWorker) updateIngress(exposed bool) error {
+	var param *caas.IngressParam
+	if exposed {
+		param = w.ingressParam()
+	}
+	// Any ingress left behind by a previous run is unknown
+	// to us, so always reconcile the initial state.
+	if !w.initial && reflect.DeepEqual(param, w.currentIngress) {
+		w.logger.Debugf("no ingress changes for app %q", w.appName)
+		return nil
+	}
+	if param == nil {
+		if err := w.ingressUpdater.DeleteIngress(); err != nil {
+			return errors.Annotatef(err, "cannot delete ingress for application %q", w.appName)
+		}
+	} else if err := w.ingressUpdater.UpdateIngress(*param); err != nil {
+		return errors.Annotatef(err, "cannot update ingress for application %q", w.appName)
+	}
+	w.currentIngress = param
+	return nil
+}
+
+// ingressParam returns the ingress for the application derived from its
+// config and opened ports, or nil if no ingress is wanted.
+func (w *applicationWorker) ingressParam() *caas.IngressParam {
+	host := w.appConfig.GetString(application.IngressHostConfigOptionName, "")
+	if host == "" {
+		return nil
+	}
+	endpoint := w.appConfig.GetString(application.IngressEndpointConfigOptionName, "")
+	port := w.ingressPort(endpoint)
+	if port == 0 {
+		w.logger.Warningf("no TCP port opened on endpoint %q for ingress of application %q", endpoint, w.appName)
+		return nil
+	}
+	return &caas.IngressParam{
+		Host:          host,
+		Path:          w.appConfig.GetString(application.IngressPathConfigOptionName, ""),
+		Port:          port,
+		TLSSecretName: w.appConfig.GetString(application.IngressTLSSecretConfigOptionName, ""),
+		ClassName:     w.ingressClass,
+	}
+}
+
+// ingressPort returns the lowest TCP port opened for the specified
+// endpoint, including the ports opened for all endpoints.
+func (w *applicationWorker) ingressPort(endpoint string) int {
+	portRanges := append([]network.PortRange(nil), w.currentPorts[""]...)
+	if endpoint != "" {
+		portRanges = append(portRanges, w.currentPorts[endpoint]...)
+	}
+	network.SortPortRanges(portRanges)
+	for _, pr := range portRanges {
+		if pr.Protocol == "tcp" {
+			return pr.FromPort
+		}
+	}
+	return 0
+}
+
 func (w *applicationWorker) onApplicationChanged() (err error) {
 	defer func() {
 		// Not found could be because the app got removed or there's
@@ -207,10 +459,17 @@ func (w *applicationWorker) onApplicationChanged() (err error) {
 		}
 	}()
 
-	exposed, err := w.firewallerAPI.IsExposed(w.appName)
+	exposed, ingressCIDRs, err := w.exposeInfo()
 	if err != nil {
 		return errors.Trace(err)
 	}
+	w.ingressCIDRs = ingressCIDRs
+	if err := w.updateIngress(exposed); err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.updateNetworkPolicy(); err != nil {
+		return errors.Trace(err)
+	}
 	if !w.initial && exposed == w.previouslyExposed {
 		return nil
 	}
@@ -15,9 +15,12 @@ import (
 
 	"github.com/juju/juju/caas"
 	caasmocks "github.com/juju/juju/caas/mocks"
+	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/watchertest"
+	environsconfig "github.com/juju/juju/environs/config"
+	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/testing"
 	"github.com/juju/juju/worker/caasfirewaller"
 	"github.com/juju/juju/worker/caasfirewaller/mocks"
@@ -34,10 +37,18 @@ type appWorkerSuite struct {
 	brokerApp     *caasmocks.MockApplication
 
 	applicationChanges chan struct{}
+	appConfigChanges   chan struct{}
+	modelConfigChanges chan struct{}
 	portsChanges       chan []string
+	relationsChanges   chan []string
 
-	appsWatcher  watcher.NotifyWatcher
-	portsWatcher watcher.StringsWatcher
+	appsWatcher        watcher.NotifyWatcher
+	appConfigWatcher   watcher.NotifyWatcher
+	modelConfigWatcher watcher.NotifyWatcher
+	portsWatcher       watcher.StringsWatcher
+	relationsWatcher   watcher.StringsWatcher
+
+	modelConfig *environsconfig.Config
 }
 
 var _ = gc.Suite(&appWorkerSuite{})
@@ -47,14 +58,26 @@ func (s *appWorkerSuite) SetUpTest(c *gc.C) {
 
 	s.appName = "app1"
 	s.applicationChanges = make(chan struct{})
+	s.appConfigChanges = make(chan struct{})
+	s.modelConfigChanges = make(chan struct{})
 	s.portsChanges = make(chan []string)
+	s.relationsChanges = make(chan []string)
+
+	var err error
+	s.modelConfig, err = environsconfig.New(environsconfig.UseDefaults, testing.FakeConfig().Merge(testing.Attrs{
+		"ingress-class": "nginx",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
 }
 
 func (s *appWorkerSuite) getController(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.appsWatcher = watchertest.NewMockNotifyWatcher(s.applicationChanges)
+	s.appConfigWatcher = watchertest.NewMockNotifyWatcher(s.appConfigChanges)
+	s.modelConfigWatcher = watchertest.NewMockNotifyWatcher(s.modelConfigChanges)
 	s.portsWatcher = watchertest.NewMockStringsWatcher(s.portsChanges)
+	s.relationsWatcher = watchertest.NewMockStringsWatcher(s.relationsChanges)
 
 	s.firewallerAPI = mocks.NewMockCAASFirewallerAPI(ctrl)
 
@@ -93,6 +116,11 @@ func (s *appWorkerSuite) TestWorker(c *gc.C) {
 		// 3rd port change event.
 		s.portsChanges <- []string{"port changes"}
 
+		s.relationsChanges <- []string{"app1:db mysql:server"}
+
+		// Expose.
+		s.applicationChanges <- struct{}{}
+		// Unexpose.
 		s.applicationChanges <- struct{}{}
 	}()
 
@@ -111,13 +139,26 @@ func (s *appWorkerSuite) TestWorker(c *gc.C) {
 		},
 	}
 
+	appConfig := config.ConfigAttributes{
+		"kubernetes-network-policy":     true,
+		"kubernetes-ingress-host":       "app1.example.com",
+		"kubernetes-ingress-tls-secret": "app1-tls",
+	}
+
 	gomock.InOrder(
 		s.firewallerAPI.EXPECT().WatchApplication(s.appName).Return(s.appsWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchApplicationConfig(s.appName).Return(s.appConfigWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchForModelConfigChanges().Return(s.modelConfigWatcher, nil),
 		s.firewallerAPI.EXPECT().WatchOpenedPorts().Return(s.portsWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchRelations(s.appName).Return(s.relationsWatcher, nil),
 		s.broker.EXPECT().Application(s.appName, caas.DeploymentStateful).Return(s.brokerApp),
 
 		// initial fetch.
+		s.firewallerAPI.EXPECT().ApplicationConfig(s.appName).Return(appConfig, nil),
+		s.firewallerAPI.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig, nil),
 		s.firewallerAPI.EXPECT().GetOpenedPorts(s.appName).Return(network.GroupedPortRanges{}, nil),
+		s.firewallerAPI.EXPECT().ExposeInfo(s.appName).Return(false, nil, nil),
+		s.firewallerAPI.EXPECT().RelatedApplications(s.appName).Return(nil, nil),
 
 		// 1st triggerred by port change event.
 		s.firewallerAPI.EXPECT().GetOpenedPorts(s.appName).Return(gpr1, nil),
@@ -129,6 +170,11 @@ func (s *appWorkerSuite) TestWorker(c *gc.C) {
 				Protocol:   "tcp",
 			},
 		}, false).Return(nil),
+		s.brokerApp.EXPECT().UpdateNetworkPolicy(caas.NetworkPolicyParam{
+			Ports: []network.PortRange{
+				network.MustParsePortRange("1000/tcp"),
+			},
+		}).Return(nil),
 
 		// 2nd triggerred by port change event, no UpdatePorts because no diff on the portchanges.
 		s.firewallerAPI.EXPECT().GetOpenedPorts(s.appName).Return(gpr1, nil),
@@ -149,10 +195,205 @@ func (s *appWorkerSuite) TestWorker(c *gc.C) {
 				Protocol:   "udp",
 			},
 		}, false).Return(nil),
+		s.brokerApp.EXPECT().UpdateNetworkPolicy(caas.NetworkPolicyParam{
+			Ports: []network.PortRange{
+				network.MustParsePortRange("1000/tcp"),
+				network.MustParsePortRange("2000/udp"),
+			},
+		}).Return(nil),
+
+		// triggerred by relation change event.
+		s.firewallerAPI.EXPECT().RelatedApplications(s.appName).Return([]string{"mysql"}, nil),
+		s.brokerApp.EXPECT().UpdateNetworkPolicy(caas.NetworkPolicyParam{
+			RelatedApplications: []string{"mysql"},
+			Ports: []network.PortRange{
+				network.MustParsePortRange("1000/tcp"),
+				network.MustParsePortRange("2000/udp"),
+			},
+		}).Return(nil),
+
+		// triggerred by application change event.
+		s.firewallerAPI.EXPECT().ExposeInfo(s.appName).Return(true, map[string]params.ExposedEndpoint{
+			"":   {ExposeToCIDRs: []string{"10.0.0.0/24"}},
+			"db": {ExposeToSpaces: []string{"alpha"}, ExposeToCIDRs: []string{"192.168.0.0/16"}},
+		}, nil),
+		s.brokerApp.EXPECT().UpdateIngress(caas.IngressParam{
+			Host:          "app1.example.com",
+			Port:          1000,
+			TLSSecretName: "app1-tls",
+			ClassName:     "nginx",
+		}).Return(nil),
+		s.brokerApp.EXPECT().UpdateNetworkPolicy(caas.NetworkPolicyParam{
+			RelatedApplications: []string{"mysql"},
+			IngressCIDRs:        []string{"10.0.0.0/24", "192.168.0.0/16"},
+			Ports: []network.PortRange{
+				network.MustParsePortRange("1000/tcp"),
+				network.MustParsePortRange("2000/udp"),
+			},
+			IngressPort: 1000,
+		}).Return(nil),
+
+		// triggerred by application change event.
+		s.firewallerAPI.EXPECT().ExposeInfo(s.appName).Return(false, nil, nil),
+		s.brokerApp.EXPECT().DeleteIngress().Return(nil),
+		s.brokerApp.EXPECT().UpdateNetworkPolicy(caas.NetworkPolicyParam{
+			RelatedApplications: []string{"mysql"},
+			Ports: []network.PortRange{
+				network.MustParsePortRange("1000/tcp"),
+				network.MustParsePortRange("2000/udp"),
+			},
+		}).DoAndReturn(func(caas.NetworkPolicyParam) error {
+			close(done)
+			return nil
+		}),
+	)
+
+	w := s.getWorker(c)
+
+	select {
+	case <-done:
+	case <-time.After(testing.ShortWait):
+		c.Errorf("timed out waiting for worker")
+	}
+	workertest.CleanKill(c, w)
+}
+
+func (s *appWorkerSuite) TestWorkerNetworkPolicyDisabled(c *gc.C) {
+	ctrl := s.getController(c)
+	defer ctrl.Finish()
+
+	done := make(chan struct{})
+
+	go func() {
+		s.portsChanges <- []string{"port changes"}
+		s.relationsChanges <- []string{"app1:db mysql:server"}
+	}()
+
+	gpr := network.GroupedPortRanges{
+		"": []network.PortRange{
+			network.MustParsePortRange("1000/tcp"),
+		},
+	}
+
+	gomock.InOrder(
+		s.firewallerAPI.EXPECT().WatchApplication(s.appName).Return(s.appsWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchApplicationConfig(s.appName).Return(s.appConfigWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchForModelConfigChanges().Return(s.modelConfigWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchOpenedPorts().Return(s.portsWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchRelations(s.appName).Return(s.relationsWatcher, nil),
+		s.broker.EXPECT().Application(s.appName, caas.DeploymentStateful).Return(s.brokerApp),
+
+		// initial fetch.
+		s.firewallerAPI.EXPECT().ApplicationConfig(s.appName).Return(config.ConfigAttributes{}, nil),
+		s.firewallerAPI.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig, nil),
+		s.firewallerAPI.EXPECT().GetOpenedPorts(s.appName).Return(network.GroupedPortRanges{}, nil),
+		s.firewallerAPI.EXPECT().ExposeInfo(s.appName).Return(false, nil, nil),
+		s.firewallerAPI.EXPECT().RelatedApplications(s.appName).Return(nil, nil),
+
+		// triggerred by port change event, removing any policy
+		// left behind.
+		s.firewallerAPI.EXPECT().GetOpenedPorts(s.appName).Return(gpr, nil),
+		s.brokerApp.EXPECT().UpdatePorts(gomock.Any(), false).Return(nil),
+		s.brokerApp.EXPECT().DeleteNetworkPolicy().Return(nil),
+
+		// triggerred by relation change event, with nothing to do.
+		s.firewallerAPI.EXPECT().RelatedApplications(s.appName).DoAndReturn(func(string) ([]string, error) {
+			close(done)
+			return []string{"mysql"}, nil
+		}),
+	)
+
+	w := s.getWorker(c)
+
+	select {
+	case <-done:
+	case <-time.After(testing.ShortWait):
+		c.Errorf("timed out waiting for worker")
+	}
+	workertest.CleanKill(c, w)
+}
+
+func (s *appWorkerSuite) TestWorkerConfigChanges(c *gc.C) {
+	ctrl := s.getController(c)
+	defer ctrl.Finish()
 
-		s.firewallerAPI.EXPECT().IsExposed(s.appName).DoAndReturn(func(_ string) (bool, error) {
+	done := make(chan struct{})
+
+	go func() {
+		s.portsChanges <- []string{"port changes"}
+		// Expose.
+		s.applicationChanges <- struct{}{}
+		// Change the ingress host and enable the network policy.
+		s.appConfigChanges <- struct{}{}
+		// Change the ingress class.
+		s.modelConfigChanges <- struct{}{}
+	}()
+
+	gpr := network.GroupedPortRanges{
+		"": []network.PortRange{
+			network.MustParsePortRange("1000/tcp"),
+		},
+	}
+	modelConfig, err := s.modelConfig.Apply(map[string]interface{}{"ingress-class": "traefik"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	gomock.InOrder(
+		s.firewallerAPI.EXPECT().WatchApplication(s.appName).Return(s.appsWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchApplicationConfig(s.appName).Return(s.appConfigWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchForModelConfigChanges().Return(s.modelConfigWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchOpenedPorts().Return(s.portsWatcher, nil),
+		s.firewallerAPI.EXPECT().WatchRelations(s.appName).Return(s.relationsWatcher, nil),
+		s.broker.EXPECT().Application(s.appName, caas.DeploymentStateful).Return(s.brokerApp),
+
+		// initial fetch.
+		s.firewallerAPI.EXPECT().ApplicationConfig(s.appName).Return(config.ConfigAttributes{
+			"kubernetes-ingress-host": "app1.example.com",
+		}, nil),
+		s.firewallerAPI.EXPECT().ModelConfig(gomock.Any()).Return(s.modelConfig, nil),
+		s.firewallerAPI.EXPECT().GetOpenedPorts(s.appName).Return(network.GroupedPortRanges{}, nil),
+		s.firewallerAPI.EXPECT().ExposeInfo(s.appName).Return(false, nil, nil),
+		s.firewallerAPI.EXPECT().RelatedApplications(s.appName).Return(nil, nil),
+
+		// triggerred by port change event.
+		s.firewallerAPI.EXPECT().GetOpenedPorts(s.appName).Return(gpr, nil),
+		s.brokerApp.EXPECT().UpdatePorts(gomock.Any(), false).Return(nil),
+		s.brokerApp.EXPECT().DeleteNetworkPolicy().Return(nil),
+
+		// triggerred by application change event.
+		s.firewallerAPI.EXPECT().ExposeInfo(s.appName).Return(true, nil, nil),
+		s.brokerApp.EXPECT().UpdateIngress(caas.IngressParam{
+			Host:      "app1.example.com",
+			Port:      1000,
+			ClassName: "nginx",
+		}).Return(nil),
+
+		// triggerred by application config change event.
+		s.firewallerAPI.EXPECT().ApplicationConfig(s.appName).Return(config.ConfigAttributes{
+			"kubernetes-ingress-host":   "app1.example.org",
+			"kubernetes-network-policy": true,
+		}, nil),
+		s.brokerApp.EXPECT().UpdateIngress(caas.IngressParam{
+			Host:      "app1.example.org",
+			Port:      1000,
+			ClassName: "nginx",
+		}).Return(nil),
+		s.brokerApp.EXPECT().UpdateNetworkPolicy(caas.NetworkPolicyParam{
+			IngressCIDRs: []string{"0.0.0.0/0", "::/0"},
+			Ports: []network.PortRange{
+				network.MustParsePortRange("1000/tcp"),
+			},
+			IngressPort: 1000,
+		}).Return(nil),
+
+		// triggerred by model config change event.
+		s.firewallerAPI.EXPECT().ModelConfig(gomock.Any()).Return(modelConfig, nil),
+		s.brokerApp.EXPECT().UpdateIngress(caas.IngressParam{
+			Host:      "app1.example.org",
+			Port:      1000,
+			ClassName: "traefik",
+		}).DoAndReturn(func(caas.IngressParam) error {
 			close(done)
-			return false, nil
+			return nil
 		}),
 	)
 
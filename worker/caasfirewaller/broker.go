@@ -21,3 +21,15 @@ type PortMutator interface {
 type ServiceUpdater interface {
 	UpdateService(caas.ServiceParam) error
 }
+
+// NetworkPolicyUpdater exposes CAAS application functionality to a worker.
+type NetworkPolicyUpdater interface {
+	UpdateNetworkPolicy(caas.NetworkPolicyParam) error
+	DeleteNetworkPolicy() error
+}
+
+// IngressUpdater exposes CAAS application functionality to a worker.
+type IngressUpdater interface {
+	UpdateIngress(caas.IngressParam) error
+	DeleteIngress() error
+}
@@ -4,11 +4,15 @@
 package caasfirewaller
 
 import (
+	"context"
+
 	charmscommon "github.com/juju/juju/api/common/charms"
 	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/watcher"
+	environsconfig "github.com/juju/juju/environs/config"
+	"github.com/juju/juju/rpc/params"
 )
 
 // Client provides an interface for interacting with the
@@ -30,7 +34,15 @@ type CAASFirewallerAPI interface {
 	GetOpenedPorts(appName string) (network.GroupedPortRanges, error)
 
 	IsExposed(string) (bool, error)
+	ExposeInfo(string) (bool, map[string]params.ExposedEndpoint, error)
 	ApplicationConfig(string) (config.ConfigAttributes, error)
+	WatchApplicationConfig(string) (watcher.NotifyWatcher, error)
+
+	ModelConfig(context.Context) (*environsconfig.Config, error)
+	WatchForModelConfigChanges() (watcher.NotifyWatcher, error)
+
+	WatchRelations(string) (watcher.StringsWatcher, error)
+	RelatedApplications(string) ([]string, error)
 
 	ApplicationCharmInfo(appName string) (*charmscommon.CharmInfo, error)
 }
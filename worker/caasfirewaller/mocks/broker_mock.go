@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/worker/caasfirewaller (interfaces: CAASBroker,PortMutator,ServiceUpdater)
+// Source: github.com/juju/juju/worker/caasfirewaller (interfaces: CAASBroker,PortMutator,ServiceUpdater,NetworkPolicyUpdater,IngressUpdater)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -121,3 +121,105 @@ func (mr *MockServiceUpdaterMockRecorder) UpdateService(arg0 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockServiceUpdater)(nil).UpdateService), arg0)
 }
+
+// MockNetworkPolicyUpdater is a mock of NetworkPolicyUpdater interface.
+type MockNetworkPolicyUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkPolicyUpdaterMockRecorder
+}
+
+// MockNetworkPolicyUpdaterMockRecorder is the mock recorder for MockNetworkPolicyUpdater.
+type MockNetworkPolicyUpdaterMockRecorder struct {
+	mock *MockNetworkPolicyUpdater
+}
+
+// NewMockNetworkPolicyUpdater creates a new mock instance.
+func NewMockNetworkPolicyUpdater(ctrl *gomock.Controller) *MockNetworkPolicyUpdater {
+	mock := &MockNetworkPolicyUpdater{ctrl: ctrl}
+	mock.recorder = &MockNetworkPolicyUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkPolicyUpdater) EXPECT() *MockNetworkPolicyUpdaterMockRecorder {
+	return m.recorder
+}
+
+// DeleteNetworkPolicy mocks base method.
+func (m *MockNetworkPolicyUpdater) DeleteNetworkPolicy() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkPolicy")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkPolicy indicates an expected call of DeleteNetworkPolicy.
+func (mr *MockNetworkPolicyUpdaterMockRecorder) DeleteNetworkPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkPolicy", reflect.TypeOf((*MockNetworkPolicyUpdater)(nil).DeleteNetworkPolicy))
+}
+
+// UpdateNetworkPolicy mocks base method.
+func (m *MockNetworkPolicyUpdater) UpdateNetworkPolicy(arg0 caas.NetworkPolicyParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkPolicy indicates an expected call of UpdateNetworkPolicy.
+func (mr *MockNetworkPolicyUpdaterMockRecorder) UpdateNetworkPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkPolicy", reflect.TypeOf((*MockNetworkPolicyUpdater)(nil).UpdateNetworkPolicy), arg0)
+}
+
+// MockIngressUpdater is a mock of IngressUpdater interface.
+type MockIngressUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockIngressUpdaterMockRecorder
+}
+
+// MockIngressUpdaterMockRecorder is the mock recorder for MockIngressUpdater.
+type MockIngressUpdaterMockRecorder struct {
+	mock *MockIngressUpdater
+}
+
+// NewMockIngressUpdater creates a new mock instance.
+func NewMockIngressUpdater(ctrl *gomock.Controller) *MockIngressUpdater {
+	mock := &MockIngressUpdater{ctrl: ctrl}
+	mock.recorder = &MockIngressUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIngressUpdater) EXPECT() *MockIngressUpdaterMockRecorder {
+	return m.recorder
+}
+
+// DeleteIngress mocks base method.
+func (m *MockIngressUpdater) DeleteIngress() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIngress")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIngress indicates an expected call of DeleteIngress.
+func (mr *MockIngressUpdaterMockRecorder) DeleteIngress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIngress", reflect.TypeOf((*MockIngressUpdater)(nil).DeleteIngress))
+}
+
+// UpdateIngress mocks base method.
+func (m *MockIngressUpdater) UpdateIngress(arg0 caas.IngressParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIngress", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIngress indicates an expected call of UpdateIngress.
+func (mr *MockIngressUpdaterMockRecorder) UpdateIngress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngress", reflect.TypeOf((*MockIngressUpdater)(nil).UpdateIngress), arg0)
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	charms "github.com/juju/juju/api/common/charms"
@@ -12,6 +13,8 @@ import (
 	life "github.com/juju/juju/core/life"
 	network "github.com/juju/juju/core/network"
 	watcher "github.com/juju/juju/core/watcher"
+	config0 "github.com/juju/juju/environs/config"
+	params "github.com/juju/juju/rpc/params"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -68,6 +71,22 @@ func (mr *MockClientMockRecorder) ApplicationConfig(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationConfig", reflect.TypeOf((*MockClient)(nil).ApplicationConfig), arg0)
 }
 
+// ExposeInfo mocks base method.
+func (m *MockClient) ExposeInfo(arg0 string) (bool, map[string]params.ExposedEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExposeInfo", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(map[string]params.ExposedEndpoint)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExposeInfo indicates an expected call of ExposeInfo.
+func (mr *MockClientMockRecorder) ExposeInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExposeInfo", reflect.TypeOf((*MockClient)(nil).ExposeInfo), arg0)
+}
+
 // GetOpenedPorts mocks base method.
 func (m *MockClient) GetOpenedPorts(arg0 string) (network.GroupedPortRanges, error) {
 	m.ctrl.T.Helper()
@@ -113,6 +132,36 @@ func (mr *MockClientMockRecorder) Life(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Life", reflect.TypeOf((*MockClient)(nil).Life), arg0)
 }
 
+// ModelConfig mocks base method.
+func (m *MockClient) ModelConfig(arg0 context.Context) (*config0.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config0.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockClientMockRecorder) ModelConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockClient)(nil).ModelConfig), arg0)
+}
+
+// RelatedApplications mocks base method.
+func (m *MockClient) RelatedApplications(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedApplications", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedApplications indicates an expected call of RelatedApplications.
+func (mr *MockClientMockRecorder) RelatedApplications(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedApplications", reflect.TypeOf((*MockClient)(nil).RelatedApplications), arg0)
+}
+
 // WatchApplication mocks base method.
 func (m *MockClient) WatchApplication(arg0 string) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -128,6 +177,21 @@ func (mr *MockClientMockRecorder) WatchApplication(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchApplication", reflect.TypeOf((*MockClient)(nil).WatchApplication), arg0)
 }
 
+// WatchApplicationConfig mocks base method.
+func (m *MockClient) WatchApplicationConfig(arg0 string) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchApplicationConfig", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchApplicationConfig indicates an expected call of WatchApplicationConfig.
+func (mr *MockClientMockRecorder) WatchApplicationConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchApplicationConfig", reflect.TypeOf((*MockClient)(nil).WatchApplicationConfig), arg0)
+}
+
 // WatchApplications mocks base method.
 func (m *MockClient) WatchApplications() (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -143,6 +207,21 @@ func (mr *MockClientMockRecorder) WatchApplications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchApplications", reflect.TypeOf((*MockClient)(nil).WatchApplications))
 }
 
+// WatchForModelConfigChanges mocks base method.
+func (m *MockClient) WatchForModelConfigChanges() (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchForModelConfigChanges")
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchForModelConfigChanges indicates an expected call of WatchForModelConfigChanges.
+func (mr *MockClientMockRecorder) WatchForModelConfigChanges() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchForModelConfigChanges", reflect.TypeOf((*MockClient)(nil).WatchForModelConfigChanges))
+}
+
 // WatchOpenedPorts mocks base method.
 func (m *MockClient) WatchOpenedPorts() (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -158,6 +237,21 @@ func (mr *MockClientMockRecorder) WatchOpenedPorts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchOpenedPorts", reflect.TypeOf((*MockClient)(nil).WatchOpenedPorts))
 }
 
+// WatchRelations mocks base method.
+func (m *MockClient) WatchRelations(arg0 string) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchRelations", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchRelations indicates an expected call of WatchRelations.
+func (mr *MockClientMockRecorder) WatchRelations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRelations", reflect.TypeOf((*MockClient)(nil).WatchRelations), arg0)
+}
+
 // MockCAASFirewallerAPI is a mock of CAASFirewallerAPI interface.
 type MockCAASFirewallerAPI struct {
 	ctrl     *gomock.Controller
@@ -211,6 +305,22 @@ func (mr *MockCAASFirewallerAPIMockRecorder) ApplicationConfig(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationConfig", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).ApplicationConfig), arg0)
 }
 
+// ExposeInfo mocks base method.
+func (m *MockCAASFirewallerAPI) ExposeInfo(arg0 string) (bool, map[string]params.ExposedEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExposeInfo", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(map[string]params.ExposedEndpoint)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ExposeInfo indicates an expected call of ExposeInfo.
+func (mr *MockCAASFirewallerAPIMockRecorder) ExposeInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExposeInfo", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).ExposeInfo), arg0)
+}
+
 // GetOpenedPorts mocks base method.
 func (m *MockCAASFirewallerAPI) GetOpenedPorts(arg0 string) (network.GroupedPortRanges, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +351,36 @@ func (mr *MockCAASFirewallerAPIMockRecorder) IsExposed(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsExposed", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).IsExposed), arg0)
 }
 
+// ModelConfig mocks base method.
+func (m *MockCAASFirewallerAPI) ModelConfig(arg0 context.Context) (*config0.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config0.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockCAASFirewallerAPIMockRecorder) ModelConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).ModelConfig), arg0)
+}
+
+// RelatedApplications mocks base method.
+func (m *MockCAASFirewallerAPI) RelatedApplications(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedApplications", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedApplications indicates an expected call of RelatedApplications.
+func (mr *MockCAASFirewallerAPIMockRecorder) RelatedApplications(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedApplications", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).RelatedApplications), arg0)
+}
+
 // WatchApplication mocks base method.
 func (m *MockCAASFirewallerAPI) WatchApplication(arg0 string) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -256,6 +396,21 @@ func (mr *MockCAASFirewallerAPIMockRecorder) WatchApplication(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchApplication", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).WatchApplication), arg0)
 }
 
+// WatchApplicationConfig mocks base method.
+func (m *MockCAASFirewallerAPI) WatchApplicationConfig(arg0 string) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchApplicationConfig", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchApplicationConfig indicates an expected call of WatchApplicationConfig.
+func (mr *MockCAASFirewallerAPIMockRecorder) WatchApplicationConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchApplicationConfig", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).WatchApplicationConfig), arg0)
+}
+
 // WatchApplications mocks base method.
 func (m *MockCAASFirewallerAPI) WatchApplications() (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -271,6 +426,21 @@ func (mr *MockCAASFirewallerAPIMockRecorder) WatchApplications() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchApplications", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).WatchApplications))
 }
 
+// WatchForModelConfigChanges mocks base method.
+func (m *MockCAASFirewallerAPI) WatchForModelConfigChanges() (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchForModelConfigChanges")
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchForModelConfigChanges indicates an expected call of WatchForModelConfigChanges.
+func (mr *MockCAASFirewallerAPIMockRecorder) WatchForModelConfigChanges() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchForModelConfigChanges", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).WatchForModelConfigChanges))
+}
+
 // WatchOpenedPorts mocks base method.
 func (m *MockCAASFirewallerAPI) WatchOpenedPorts() (watcher.Watcher[[]string], error) {
 	m.ctrl.T.Helper()
@@ -286,6 +456,21 @@ func (mr *MockCAASFirewallerAPIMockRecorder) WatchOpenedPorts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchOpenedPorts", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).WatchOpenedPorts))
 }
 
+// WatchRelations mocks base method.
+func (m *MockCAASFirewallerAPI) WatchRelations(arg0 string) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchRelations", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchRelations indicates an expected call of WatchRelations.
+func (mr *MockCAASFirewallerAPIMockRecorder) WatchRelations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRelations", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).WatchRelations), arg0)
+}
+
 // MockLifeGetter is a mock of LifeGetter interface.
 type MockLifeGetter struct {
 	ctrl     *gomock.Controller
@@ -46,6 +46,7 @@ type ManifoldConfig struct {
 	NewMetricsCollector  MetricsCollectorFn
 	PrometheusRegisterer prometheus.Registerer
 	NewWatchableDB       WatchableDBFn
+	SetReporter          func(Reporter)
 }
 
 func (cfg ManifoldConfig) Validate() error {
@@ -129,10 +130,19 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				config.PrometheusRegisterer.Unregister(metricsCollector)
 				return nil, errors.Trace(err)
 			}
+			if config.SetReporter != nil {
+				config.SetReporter(w)
+			}
 			return common.NewCleanupWorker(w, func() {
 				// Clean up the metrics for the worker, so the next time a
 				// worker is created we can safely register the metrics again.
 				config.PrometheusRegisterer.Unregister(metricsCollector)
+
+				// The worker has stopped, so it can no longer be asked for
+				// a report.
+				if config.SetReporter != nil {
+					config.SetReporter(nil)
+				}
 			}), nil
 		},
 	}
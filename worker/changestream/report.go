@@ -0,0 +1,94 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changestream
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/changestream"
+)
+
+// databaseReporter is implemented by watchable databases that can report
+// the state of their change stream.
+type databaseReporter interface {
+	DatabaseReport(ctx context.Context) (changestream.DatabaseReport, error)
+}
+
+// ChangeStreamReport returns a snapshot of the change stream for every
+// database that is currently being watched.
+func (w *changeStreamWorker) ChangeStreamReport(ctx context.Context) (changestream.Report, error) {
+	result := changestream.Report{
+		Databases: make([]changestream.DatabaseReport, 0),
+	}
+	for _, namespace := range w.runner.WorkerNames() {
+		mux, err := w.runner.Worker(namespace, ctx.Done())
+		if errors.Is(err, errors.NotFound) {
+			// The worker was removed since we got the names.
+			continue
+		} else if err != nil {
+			return changestream.Report{}, errors.Trace(err)
+		}
+
+		reporter, ok := mux.(databaseReporter)
+		if !ok {
+			continue
+		}
+		report, err := reporter.DatabaseReport(ctx)
+		if err != nil {
+			return changestream.Report{}, errors.Annotatef(err, "reporting on %q", namespace)
+		}
+		report.Namespace = namespace
+		result.Databases = append(result.Databases, report)
+	}
+
+	sort.Slice(result.Databases, func(i, j int) bool {
+		return result.Databases[i].Namespace < result.Databases[j].Namespace
+	})
+	return result, nil
+}
+
+// Reporter gives the introspection worker access to the change stream
+// worker, which is started later by the dependency engine.
+type Reporter interface {
+	// ChangeStreamReport returns a snapshot of the change stream for every
+	// database that is currently being watched.
+	ChangeStreamReport(ctx context.Context) (changestream.Report, error)
+}
+
+// NewReporter returns a reporter for the change stream worker.
+func NewReporter() *WorkerReporter {
+	return &WorkerReporter{}
+}
+
+// WorkerReporter is a Reporter that forwards reports to the change stream
+// worker that is currently running, if any.
+type WorkerReporter struct {
+	mu     sync.Mutex
+	worker Reporter
+}
+
+// ChangeStreamReport is the method called by the introspection worker to get
+// the change stream state to show to the user.
+func (r *WorkerReporter) ChangeStreamReport(ctx context.Context) (changestream.Report, error) {
+	r.mu.Lock()
+	worker := r.worker
+	r.mu.Unlock()
+
+	if worker == nil {
+		return changestream.Report{}, errors.NotFoundf("change stream worker")
+	}
+	return worker.ChangeStreamReport(ctx)
+}
+
+// SetWorker sets the change stream worker that reports are forwarded to.
+// Setting a nil worker indicates that the worker is no longer running.
+func (r *WorkerReporter) SetWorker(worker Reporter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.worker = worker
+}
@@ -31,8 +31,9 @@ type WatchableDBWorker interface {
 type WatchableDB struct {
 	catacomb catacomb.Catacomb
 
-	db  coredatabase.TxnRunner
-	mux *eventmultiplexer.EventMultiplexer
+	db     coredatabase.TxnRunner
+	stream *stream.Stream
+	mux    *eventmultiplexer.EventMultiplexer
 }
 
 // NewWatchableDB creates a new WatchableDB.
@@ -52,8 +53,9 @@ func NewWatchableDB(
 	}
 
 	w := &WatchableDB{
-		db:  db,
-		mux: mux,
+		db:     db,
+		stream: stream,
+		mux:    mux,
 	}
 
 	if err := catacomb.Invoke(catacomb.Plan{
@@ -100,6 +102,42 @@ func (w *WatchableDB) Subscribe(opts ...changestream.SubscriptionOption) (change
 	return w.mux.Subscribe(opts...)
 }
 
+// SubscribeFrom returns a subscription for the input options, which first
+// receives the changes after the given change log cursor. This allows
+// watchers to resume from the last change they processed.
+func (w *WatchableDB) SubscribeFrom(cursor int64, opts ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+	return w.mux.SubscribeFrom(cursor, opts...)
+}
+
+// DatabaseReport returns a report of the subscriptions to the change stream
+// and how far behind the latest change they are.
+func (w *WatchableDB) DatabaseReport(ctx context.Context) (changestream.DatabaseReport, error) {
+	subscriptions, lastChangeID := w.mux.SubscriptionReport()
+
+	latest, witnesses, err := w.stream.ChangeLogReport(ctx)
+	if err != nil {
+		return changestream.DatabaseReport{}, errors.Trace(err)
+	}
+
+	for i, sub := range subscriptions {
+		if sub.QueueDepth > 0 && latest > sub.LastChangeID {
+			subscriptions[i].Lag = latest - sub.LastChangeID
+		}
+	}
+
+	var lag int64
+	if latest > lastChangeID {
+		lag = latest - lastChangeID
+	}
+	return changestream.DatabaseReport{
+		LatestChangeID:       latest,
+		LastReceivedChangeID: lastChangeID,
+		Lag:                  lag,
+		Witnesses:            witnesses,
+		Subscriptions:        subscriptions,
+	}, nil
+}
+
 func (w *WatchableDB) loop() error {
 	<-w.catacomb.Dying()
 	return w.catacomb.ErrDying()
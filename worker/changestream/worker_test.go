@@ -3,10 +3,12 @@
 package changestream
 
 import (
+	"context"
 	"time"
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"github.com/juju/loggo"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v3"
 	"github.com/juju/worker/v3/workertest"
@@ -170,6 +172,58 @@ func (s *workerSuite) TestEventSourceCalledTwice(c *gc.C) {
 	close(done)
 }
 
+func (s *workerSuite) TestChangeStreamReport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.dbGetter.EXPECT().GetDB("controller").Return(s.TxnRunner(), nil)
+	s.fileNotifyWatcher.EXPECT().Changes("controller").Return(make(<-chan bool), nil)
+
+	logger := loggo.GetLogger("juju.worker.changestream")
+	w, err := newWorker(WorkerConfig{
+		AgentTag:          "agent-tag",
+		DBGetter:          s.dbGetter,
+		FileNotifyWatcher: s.fileNotifyWatcher,
+		Clock:             clock.WallClock,
+		Logger:            logger,
+		Metrics:           NewMetricsCollector(),
+		NewWatchableDB:    NewWatchableDB,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	r := NewReporter()
+	_, err = r.ChangeStreamReport(context.Background())
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+	r.SetWorker(w)
+
+	report, err := r.ChangeStreamReport(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(report.Databases, gc.HasLen, 0)
+
+	wdb, err := w.GetWatchableDB("controller")
+	c.Assert(err, jc.ErrorIsNil)
+
+	sub, err := wdb.Subscribe(changestream.Namespace("foo", changestream.Create))
+	c.Assert(err, jc.ErrorIsNil)
+	defer sub.Unsubscribe()
+
+	report, err = r.ChangeStreamReport(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(report.Databases, gc.HasLen, 1)
+	c.Check(report.Databases[0].Namespace, gc.Equals, "controller")
+	c.Check(report.Databases[0].Subscriptions, jc.DeepEquals, []changestream.SubscriptionReport{{
+		ID: 1,
+		Topics: []changestream.TopicReport{{
+			Namespace:  "foo",
+			ChangeMask: "create",
+		}},
+	}})
+
+	r.SetWorker(nil)
+	_, err = r.ChangeStreamReport(context.Background())
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
 func (s *workerSuite) newWorker(c *gc.C, attempts int) worker.Worker {
 	cfg := WorkerConfig{
 		AgentTag:          "agent-tag",
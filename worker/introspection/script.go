@@ -193,27 +193,53 @@ juju_start_unit () {
 }
 
 juju_leases () {
-  # This requires some arguments.
-  local query
-  local model
-  if [ "$1" = "-m" ]; then
-    if [ "$#" -lt 2 ]; then
-      echo "usage: juju_leases [-m <partial-model-uuid>] [<partial-app-name>...]"
-      return 1
-    fi
-    shift
-    model=$1
-    shift
-    query="&model=$model"
-  fi
-  for i in "$@"; do
-    query="$query&app=$i"
+  local query="q=y"
+  while [ "$#" -gt 0 ]; do
+    case "$1" in
+      -m)
+        if [ "$#" -lt 2 ]; then
+          echo "usage: juju_leases [-m <partial-model-uuid>] [--json] [<partial-app-name>...]"
+          return 1
+        fi
+        query="$query&model=$2"
+        shift; shift
+      ;;
+      --json)
+        query="$query&format=json"
+        shift
+      ;;
+      *)
+        query="$query&app=$1"
+        shift
+      ;;
+    esac
   done
-  if [ -z "$query" ]; then
-    juju_agent leases
-  else
-    juju_agent "leases?q=y&$query"
-  fi
+  juju_agent "leases?$query"
+}
+
+juju_changestream () {
+  local query="q=y"
+  while [ "$#" -gt 0 ]; do
+    case "$1" in
+      --json)
+        query="$query&format=json"
+        shift
+      ;;
+      --lagging)
+        query="$query&lagging=y"
+        shift
+      ;;
+      -*)
+        echo "usage: juju_changestream [--json] [--lagging] [<partial-model-uuid>...]"
+        return 1
+      ;;
+      *)
+        query="$query&model=$1"
+        shift
+      ;;
+    esac
+  done
+  juju_agent "changestream?$query"
 }
 
 juju_revoke_lease () {
@@ -276,5 +302,6 @@ if [ "$shell" = "bash" ]; then
   export -f juju_stop_unit
   export -f juju_leases
   export -f juju_revoke_lease
+  export -f juju_changestream
 fi
 `
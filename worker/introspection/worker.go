@@ -4,11 +4,14 @@
 package introspection
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 	"runtime"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/juju/errors"
@@ -19,6 +22,8 @@ import (
 	"gopkg.in/tomb.v2"
 	"gopkg.in/yaml.v2"
 
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/output"
 	"github.com/juju/juju/core/presence"
@@ -41,6 +46,22 @@ type Reporter interface {
 	IntrospectionReport() string
 }
 
+// LeaseReporter provides insight into the leases managed by the lease
+// manager of a controller agent.
+type LeaseReporter interface {
+	// LeaseReport returns a snapshot of the leases known to the lease
+	// manager.
+	LeaseReport(ctx context.Context) (lease.Report, error)
+}
+
+// ChangeStreamReporter provides insight into the change stream subscriptions
+// of a controller agent.
+type ChangeStreamReporter interface {
+	// ChangeStreamReport returns a snapshot of the change stream for every
+	// database that is currently being watched.
+	ChangeStreamReport(ctx context.Context) (changestream.Report, error)
+}
+
 // Clock represents the ability to wait for a bit.
 type Clock interface {
 	Now() time.Time
@@ -72,6 +93,8 @@ type Config struct {
 	Clock              Clock
 	LocalHub           SimpleHub
 	CentralHub         StructuredHub
+	Leases             LeaseReporter
+	ChangeStream       ChangeStreamReporter
 }
 
 // Validate checks the config values to assert they are valid to create the worker.
@@ -101,6 +124,8 @@ type socketListener struct {
 	clock              Clock
 	localHub           SimpleHub
 	centralHub         StructuredHub
+	leases             LeaseReporter
+	changeStream       ChangeStreamReporter
 	done               chan struct{}
 }
 
@@ -137,6 +162,8 @@ func NewWorker(config Config) (worker.Worker, error) {
 		clock:              config.Clock,
 		localHub:           config.LocalHub,
 		centralHub:         config.CentralHub,
+		leases:             config.Leases,
+		changeStream:       config.ChangeStream,
 		done:               make(chan struct{}),
 	}
 	go w.serve()
@@ -223,8 +250,16 @@ func (w *socketListener) RegisterHTTPHandlers(
 	} else {
 		handle("/units", notSupportedHandler{"Units"})
 	}
-	// TODO(leases) - add metrics
-	handle("/leases", notSupportedHandler{"Leases"})
+	if w.leases != nil {
+		handle("/leases", leasesHandler{w.leases})
+	} else {
+		handle("/leases", notSupportedHandler{"Leases"})
+	}
+	if w.changeStream != nil {
+		handle("/changestream", changeStreamHandler{w.changeStream})
+	} else {
+		handle("/changestream", notSupportedHandler{"Change Stream"})
+	}
 }
 
 type notSupportedHandler struct {
@@ -308,6 +343,130 @@ func (h introspectionReporterHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	fmt.Fprint(w, h.reporter.IntrospectionReport())
 }
 
+type leasesHandler struct {
+	reporter LeaseReporter
+}
+
+// ServeHTTP is part of the http.Handler interface.
+func (h leasesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.reporter.LeaseReport(r.Context())
+	if errors.Is(err, errors.NotFound) {
+		http.Error(w, fmt.Sprintf("error: %v", err), http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
+		return
+	}
+	report.Leases = filterLeases(report.Leases, r.Form.Get("model"), r.Form["app"])
+	writeReport(w, r.Form.Get("format"), report)
+}
+
+// writeReport writes the report in the requested format, which is either
+// yaml (the default) or json.
+func writeReport(w http.ResponseWriter, format string, report any) {
+	switch format {
+	case "", "yaml":
+		bytes, err := yaml.Marshal(report)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write(bytes)
+	case "json":
+		bytes, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(append(bytes, '\n'))
+	default:
+		http.Error(w, fmt.Sprintf("unknown format: %q", format), http.StatusBadRequest)
+	}
+}
+
+// filterLeases returns the leases belonging to the model with the given
+// uuid prefix, and whose names start with any of the given prefixes. Empty
+// filters match everything.
+func filterLeases(leases []lease.LeaseReport, model string, names []string) []lease.LeaseReport {
+	result := make([]lease.LeaseReport, 0, len(leases))
+	for _, l := range leases {
+		if !strings.HasPrefix(l.ModelUUID, model) {
+			continue
+		}
+		if len(names) > 0 && !hasAnyPrefix(l.Lease, names) {
+			continue
+		}
+		result = append(result, l)
+	}
+	return result
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type changeStreamHandler struct {
+	reporter ChangeStreamReporter
+}
+
+// ServeHTTP is part of the http.Handler interface.
+func (h changeStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.reporter.ChangeStreamReport(r.Context())
+	if errors.Is(err, errors.NotFound) {
+		http.Error(w, fmt.Sprintf("error: %v", err), http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
+		return
+	}
+	report.Databases = filterDatabases(report.Databases, r.Form["model"], r.Form.Get("lagging") != "")
+	writeReport(w, r.Form.Get("format"), report)
+}
+
+// filterDatabases returns the databases whose namespace starts with any of
+// the given prefixes. If lagging is true, only the subscriptions that have
+// changes queued are included, along with the databases that have either
+// lagging subscriptions or are themselves behind the change log.
+func filterDatabases(databases []changestream.DatabaseReport, namespaces []string, lagging bool) []changestream.DatabaseReport {
+	result := make([]changestream.DatabaseReport, 0, len(databases))
+	for _, db := range databases {
+		if len(namespaces) > 0 && !hasAnyPrefix(db.Namespace, namespaces) {
+			continue
+		}
+		if lagging {
+			subs := make([]changestream.SubscriptionReport, 0, len(db.Subscriptions))
+			for _, sub := range db.Subscriptions {
+				if sub.QueueDepth > 0 {
+					subs = append(subs, sub)
+				}
+			}
+			if len(subs) == 0 && db.Lag == 0 {
+				continue
+			}
+			db.Subscriptions = subs
+		}
+		result = append(result, db)
+	}
+	return result
+}
+
 type presenceHandler struct {
 	presence presence.Recorder
 }
@@ -4,6 +4,7 @@
 package introspection_test
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/pubsub/v2"
 	"github.com/juju/testing"
@@ -24,6 +26,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/internal/pubsub/agent"
 	_ "github.com/juju/juju/state"
@@ -77,6 +81,8 @@ type introspectionSuite struct {
 	recorder   presence.Recorder
 	localHub   *pubsub.SimpleHub
 	centralHub introspection.StructuredHub
+	leases     introspection.LeaseReporter
+	changes    introspection.ChangeStreamReporter
 	clock      *testclock.Clock
 }
 
@@ -90,6 +96,8 @@ func (s *introspectionSuite) SetUpTest(c *gc.C) {
 	s.reporter = nil
 	s.worker = nil
 	s.recorder = nil
+	s.leases = nil
+	s.changes = nil
 	s.gatherer = newPrometheusGatherer()
 	s.localHub = pubsub.NewSimpleHub(&pubsub.SimpleHubConfig{Logger: loggo.GetLogger("test.localhub")})
 	s.centralHub = pubsub.NewStructuredHub(&pubsub.StructuredHubConfig{Logger: loggo.GetLogger("test.centralhub")})
@@ -107,6 +115,8 @@ func (s *introspectionSuite) startWorker(c *gc.C) {
 		Clock:              s.clock,
 		LocalHub:           s.localHub,
 		CentralHub:         s.centralHub,
+		Leases:             s.leases,
+		ChangeStream:       s.changes,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 	s.worker = w
@@ -371,6 +381,262 @@ func (s *introspectionSuite) TestUnitStatusTimeout(c *gc.C) {
 	s.assertBody(c, response, "response timed out")
 }
 
+func (s *introspectionSuite) TestMissingLeaseReporter(c *gc.C) {
+	response := s.call(c, "/leases")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusNotFound)
+	s.assertBody(c, response, `"Leases" introspection not supported`)
+}
+
+func (s *introspectionSuite) TestLeaseManagerNotRunning(c *gc.C) {
+	workertest.CheckKill(c, s.worker)
+	s.leases = &leaseReporter{err: errors.NotFoundf("lease manager")}
+	s.startWorker(c)
+
+	response := s.call(c, "/leases")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusNotFound)
+	s.assertBody(c, response, "error: lease manager not found")
+}
+
+func (s *introspectionSuite) TestLeases(c *gc.C) {
+	s.startLeaseReporter(c)
+
+	response := s.call(c, "/leases")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusOK)
+	s.assertBody(c, response, `
+entity-uuid: deadbeef
+outstanding-claims: 1
+outstanding-revokes: 0
+leases:
+- namespace: application-leadership
+  model-uuid: 1234abcd
+  lease: mysql
+  holder: mysql/1
+  expiry: 2023-10-01T12:00:00Z
+  expires-in: 30s
+  pinned-by:
+  - machine-0
+- namespace: application-leadership
+  model-uuid: 5678efgh
+  lease: redis
+  pending-claims:
+  - redis/0
+  blocks: 2`[1:])
+}
+
+func (s *introspectionSuite) TestLeasesJSON(c *gc.C) {
+	s.startLeaseReporter(c)
+
+	response := s.call(c, "/leases?format=json&model=1234")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusOK)
+	c.Assert(response.Header.Get("Content-Type"), gc.Equals, "application/json")
+	s.assertBody(c, response, `
+{
+  "entity-uuid": "deadbeef",
+  "outstanding-claims": 1,
+  "outstanding-revokes": 0,
+  "leases": [
+    {
+      "namespace": "application-leadership",
+      "model-uuid": "1234abcd",
+      "lease": "mysql",
+      "holder": "mysql/1",
+      "expiry": "2023-10-01T12:00:00Z",
+      "expires-in": "30s",
+      "pinned-by": [
+        "machine-0"
+      ]
+    }
+  ]
+}`[1:])
+}
+
+func (s *introspectionSuite) TestLeasesFilterByApp(c *gc.C) {
+	s.startLeaseReporter(c)
+
+	response := s.call(c, "/leases?q=y&app=re&app=postgresql")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusOK)
+	body := s.body(c, response)
+	s.assertContains(c, body, "lease: redis")
+	c.Check(strings.Contains(body, "lease: mysql"), jc.IsFalse)
+}
+
+func (s *introspectionSuite) TestLeasesUnknownFormat(c *gc.C) {
+	s.startLeaseReporter(c)
+
+	response := s.call(c, "/leases?format=xml")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusBadRequest)
+	s.assertBody(c, response, `unknown format: "xml"`)
+}
+
+func (s *introspectionSuite) startLeaseReporter(c *gc.C) {
+	workertest.CheckKill(c, s.worker)
+	expiry := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	s.leases = &leaseReporter{
+		report: lease.Report{
+			EntityUUID:        "deadbeef",
+			OutstandingClaims: 1,
+			Leases: []lease.LeaseReport{{
+				Namespace: "application-leadership",
+				ModelUUID: "1234abcd",
+				Lease:     "mysql",
+				Holder:    "mysql/1",
+				Expiry:    &expiry,
+				ExpiresIn: "30s",
+				PinnedBy:  []string{"machine-0"},
+			}, {
+				Namespace:     "application-leadership",
+				ModelUUID:     "5678efgh",
+				Lease:         "redis",
+				PendingClaims: []string{"redis/0"},
+				Blocks:        2,
+			}},
+		},
+	}
+	s.startWorker(c)
+}
+
+func (s *introspectionSuite) TestMissingChangeStreamReporter(c *gc.C) {
+	response := s.call(c, "/changestream")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusNotFound)
+	s.assertBody(c, response, `"Change Stream" introspection not supported`)
+}
+
+func (s *introspectionSuite) TestChangeStream(c *gc.C) {
+	s.startChangeStreamReporter(c)
+
+	response := s.call(c, "/changestream")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusOK)
+	s.assertBody(c, response, `
+databases:
+- namespace: 1234abcd
+  latest-change-id: 12
+  last-received-change-id: 10
+  lag: 2
+  witnesses:
+  - controller-id: "0"
+    lower-bound: 8
+    upper-bound: 10
+    updated-at: 2023-10-01T12:00:00Z
+  subscriptions:
+  - id: 1
+    topics:
+    - namespace: application
+      change-mask: create|update
+    queue-depth: 0
+    last-change-id: 9
+    lag: 0
+  - id: 2
+    queue-depth: 3
+    last-change-id: 7
+    lag: 5
+- namespace: controller
+  latest-change-id: 4
+  last-received-change-id: 4
+  lag: 0
+  witnesses: []
+  subscriptions:
+  - id: 1
+    cursor: 2
+    queue-depth: 0
+    last-change-id: 4
+    lag: 0`[1:])
+}
+
+func (s *introspectionSuite) TestChangeStreamFilterLagging(c *gc.C) {
+	s.startChangeStreamReporter(c)
+
+	response := s.call(c, "/changestream?format=json&lagging=y")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusOK)
+	c.Assert(response.Header.Get("Content-Type"), gc.Equals, "application/json")
+	body := s.body(c, response)
+	s.assertContains(c, body, `"namespace": "1234abcd"`)
+	s.assertContains(c, body, `"queue-depth": 3`)
+	c.Check(strings.Contains(body, `"namespace": "controller"`), jc.IsFalse)
+	c.Check(strings.Contains(body, `"namespace": "application"`), jc.IsFalse)
+}
+
+func (s *introspectionSuite) TestChangeStreamFilterByModel(c *gc.C) {
+	s.startChangeStreamReporter(c)
+
+	response := s.call(c, "/changestream?q=y&model=cont")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusOK)
+	body := s.body(c, response)
+	s.assertContains(c, body, "namespace: controller")
+	c.Check(strings.Contains(body, "1234abcd"), jc.IsFalse)
+}
+
+func (s *introspectionSuite) TestChangeStreamNotRunning(c *gc.C) {
+	workertest.CheckKill(c, s.worker)
+	s.changes = &changeStreamReporter{err: errors.NotFoundf("change stream worker")}
+	s.startWorker(c)
+
+	response := s.call(c, "/changestream")
+	c.Assert(response.StatusCode, gc.Equals, http.StatusNotFound)
+	s.assertBody(c, response, "error: change stream worker not found")
+}
+
+func (s *introspectionSuite) startChangeStreamReporter(c *gc.C) {
+	workertest.CheckKill(c, s.worker)
+	s.changes = &changeStreamReporter{
+		report: changestream.Report{
+			Databases: []changestream.DatabaseReport{{
+				Namespace:            "1234abcd",
+				LatestChangeID:       12,
+				LastReceivedChangeID: 10,
+				Lag:                  2,
+				Witnesses: []changestream.WitnessReport{{
+					ControllerID: "0",
+					LowerBound:   8,
+					UpperBound:   10,
+					UpdatedAt:    time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+				}},
+				Subscriptions: []changestream.SubscriptionReport{{
+					ID: 1,
+					Topics: []changestream.TopicReport{{
+						Namespace:  "application",
+						ChangeMask: "create|update",
+					}},
+					LastChangeID: 9,
+				}, {
+					ID:           2,
+					QueueDepth:   3,
+					LastChangeID: 7,
+					Lag:          5,
+				}},
+			}, {
+				Namespace:            "controller",
+				LatestChangeID:       4,
+				LastReceivedChangeID: 4,
+				Witnesses:            []changestream.WitnessReport{},
+				Subscriptions: []changestream.SubscriptionReport{{
+					ID:           1,
+					Cursor:       2,
+					LastChangeID: 4,
+				}},
+			}},
+		},
+	}
+	s.startWorker(c)
+}
+
+type changeStreamReporter struct {
+	report changestream.Report
+	err    error
+}
+
+func (r *changeStreamReporter) ChangeStreamReport(context.Context) (changestream.Report, error) {
+	return r.report, r.err
+}
+
+type leaseReporter struct {
+	report lease.Report
+	err    error
+}
+
+func (r *leaseReporter) LeaseReport(context.Context) (lease.Report, error) {
+	return r.report, r.err
+}
+
 type reporter struct {
 	values map[string]interface{}
 }
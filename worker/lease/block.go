@@ -49,3 +49,12 @@ func (b blocks) unblock(lease lease.Key) {
 		close(unblock)
 	}
 }
+
+// counts returns the number of blocks waiting on each lease key.
+func (b blocks) counts() map[lease.Key]int {
+	counts := make(map[lease.Key]int, len(b))
+	for key, unblocks := range b {
+		counts[key] = len(unblocks)
+	}
+	return counts
+}
@@ -66,10 +66,13 @@ func NewManager(config ManagerConfig) (*Manager, error) {
 		revokes:    make(chan revoke),
 		checks:     make(chan check),
 		blocks:     make(chan block),
+		reports:    make(chan blockReport),
 		expireDone: make(chan struct{}),
 		pins:       make(chan pin),
 		unpins:     make(chan pin),
 		logContext: logContext,
+
+		pendingClaims: make(map[lease.Key][]string),
 	}
 	manager.tomb.Go(manager.loop)
 	return manager, nil
@@ -116,6 +119,9 @@ type Manager struct {
 	// unpins is used to deliver lease unpin requests to the loop.
 	unpins chan pin
 
+	// reports is used to request a snapshot of the blocks held by the loop.
+	reports chan blockReport
+
 	// wg is used to ensure that all child goroutines are finished
 	// before we stop.
 	wg sync.WaitGroup
@@ -127,6 +133,11 @@ type Manager struct {
 	// outstandingRevokes tracks how many unfinished revoke goroutines
 	// are running (for debugging purposes).
 	outstandingRevokes int64
+
+	// pendingClaims tracks the holders of the unfinished claims for each
+	// lease (for debugging purposes).
+	pendingMutex  sync.Mutex
+	pendingClaims map[lease.Key][]string
 }
 
 // Kill is part of the worker.Worker interface.
@@ -204,7 +215,7 @@ func (manager *Manager) choose(ctx context.Context, blocks blocks) error {
 		}
 
 	case claim := <-manager.claims:
-		manager.startingClaim()
+		manager.startingClaim(claim)
 		go manager.retryingClaim(ctx, claim)
 
 	case revoke := <-manager.revokes:
@@ -220,6 +231,9 @@ func (manager *Manager) choose(ctx context.Context, blocks blocks) error {
 	case block := <-manager.blocks:
 		manager.config.Logger.Tracef("[%s] adding block for: %s", manager.logContext, block.leaseKey.Lease)
 		blocks.add(block)
+
+	case report := <-manager.reports:
+		report.respond(blocks.counts())
 	}
 	return nil
 }
@@ -266,7 +280,7 @@ func (manager *Manager) Reader(namespace, modelUUID string) (lease.Reader, error
 // claiming party when it eventually succeeds or fails, or if it times
 // out after a number of retries.
 func (manager *Manager) retryingClaim(ctx context.Context, claim claim) {
-	defer manager.finishedClaim()
+	defer manager.finishedClaim(claim)
 	var (
 		err     error
 		success bool
@@ -689,12 +703,31 @@ func (manager *Manager) leases(ctx context.Context, namespace, modelUUID string)
 	return leases, nil
 }
 
-func (manager *Manager) startingClaim() {
+func (manager *Manager) startingClaim(claim claim) {
 	atomic.AddInt64(&manager.outstandingClaims, 1)
 	manager.wg.Add(1)
+
+	manager.pendingMutex.Lock()
+	defer manager.pendingMutex.Unlock()
+	manager.pendingClaims[claim.leaseKey] = append(manager.pendingClaims[claim.leaseKey], claim.holderName)
 }
 
-func (manager *Manager) finishedClaim() {
+func (manager *Manager) finishedClaim(claim claim) {
+	manager.pendingMutex.Lock()
+	holders := manager.pendingClaims[claim.leaseKey]
+	for i, holder := range holders {
+		if holder == claim.holderName {
+			holders = append(holders[:i], holders[i+1:]...)
+			break
+		}
+	}
+	if len(holders) == 0 {
+		delete(manager.pendingClaims, claim.leaseKey)
+	} else {
+		manager.pendingClaims[claim.leaseKey] = holders
+	}
+	manager.pendingMutex.Unlock()
+
 	manager.wg.Done()
 	atomic.AddInt64(&manager.outstandingClaims, -1)
 }
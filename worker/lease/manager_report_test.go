@@ -0,0 +1,157 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lease_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	corelease "github.com/juju/juju/core/lease"
+	"github.com/juju/juju/worker/lease"
+)
+
+type ReportSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&ReportSuite{})
+
+func (s *ReportSuite) TestLeaseReport(c *gc.C) {
+	fix := &Fixture{
+		leases: map[corelease.Key]corelease.Info{
+			key("redis"): {
+				Holder: "redis/0",
+				Expiry: offset(time.Minute),
+			},
+		},
+		expectCalls: []call{{
+			method: "Pinned",
+		}},
+	}
+	fix.RunTest(c, func(manager *lease.Manager, _ *testclock.Clock) {
+		blockTest := newBlockTest(c, manager, key("redis"))
+		blockTest.assertBlocked(c)
+
+		report, err := manager.LeaseReport(context.Background())
+		c.Assert(err, jc.ErrorIsNil)
+
+		expiry := offset(time.Minute)
+		c.Check(report, jc.DeepEquals, corelease.Report{
+			Leases: []corelease.LeaseReport{{
+				Namespace: "ignored-namespace",
+				ModelUUID: "ignored modelUUID",
+				Lease:     "lolwut",
+				PinnedBy:  []string{names.NewMachineTag("666").String()},
+			}, {
+				Namespace: "namespace",
+				ModelUUID: "modelUUID",
+				Lease:     "redis",
+				Holder:    "redis/0",
+				Expiry:    &expiry,
+				ExpiresIn: "1m0s",
+				PinnedBy:  []string{names.NewMachineTag("0").String()},
+				Blocks:    1,
+			}},
+		})
+
+		blockTest.cancelWait()
+		err = blockTest.assertUnblocked(c)
+		c.Check(err, gc.Equals, corelease.ErrWaitCancelled)
+	})
+}
+
+func (s *ReportSuite) TestLeaseReportPendingClaim(c *gc.C) {
+	claiming := make(chan struct{})
+	release := make(chan struct{})
+	fix := &Fixture{
+		expectCalls: []call{{
+			method: "ClaimLease",
+			args: []interface{}{
+				key("redis"),
+				corelease.Request{"redis/0", time.Minute},
+			},
+			parallelCallback: func(mu *sync.Mutex, leases map[corelease.Key]corelease.Info) {
+				close(claiming)
+				<-release
+			},
+		}, {
+			method: "Pinned",
+		}},
+	}
+	fix.RunTest(c, func(manager *lease.Manager, _ *testclock.Clock) {
+		result := make(chan error, 1)
+		go func() {
+			result <- getClaimer(c, manager).Claim("redis", "redis/0", time.Minute)
+		}()
+
+		select {
+		case <-claiming:
+		case <-time.After(testing.LongWait):
+			c.Fatalf("timed out waiting for claim")
+		}
+
+		report, err := manager.LeaseReport(context.Background())
+		close(release)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(report.OutstandingClaims, gc.Equals, int64(1))
+		c.Assert(report.Leases, gc.HasLen, 2)
+		c.Check(report.Leases[1], jc.DeepEquals, corelease.LeaseReport{
+			Namespace:     "namespace",
+			ModelUUID:     "modelUUID",
+			Lease:         "redis",
+			PinnedBy:      []string{names.NewMachineTag("0").String()},
+			PendingClaims: []string{"redis/0"},
+		})
+
+		select {
+		case err := <-result:
+			c.Check(err, jc.ErrorIsNil)
+		case <-time.After(testing.LongWait):
+			c.Fatalf("timed out waiting for claim result")
+		}
+	})
+}
+
+func (s *ReportSuite) TestLeaseReportStopped(c *gc.C) {
+	fix := &Fixture{}
+	fix.RunTest(c, func(manager *lease.Manager, _ *testclock.Clock) {
+		manager.Kill()
+		c.Assert(manager.Wait(), jc.ErrorIsNil)
+
+		_, err := manager.LeaseReport(context.Background())
+		c.Check(err, gc.ErrorMatches, "lease manager stopped")
+	})
+}
+
+func (s *ReportSuite) TestReporterNotStarted(c *gc.C) {
+	_, err := lease.NewReporter().LeaseReport(context.Background())
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *ReportSuite) TestReporterManagerCleared(c *gc.C) {
+	fix := &Fixture{}
+	fix.RunTest(c, func(manager *lease.Manager, _ *testclock.Clock) {
+		reporter := lease.NewReporter()
+		reporter.SetManager(manager)
+
+		expected, err := manager.LeaseReport(context.Background())
+		c.Assert(err, jc.ErrorIsNil)
+		report, err := reporter.LeaseReport(context.Background())
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(report, jc.DeepEquals, expected)
+
+		reporter.SetManager(nil)
+
+		_, err = reporter.LeaseReport(context.Background())
+		c.Check(err, jc.ErrorIs, errors.NotFound)
+	})
+}
@@ -41,6 +41,7 @@ type ManifoldConfig struct {
 	Logger               Logger
 	LogDir               string
 	PrometheusRegisterer prometheus.Registerer
+	SetReporter          func(Reporter)
 	NewWorker            func(ManagerConfig) (worker.Worker, error)
 	NewStore             func(database.DBGetter, Logger) lease.Store
 }
@@ -122,7 +123,22 @@ func (s *manifoldState) start(context dependency.Context) (worker.Worker, error)
 		LogDir:               s.config.LogDir,
 		PrometheusRegisterer: s.config.PrometheusRegisterer,
 	})
-	return w, errors.Trace(err)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if s.config.SetReporter == nil {
+		return w, nil
+	}
+	manager, ok := w.(Reporter)
+	if !ok {
+		return w, nil
+	}
+	s.config.SetReporter(manager)
+	return common.NewCleanupWorker(w, func() {
+		// The manager has stopped, so it can no longer be asked for a
+		// report.
+		s.config.SetReporter(nil)
+	}), nil
 }
 
 func (s *manifoldState) output(in worker.Worker, out interface{}) error {
@@ -0,0 +1,181 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lease
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/lease"
+)
+
+// blockReport is used to request a snapshot of the blocks held by a
+// manager's loop goroutine on behalf of LeaseReport.
+type blockReport struct {
+	response chan map[lease.Key]int
+	stop     <-chan struct{}
+	cancel   <-chan struct{}
+}
+
+// invoke sends the report request on the supplied channel and waits for
+// the block counts to be returned.
+func (r blockReport) invoke(ch chan<- blockReport) (map[lease.Key]int, error) {
+	for {
+		select {
+		case <-r.stop:
+			return nil, errStopped
+		case <-r.cancel:
+			return nil, errors.New("lease report cancelled")
+		case ch <- r:
+			ch = nil
+		case counts := <-r.response:
+			return counts, nil
+		}
+	}
+}
+
+// respond sends the block counts back to invoke. The response channel is
+// buffered, so the loop is never blocked by a caller that has gone away.
+func (r blockReport) respond(counts map[lease.Key]int) {
+	select {
+	case r.response <- counts:
+	default:
+	}
+}
+
+// LeaseReport returns a snapshot of all the leases held in the store, along
+// with their pins, and the claims and blocks that are pending in the
+// manager.
+func (manager *Manager) LeaseReport(ctx context.Context) (lease.Report, error) {
+	blocks, err := blockReport{
+		response: make(chan map[lease.Key]int, 1),
+		stop:     manager.tomb.Dying(),
+		cancel:   ctx.Done(),
+	}.invoke(manager.reports)
+	if err != nil {
+		return lease.Report{}, errors.Trace(err)
+	}
+
+	leases, err := manager.config.Store.Leases(ctx)
+	if err != nil {
+		return lease.Report{}, errors.Annotate(err, "getting leases")
+	}
+	pinned, err := manager.config.Store.Pinned(ctx)
+	if err != nil {
+		return lease.Report{}, errors.Annotate(err, "getting pinned leases")
+	}
+	claims := manager.pendingClaimHolders()
+
+	reports := make(map[lease.Key]*lease.LeaseReport)
+	reportFor := func(key lease.Key) *lease.LeaseReport {
+		if r, ok := reports[key]; ok {
+			return r
+		}
+		r := &lease.LeaseReport{
+			Namespace: key.Namespace,
+			ModelUUID: key.ModelUUID,
+			Lease:     key.Lease,
+		}
+		reports[key] = r
+		return r
+	}
+
+	now := manager.config.Clock.Now()
+	for key, info := range leases {
+		r := reportFor(key)
+		expiry := info.Expiry
+		r.Holder = info.Holder
+		r.Expiry = &expiry
+		r.ExpiresIn = expiry.Sub(now).Round(time.Second).String()
+	}
+	for key, entities := range pinned {
+		reportFor(key).PinnedBy = entities
+	}
+	for key, holders := range claims {
+		reportFor(key).PendingClaims = holders
+	}
+	for key, count := range blocks {
+		reportFor(key).Blocks = count
+	}
+
+	result := lease.Report{
+		EntityUUID:         manager.config.EntityUUID,
+		OutstandingClaims:  atomic.LoadInt64(&manager.outstandingClaims),
+		OutstandingRevokes: atomic.LoadInt64(&manager.outstandingRevokes),
+		Leases:             make([]lease.LeaseReport, 0, len(reports)),
+	}
+	for _, r := range reports {
+		result.Leases = append(result.Leases, *r)
+	}
+	sort.Slice(result.Leases, func(i, j int) bool {
+		a, b := result.Leases[i], result.Leases[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.ModelUUID != b.ModelUUID {
+			return a.ModelUUID < b.ModelUUID
+		}
+		return a.Lease < b.Lease
+	})
+	return result, nil
+}
+
+// pendingClaimHolders returns a copy of the holders of the claims that are
+// in flight, keyed by lease.
+func (manager *Manager) pendingClaimHolders() map[lease.Key][]string {
+	manager.pendingMutex.Lock()
+	defer manager.pendingMutex.Unlock()
+
+	claims := make(map[lease.Key][]string, len(manager.pendingClaims))
+	for key, holders := range manager.pendingClaims {
+		claims[key] = append([]string(nil), holders...)
+	}
+	return claims
+}
+
+// Reporter gives the introspection worker access to the lease manager,
+// which is started later by the dependency engine.
+type Reporter interface {
+	// LeaseReport returns a snapshot of the leases known to the lease
+	// manager.
+	LeaseReport(ctx context.Context) (lease.Report, error)
+}
+
+// NewReporter returns a reporter for the lease manager.
+func NewReporter() *ManagerReporter {
+	return &ManagerReporter{}
+}
+
+// ManagerReporter is a Reporter that forwards reports to the lease manager
+// that is currently running, if any.
+type ManagerReporter struct {
+	mu      sync.Mutex
+	manager Reporter
+}
+
+// LeaseReport is the method called by the introspection worker to get the
+// leases to show to the user.
+func (r *ManagerReporter) LeaseReport(ctx context.Context) (lease.Report, error) {
+	r.mu.Lock()
+	manager := r.manager
+	r.mu.Unlock()
+
+	if manager == nil {
+		return lease.Report{}, errors.NotFoundf("lease manager")
+	}
+	return manager.LeaseReport(ctx)
+}
+
+// SetManager sets the lease manager that reports are forwarded to. Setting
+// a nil manager indicates that the lease manager is no longer running.
+func (r *ManagerReporter) SetManager(manager Reporter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.manager = manager
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import "github.com/juju/worker/v3"
+
+func NewOrchestratorForController(args OrchestratorArgs) (worker.Worker, error) {
+	o, err := newOrchestratorForController(args)
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/internal/logfwd"
+	"github.com/juju/juju/internal/logfwd/jsonhttp"
 	"github.com/juju/juju/internal/logfwd/syslog"
 	"github.com/juju/juju/rpc/params"
 	coretesting "github.com/juju/juju/testing"
@@ -86,8 +87,8 @@ func (s *LogForwarderSuite) newLogForwarderArgsWithAPI(
 		Caller:           &mockCaller{},
 		LogForwardConfig: configAPI,
 		ControllerUUID:   "feebdaed-2f18-4fd2-967d-db9663db7bea",
-		OpenSink: func(cfg *syslog.RawConfig) (*logforwarder.LogSink, error) {
-			sender.host = cfg.Host
+		OpenSink: func(cfg logforwarder.SinkConfig) (*logforwarder.LogSink, error) {
+			sender.host = cfg.(*syslog.RawConfig).Host
 			sink := &logforwarder.LogSink{
 				sender,
 			}
@@ -154,6 +155,54 @@ func (s *LogForwarderSuite) TestConfigChange(c *gc.C) {
 	})
 }
 
+func (s *LogForwarderSuite) TestHTTPSink(c *gc.C) {
+	api := &mockLogForwardConfig{
+		enabled: true,
+		url:     "https://10.0.0.3/push",
+	}
+	args := s.newLogForwarderArgsWithAPI(c, api, s.stream, s.sender)
+	args.Config = logforwarder.HTTPConfig
+	args.OpenSink = func(cfg logforwarder.SinkConfig) (*logforwarder.LogSink, error) {
+		s.sender.host = cfg.(*jsonhttp.RawConfig).URL
+		return &logforwarder.LogSink{s.sender}, nil
+	}
+
+	s.stream.addRecords(c, s.rec)
+	lf, err := logforwarder.NewLogForwarder(args)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, lf)
+
+	s.sender.waitForSend(c)
+	workertest.CleanKill(c, lf)
+
+	rec := s.rec
+	rec.Message = "send to https://10.0.0.3/push"
+	s.sender.stub.CheckCalls(c, []testing.StubCall{
+		{"Send", []interface{}{[]logfwd.Record{rec}}},
+		{"Close", nil},
+	})
+}
+
+func (s *LogForwarderSuite) TestHTTPSinkNotEnabled(c *gc.C) {
+	api := &mockLogForwardConfig{
+		enabled: true,
+		host:    "10.0.0.1",
+	}
+	args := s.newLogForwarderArgsWithAPI(c, api, s.stream, s.sender)
+	args.Config = logforwarder.HTTPConfig
+
+	lf, err := logforwarder.NewLogForwarder(args)
+	c.Assert(err, jc.ErrorIsNil)
+
+	time.Sleep(coretesting.ShortWait)
+	workertest.CleanKill(c, lf)
+
+	// Only syslog forwarding is configured, so the HTTP sink must
+	// not be opened.
+	s.stream.stub.CheckCallNames(c)
+	s.sender.stub.CheckCallNames(c)
+}
+
 func (s *LogForwarderSuite) TestNotEnabled(c *gc.C) {
 	lf, err := logforwarder.NewLogForwarder(s.newLogForwarderArgs(c, nil, s.sender))
 	c.Assert(err, jc.ErrorIsNil)
@@ -211,6 +260,7 @@ func (s *LogForwarderSuite) TestSenderError(c *gc.C) {
 type mockLogForwardConfig struct {
 	enabled bool
 	host    string
+	url     string
 	changes chan struct{}
 }
 
@@ -264,6 +314,13 @@ func (c *mockLogForwardConfig) LogForwardConfig() (*syslog.RawConfig, bool, erro
 	}, true, nil
 }
 
+func (c *mockLogForwardConfig) LogForwardHTTPConfig() (*jsonhttp.RawConfig, bool, error) {
+	return &jsonhttp.RawConfig{
+		Enabled: c.enabled && c.url != "",
+		URL:     c.url,
+	}, true, nil
+}
+
 type stubStream struct {
 	stub     *testing.Stub
 	nextRecs chan logfwd.Record
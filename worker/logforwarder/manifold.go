@@ -4,6 +4,7 @@
 package logforwarder
 
 import (
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v3"
 	"github.com/juju/worker/v3/dependency"
@@ -16,6 +17,7 @@ import (
 
 // Logger represents the methods used by the worker to log details.
 type Logger interface {
+	Debugf(string, ...interface{})
 	Infof(string, ...interface{})
 	Errorf(string, ...interface{})
 }
@@ -37,6 +39,9 @@ type ManifoldConfig struct {
 	// OpenLogForwarder opens each log forwarder that will be used.
 	OpenLogForwarder func(OpenLogForwarderArgs) (*LogForwarder, error)
 
+	// Clock is used to delay restarting a failed log forwarder.
+	Clock clock.Clock
+
 	Logger Logger
 }
 
@@ -81,6 +86,7 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				Sinks:            config.Sinks,
 				OpenLogStream:    openLogStream,
 				OpenLogForwarder: openForwarder,
+				Clock:            config.Clock,
 				Logger:           config.Logger,
 			})
 			return orchestrator, errors.Annotate(err, "creating log forwarding orchestrator")
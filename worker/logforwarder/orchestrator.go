@@ -4,13 +4,27 @@
 package logforwarder
 
 import (
+	"time"
+
+	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"github.com/juju/worker/v3/catacomb"
 
 	"github.com/juju/juju/api/base"
 )
 
+// sinkRestartDelay is how long to wait before reopening the forwarder for
+// a log sink after it has failed.
+const sinkRestartDelay = 10 * time.Second
+
+// orchestrator runs a LogForwarder for each of the configured log
+// sinks. Each forwarder streams and tracks its records independently,
+// and is run under its own entry in a runner, so a slow or failing sink
+// is restarted on its own without holding back or bouncing the others.
 type orchestrator struct {
-	*LogForwarder // For now its just a single forwarder.
+	catacomb catacomb.Catacomb
+	runner   *worker.Runner
 }
 
 // OrchestratorArgs holds the info needed to open a log forwarding
@@ -36,26 +50,77 @@ type OrchestratorArgs struct {
 	// OpenLogForwarder opens each log forwarder that will be used.
 	OpenLogForwarder func(OpenLogForwarderArgs) (*LogForwarder, error)
 
+	// Clock is used to delay restarting a failed log forwarder.
+	Clock clock.Clock
+
 	Logger Logger
 }
 
 func newOrchestratorForController(args OrchestratorArgs) (*orchestrator, error) {
-	// For now we work with only 1 forwarder. Later we can have a proper
-	// orchestrator that spawns a sub-worker for each log sink.
 	if len(args.Sinks) == 0 {
 		return nil, nil
 	}
-	if len(args.Sinks) > 1 {
-		return nil, errors.Errorf("multiple log forwarding targets not supported (yet)")
+
+	seen := make(map[string]bool)
+	for _, spec := range args.Sinks {
+		if seen[spec.Name] {
+			return nil, errors.NotValidf("duplicate log sink name %q", spec.Name)
+		}
+		seen[spec.Name] = true
 	}
-	lf, err := args.OpenLogForwarder(OpenLogForwarderArgs{
-		ControllerUUID:   args.ControllerUUID,
-		LogForwardConfig: args.LogForwardConfig,
-		Caller:           args.Caller,
-		Name:             args.Sinks[0].Name,
-		OpenSink:         args.Sinks[0].OpenFn,
-		OpenLogStream:    args.OpenLogStream,
-		Logger:           args.Logger,
+
+	o := &orchestrator{
+		runner: worker.NewRunner(worker.RunnerParams{
+			Clock: args.Clock,
+			IsFatal: func(err error) bool {
+				return false
+			},
+			RestartDelay: sinkRestartDelay,
+			Logger:       args.Logger,
+		}),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &o.catacomb,
+		Work: func() error {
+			<-o.catacomb.Dying()
+			return o.catacomb.ErrDying()
+		},
+		Init: []worker.Worker{o.runner},
 	})
-	return &orchestrator{lf}, errors.Annotate(err, "opening log forwarder")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	for _, spec := range args.Sinks {
+		spec := spec
+		err := o.runner.StartWorker(spec.Name, func() (worker.Worker, error) {
+			lf, err := args.OpenLogForwarder(OpenLogForwarderArgs{
+				ControllerUUID:   args.ControllerUUID,
+				LogForwardConfig: args.LogForwardConfig,
+				Caller:           args.Caller,
+				Name:             spec.Name,
+				Config:           spec.Config,
+				OpenSink:         spec.OpenFn,
+				OpenLogStream:    args.OpenLogStream,
+				Logger:           args.Logger,
+			})
+			return lf, errors.Annotatef(err, "opening log forwarder %q", spec.Name)
+		})
+		if err != nil {
+			o.Kill()
+			_ = o.Wait()
+			return nil, errors.Trace(err)
+		}
+	}
+	return o, nil
+}
+
+// Kill implements Worker.Kill()
+func (o *orchestrator) Kill() {
+	o.catacomb.Kill(nil)
+}
+
+// Wait implements Worker.Wait()
+func (o *orchestrator) Wait() error {
+	return o.catacomb.Wait()
 }
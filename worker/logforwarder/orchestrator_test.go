@@ -0,0 +1,131 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder_test
+
+import (
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/logforwarder"
+)
+
+type OrchestratorSuite struct {
+	testing.IsolationSuite
+
+	clock *testclock.Clock
+}
+
+var _ = gc.Suite(&OrchestratorSuite{})
+
+func (s *OrchestratorSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.clock = testclock.NewClock(time.Now())
+}
+
+func (s *OrchestratorSuite) newArgs(
+	sinks []logforwarder.LogSinkSpec,
+	opened chan<- logforwarder.OpenLogForwarderArgs,
+	open func(logforwarder.OpenLogForwarderArgs) (*logforwarder.LogForwarder, error),
+) logforwarder.OrchestratorArgs {
+	return logforwarder.OrchestratorArgs{
+		ControllerUUID:   "feebdaed-2f18-4fd2-967d-db9663db7bea",
+		LogForwardConfig: &mockLogForwardConfig{},
+		Caller:           &mockCaller{},
+		Sinks:            sinks,
+		OpenLogForwarder: func(args logforwarder.OpenLogForwarderArgs) (*logforwarder.LogForwarder, error) {
+			opened <- args
+			return open(args)
+		},
+		Clock:  s.clock,
+		Logger: loggo.GetLogger("test"),
+	}
+}
+
+func (s *OrchestratorSuite) TestOpensForwarderPerSink(c *gc.C) {
+	opened := make(chan logforwarder.OpenLogForwarderArgs, 2)
+	w, err := logforwarder.NewOrchestratorForController(s.newArgs([]logforwarder.LogSinkSpec{{
+		Name:   "juju-log-forward",
+		Config: logforwarder.SyslogConfig,
+	}, {
+		Name:   "juju-log-forward-http",
+		Config: logforwarder.HTTPConfig,
+	}}, opened, logforwarder.NewLogForwarder))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	byName := make(map[string]logforwarder.OpenLogForwarderArgs)
+	for i := 0; i < 2; i++ {
+		args := s.waitOpened(c, opened)
+		byName[args.Name] = args
+	}
+	c.Assert(byName, gc.HasLen, 2)
+	c.Check(byName["juju-log-forward"].ControllerUUID, gc.Equals, "feebdaed-2f18-4fd2-967d-db9663db7bea")
+	c.Check(byName["juju-log-forward-http"].Config, gc.NotNil)
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *OrchestratorSuite) TestFailingSinkRestartedAlone(c *gc.C) {
+	opened := make(chan logforwarder.OpenLogForwarderArgs, 3)
+	failed := false
+	w, err := logforwarder.NewOrchestratorForController(s.newArgs([]logforwarder.LogSinkSpec{{
+		Name:   "juju-log-forward",
+		Config: logforwarder.SyslogConfig,
+	}, {
+		Name:   "juju-log-forward-http",
+		Config: logforwarder.HTTPConfig,
+	}}, opened, func(args logforwarder.OpenLogForwarderArgs) (*logforwarder.LogForwarder, error) {
+		if args.Name == "juju-log-forward-http" && !failed {
+			failed = true
+			return nil, errors.New("boom")
+		}
+		return logforwarder.NewLogForwarder(args)
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.waitOpened(c, opened)
+	s.waitOpened(c, opened)
+
+	// Only the failed sink is reopened, once the restart delay has passed.
+	c.Assert(s.clock.WaitAdvance(10*time.Second, coretesting.LongWait, 1), jc.ErrorIsNil)
+	args := s.waitOpened(c, opened)
+	c.Check(args.Name, gc.Equals, "juju-log-forward-http")
+
+	select {
+	case args := <-opened:
+		c.Fatalf("unexpected reopen of %q", args.Name)
+	case <-time.After(coretesting.ShortWait):
+	}
+	workertest.CheckAlive(c, w)
+}
+
+func (s *OrchestratorSuite) TestDuplicateSinkNames(c *gc.C) {
+	opened := make(chan logforwarder.OpenLogForwarderArgs, 2)
+	_, err := logforwarder.NewOrchestratorForController(s.newArgs([]logforwarder.LogSinkSpec{{
+		Name: "juju-log-forward",
+	}, {
+		Name: "juju-log-forward",
+	}}, opened, logforwarder.NewLogForwarder))
+	c.Assert(err, gc.ErrorMatches, `duplicate log sink name "juju-log-forward" not valid`)
+	c.Check(opened, gc.HasLen, 0)
+}
+
+func (s *OrchestratorSuite) waitOpened(c *gc.C, opened <-chan logforwarder.OpenLogForwarderArgs) logforwarder.OpenLogForwarderArgs {
+	select {
+	case args := <-opened:
+		return args
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for log forwarder to be opened")
+	}
+	return logforwarder.OpenLogForwarderArgs{}
+}
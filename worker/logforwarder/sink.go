@@ -4,7 +4,10 @@
 package logforwarder
 
 import (
+	"github.com/juju/errors"
+
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/logfwd/jsonhttp"
 	"github.com/juju/juju/internal/logfwd/syslog"
 )
 
@@ -14,20 +17,63 @@ type LogForwardConfig interface {
 	// log forward configuration to change.
 	WatchForLogForwardConfigChanges() (watcher.NotifyWatcher, error)
 
-	// LogForwardConfig returns the current log forward configuration.
+	// LogForwardConfig returns the current syslog log forward configuration.
 	LogForwardConfig() (*syslog.RawConfig, bool, error)
+
+	// LogForwardHTTPConfig returns the current HTTP log forward
+	// configuration.
+	LogForwardHTTPConfig() (*jsonhttp.RawConfig, bool, error)
+}
+
+// SinkConfig is the configuration used to open a single log sink.
+type SinkConfig interface {
+	// Validate ensures that the config is currently valid.
+	Validate() error
+}
+
+// SinkConfigFn returns the current config for a log sink and whether
+// forwarding to that sink is enabled.
+type SinkConfigFn func(LogForwardConfig) (SinkConfig, bool, error)
+
+// SyslogConfig is a SinkConfigFn that returns the syslog forwarding config.
+func SyslogConfig(api LogForwardConfig) (SinkConfig, bool, error) {
+	cfg, ok, err := api.LogForwardConfig()
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	if !ok || !cfg.Enabled {
+		return nil, false, nil
+	}
+	return cfg, true, nil
+}
+
+// HTTPConfig is a SinkConfigFn that returns the HTTP forwarding config.
+func HTTPConfig(api LogForwardConfig) (SinkConfig, bool, error) {
+	cfg, ok, err := api.LogForwardHTTPConfig()
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	if !ok || !cfg.Enabled {
+		return nil, false, nil
+	}
+	return cfg, true, nil
 }
 
 type LogSinkSpec struct {
-	// Name is the name of the log sink.
+	// Name is the name of the log sink. It is also used to track the
+	// last record sent to the sink, so it must be unique.
 	Name string
 
+	// Config returns the config for the log sink. If it is nil then
+	// the syslog config is used.
+	Config SinkConfigFn
+
 	// OpenFn is a function that opens a log sink.
 	OpenFn LogSinkFn
 }
 
 // LogSinkFn is a function that opens a log sink.
-type LogSinkFn func(cfg *syslog.RawConfig) (*LogSink, error)
+type LogSinkFn func(cfg SinkConfig) (*LogSink, error)
 
 // LogSink is a single log sink, to which log records may be sent.
 type LogSink struct {
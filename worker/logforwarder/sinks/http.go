@@ -0,0 +1,31 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sinks
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/logfwd/jsonhttp"
+	"github.com/juju/juju/worker/logforwarder"
+)
+
+// OpenHTTP returns a sink that forwards log messages as newline-delimited
+// JSON to an HTTP(S) endpoint.
+func OpenHTTP(sinkCfg logforwarder.SinkConfig) (*logforwarder.LogSink, error) {
+	cfg, ok := sinkCfg.(*jsonhttp.RawConfig)
+	if !ok {
+		return nil, errors.Errorf("expected HTTP log forwarding config, got %T", sinkCfg)
+	}
+	if !cfg.Enabled {
+		return nil, errors.New("log forwarding not enabled")
+	}
+	client, err := jsonhttp.Open(*cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	sink := &logforwarder.LogSink{
+		SendCloser: client,
+	}
+	return sink, nil
+}
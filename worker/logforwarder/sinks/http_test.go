@@ -0,0 +1,63 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sinks_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/logfwd"
+	"github.com/juju/juju/internal/logfwd/jsonhttp"
+	"github.com/juju/juju/internal/logfwd/syslog"
+	"github.com/juju/juju/worker/logforwarder/sinks"
+)
+
+type HTTPSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&HTTPSuite{})
+
+func (s *HTTPSuite) TestOpenHTTP(c *gc.C) {
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink, err := sinks.OpenHTTP(&jsonhttp.RawConfig{
+		Enabled: true,
+		URL:     server.URL,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { _ = sink.Close() }()
+
+	err = sink.Send([]logfwd.Record{{
+		ID:        1,
+		Timestamp: time.Now(),
+		Level:     loggo.INFO,
+		Message:   "hello",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(<-received, gc.Equals, "application/x-ndjson")
+}
+
+func (s *HTTPSuite) TestOpenHTTPNotEnabled(c *gc.C) {
+	_, err := sinks.OpenHTTP(&jsonhttp.RawConfig{
+		URL: "https://10.0.0.1/push",
+	})
+	c.Assert(err, gc.ErrorMatches, "log forwarding not enabled")
+}
+
+func (s *HTTPSuite) TestOpenHTTPWrongConfig(c *gc.C) {
+	_, err := sinks.OpenHTTP(&syslog.RawConfig{Enabled: true})
+	c.Assert(err, gc.ErrorMatches, `expected HTTP log forwarding config, got \*syslog.RawConfig`)
+}
@@ -13,8 +13,10 @@ import (
 	"github.com/juju/worker/v3/dependency"
 
 	"github.com/juju/juju/agent"
+	"github.com/juju/juju/controller"
 	coreobjectstore "github.com/juju/juju/core/objectstore"
 	internalobjectstore "github.com/juju/juju/internal/objectstore"
+	"github.com/juju/juju/internal/servicefactory"
 	jujustate "github.com/juju/juju/state"
 	"github.com/juju/juju/worker/common"
 	"github.com/juju/juju/worker/state"
@@ -38,6 +40,20 @@ type ObjectStoreGetter interface {
 	GetObjectStore(context.Context, string) (coreobjectstore.ObjectStore, error)
 }
 
+// ControllerConfigService is the interface that is used to get the
+// controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller configuration.
+	ControllerConfig(context.Context) (controller.Config, error)
+}
+
+// MetadataServiceGetter is the interface that is used to get the
+// object store metadata service for a given namespace.
+type MetadataServiceGetter interface {
+	// ForModelUUID returns the metadata service for the given model UUID.
+	ForModelUUID(string) internalobjectstore.MetadataService
+}
+
 // StatePool is the interface to retrieve the mongo session from.
 // Deprecated: is only here for backwards compatibility.
 type StatePool interface {
@@ -55,13 +71,18 @@ type MongoSession interface {
 
 // ManifoldConfig defines the configuration for the trace manifold.
 type ManifoldConfig struct {
-	AgentName string
-	TraceName string
+	AgentName          string
+	TraceName          string
+	ServiceFactoryName string
 
 	Clock                clock.Clock
 	Logger               Logger
 	NewObjectStoreWorker internalobjectstore.ObjectStoreWorkerFunc
 
+	// CentralHub is used to discover the peer controllers, from which
+	// objects are retrieved.
+	CentralHub Hub
+
 	// StateName is only here for backwards compatibility. Once we have
 	// the right abstractions in place, and we have a replacement, we can
 	// remove this.
@@ -76,6 +97,9 @@ func (cfg ManifoldConfig) Validate() error {
 	if cfg.TraceName == "" {
 		return errors.NotValidf("empty TraceName")
 	}
+	if cfg.ServiceFactoryName == "" {
+		return errors.NotValidf("empty ServiceFactoryName")
+	}
 	if cfg.Clock == nil {
 		return errors.NotValidf("nil Clock")
 	}
@@ -85,6 +109,9 @@ func (cfg ManifoldConfig) Validate() error {
 	if cfg.NewObjectStoreWorker == nil {
 		return errors.NotValidf("nil NewObjectStoreWorker")
 	}
+	if cfg.CentralHub == nil {
+		return errors.NotValidf("nil CentralHub")
+	}
 	return nil
 }
 
@@ -94,6 +121,7 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 		Inputs: []string{
 			config.AgentName,
 			config.TraceName,
+			config.ServiceFactoryName,
 			config.StateName,
 		},
 		Output: output,
@@ -107,11 +135,27 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				return nil, err
 			}
 
+			agentConfig := a.CurrentConfig()
+			apiInfo, ok := agentConfig.APIInfo()
+			if !ok {
+				return nil, dependency.ErrMissing
+			}
+
 			var tracerGetter trace.TracerGetter
 			if err := context.Get(config.TraceName, &tracerGetter); err != nil {
 				return nil, errors.Trace(err)
 			}
 
+			var controllerServiceFactory servicefactory.ControllerServiceFactory
+			if err := context.Get(config.ServiceFactoryName, &controllerServiceFactory); err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			var serviceFactoryGetter servicefactory.ServiceFactoryGetter
+			if err := context.Get(config.ServiceFactoryName, &serviceFactoryGetter); err != nil {
+				return nil, errors.Trace(err)
+			}
+
 			var stTracker state.StateTracker
 			if err := context.Get(config.StateName, &stTracker); err != nil {
 				return nil, errors.Trace(err)
@@ -125,10 +169,19 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			}
 
 			w, err := NewWorker(WorkerConfig{
-				TracerGetter:         tracerGetter,
-				Clock:                config.Clock,
-				Logger:               config.Logger,
-				NewObjectStoreWorker: config.NewObjectStoreWorker,
+				TracerGetter:            tracerGetter,
+				RootDir:                 agentConfig.DataDir(),
+				Clock:                   config.Clock,
+				Logger:                  config.Logger,
+				NewObjectStoreWorker:    config.NewObjectStoreWorker,
+				ControllerConfigService: controllerServiceFactory.ControllerConfig(),
+				MetadataServiceGetter: metadataServiceGetter{
+					factoryGetter: serviceFactoryGetter,
+				},
+				Hub:           config.CentralHub,
+				ControllerID:  agentConfig.Tag().Id(),
+				APIInfo:       apiInfo,
+				NewPeerClient: NewPeerClient,
 
 				// StatePool is only here for backwards compatibility. Once we
 				// have the right abstractions in place, and we have a
@@ -167,6 +220,16 @@ func output(in worker.Worker, out any) error {
 	return nil
 }
 
+type metadataServiceGetter struct {
+	factoryGetter servicefactory.ServiceFactoryGetter
+}
+
+// ForModelUUID returns the object store metadata service for the given
+// model UUID.
+func (s metadataServiceGetter) ForModelUUID(modelUUID string) internalobjectstore.MetadataService {
+	return s.factoryGetter.FactoryForModel(modelUUID).ObjectStore()
+}
+
 type shimStatePool struct {
 	statePool *jujustate.StatePool
 }
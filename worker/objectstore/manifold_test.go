@@ -7,12 +7,16 @@ import (
 	"context"
 
 	"github.com/juju/errors"
+	"github.com/juju/names/v4"
+	"github.com/juju/pubsub/v2"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v3/dependency"
 	dependencytesting "github.com/juju/worker/v3/dependency/testing"
 	"github.com/juju/worker/v3/workertest"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/api"
+	coreobjectstore "github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/trace"
 	internalobjectstore "github.com/juju/juju/internal/objectstore"
 	"github.com/juju/juju/state"
@@ -40,19 +44,29 @@ func (s *manifoldSuite) TestValidateConfig(c *gc.C) {
 	cfg.Logger = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 
+	cfg = s.getConfig()
+	cfg.ServiceFactoryName = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
 	cfg = s.getConfig()
 	cfg.NewObjectStoreWorker = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig()
+	cfg.CentralHub = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 }
 
 func (s *manifoldSuite) getConfig() ManifoldConfig {
 	return ManifoldConfig{
-		AgentName: "agent",
-		StateName: "state",
-		TraceName: "trace",
-		Clock:     s.clock,
-		Logger:    s.logger,
-		NewObjectStoreWorker: func(context.Context, string, internalobjectstore.MongoSession, internalobjectstore.Logger) (internalobjectstore.TrackedObjectStore, error) {
+		AgentName:          "agent",
+		StateName:          "state",
+		TraceName:          "trace",
+		ServiceFactoryName: "service-factory",
+		Clock:              s.clock,
+		Logger:             s.logger,
+		CentralHub:         pubsub.NewStructuredHub(nil),
+		NewObjectStoreWorker: func(context.Context, coreobjectstore.BackendType, string, ...internalobjectstore.Option) (internalobjectstore.TrackedObjectStore, error) {
 			return nil, nil
 		},
 	}
@@ -63,11 +77,15 @@ func (s *manifoldSuite) getContext() dependency.Context {
 		"agent": s.agent,
 		"trace": &stubTracerGetter{},
 		"state": s.stateTracker,
+		"service-factory": stubServiceFactory{
+			MockControllerServiceFactory: s.controllerServiceFactory,
+			MockServiceFactoryGetter:     s.serviceFactoryGetter,
+		},
 	}
 	return dependencytesting.StubContext(nil, resources)
 }
 
-var expectedInputs = []string{"agent", "state", "trace"}
+var expectedInputs = []string{"agent", "state", "trace", "service-factory"}
 
 func (s *manifoldSuite) TestInputs(c *gc.C) {
 	c.Assert(Manifold(s.getConfig()).Inputs, jc.SameContents, expectedInputs)
@@ -77,6 +95,11 @@ func (s *manifoldSuite) TestStart(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.expectStateTracker()
+	s.agent.EXPECT().CurrentConfig().Return(s.agentConfig)
+	s.agentConfig.EXPECT().APIInfo().Return(&api.Info{}, true)
+	s.agentConfig.EXPECT().Tag().Return(names.NewMachineTag("0"))
+	s.agentConfig.EXPECT().DataDir().Return(c.MkDir())
+	s.controllerServiceFactory.EXPECT().ControllerConfig().Return(nil)
 
 	w, err := Manifold(s.getConfig()).Start(s.getContext())
 	c.Assert(err, jc.ErrorIsNil)
@@ -88,6 +111,14 @@ func (s *manifoldSuite) expectStateTracker() {
 	s.stateTracker.EXPECT().Done()
 }
 
+// stubServiceFactory is the output of the service factory manifold, which
+// provides both the controller service factory and the service factory
+// getter.
+type stubServiceFactory struct {
+	*MockControllerServiceFactory
+	*MockServiceFactoryGetter
+}
+
 type stubTracerGetter struct{}
 
 func (s *stubTracerGetter) GetTracer(ctx context.Context, namespace trace.TracerNamespace) (trace.Tracer, error) {
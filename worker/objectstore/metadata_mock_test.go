@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/objectstore (interfaces: MetadataService)
+
+// Package objectstore is a generated GoMock package.
+package objectstore
+
+import (
+	context "context"
+	reflect "reflect"
+
+	objectstore "github.com/juju/juju/core/objectstore"
+	watcher "github.com/juju/juju/core/watcher"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMetadataService is a mock of MetadataService interface.
+type MockMetadataService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetadataServiceMockRecorder
+}
+
+// MockMetadataServiceMockRecorder is the mock recorder for MockMetadataService.
+type MockMetadataServiceMockRecorder struct {
+	mock *MockMetadataService
+}
+
+// NewMockMetadataService creates a new mock instance.
+func NewMockMetadataService(ctrl *gomock.Controller) *MockMetadataService {
+	mock := &MockMetadataService{ctrl: ctrl}
+	mock.recorder = &MockMetadataServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetadataService) EXPECT() *MockMetadataServiceMockRecorder {
+	return m.recorder
+}
+
+// ClaimRemoval mocks base method.
+func (m *MockMetadataService) ClaimRemoval(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRemoval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimRemoval indicates an expected call of ClaimRemoval.
+func (mr *MockMetadataServiceMockRecorder) ClaimRemoval(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRemoval", reflect.TypeOf((*MockMetadataService)(nil).ClaimRemoval), arg0, arg1)
+}
+
+// GetMetadata mocks base method.
+func (m *MockMetadataService) GetMetadata(arg0 context.Context, arg1 string) (objectstore.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata", arg0, arg1)
+	ret0, _ := ret[0].(objectstore.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata.
+func (mr *MockMetadataServiceMockRecorder) GetMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockMetadataService)(nil).GetMetadata), arg0, arg1)
+}
+
+// ListMetadata mocks base method.
+func (m *MockMetadataService) ListMetadata(arg0 context.Context) ([]objectstore.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMetadata", arg0)
+	ret0, _ := ret[0].([]objectstore.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMetadata indicates an expected call of ListMetadata.
+func (mr *MockMetadataServiceMockRecorder) ListMetadata(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetadata", reflect.TypeOf((*MockMetadataService)(nil).ListMetadata), arg0)
+}
+
+// ObjectReferenced mocks base method.
+func (m *MockMetadataService) ObjectReferenced(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectReferenced", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectReferenced indicates an expected call of ObjectReferenced.
+func (mr *MockMetadataServiceMockRecorder) ObjectReferenced(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectReferenced", reflect.TypeOf((*MockMetadataService)(nil).ObjectReferenced), arg0, arg1)
+}
+
+// ReleaseRemoval mocks base method.
+func (m *MockMetadataService) ReleaseRemoval(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRemoval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseRemoval indicates an expected call of ReleaseRemoval.
+func (mr *MockMetadataServiceMockRecorder) ReleaseRemoval(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRemoval", reflect.TypeOf((*MockMetadataService)(nil).ReleaseRemoval), arg0, arg1)
+}
+
+// RemoveMetadata mocks base method.
+func (m *MockMetadataService) RemoveMetadata(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMetadata", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMetadata indicates an expected call of RemoveMetadata.
+func (mr *MockMetadataServiceMockRecorder) RemoveMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMetadata", reflect.TypeOf((*MockMetadataService)(nil).RemoveMetadata), arg0, arg1)
+}
+
+// ReplaceMetadata mocks base method.
+func (m *MockMetadataService) ReplaceMetadata(arg0 context.Context, arg1 objectstore.Metadata) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceMetadata", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceMetadata indicates an expected call of ReplaceMetadata.
+func (mr *MockMetadataServiceMockRecorder) ReplaceMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceMetadata", reflect.TypeOf((*MockMetadataService)(nil).ReplaceMetadata), arg0, arg1)
+}
+
+// Watch mocks base method.
+func (m *MockMetadataService) Watch() (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch")
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockMetadataServiceMockRecorder) Watch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockMetadataService)(nil).Watch))
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/worker/objectstore (interfaces: TrackedObjectStore,StatePool,MongoSession)
+// Source: github.com/juju/juju/worker/objectstore (interfaces: TrackedObjectStore,StatePool,MongoSession,MetadataServiceGetter,ControllerConfigService,PeerClient)
 
 // Package objectstore is a generated GoMock package.
 package objectstore
@@ -9,6 +9,8 @@ import (
 	io "io"
 	reflect "reflect"
 
+	controller "github.com/juju/juju/controller"
+	objectstore "github.com/juju/juju/internal/objectstore"
 	mgo "github.com/juju/mgo/v3"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -52,6 +54,22 @@ func (mr *MockTrackedObjectStoreMockRecorder) Get(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTrackedObjectStore)(nil).Get), arg0, arg1)
 }
 
+// GetLocal mocks base method.
+func (m *MockTrackedObjectStore) GetLocal(arg0 context.Context, arg1 string) (io.ReadCloser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocal", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLocal indicates an expected call of GetLocal.
+func (mr *MockTrackedObjectStoreMockRecorder) GetLocal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocal", reflect.TypeOf((*MockTrackedObjectStore)(nil).GetLocal), arg0, arg1)
+}
+
 // Kill mocks base method.
 func (m *MockTrackedObjectStore) Kill() {
 	m.ctrl.T.Helper()
@@ -194,3 +212,131 @@ func (mr *MockMongoSessionMockRecorder) MongoSession() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MongoSession", reflect.TypeOf((*MockMongoSession)(nil).MongoSession))
 }
+
+// MockMetadataServiceGetter is a mock of MetadataServiceGetter interface.
+type MockMetadataServiceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetadataServiceGetterMockRecorder
+}
+
+// MockMetadataServiceGetterMockRecorder is the mock recorder for MockMetadataServiceGetter.
+type MockMetadataServiceGetterMockRecorder struct {
+	mock *MockMetadataServiceGetter
+}
+
+// NewMockMetadataServiceGetter creates a new mock instance.
+func NewMockMetadataServiceGetter(ctrl *gomock.Controller) *MockMetadataServiceGetter {
+	mock := &MockMetadataServiceGetter{ctrl: ctrl}
+	mock.recorder = &MockMetadataServiceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetadataServiceGetter) EXPECT() *MockMetadataServiceGetterMockRecorder {
+	return m.recorder
+}
+
+// ForModelUUID mocks base method.
+func (m *MockMetadataServiceGetter) ForModelUUID(arg0 string) objectstore.MetadataService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForModelUUID", arg0)
+	ret0, _ := ret[0].(objectstore.MetadataService)
+	return ret0
+}
+
+// ForModelUUID indicates an expected call of ForModelUUID.
+func (mr *MockMetadataServiceGetterMockRecorder) ForModelUUID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForModelUUID", reflect.TypeOf((*MockMetadataServiceGetter)(nil).ForModelUUID), arg0)
+}
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock *MockControllerConfigService
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig", arg0)
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).ControllerConfig), arg0)
+}
+
+// MockPeerClient is a mock of PeerClient interface.
+type MockPeerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPeerClientMockRecorder
+}
+
+// MockPeerClientMockRecorder is the mock recorder for MockPeerClient.
+type MockPeerClientMockRecorder struct {
+	mock *MockPeerClient
+}
+
+// NewMockPeerClient creates a new mock instance.
+func NewMockPeerClient(ctrl *gomock.Controller) *MockPeerClient {
+	mock := &MockPeerClient{ctrl: ctrl}
+	mock.recorder = &MockPeerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPeerClient) EXPECT() *MockPeerClientMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockPeerClient) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockPeerClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPeerClient)(nil).Close))
+}
+
+// GetObject mocks base method.
+func (m *MockPeerClient) GetObject(arg0 context.Context, arg1, arg2 string) (io.ReadCloser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockPeerClientMockRecorder) GetObject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockPeerClient)(nil).GetObject), arg0, arg1, arg2)
+}
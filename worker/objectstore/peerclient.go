@@ -0,0 +1,78 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/rpc/params"
+)
+
+var dialOpts = api.DialOpts{
+	DialAddressInterval: 20 * time.Millisecond,
+	Timeout:             10 * time.Second,
+	RetryDelay:          1 * time.Second,
+}
+
+// NewPeerClient connects to the peer controller defined by the info, and
+// returns a PeerClient for retrieving objects from it.
+func NewPeerClient(info *api.Info) (PeerClient, error) {
+	conn, err := api.Open(info, dialOpts)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	client, err := conn.HTTPClient()
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Trace(err)
+	}
+	return &peerClient{
+		conn:   conn,
+		client: client,
+	}, nil
+}
+
+type peerClient struct {
+	conn   api.Connection
+	client *httprequest.Client
+}
+
+// GetObject returns an io.ReadCloser for the object at path, in the given
+// namespace, along with its size.
+func (c *peerClient) GetObject(ctx context.Context, namespace, path string) (io.ReadCloser, int64, error) {
+	// The HTTP client is bound to the model of the connection, so use an
+	// absolute URL to request objects from other models.
+	u := url.URL{
+		Scheme:   "https",
+		Host:     c.conn.Addr(),
+		Path:     fmt.Sprintf("/model/%s/objects", namespace),
+		RawQuery: url.Values{"path": []string{path}}.Encode(),
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, -1, errors.Trace(err)
+	}
+
+	var resp *http.Response
+	if err := c.client.Do(ctx, req, &resp); params.IsCodeNotFound(err) {
+		return nil, -1, errors.NotFoundf("object at path %q", path)
+	} else if err != nil {
+		return nil, -1, errors.Trace(err)
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// Close closes the connection to the peer controller.
+func (c *peerClient) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,279 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3/catacomb"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/internal/pubsub/apiserver"
+)
+
+// Hub provides the methods of the central hub that are used to discover
+// the peer controllers.
+type Hub interface {
+	Subscribe(topic string, handler interface{}) (func(), error)
+	Publish(topic string, data interface{}) (func(), error)
+}
+
+// PeerClient is used to retrieve objects from a peer controller.
+type PeerClient interface {
+	// GetObject returns an io.ReadCloser for the object at path, in the
+	// given namespace, along with its size.
+	GetObject(ctx context.Context, namespace, path string) (io.ReadCloser, int64, error)
+
+	// Close closes the connection to the peer controller.
+	Close() error
+}
+
+// NewPeerClientFunc is the function signature for creating a new client
+// for a peer controller.
+type NewPeerClientFunc func(*api.Info) (PeerClient, error)
+
+// peerBlobRetriever retrieves objects from the peer controllers in an HA
+// set. The peer controllers are discovered from the api server details that
+// are published on the central hub. The connection to each peer is kept open
+// and reused across retrievals, until the peer's addresses change or the
+// connection fails.
+type peerBlobRetriever struct {
+	catacomb catacomb.Catacomb
+
+	controllerID  string
+	apiInfo       *api.Info
+	newPeerClient NewPeerClientFunc
+	logger        Logger
+
+	mutex   sync.Mutex
+	peers   map[string][]string
+	clients map[string]PeerClient
+}
+
+func newPeerBlobRetriever(
+	hub Hub,
+	controllerID string,
+	apiInfo *api.Info,
+	newPeerClient NewPeerClientFunc,
+	logger Logger,
+) (*peerBlobRetriever, error) {
+	r := &peerBlobRetriever{
+		controllerID:  controllerID,
+		apiInfo:       apiInfo,
+		newPeerClient: newPeerClient,
+		logger:        logger,
+		peers:         make(map[string][]string),
+		clients:       make(map[string]PeerClient),
+	}
+
+	unsubscribe, err := hub.Subscribe(apiserver.DetailsTopic, r.apiServerChanges)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if err := catacomb.Invoke(catacomb.Plan{
+		Site: &r.catacomb,
+		Work: func() error {
+			defer unsubscribe()
+			defer r.closeClients()
+			<-r.catacomb.Dying()
+			return r.catacomb.ErrDying()
+		},
+	}); err != nil {
+		unsubscribe()
+		return nil, errors.Trace(err)
+	}
+
+	// Ask for the current server details now that we're subscribed.
+	if _, err := hub.Publish(apiserver.DetailsRequestTopic, apiserver.DetailsRequest{
+		Requester: "objectstore",
+		LocalOnly: true,
+	}); err != nil {
+		r.Kill()
+		_ = r.Wait()
+		return nil, errors.Trace(err)
+	}
+
+	return r, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (r *peerBlobRetriever) Kill() {
+	r.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (r *peerBlobRetriever) Wait() error {
+	return r.catacomb.Wait()
+}
+
+// RetrieveBlob returns an io.ReadCloser for the object at path, in the given
+// namespace, along with its size. Each of the peer controllers is tried in
+// turn, until one of them returns the object. The caller is responsible for
+// verifying the integrity of the object.
+func (r *peerBlobRetriever) RetrieveBlob(ctx context.Context, namespace, path string) (io.ReadCloser, int64, error) {
+	peers := r.currentPeers()
+	if len(peers) == 0 {
+		return nil, -1, errors.NotFoundf("peer controllers to retrieve %q from", path)
+	}
+
+	var lastErr error
+	for _, peer := range peers {
+		client, err := r.peerClient(peer)
+		if err != nil {
+			r.logger.Debugf("unable to connect to controller %q: %v", peer.id, err)
+			lastErr = err
+			continue
+		}
+
+		reader, size, err := client.GetObject(ctx, namespace, path)
+		if err != nil {
+			// The peer not holding the object doesn't mean there is anything
+			// wrong with the connection, so only drop it on other errors.
+			if !errors.Is(err, errors.NotFound) {
+				r.dropClient(peer.id, client)
+			}
+			r.logger.Debugf("unable to retrieve %q from controller %q: %v", path, peer.id, err)
+			lastErr = err
+			continue
+		}
+
+		r.logger.Debugf("retrieving %q from controller %q", path, peer.id)
+		return reader, size, nil
+	}
+	return nil, -1, errors.Annotatef(lastErr, "retrieving %q from peer controllers", path)
+}
+
+// Report provides information for the engine report.
+func (r *peerBlobRetriever) Report() map[string]any {
+	peers := make(map[string]any)
+	for _, peer := range r.currentPeers() {
+		peers[peer.id] = peer.addresses
+	}
+	return map[string]any{
+		"peers": peers,
+	}
+}
+
+type peer struct {
+	id        string
+	addresses []string
+}
+
+func (r *peerBlobRetriever) currentPeers() []peer {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	peers := make([]peer, 0, len(r.peers))
+	for id, addresses := range r.peers {
+		peers = append(peers, peer{
+			id:        id,
+			addresses: addresses,
+		})
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].id < peers[j].id
+	})
+	return peers
+}
+
+func (r *peerBlobRetriever) apiServerChanges(topic string, details apiserver.Details, err error) {
+	if err != nil {
+		// This should never happen.
+		r.logger.Errorf("api server details callback error: %v", err)
+		return
+	}
+
+	peers := make(map[string][]string)
+	for id, server := range details.Servers {
+		// We don't need to retrieve objects from ourselves.
+		if id == r.controllerID {
+			continue
+		}
+
+		addresses := server.Addresses
+		if server.InternalAddress != "" {
+			addresses = []string{server.InternalAddress}
+		}
+		if len(addresses) == 0 {
+			continue
+		}
+		peers[id] = addresses
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Close the connections to any peers that have gone away, or whose
+	// addresses have changed, so that they're dialled again when needed.
+	for id, client := range r.clients {
+		if addresses, ok := peers[id]; ok && equalAddresses(addresses, r.peers[id]) {
+			continue
+		}
+		_ = client.Close()
+		delete(r.clients, id)
+	}
+	r.peers = peers
+}
+
+// peerClient returns the client for the peer, dialling it if there isn't
+// already an open connection.
+func (r *peerBlobRetriever) peerClient(peer peer) (PeerClient, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if client, ok := r.clients[peer.id]; ok {
+		return client, nil
+	}
+
+	info := *r.apiInfo
+	info.Addrs = peer.addresses
+	client, err := r.newPeerClient(&info)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	r.clients[peer.id] = client
+	return client, nil
+}
+
+// dropClient closes the client for the peer, so that the peer is dialled
+// again on the next retrieval.
+func (r *peerBlobRetriever) dropClient(id string, client PeerClient) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// The client may have already been closed and replaced, either by a
+	// concurrent retrieval or by a change to the peer's addresses.
+	if r.clients[id] != client {
+		return
+	}
+	delete(r.clients, id)
+	_ = client.Close()
+}
+
+func (r *peerBlobRetriever) closeClients() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for id, client := range r.clients {
+		_ = client.Close()
+		delete(r.clients, id)
+	}
+}
+
+func equalAddresses(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,243 @@
+// Copyright 2023 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/pubsub/v2"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/internal/pubsub/apiserver"
+)
+
+type peersSuite struct {
+	baseSuite
+
+	hub        *pubsub.StructuredHub
+	peerClient *MockPeerClient
+	dialed     [][]string
+}
+
+var _ = gc.Suite(&peersSuite{})
+
+func (s *peersSuite) TestRetrieveBlobNoPeers(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	r := s.newRetriever(c)
+	defer workertest.CleanKill(c, r)
+
+	_, _, err := r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *peersSuite) TestRetrieveBlobSkipsSelf(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	r := s.newRetriever(c)
+	defer workertest.CleanKill(c, r)
+
+	s.publishDetails(c, map[string]apiserver.APIServer{
+		"0": {ID: "0", InternalAddress: "10.0.0.1:17070"},
+	})
+
+	_, _, err := r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+	c.Check(s.dialed, gc.HasLen, 0)
+}
+
+func (s *peersSuite) TestRetrieveBlob(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "foo").Return(io.NopCloser(strings.NewReader("blob")), int64(4), nil)
+	s.peerClient.EXPECT().Close().Return(nil)
+
+	r := s.newRetriever(c)
+	defer workertest.CleanKill(c, r)
+
+	s.publishDetails(c, map[string]apiserver.APIServer{
+		"0": {ID: "0", InternalAddress: "10.0.0.1:17070"},
+		"1": {ID: "1", Addresses: []string{"10.0.0.2:17070", "10.0.1.2:17070"}, InternalAddress: "10.0.0.2:17070"},
+	})
+
+	reader, size, err := r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(size, gc.Equals, int64(4))
+	c.Check(s.dialed, gc.DeepEquals, [][]string{{"10.0.0.2:17070"}})
+
+	data, err := io.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "blob")
+	c.Assert(reader.Close(), jc.ErrorIsNil)
+
+	// The client is only closed when the retriever is stopped.
+	workertest.CleanKill(c, r)
+}
+
+func (s *peersSuite) TestRetrieveBlobReusesClient(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "foo").Return(io.NopCloser(strings.NewReader("blob")), int64(4), nil)
+	s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "bar").Return(io.NopCloser(strings.NewReader("blob")), int64(4), nil)
+	s.peerClient.EXPECT().Close().Return(nil)
+
+	r := s.newRetriever(c)
+	defer workertest.CleanKill(c, r)
+
+	s.publishDetails(c, map[string]apiserver.APIServer{
+		"1": {ID: "1", Addresses: []string{"10.0.0.2:17070"}},
+	})
+
+	_, _, err := r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	_, _, err = r.RetrieveBlob(context.Background(), "inferi", "bar")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.dialed, gc.DeepEquals, [][]string{{"10.0.0.2:17070"}})
+}
+
+func (s *peersSuite) TestRetrieveBlobRedialsAfterError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	gomock.InOrder(
+		s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "foo").Return(nil, int64(-1), errors.New("boom")),
+		s.peerClient.EXPECT().Close().Return(nil),
+		s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "foo").Return(io.NopCloser(strings.NewReader("blob")), int64(4), nil),
+		s.peerClient.EXPECT().Close().Return(nil),
+	)
+
+	r := s.newRetriever(c)
+	defer workertest.CleanKill(c, r)
+
+	s.publishDetails(c, map[string]apiserver.APIServer{
+		"1": {ID: "1", Addresses: []string{"10.0.0.2:17070"}},
+	})
+
+	_, _, err := r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, gc.ErrorMatches, `retrieving "foo" from peer controllers: boom`)
+	_, _, err = r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.dialed, gc.DeepEquals, [][]string{{"10.0.0.2:17070"}, {"10.0.0.2:17070"}})
+}
+
+func (s *peersSuite) TestRetrieveBlobRedialsAfterAddressChange(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	gomock.InOrder(
+		s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "foo").Return(io.NopCloser(strings.NewReader("blob")), int64(4), nil),
+		s.peerClient.EXPECT().Close().Return(nil),
+		s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "foo").Return(io.NopCloser(strings.NewReader("blob")), int64(4), nil),
+		s.peerClient.EXPECT().Close().Return(nil),
+	)
+
+	r := s.newRetriever(c)
+	defer workertest.CleanKill(c, r)
+
+	s.publishDetails(c, map[string]apiserver.APIServer{
+		"1": {ID: "1", Addresses: []string{"10.0.0.2:17070"}},
+	})
+	_, _, err := r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.publishDetails(c, map[string]apiserver.APIServer{
+		"1": {ID: "1", Addresses: []string{"10.0.0.3:17070"}},
+	})
+	_, _, err = r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.dialed, gc.DeepEquals, [][]string{{"10.0.0.2:17070"}, {"10.0.0.3:17070"}})
+}
+
+func (s *peersSuite) TestRetrieveBlobTriesEachPeer(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	gomock.InOrder(
+		s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "foo").Return(nil, int64(-1), errors.NotFoundf("foo")),
+		s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "foo").Return(io.NopCloser(strings.NewReader("blob")), int64(4), nil),
+	)
+	// The peer not holding the object doesn't close the connection, so both
+	// clients are closed when the retriever is stopped.
+	s.peerClient.EXPECT().Close().Return(nil).Times(2)
+
+	r := s.newRetriever(c)
+	defer workertest.CleanKill(c, r)
+
+	s.publishDetails(c, map[string]apiserver.APIServer{
+		"1": {ID: "1", Addresses: []string{"10.0.0.2:17070"}},
+		"2": {ID: "2", Addresses: []string{"10.0.0.3:17070"}},
+	})
+
+	_, size, err := r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(size, gc.Equals, int64(4))
+	c.Check(s.dialed, gc.DeepEquals, [][]string{{"10.0.0.2:17070"}, {"10.0.0.3:17070"}})
+}
+
+func (s *peersSuite) TestRetrieveBlobAllPeersFail(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.peerClient.EXPECT().GetObject(gomock.Any(), "inferi", "foo").Return(nil, int64(-1), errors.NotFoundf("foo"))
+	s.peerClient.EXPECT().Close().Return(nil)
+
+	r := s.newRetriever(c)
+	defer workertest.CleanKill(c, r)
+
+	s.publishDetails(c, map[string]apiserver.APIServer{
+		"1": {ID: "1", Addresses: []string{"10.0.0.2:17070"}},
+	})
+
+	_, _, err := r.RetrieveBlob(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *peersSuite) TestReport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	r := s.newRetriever(c)
+	defer workertest.CleanKill(c, r)
+
+	s.publishDetails(c, map[string]apiserver.APIServer{
+		"0": {ID: "0", InternalAddress: "10.0.0.1:17070"},
+		"1": {ID: "1", Addresses: []string{"10.0.0.2:17070"}},
+	})
+
+	c.Check(r.Report(), gc.DeepEquals, map[string]any{
+		"peers": map[string]any{
+			"1": []string{"10.0.0.2:17070"},
+		},
+	})
+}
+
+func (s *peersSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := s.baseSuite.setupMocks(c)
+
+	s.hub = pubsub.NewStructuredHub(nil)
+	s.peerClient = NewMockPeerClient(ctrl)
+	s.dialed = nil
+
+	return ctrl
+}
+
+func (s *peersSuite) newRetriever(c *gc.C) *peerBlobRetriever {
+	r, err := newPeerBlobRetriever(s.hub, "0", &api.Info{}, func(info *api.Info) (PeerClient, error) {
+		s.dialed = append(s.dialed, info.Addrs)
+		return s.peerClient, nil
+	}, s.logger)
+	c.Assert(err, jc.ErrorIsNil)
+	return r
+}
+
+func (s *peersSuite) publishDetails(c *gc.C, servers map[string]apiserver.APIServer) {
+	done, err := s.hub.Publish(apiserver.DetailsTopic, apiserver.Details{
+		Servers:   servers,
+		LocalOnly: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	done()
+}
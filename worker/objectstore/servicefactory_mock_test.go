@@ -0,0 +1,265 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/servicefactory (interfaces: ControllerServiceFactory,ServiceFactoryGetter)
+
+// Package objectstore is a generated GoMock package.
+package objectstore
+
+import (
+	reflect "reflect"
+
+	service "github.com/juju/juju/domain/autocert/service"
+	service0 "github.com/juju/juju/domain/cloud/service"
+	service1 "github.com/juju/juju/domain/controllerconfig/service"
+	service2 "github.com/juju/juju/domain/controllernode/service"
+	service3 "github.com/juju/juju/domain/credential/service"
+	service4 "github.com/juju/juju/domain/externalcontroller/service"
+	service5 "github.com/juju/juju/domain/model/service"
+	service6 "github.com/juju/juju/domain/modeldefaults/service"
+	service7 "github.com/juju/juju/domain/modelmanager/service"
+	service8 "github.com/juju/juju/domain/upgrade/service"
+	service9 "github.com/juju/juju/domain/user/service"
+	servicefactory "github.com/juju/juju/internal/servicefactory"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockControllerServiceFactory is a mock of ControllerServiceFactory interface.
+type MockControllerServiceFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerServiceFactoryMockRecorder
+}
+
+// MockControllerServiceFactoryMockRecorder is the mock recorder for MockControllerServiceFactory.
+type MockControllerServiceFactoryMockRecorder struct {
+	mock *MockControllerServiceFactory
+}
+
+// NewMockControllerServiceFactory creates a new mock instance.
+func NewMockControllerServiceFactory(ctrl *gomock.Controller) *MockControllerServiceFactory {
+	mock := &MockControllerServiceFactory{ctrl: ctrl}
+	mock.recorder = &MockControllerServiceFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerServiceFactory) EXPECT() *MockControllerServiceFactoryMockRecorder {
+	return m.recorder
+}
+
+// APIToken mocks base method.
+func (m *MockControllerServiceFactory) APIToken() *service9.TokenService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIToken")
+	ret0, _ := ret[0].(*service9.TokenService)
+	return ret0
+}
+
+// APIToken indicates an expected call of APIToken.
+func (mr *MockControllerServiceFactoryMockRecorder) APIToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIToken", reflect.TypeOf((*MockControllerServiceFactory)(nil).APIToken))
+}
+
+// AutocertCache mocks base method.
+func (m *MockControllerServiceFactory) AutocertCache() *service.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutocertCache")
+	ret0, _ := ret[0].(*service.Service)
+	return ret0
+}
+
+// AutocertCache indicates an expected call of AutocertCache.
+func (mr *MockControllerServiceFactoryMockRecorder) AutocertCache() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocertCache", reflect.TypeOf((*MockControllerServiceFactory)(nil).AutocertCache))
+}
+
+// Cloud mocks base method.
+func (m *MockControllerServiceFactory) Cloud() *service0.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cloud")
+	ret0, _ := ret[0].(*service0.Service)
+	return ret0
+}
+
+// Cloud indicates an expected call of Cloud.
+func (mr *MockControllerServiceFactoryMockRecorder) Cloud() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cloud", reflect.TypeOf((*MockControllerServiceFactory)(nil).Cloud))
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerServiceFactory) ControllerConfig() *service1.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig")
+	ret0, _ := ret[0].(*service1.Service)
+	return ret0
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerServiceFactoryMockRecorder) ControllerConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerServiceFactory)(nil).ControllerConfig))
+}
+
+// ControllerNode mocks base method.
+func (m *MockControllerServiceFactory) ControllerNode() *service2.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerNode")
+	ret0, _ := ret[0].(*service2.Service)
+	return ret0
+}
+
+// ControllerNode indicates an expected call of ControllerNode.
+func (mr *MockControllerServiceFactoryMockRecorder) ControllerNode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerNode", reflect.TypeOf((*MockControllerServiceFactory)(nil).ControllerNode))
+}
+
+// Credential mocks base method.
+func (m *MockControllerServiceFactory) Credential() *service3.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Credential")
+	ret0, _ := ret[0].(*service3.Service)
+	return ret0
+}
+
+// Credential indicates an expected call of Credential.
+func (mr *MockControllerServiceFactoryMockRecorder) Credential() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Credential", reflect.TypeOf((*MockControllerServiceFactory)(nil).Credential))
+}
+
+// ExternalController mocks base method.
+func (m *MockControllerServiceFactory) ExternalController() *service4.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalController")
+	ret0, _ := ret[0].(*service4.Service)
+	return ret0
+}
+
+// ExternalController indicates an expected call of ExternalController.
+func (mr *MockControllerServiceFactoryMockRecorder) ExternalController() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalController", reflect.TypeOf((*MockControllerServiceFactory)(nil).ExternalController))
+}
+
+// Model mocks base method.
+func (m *MockControllerServiceFactory) Model() *service5.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Model")
+	ret0, _ := ret[0].(*service5.Service)
+	return ret0
+}
+
+// Model indicates an expected call of Model.
+func (mr *MockControllerServiceFactoryMockRecorder) Model() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Model", reflect.TypeOf((*MockControllerServiceFactory)(nil).Model))
+}
+
+// ModelDefaults mocks base method.
+func (m *MockControllerServiceFactory) ModelDefaults() *service6.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelDefaults")
+	ret0, _ := ret[0].(*service6.Service)
+	return ret0
+}
+
+// ModelDefaults indicates an expected call of ModelDefaults.
+func (mr *MockControllerServiceFactoryMockRecorder) ModelDefaults() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelDefaults", reflect.TypeOf((*MockControllerServiceFactory)(nil).ModelDefaults))
+}
+
+// ModelManager mocks base method.
+func (m *MockControllerServiceFactory) ModelManager() *service7.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelManager")
+	ret0, _ := ret[0].(*service7.Service)
+	return ret0
+}
+
+// ModelManager indicates an expected call of ModelManager.
+func (mr *MockControllerServiceFactoryMockRecorder) ModelManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelManager", reflect.TypeOf((*MockControllerServiceFactory)(nil).ModelManager))
+}
+
+// Role mocks base method.
+func (m *MockControllerServiceFactory) Role() *service9.RoleService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Role")
+	ret0, _ := ret[0].(*service9.RoleService)
+	return ret0
+}
+
+// Role indicates an expected call of Role.
+func (mr *MockControllerServiceFactoryMockRecorder) Role() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Role", reflect.TypeOf((*MockControllerServiceFactory)(nil).Role))
+}
+
+// Upgrade mocks base method.
+func (m *MockControllerServiceFactory) Upgrade() *service8.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade")
+	ret0, _ := ret[0].(*service8.Service)
+	return ret0
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockControllerServiceFactoryMockRecorder) Upgrade() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockControllerServiceFactory)(nil).Upgrade))
+}
+
+// UserGroup mocks base method.
+func (m *MockControllerServiceFactory) UserGroup() *service9.GroupService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGroup")
+	ret0, _ := ret[0].(*service9.GroupService)
+	return ret0
+}
+
+// UserGroup indicates an expected call of UserGroup.
+func (mr *MockControllerServiceFactoryMockRecorder) UserGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGroup", reflect.TypeOf((*MockControllerServiceFactory)(nil).UserGroup))
+}
+
+// MockServiceFactoryGetter is a mock of ServiceFactoryGetter interface.
+type MockServiceFactoryGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceFactoryGetterMockRecorder
+}
+
+// MockServiceFactoryGetterMockRecorder is the mock recorder for MockServiceFactoryGetter.
+type MockServiceFactoryGetterMockRecorder struct {
+	mock *MockServiceFactoryGetter
+}
+
+// NewMockServiceFactoryGetter creates a new mock instance.
+func NewMockServiceFactoryGetter(ctrl *gomock.Controller) *MockServiceFactoryGetter {
+	mock := &MockServiceFactoryGetter{ctrl: ctrl}
+	mock.recorder = &MockServiceFactoryGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceFactoryGetter) EXPECT() *MockServiceFactoryGetterMockRecorder {
+	return m.recorder
+}
+
+// FactoryForModel mocks base method.
+func (m *MockServiceFactoryGetter) FactoryForModel(arg0 string) servicefactory.ServiceFactory {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FactoryForModel", arg0)
+	ret0, _ := ret[0].(servicefactory.ServiceFactory)
+	return ret0
+}
+
+// FactoryForModel indicates an expected call of FactoryForModel.
+func (mr *MockServiceFactoryGetterMockRecorder) FactoryForModel(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FactoryForModel", reflect.TypeOf((*MockServiceFactoryGetter)(nil).FactoryForModel), arg0)
+}
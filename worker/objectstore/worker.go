@@ -13,6 +13,7 @@ import (
 	"github.com/juju/worker/v3"
 	"github.com/juju/worker/v3/catacomb"
 
+	"github.com/juju/juju/api"
 	coreobjectstore "github.com/juju/juju/core/objectstore"
 	coretrace "github.com/juju/juju/core/trace"
 	internalobjectstore "github.com/juju/juju/internal/objectstore"
@@ -34,10 +35,21 @@ type TrackedObjectStore interface {
 // WorkerConfig encapsulates the configuration options for the
 // objectStore worker.
 type WorkerConfig struct {
-	TracerGetter         trace.TracerGetter
-	Clock                clock.Clock
-	Logger               Logger
-	NewObjectStoreWorker internalobjectstore.ObjectStoreWorkerFunc
+	TracerGetter            trace.TracerGetter
+	RootDir                 string
+	Clock                   clock.Clock
+	Logger                  Logger
+	NewObjectStoreWorker    internalobjectstore.ObjectStoreWorkerFunc
+	ControllerConfigService ControllerConfigService
+	MetadataServiceGetter   MetadataServiceGetter
+
+	// Hub, ControllerID, APIInfo and NewPeerClient are used to retrieve
+	// objects from the peer controllers, for object stores that are local
+	// to each controller.
+	Hub           Hub
+	ControllerID  string
+	APIInfo       *api.Info
+	NewPeerClient NewPeerClientFunc
 
 	// StatePool is only here for backwards compatibility. Once we have
 	// the right abstractions in place, and we have a replacement, we can
@@ -50,6 +62,9 @@ func (c *WorkerConfig) Validate() error {
 	if c.TracerGetter == nil {
 		return errors.NotValidf("nil TracerGetter")
 	}
+	if c.RootDir == "" {
+		return errors.NotValidf("empty RootDir")
+	}
 	if c.Clock == nil {
 		return errors.NotValidf("nil Clock")
 	}
@@ -59,6 +74,24 @@ func (c *WorkerConfig) Validate() error {
 	if c.NewObjectStoreWorker == nil {
 		return errors.NotValidf("nil NewObjectStoreWorker")
 	}
+	if c.ControllerConfigService == nil {
+		return errors.NotValidf("nil ControllerConfigService")
+	}
+	if c.MetadataServiceGetter == nil {
+		return errors.NotValidf("nil MetadataServiceGetter")
+	}
+	if c.Hub == nil {
+		return errors.NotValidf("nil Hub")
+	}
+	if c.ControllerID == "" {
+		return errors.NotValidf("empty ControllerID")
+	}
+	if c.APIInfo == nil {
+		return errors.NotValidf("nil APIInfo")
+	}
+	if c.NewPeerClient == nil {
+		return errors.NotValidf("nil NewPeerClient")
+	}
 	if c.StatePool == nil {
 		return errors.NotValidf("nil StatePool")
 	}
@@ -77,7 +110,8 @@ type objectStoreWorker struct {
 	cfg            WorkerConfig
 	catacomb       catacomb.Catacomb
 
-	runner *worker.Runner
+	runner        *worker.Runner
+	blobRetriever *peerBlobRetriever
 
 	objectStoreRequests chan objectStoreRequest
 }
@@ -93,6 +127,11 @@ func newWorker(cfg WorkerConfig, internalStates chan string) (*objectStoreWorker
 		return nil, errors.Trace(err)
 	}
 
+	blobRetriever, err := newPeerBlobRetriever(cfg.Hub, cfg.ControllerID, cfg.APIInfo, cfg.NewPeerClient, cfg.Logger)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	w := &objectStoreWorker{
 		internalStates: internalStates,
 		cfg:            cfg,
@@ -104,6 +143,7 @@ func newWorker(cfg WorkerConfig, internalStates chan string) (*objectStoreWorker
 			RestartDelay: time.Second * 10,
 			Logger:       cfg.Logger,
 		}),
+		blobRetriever:       blobRetriever,
 		objectStoreRequests: make(chan objectStoreRequest),
 	}
 
@@ -112,8 +152,11 @@ func newWorker(cfg WorkerConfig, internalStates chan string) (*objectStoreWorker
 		Work: w.loop,
 		Init: []worker.Worker{
 			w.runner,
+			w.blobRetriever,
 		},
 	}); err != nil {
+		blobRetriever.Kill()
+		_ = blobRetriever.Wait()
 		return nil, errors.Trace(err)
 	}
 
@@ -245,18 +288,57 @@ func (w *objectStoreWorker) initObjectStore(namespace string) error {
 			return nil, errors.Trace(err)
 		}
 
-		// This is only here until we have a better backing store.
-		state, err := w.cfg.StatePool.Get(namespace)
+		controllerConfig, err := w.cfg.ControllerConfigService.ControllerConfig(ctx)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		backendType := controllerConfig.ObjectStoreType()
+
+		opts := []internalobjectstore.Option{
+			internalobjectstore.WithRootDir(w.cfg.RootDir),
+			internalobjectstore.WithMetadataService(w.cfg.MetadataServiceGetter.ForModelUUID(namespace)),
+			internalobjectstore.WithLogger(w.cfg.Logger),
+		}
+
+		switch backendType {
+		case coreobjectstore.StateBackend:
+			// The state backend is only here until we have migrated all the
+			// object stores away from GridFS.
+			state, err := w.cfg.StatePool.Get(namespace)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			opts = append(opts, internalobjectstore.WithMongoSession(state))
+
+		case coreobjectstore.FileBackend:
+			// Each controller holds its own copy of the objects, so any
+			// objects written via another controller need to be retrieved
+			// from it.
+			opts = append(opts,
+				internalobjectstore.WithBlobRetriever(w.blobRetriever),
+				internalobjectstore.WithClock(w.cfg.Clock),
+			)
+
+		case coreobjectstore.S3Backend:
+			client, err := internalobjectstore.NewS3Client(
+				controllerConfig.ObjectStoreS3Endpoint(),
+				internalobjectstore.S3Credentials{
+					AccessKey: controllerConfig.ObjectStoreS3StaticKey(),
+					SecretKey: controllerConfig.ObjectStoreS3StaticSecret(),
+					Session:   controllerConfig.ObjectStoreS3StaticSession(),
+				},
+				w.cfg.Logger,
+			)
+			if err != nil {
+				return nil, errors.Annotate(err, "creating s3 client")
+			}
+			opts = append(opts,
+				internalobjectstore.WithS3Client(client),
+				internalobjectstore.WithS3Bucket(controllerConfig.ObjectStoreS3Bucket()),
+			)
+		}
 
-		objectStore, err := w.cfg.NewObjectStoreWorker(
-			ctx,
-			namespace,
-			state,
-			w.cfg.Logger,
-		)
+		objectStore, err := w.cfg.NewObjectStoreWorker(ctx, backendType, namespace, opts...)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
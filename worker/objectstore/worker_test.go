@@ -10,12 +10,16 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/juju/errors"
+	"github.com/juju/pubsub/v2"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v3"
 	"github.com/juju/worker/v3/workertest"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/controller"
 	coreobjectstore "github.com/juju/juju/core/objectstore"
 	internalobjectstore "github.com/juju/juju/internal/objectstore"
 	"github.com/juju/juju/testing"
@@ -27,6 +31,7 @@ type workerSuite struct {
 	states             chan string
 	trackedObjectStore *MockTrackedObjectStore
 	called             int64
+	backendType        coreobjectstore.BackendType
 }
 
 var _ = gc.Suite(&workerSuite{})
@@ -74,6 +79,77 @@ func (s *workerSuite) TestGetObjectStore(c *gc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *workerSuite) TestGetObjectStoreFileBackend(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectClock()
+
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.ensureStartup(c)
+
+	// The file backend doesn't require a mongo session.
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.ObjectStoreType: coreobjectstore.FileBackend.String(),
+	}, nil)
+	s.metadataServiceGetter.EXPECT().ForModelUUID("foo").Return(s.metadataService)
+
+	done := make(chan struct{})
+	s.trackedObjectStore.EXPECT().Kill().AnyTimes()
+	s.trackedObjectStore.EXPECT().Wait().DoAndReturn(func() error {
+		<-done
+		return nil
+	}).AnyTimes()
+
+	worker := w.(*objectStoreWorker)
+	objectStore, err := worker.GetObjectStore(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(objectStore, gc.NotNil)
+	c.Check(s.backendType, gc.Equals, coreobjectstore.FileBackend)
+
+	close(done)
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *workerSuite) TestGetObjectStoreS3Backend(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectClock()
+
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.ensureStartup(c)
+
+	// The s3 backend doesn't require a mongo session.
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.ObjectStoreType:           coreobjectstore.S3Backend.String(),
+		controller.ObjectStoreS3Endpoint:     "http://localhost:9000",
+		controller.ObjectStoreS3StaticKey:    "key",
+		controller.ObjectStoreS3StaticSecret: "secret",
+	}, nil)
+	s.metadataServiceGetter.EXPECT().ForModelUUID("foo").Return(s.metadataService)
+
+	done := make(chan struct{})
+	s.trackedObjectStore.EXPECT().Kill().AnyTimes()
+	s.trackedObjectStore.EXPECT().Wait().DoAndReturn(func() error {
+		<-done
+		return nil
+	}).AnyTimes()
+
+	worker := w.(*objectStoreWorker)
+	objectStore, err := worker.GetObjectStore(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(objectStore, gc.NotNil)
+	c.Check(s.backendType, gc.Equals, coreobjectstore.S3Backend)
+
+	close(done)
+
+	workertest.CleanKill(c, w)
+}
+
 func (s *workerSuite) TestGetObjectStoreIsCached(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -185,12 +261,22 @@ func (s *workerSuite) TestGetObjectStoreConcurrently(c *gc.C) {
 
 func (s *workerSuite) newWorker(c *gc.C) worker.Worker {
 	w, err := newWorker(WorkerConfig{
-		Clock:        s.clock,
-		Logger:       s.logger,
-		TracerGetter: &stubTracerGetter{},
-		StatePool:    s.statePool,
-		NewObjectStoreWorker: func(context.Context, string, internalobjectstore.MongoSession, internalobjectstore.Logger) (internalobjectstore.TrackedObjectStore, error) {
+		Clock:                   s.clock,
+		Logger:                  s.logger,
+		TracerGetter:            &stubTracerGetter{},
+		RootDir:                 c.MkDir(),
+		StatePool:               s.statePool,
+		ControllerConfigService: s.controllerConfigService,
+		MetadataServiceGetter:   s.metadataServiceGetter,
+		Hub:                     pubsub.NewStructuredHub(nil),
+		ControllerID:            "0",
+		APIInfo:                 &api.Info{},
+		NewPeerClient: func(*api.Info) (PeerClient, error) {
+			return nil, errors.NotImplementedf("peer client")
+		},
+		NewObjectStoreWorker: func(_ context.Context, backendType coreobjectstore.BackendType, _ string, _ ...internalobjectstore.Option) (internalobjectstore.TrackedObjectStore, error) {
 			atomic.AddInt64(&s.called, 1)
+			s.backendType = backendType
 			return s.trackedObjectStore, nil
 		},
 	}, s.states)
@@ -212,7 +298,9 @@ func (s *workerSuite) setupMocks(c *gc.C) *gomock.Controller {
 }
 
 func (s *workerSuite) expectStatePool(namespace string) {
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{}, nil)
 	s.statePool.EXPECT().Get(namespace).Return(s.mongoSession, nil)
+	s.metadataServiceGetter.EXPECT().ForModelUUID(namespace).Return(s.metadataService)
 }
 
 func (s *workerSuite) ensureStartup(c *gc.C) {
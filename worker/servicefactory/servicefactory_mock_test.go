@@ -17,7 +17,9 @@ import (
 	service6 "github.com/juju/juju/domain/modelconfig/service"
 	service7 "github.com/juju/juju/domain/modeldefaults/service"
 	service8 "github.com/juju/juju/domain/modelmanager/service"
-	service9 "github.com/juju/juju/domain/upgrade/service"
+	service9 "github.com/juju/juju/domain/objectstore/service"
+	service10 "github.com/juju/juju/domain/upgrade/service"
+	service11 "github.com/juju/juju/domain/user/service"
 	servicefactory "github.com/juju/juju/internal/servicefactory"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -45,6 +47,20 @@ func (m *MockControllerServiceFactory) EXPECT() *MockControllerServiceFactoryMoc
 	return m.recorder
 }
 
+// APIToken mocks base method.
+func (m *MockControllerServiceFactory) APIToken() *service11.TokenService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIToken")
+	ret0, _ := ret[0].(*service11.TokenService)
+	return ret0
+}
+
+// APIToken indicates an expected call of APIToken.
+func (mr *MockControllerServiceFactoryMockRecorder) APIToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIToken", reflect.TypeOf((*MockControllerServiceFactory)(nil).APIToken))
+}
+
 // AutocertCache mocks base method.
 func (m *MockControllerServiceFactory) AutocertCache() *service.Service {
 	m.ctrl.T.Helper()
@@ -171,11 +187,25 @@ func (mr *MockControllerServiceFactoryMockRecorder) ModelManager() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelManager", reflect.TypeOf((*MockControllerServiceFactory)(nil).ModelManager))
 }
 
+// Role mocks base method.
+func (m *MockControllerServiceFactory) Role() *service11.RoleService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Role")
+	ret0, _ := ret[0].(*service11.RoleService)
+	return ret0
+}
+
+// Role indicates an expected call of Role.
+func (mr *MockControllerServiceFactoryMockRecorder) Role() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Role", reflect.TypeOf((*MockControllerServiceFactory)(nil).Role))
+}
+
 // Upgrade mocks base method.
-func (m *MockControllerServiceFactory) Upgrade() *service9.Service {
+func (m *MockControllerServiceFactory) Upgrade() *service10.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Upgrade")
-	ret0, _ := ret[0].(*service9.Service)
+	ret0, _ := ret[0].(*service10.Service)
 	return ret0
 }
 
@@ -185,6 +215,20 @@ func (mr *MockControllerServiceFactoryMockRecorder) Upgrade() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockControllerServiceFactory)(nil).Upgrade))
 }
 
+// UserGroup mocks base method.
+func (m *MockControllerServiceFactory) UserGroup() *service11.GroupService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGroup")
+	ret0, _ := ret[0].(*service11.GroupService)
+	return ret0
+}
+
+// UserGroup indicates an expected call of UserGroup.
+func (mr *MockControllerServiceFactoryMockRecorder) UserGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGroup", reflect.TypeOf((*MockControllerServiceFactory)(nil).UserGroup))
+}
+
 // MockModelServiceFactory is a mock of ModelServiceFactory interface.
 type MockModelServiceFactory struct {
 	ctrl     *gomock.Controller
@@ -222,6 +266,20 @@ func (mr *MockModelServiceFactoryMockRecorder) Config(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockModelServiceFactory)(nil).Config), arg0)
 }
 
+// ObjectStore mocks base method.
+func (m *MockModelServiceFactory) ObjectStore() *service9.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStore")
+	ret0, _ := ret[0].(*service9.Service)
+	return ret0
+}
+
+// ObjectStore indicates an expected call of ObjectStore.
+func (mr *MockModelServiceFactoryMockRecorder) ObjectStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStore", reflect.TypeOf((*MockModelServiceFactory)(nil).ObjectStore))
+}
+
 // MockServiceFactory is a mock of ServiceFactory interface.
 type MockServiceFactory struct {
 	ctrl     *gomock.Controller
@@ -245,6 +303,20 @@ func (m *MockServiceFactory) EXPECT() *MockServiceFactoryMockRecorder {
 	return m.recorder
 }
 
+// APIToken mocks base method.
+func (m *MockServiceFactory) APIToken() *service11.TokenService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIToken")
+	ret0, _ := ret[0].(*service11.TokenService)
+	return ret0
+}
+
+// APIToken indicates an expected call of APIToken.
+func (mr *MockServiceFactoryMockRecorder) APIToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIToken", reflect.TypeOf((*MockServiceFactory)(nil).APIToken))
+}
+
 // AutocertCache mocks base method.
 func (m *MockServiceFactory) AutocertCache() *service.Service {
 	m.ctrl.T.Helper()
@@ -385,11 +457,39 @@ func (mr *MockServiceFactoryMockRecorder) ModelManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelManager", reflect.TypeOf((*MockServiceFactory)(nil).ModelManager))
 }
 
+// ObjectStore mocks base method.
+func (m *MockServiceFactory) ObjectStore() *service9.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStore")
+	ret0, _ := ret[0].(*service9.Service)
+	return ret0
+}
+
+// ObjectStore indicates an expected call of ObjectStore.
+func (mr *MockServiceFactoryMockRecorder) ObjectStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStore", reflect.TypeOf((*MockServiceFactory)(nil).ObjectStore))
+}
+
+// Role mocks base method.
+func (m *MockServiceFactory) Role() *service11.RoleService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Role")
+	ret0, _ := ret[0].(*service11.RoleService)
+	return ret0
+}
+
+// Role indicates an expected call of Role.
+func (mr *MockServiceFactoryMockRecorder) Role() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Role", reflect.TypeOf((*MockServiceFactory)(nil).Role))
+}
+
 // Upgrade mocks base method.
-func (m *MockServiceFactory) Upgrade() *service9.Service {
+func (m *MockServiceFactory) Upgrade() *service10.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Upgrade")
-	ret0, _ := ret[0].(*service9.Service)
+	ret0, _ := ret[0].(*service10.Service)
 	return ret0
 }
 
@@ -399,6 +499,20 @@ func (mr *MockServiceFactoryMockRecorder) Upgrade() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockServiceFactory)(nil).Upgrade))
 }
 
+// UserGroup mocks base method.
+func (m *MockServiceFactory) UserGroup() *service11.GroupService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGroup")
+	ret0, _ := ret[0].(*service11.GroupService)
+	return ret0
+}
+
+// UserGroup indicates an expected call of UserGroup.
+func (mr *MockServiceFactoryMockRecorder) UserGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGroup", reflect.TypeOf((*MockServiceFactory)(nil).UserGroup))
+}
+
 // MockServiceFactoryGetter is a mock of ServiceFactoryGetter interface.
 type MockServiceFactoryGetter struct {
 	ctrl     *gomock.Controller
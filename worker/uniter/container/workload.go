@@ -31,6 +31,15 @@ type WorkloadEventType int
 const (
 	// ReadyEvent is triggered when the container/pebble starts up.
 	ReadyEvent WorkloadEventType = iota
+	// CustomNoticeEvent is triggered when the workload records a Pebble
+	// custom notice.
+	CustomNoticeEvent
+	// CheckFailedEvent is triggered when a Pebble check reaches its
+	// failure threshold.
+	CheckFailedEvent
+	// CheckRecoveredEvent is triggered when a failed Pebble check succeeds
+	// again.
+	CheckRecoveredEvent
 )
 
 // WorkloadEvent contains information about the event type and data associated with
@@ -38,6 +47,16 @@ const (
 type WorkloadEvent struct {
 	Type         WorkloadEventType
 	WorkloadName string
+
+	// NoticeID, NoticeType and NoticeKey identify the Pebble notice
+	// that triggered a CustomNoticeEvent.
+	NoticeID   string
+	NoticeType string
+	NoticeKey  string
+
+	// CheckName is the name of the Pebble check that triggered a
+	// CheckFailedEvent or CheckRecoveredEvent.
+	CheckName string
 }
 
 // WorkloadEventCallback is the type used to callback when an event has been processed.
@@ -152,7 +171,7 @@ func (r *workloadHookResolver) NextOp(
 	noOp := func() (operation.Operation, error) {
 		if localState.Kind == operation.RunHook &&
 			localState.Hook != nil &&
-			localState.Hook.Kind == hooks.PebbleReady {
+			hook.IsWorkload(localState.Hook.Kind) {
 			// If we are resuming from an unexpected state, skip hook.
 			return opFactory.NewSkipHook(*localState.Hook)
 		}
@@ -162,7 +181,7 @@ func (r *workloadHookResolver) NextOp(
 	case operation.RunHook:
 		if localState.Step != operation.Pending ||
 			localState.Hook == nil ||
-			localState.Hook.Kind != hooks.PebbleReady {
+			!hook.IsWorkload(localState.Hook.Kind) {
 			break
 		}
 		fallthrough
@@ -174,8 +193,9 @@ func (r *workloadHookResolver) NextOp(
 			} else if err != nil {
 				return nil, errors.Trace(err)
 			}
-			if evt.Type != ReadyEvent {
-				return nil, errors.NotValidf("workload event type %v", evt.Type)
+			hookInfo, err := workloadHookInfo(evt)
+			if err != nil {
+				return nil, errors.Trace(err)
 			}
 			done := func(err error) {
 				cb(err)
@@ -184,10 +204,7 @@ func (r *workloadHookResolver) NextOp(
 					r.eventCompleted(id)
 				}
 			}
-			op, err := opFactory.NewRunHook(hook.Info{
-				Kind:         hooks.PebbleReady,
-				WorkloadName: evt.WorkloadName,
-			})
+			op, err := opFactory.NewRunHook(hookInfo)
 			if err != nil {
 				done(err)
 				return nil, errors.Trace(err)
@@ -202,6 +219,38 @@ func (r *workloadHookResolver) NextOp(
 	return noOp()
 }
 
+// workloadHookInfo returns the hook to run for the workload event.
+func workloadHookInfo(evt WorkloadEvent) (hook.Info, error) {
+	switch evt.Type {
+	case ReadyEvent:
+		return hook.Info{
+			Kind:         hooks.PebbleReady,
+			WorkloadName: evt.WorkloadName,
+		}, nil
+	case CustomNoticeEvent:
+		return hook.Info{
+			Kind:         hook.PebbleCustomNotice,
+			WorkloadName: evt.WorkloadName,
+			NoticeID:     evt.NoticeID,
+			NoticeType:   evt.NoticeType,
+			NoticeKey:    evt.NoticeKey,
+		}, nil
+	case CheckFailedEvent:
+		return hook.Info{
+			Kind:         hook.PebbleCheckFailed,
+			WorkloadName: evt.WorkloadName,
+			CheckName:    evt.CheckName,
+		}, nil
+	case CheckRecoveredEvent:
+		return hook.Info{
+			Kind:         hook.PebbleCheckRecovered,
+			WorkloadName: evt.WorkloadName,
+			CheckName:    evt.CheckName,
+		}, nil
+	}
+	return hook.Info{}, errors.NotValidf("workload event type %v", evt.Type)
+}
+
 // errorWrappedOp calls the handler function when any Prepare, Execute or Commit fail.
 // On success handler will be called once with a nil error.
 type errorWrappedOp struct {
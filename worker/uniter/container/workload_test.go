@@ -4,6 +4,7 @@
 package container_test
 
 import (
+	"github.com/juju/charm/v11/hooks"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	jc "github.com/juju/testing/checkers"
@@ -88,3 +89,110 @@ func (s *workloadSuite) TestWorkloadReadyHook(c *gc.C) {
 		WorkloadName: "test",
 	})
 }
+
+func (s *workloadSuite) TestWorkloadCustomNoticeHook(c *gc.C) {
+	events := container.NewWorkloadEvents()
+	containerResolver := container.NewWorkloadHookResolver(
+		loggo.GetLogger("test"),
+		events,
+		events.RemoveWorkloadEvent)
+	localState := resolver.LocalState{
+		State: operation.State{
+			Kind: operation.Continue,
+			Step: operation.Pending,
+		},
+	}
+	remoteState := remotestate.Snapshot{
+		WorkloadEvents: []string{
+			events.AddWorkloadEvent(container.WorkloadEvent{
+				Type:         container.CustomNoticeEvent,
+				WorkloadName: "test",
+				NoticeID:     "123",
+				NoticeType:   "custom",
+				NoticeKey:    "example.com/foo",
+			}, func(err error) {}),
+		},
+	}
+	opFactory := &mockOperations{}
+	op, err := containerResolver.NextOp(localState, remoteState, opFactory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op, gc.NotNil)
+	op = operation.Unwrap(op)
+	hookOp, ok := op.(*mockRunHookOp)
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(hookOp.hookInfo, gc.DeepEquals, hook.Info{
+		Kind:         "pebble-custom-notice",
+		WorkloadName: "test",
+		NoticeID:     "123",
+		NoticeType:   "custom",
+		NoticeKey:    "example.com/foo",
+	})
+}
+
+func (s *workloadSuite) TestWorkloadCustomNoticeHookSkippedOnResume(c *gc.C) {
+	events := container.NewWorkloadEvents()
+	containerResolver := container.NewWorkloadHookResolver(
+		loggo.GetLogger("test"),
+		events,
+		events.RemoveWorkloadEvent)
+	hookInfo := hook.Info{
+		Kind:         hook.PebbleCustomNotice,
+		WorkloadName: "test",
+		NoticeID:     "123",
+		NoticeType:   "custom",
+		NoticeKey:    "example.com/foo",
+	}
+	localState := resolver.LocalState{
+		State: operation.State{
+			Kind: operation.RunHook,
+			Step: operation.Pending,
+			Hook: &hookInfo,
+		},
+	}
+	// The event that queued the hook was lost when the uniter restarted.
+	op, err := containerResolver.NextOp(localState, remotestate.Snapshot{}, &mockOperations{})
+	c.Assert(err, jc.ErrorIsNil)
+	skipOp, ok := op.(*mockSkipHookOp)
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(skipOp.hookInfo, gc.DeepEquals, hookInfo)
+}
+
+func (s *workloadSuite) TestWorkloadCheckHooks(c *gc.C) {
+	for _, t := range []struct {
+		eventType container.WorkloadEventType
+		kind      string
+	}{
+		{container.CheckFailedEvent, "pebble-check-failed"},
+		{container.CheckRecoveredEvent, "pebble-check-recovered"},
+	} {
+		events := container.NewWorkloadEvents()
+		containerResolver := container.NewWorkloadHookResolver(
+			loggo.GetLogger("test"),
+			events,
+			events.RemoveWorkloadEvent)
+		localState := resolver.LocalState{
+			State: operation.State{
+				Kind: operation.Continue,
+				Step: operation.Pending,
+			},
+		}
+		remoteState := remotestate.Snapshot{
+			WorkloadEvents: []string{
+				events.AddWorkloadEvent(container.WorkloadEvent{
+					Type:         t.eventType,
+					WorkloadName: "test",
+					CheckName:    "ready",
+				}, func(err error) {}),
+			},
+		}
+		op, err := containerResolver.NextOp(localState, remoteState, &mockOperations{})
+		c.Assert(err, jc.ErrorIsNil)
+		hookOp, ok := operation.Unwrap(op).(*mockRunHookOp)
+		c.Assert(ok, jc.IsTrue)
+		c.Check(hookOp.hookInfo, gc.DeepEquals, hook.Info{
+			Kind:         hooks.Kind(t.kind),
+			WorkloadName: "test",
+			CheckName:    "ready",
+		})
+	}
+}
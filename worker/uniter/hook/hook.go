@@ -11,6 +11,33 @@ import (
 	"github.com/juju/juju/core/secrets"
 )
 
+// Workload hook kinds that are defined here until the charm library knows
+// of them. Like hooks.PebbleReady, their hook names are prefixed by the
+// name of the workload.
+const (
+	// PebbleCustomNotice is run when a workload records a Pebble custom
+	// notice.
+	PebbleCustomNotice hooks.Kind = "pebble-custom-notice"
+
+	// PebbleCheckFailed is run when a Pebble check of a workload reaches
+	// its failure threshold.
+	PebbleCheckFailed hooks.Kind = "pebble-check-failed"
+
+	// PebbleCheckRecovered is run when a failed Pebble check of a workload
+	// succeeds again.
+	PebbleCheckRecovered hooks.Kind = "pebble-check-recovered"
+)
+
+// IsWorkload returns whether the kind represents a workload hook, whose
+// hook name is prefixed by the name of the workload.
+func IsWorkload(kind hooks.Kind) bool {
+	switch kind {
+	case PebbleCustomNotice, PebbleCheckFailed, PebbleCheckRecovered:
+		return true
+	}
+	return kind.IsWorkload()
+}
+
 // Info holds details required to execute a hook. Not all fields are
 // relevant to all Kind values.
 type Info struct {
@@ -43,6 +70,18 @@ type Info struct {
 	// WorkloadName is the name of the sidecar container or workload relevant to the hook.
 	WorkloadName string `yaml:"workload-name,omitempty"`
 
+	// NoticeID is the ID of the Pebble notice that triggered the hook.
+	NoticeID string `yaml:"notice-id,omitempty"`
+
+	// NoticeType is the type of the Pebble notice that triggered the hook.
+	NoticeType string `yaml:"notice-type,omitempty"`
+
+	// NoticeKey is the key of the Pebble notice that triggered the hook.
+	NoticeKey string `yaml:"notice-key,omitempty"`
+
+	// CheckName is the name of the Pebble check that triggered the hook.
+	CheckName string `yaml:"check-name,omitempty"`
+
 	// MachineUpgradeTarget is the base that the unit's machine is to be
 	// updated to when Juju is issued the `upgrade-machine` command.
 	// It is only set for the pre-series-upgrade hook.
@@ -88,6 +127,22 @@ func (hi Info) Validate() error {
 			return errors.Errorf("%q hook requires a workload name", hi.Kind)
 		}
 		return nil
+	case PebbleCustomNotice:
+		if hi.WorkloadName == "" {
+			return errors.Errorf("%q hook requires a workload name", hi.Kind)
+		}
+		if hi.NoticeID == "" || hi.NoticeType == "" || hi.NoticeKey == "" {
+			return errors.Errorf("%q hook requires a notice ID, type and key", hi.Kind)
+		}
+		return nil
+	case PebbleCheckFailed, PebbleCheckRecovered:
+		if hi.WorkloadName == "" {
+			return errors.Errorf("%q hook requires a workload name", hi.Kind)
+		}
+		if hi.CheckName == "" {
+			return errors.Errorf("%q hook requires a check name", hi.Kind)
+		}
+		return nil
 	case hooks.PreSeriesUpgrade:
 		if hi.MachineUpgradeTarget == "" {
 			return errors.Errorf("%q hook requires a target base", hi.Kind)
@@ -46,6 +46,18 @@ var validateTests = []struct {
 	}, {
 		hook.Info{Kind: hooks.PebbleReady},
 		`"pebble-ready" hook requires a workload name`,
+	}, {
+		hook.Info{Kind: hook.PebbleCustomNotice, NoticeID: "1", NoticeType: "custom", NoticeKey: "a.com/b"},
+		`"pebble-custom-notice" hook requires a workload name`,
+	}, {
+		hook.Info{Kind: hook.PebbleCustomNotice, WorkloadName: "gitlab"},
+		`"pebble-custom-notice" hook requires a notice ID, type and key`,
+	}, {
+		hook.Info{Kind: hook.PebbleCheckFailed, CheckName: "http"},
+		`"pebble-check-failed" hook requires a workload name`,
+	}, {
+		hook.Info{Kind: hook.PebbleCheckRecovered, WorkloadName: "gitlab"},
+		`"pebble-check-recovered" hook requires a check name`,
 	}, {
 		hook.Info{Kind: hooks.PreSeriesUpgrade},
 		`"pre-series-upgrade" hook requires a target base`,
@@ -77,6 +89,9 @@ var validateTests = []struct {
 	{hook.Info{Kind: hooks.StorageAttached, StorageId: "data/0"}, ""},
 	{hook.Info{Kind: hooks.StorageDetaching, StorageId: "data/0"}, ""},
 	{hook.Info{Kind: hooks.PebbleReady, WorkloadName: "gitlab"}, ""},
+	{hook.Info{Kind: hook.PebbleCustomNotice, WorkloadName: "gitlab", NoticeID: "1", NoticeType: "custom", NoticeKey: "a.com/b"}, ""},
+	{hook.Info{Kind: hook.PebbleCheckFailed, WorkloadName: "gitlab", CheckName: "http"}, ""},
+	{hook.Info{Kind: hook.PebbleCheckRecovered, WorkloadName: "gitlab", CheckName: "http"}, ""},
 	{hook.Info{Kind: hooks.PreSeriesUpgrade, MachineUpgradeTarget: "ubuntu@20.04"}, ""},
 }
 
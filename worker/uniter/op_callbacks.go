@@ -29,7 +29,7 @@ type operationCallbacks struct {
 func (opc *operationCallbacks) PrepareHook(hi hook.Info) (string, error) {
 	name := string(hi.Kind)
 	switch {
-	case hi.Kind.IsWorkload():
+	case hook.IsWorkload(hi.Kind):
 		name = fmt.Sprintf("%s-%s", hi.WorkloadName, hi.Kind)
 	case hi.Kind.IsRelation():
 		var err error
@@ -70,7 +70,7 @@ func (opc *operationCallbacks) CommitHook(hi hook.Info) error {
 		opc.u.Probe.SetHasStarted(true)
 	case hi.Kind == hooks.Stop:
 		opc.u.Probe.SetHasStarted(false)
-	case hi.Kind.IsWorkload():
+	case hook.IsWorkload(hi.Kind):
 	case hi.Kind.IsRelation():
 		return opc.u.relationStateTracker.CommitHook(hi)
 	case hi.Kind.IsStorage():
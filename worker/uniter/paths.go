@@ -124,6 +124,10 @@ type StatePaths struct {
 	// MetricsSpoolDir acts as temporary storage for metrics being sent from
 	// the uniter to state.
 	MetricsSpoolDir string
+
+	// PebbleNoticesFile records, for each container, when the last Pebble
+	// notice handled by the uniter was repeated.
+	PebbleNoticesFile string
 }
 
 // SocketConfig specifies information for remote sockets.
@@ -198,7 +202,8 @@ func NewWorkerPaths(dataDir string, unitTag names.UnitTag, worker string, socket
 			ResourcesDir:    join(baseDir, "resources"),
 			BundlesDir:      join(stateDir, "bundles"),
 			DeployerDir:     join(stateDir, "deployer"),
-			MetricsSpoolDir: join(stateDir, "spool", "metrics"),
+			MetricsSpoolDir:   join(stateDir, "spool", "metrics"),
+			PebbleNoticesFile: join(stateDir, "pebble-notices.yaml"),
 		},
 	}
 }
@@ -50,12 +50,13 @@ func (s *PathsSuite) TestOther(c *gc.C) {
 			LocalJujucServerSocket: uniter.SocketPair{localJujucSocket, localJujucSocket},
 		},
 		State: uniter.StatePaths{
-			BaseDir:         relAgent(),
-			CharmDir:        relAgent("charm"),
-			ResourcesDir:    relAgent("resources"),
-			BundlesDir:      relAgent("state", "bundles"),
-			DeployerDir:     relAgent("state", "deployer"),
-			MetricsSpoolDir: relAgent("state", "spool", "metrics"),
+			BaseDir:           relAgent(),
+			CharmDir:          relAgent("charm"),
+			ResourcesDir:      relAgent("resources"),
+			BundlesDir:        relAgent("state", "bundles"),
+			DeployerDir:       relAgent("state", "deployer"),
+			MetricsSpoolDir:   relAgent("state", "spool", "metrics"),
+			PebbleNoticesFile: relAgent("state", "pebble-notices.yaml"),
 		},
 	})
 }
@@ -91,12 +92,13 @@ func (s *PathsSuite) TestTCPRemote(c *gc.C) {
 			RemoteJujucServerSocket: uniter.SocketPair{remoteJujucServerSocket, remoteJujucClientSocket},
 		},
 		State: uniter.StatePaths{
-			BaseDir:         relAgent(),
-			CharmDir:        relAgent("charm"),
-			ResourcesDir:    relAgent("resources"),
-			BundlesDir:      relAgent("state", "bundles"),
-			DeployerDir:     relAgent("state", "deployer"),
-			MetricsSpoolDir: relAgent("state", "spool", "metrics"),
+			BaseDir:           relAgent(),
+			CharmDir:          relAgent("charm"),
+			ResourcesDir:      relAgent("resources"),
+			BundlesDir:        relAgent("state", "bundles"),
+			DeployerDir:       relAgent("state", "deployer"),
+			MetricsSpoolDir:   relAgent("state", "spool", "metrics"),
+			PebbleNoticesFile: relAgent("state", "pebble-notices.yaml"),
 		},
 	})
 }
@@ -120,12 +122,13 @@ func (s *PathsSuite) TestWorkerPaths(c *gc.C) {
 			LocalJujucServerSocket: uniter.SocketPair{localJujucSocket, localJujucSocket},
 		},
 		State: uniter.StatePaths{
-			BaseDir:         relAgent(),
-			CharmDir:        relAgent("charm"),
-			ResourcesDir:    relAgent("resources"),
-			BundlesDir:      relAgent("state", "bundles"),
-			DeployerDir:     relAgent("state", "deployer"),
-			MetricsSpoolDir: relAgent("state", "spool", "metrics"),
+			BaseDir:           relAgent(),
+			CharmDir:          relAgent("charm"),
+			ResourcesDir:      relAgent("resources"),
+			BundlesDir:        relAgent("state", "bundles"),
+			DeployerDir:       relAgent("state", "deployer"),
+			MetricsSpoolDir:   relAgent("state", "spool", "metrics"),
+			PebbleNoticesFile: relAgent("state", "pebble-notices.yaml"),
 		},
 	})
 }
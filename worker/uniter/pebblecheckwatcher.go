@@ -0,0 +1,143 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package uniter
+
+import (
+	"path"
+
+	"github.com/canonical/pebble/client"
+	"github.com/juju/clock"
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v3"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/worker/uniter/container"
+)
+
+type pebbleCheckWatcher struct {
+	logger          Logger
+	clock           clock.Clock
+	tomb            tomb.Tomb
+	newPebbleClient NewPebbleClientFunc
+
+	workloadEventChan chan string
+	workloadEvents    container.WorkloadEvents
+}
+
+// NewPebbleCheckWatcher starts a worker that polls the status of the
+// Pebble checks of the supplied container list, and queues a
+// pebble-check-failed workload event when a check goes down and a
+// pebble-check-recovered workload event when it comes back up.
+//
+// A check that is already down when the worker starts is reported as
+// failed, so that a failure while the unit agent was down isn't missed.
+func NewPebbleCheckWatcher(logger Logger,
+	clock clock.Clock,
+	containerNames []string,
+	workloadEventChan chan string,
+	workloadEvents container.WorkloadEvents,
+	newPebbleClient NewPebbleClientFunc) worker.Worker {
+	if newPebbleClient == nil {
+		newPebbleClient = defaultPebbleClient
+	}
+	w := &pebbleCheckWatcher{
+		logger:            logger,
+		clock:             clock,
+		workloadEventChan: workloadEventChan,
+		workloadEvents:    workloadEvents,
+		newPebbleClient:   newPebbleClient,
+	}
+	for _, v := range containerNames {
+		containerName := v
+		w.tomb.Go(func() error {
+			return w.run(containerName)
+		})
+	}
+	return w
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *pebbleCheckWatcher) Kill() {
+	w.tomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *pebbleCheckWatcher) Wait() error {
+	return w.tomb.Wait()
+}
+
+func (w *pebbleCheckWatcher) run(containerName string) error {
+	// failed holds the names of the checks that the charm has been told
+	// are down.
+	failed := set.NewStrings()
+	timer := w.clock.NewTimer(pebblePollInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.tomb.Dying():
+			return tomb.ErrDying
+		case <-timer.Chan():
+			timer.Reset(pebblePollInterval)
+			err := w.poll(containerName, failed)
+			var socketNotFound *client.SocketNotFoundError
+			if errors.As(err, &socketNotFound) {
+				w.logger.Debugf("pebble still starting up on container %q: %v", containerName, socketNotFound)
+			} else if err != nil && err != tomb.ErrDying {
+				w.logger.Errorf("pebble checks poll failed for container %q: %v", containerName, err)
+			}
+		}
+	}
+}
+
+func (w *pebbleCheckWatcher) poll(containerName string, failed set.Strings) error {
+	config := &client.Config{
+		Socket: path.Join("/charm/containers", containerName, "pebble.socket"),
+	}
+	pc, err := w.newPebbleClient(config)
+	if err != nil {
+		return errors.Annotate(err, "failed to create Pebble client")
+	}
+	defer pc.CloseIdleConnections()
+	checks, err := pc.Checks(&client.ChecksOptions{})
+	if err != nil {
+		return errors.Annotate(err, "failed to get pebble checks")
+	}
+
+	current := set.NewStrings()
+	for _, check := range checks {
+		current.Add(check.Name)
+		var eventType container.WorkloadEventType
+		switch {
+		case check.Status == client.CheckStatusDown && !failed.Contains(check.Name):
+			eventType = container.CheckFailedEvent
+		case check.Status == client.CheckStatusUp && failed.Contains(check.Name):
+			eventType = container.CheckRecoveredEvent
+		default:
+			continue
+		}
+		err := queueWorkloadEvent(w.tomb.Dying(), w.workloadEventChan, w.workloadEvents, container.WorkloadEvent{
+			Type:         eventType,
+			WorkloadName: containerName,
+			CheckName:    check.Name,
+		})
+		if err == tomb.ErrDying {
+			return err
+		} else if err != nil {
+			return errors.Annotatef(err, "failed to send event for pebble check %q", check.Name)
+		}
+		if eventType == container.CheckFailedEvent {
+			failed.Add(check.Name)
+		} else {
+			failed.Remove(check.Name)
+		}
+	}
+
+	// Forget the checks that have been removed from the plan, so that a
+	// check added again with the same name starts afresh.
+	for _, name := range failed.Difference(current).Values() {
+		failed.Remove(name)
+	}
+	return nil
+}
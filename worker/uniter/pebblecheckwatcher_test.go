@@ -0,0 +1,137 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package uniter_test
+
+import (
+	"time"
+
+	pebbleclient "github.com/canonical/pebble/client"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/uniter"
+	"github.com/juju/juju/worker/uniter/container"
+)
+
+type pebbleCheckWatcherSuite struct {
+	client            *fakePebbleClient
+	clock             *testclock.Clock
+	workloadEventChan chan string
+	workloadEvents    container.WorkloadEvents
+}
+
+var _ = gc.Suite(&pebbleCheckWatcherSuite{})
+
+func (s *pebbleCheckWatcherSuite) SetUpTest(c *gc.C) {
+	s.client = &fakePebbleClient{err: errors.Errorf("not yet workin")}
+	s.clock = testclock.NewClock(time.Time{})
+	s.workloadEventChan = make(chan string)
+	s.workloadEvents = container.NewWorkloadEvents()
+}
+
+func (s *pebbleCheckWatcherSuite) start(c *gc.C) func() {
+	newClient := func(cfg *pebbleclient.Config) (uniter.PebbleClient, error) {
+		c.Assert(cfg.Socket, gc.Equals, "/charm/containers/redis/pebble.socket")
+		return s.client, nil
+	}
+	worker := uniter.NewPebbleCheckWatcher(loggo.GetLogger("test"), s.clock, []string{"redis"}, s.workloadEventChan, s.workloadEvents, newClient)
+	return func() { workertest.CleanKill(c, worker) }
+}
+
+// nextEvent advances the clock until a workload event is queued, and
+// completes it with the given error.
+func (s *pebbleCheckWatcherSuite) nextEvent(c *gc.C, hookErr error) container.WorkloadEvent {
+	timeout := time.After(testing.LongWait)
+	for {
+		select {
+		case id := <-s.workloadEventChan:
+			evt, cb, err := s.workloadEvents.GetWorkloadEvent(id)
+			c.Assert(err, jc.ErrorIsNil)
+			cb(hookErr)
+			return evt
+		case <-time.After(testing.ShortWait):
+			s.clock.Advance(5 * time.Second)
+		case <-timeout:
+			c.Fatalf("timed out waiting for event id")
+			return container.WorkloadEvent{}
+		}
+	}
+}
+
+func (s *pebbleCheckWatcherSuite) assertNoEvent(c *gc.C) {
+	for i := 0; i < 3; i++ {
+		s.clock.Advance(5 * time.Second)
+		select {
+		case id := <-s.workloadEventChan:
+			evt, _, _ := s.workloadEvents.GetWorkloadEvent(id)
+			c.Fatalf("unexpected event %+v", evt)
+		case <-time.After(testing.ShortWait):
+		}
+	}
+}
+
+func (s *pebbleCheckWatcherSuite) TestFailedAndRecovered(c *gc.C) {
+	defer s.start(c)()
+
+	s.client.SetChecks(
+		&pebbleclient.CheckInfo{Name: "alive", Status: pebbleclient.CheckStatusUp},
+		&pebbleclient.CheckInfo{Name: "ready", Status: pebbleclient.CheckStatusUp},
+	)
+	s.assertNoEvent(c)
+
+	s.client.SetChecks(
+		&pebbleclient.CheckInfo{Name: "alive", Status: pebbleclient.CheckStatusUp},
+		&pebbleclient.CheckInfo{Name: "ready", Status: pebbleclient.CheckStatusDown, Failures: 3, Threshold: 3},
+	)
+	c.Assert(s.nextEvent(c, nil), gc.DeepEquals, container.WorkloadEvent{
+		Type:         container.CheckFailedEvent,
+		WorkloadName: "redis",
+		CheckName:    "ready",
+	})
+	// The charm is only told once that the check failed.
+	s.assertNoEvent(c)
+
+	s.client.SetChecks(
+		&pebbleclient.CheckInfo{Name: "alive", Status: pebbleclient.CheckStatusUp},
+		&pebbleclient.CheckInfo{Name: "ready", Status: pebbleclient.CheckStatusUp},
+	)
+	c.Assert(s.nextEvent(c, nil), gc.DeepEquals, container.WorkloadEvent{
+		Type:         container.CheckRecoveredEvent,
+		WorkloadName: "redis",
+		CheckName:    "ready",
+	})
+	s.assertNoEvent(c)
+}
+
+func (s *pebbleCheckWatcherSuite) TestFailedHookRetried(c *gc.C) {
+	defer s.start(c)()
+
+	s.client.SetChecks(&pebbleclient.CheckInfo{Name: "ready", Status: pebbleclient.CheckStatusDown})
+	evt := s.nextEvent(c, errors.New("hook failed"))
+	c.Assert(evt.Type, gc.Equals, container.CheckFailedEvent)
+
+	// The charm wasn't told, so it's told again.
+	evt = s.nextEvent(c, nil)
+	c.Assert(evt.Type, gc.Equals, container.CheckFailedEvent)
+	s.assertNoEvent(c)
+}
+
+func (s *pebbleCheckWatcherSuite) TestRemovedCheckForgotten(c *gc.C) {
+	defer s.start(c)()
+
+	s.client.SetChecks(&pebbleclient.CheckInfo{Name: "ready", Status: pebbleclient.CheckStatusDown})
+	c.Assert(s.nextEvent(c, nil).Type, gc.Equals, container.CheckFailedEvent)
+
+	s.client.SetChecks()
+	s.assertNoEvent(c)
+
+	// A new check with the same name that is up doesn't recover.
+	s.client.SetChecks(&pebbleclient.CheckInfo{Name: "ready", Status: pebbleclient.CheckStatusUp})
+	s.assertNoEvent(c)
+}
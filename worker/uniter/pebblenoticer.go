@@ -0,0 +1,182 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package uniter
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/canonical/pebble/client"
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/utils/v3"
+	"github.com/juju/worker/v3"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/internal/service/pebble/notice"
+	"github.com/juju/juju/worker/uniter/container"
+)
+
+type pebbleNoticer struct {
+	logger          Logger
+	clock           clock.Clock
+	tomb            tomb.Tomb
+	newPebbleClient NewPebbleClientFunc
+
+	workloadEventChan chan string
+	workloadEvents    container.WorkloadEvents
+
+	// noticesPath is the file recording when the last notice handled
+	// for each container was repeated.
+	noticesPath  string
+	mu           sync.Mutex
+	lastRepeated map[string]time.Time
+}
+
+const (
+	// pebbleNoticeTimeout is how long each request to Pebble waits for
+	// new notices.
+	pebbleNoticeTimeout = 30 * time.Second
+
+	// pebbleNoticeRetryDelay is how long to wait before asking Pebble for
+	// notices again after a failure.
+	pebbleNoticeRetryDelay = 5 * time.Second
+)
+
+// NewPebbleNoticer starts a worker that waits for custom notices on the
+// pebble interfaces of the supplied container list, and queues a
+// pebble-custom-notice workload event for each.
+//
+// Once the hook for a notice has run, the time the notice was last
+// repeated is recorded in the file at noticesPath. When the worker starts
+// it asks only for notices repeated since, so a notice recorded while the
+// unit agent was down is delivered, but one already handled is not
+// delivered again.
+func NewPebbleNoticer(logger Logger,
+	clock clock.Clock,
+	containerNames []string,
+	noticesPath string,
+	workloadEventChan chan string,
+	workloadEvents container.WorkloadEvents,
+	newPebbleClient NewPebbleClientFunc) worker.Worker {
+	if newPebbleClient == nil {
+		newPebbleClient = defaultPebbleClient
+	}
+	n := &pebbleNoticer{
+		logger:            logger,
+		clock:             clock,
+		workloadEventChan: workloadEventChan,
+		workloadEvents:    workloadEvents,
+		newPebbleClient:   newPebbleClient,
+		noticesPath:       noticesPath,
+		lastRepeated:      make(map[string]time.Time),
+	}
+	if err := utils.ReadYaml(noticesPath, &n.lastRepeated); err != nil && !os.IsNotExist(err) {
+		logger.Warningf("cannot read handled pebble notices; all notices will be delivered: %v", err)
+	}
+	for _, v := range containerNames {
+		containerName := v
+		n.tomb.Go(func() error {
+			return n.run(containerName)
+		})
+	}
+	return n
+}
+
+// Kill is part of the worker.Worker interface.
+func (n *pebbleNoticer) Kill() {
+	n.tomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (n *pebbleNoticer) Wait() error {
+	return n.tomb.Wait()
+}
+
+func (n *pebbleNoticer) run(containerName string) error {
+	config := &client.Config{
+		Socket: path.Join("/charm/containers", containerName, "pebble.socket"),
+	}
+	pc, err := n.newPebbleClient(config)
+	if err != nil {
+		return errors.Annotate(err, "failed to create Pebble client")
+	}
+	defer pc.CloseIdleConnections()
+
+	ctx := n.tomb.Context(nil)
+	after := n.lastRepeated[containerName]
+	for {
+		notices, err := pc.WaitNotices(ctx, pebbleNoticeTimeout, &notice.NoticesOptions{
+			Types: []notice.NoticeType{notice.CustomNotice},
+			After: after,
+		})
+		if err == nil {
+			for _, nt := range notices {
+				if err = n.processNotice(containerName, nt); err != nil {
+					break
+				}
+				after = nt.LastRepeated
+				n.setLastRepeated(containerName, after)
+			}
+			if err == nil {
+				continue
+			}
+		}
+
+		select {
+		case <-n.tomb.Dying():
+			return tomb.ErrDying
+		default:
+		}
+		var socketNotFound *client.SocketNotFoundError
+		if errors.As(err, &socketNotFound) {
+			n.logger.Debugf("pebble still starting up on container %q: %v", containerName, socketNotFound)
+		} else if errors.Is(err, errors.NotSupported) {
+			n.logger.Debugf("pebble on container %q doesn't support notices", containerName)
+		} else {
+			n.logger.Errorf("pebble notices failed for container %q: %v", containerName, err)
+		}
+		select {
+		case <-n.tomb.Dying():
+			return tomb.ErrDying
+		case <-n.clock.After(pebbleNoticeRetryDelay):
+		}
+	}
+}
+
+func (n *pebbleNoticer) processNotice(containerName string, nt *notice.Notice) error {
+	err := queueWorkloadEvent(n.tomb.Dying(), n.workloadEventChan, n.workloadEvents, container.WorkloadEvent{
+		Type:         container.CustomNoticeEvent,
+		WorkloadName: containerName,
+		NoticeID:     nt.ID,
+		NoticeType:   string(nt.Type),
+		NoticeKey:    nt.Key,
+	})
+	if err == tomb.ErrDying {
+		return err
+	} else if err != nil {
+		return errors.Annotatef(err, "failed to send pebble-custom-notice event for notice %s", nt.ID)
+	}
+	return nil
+}
+
+// setLastRepeated records that the notices for the container repeated up
+// to the input time have been handled. Failing to record it only means the
+// notices are delivered again after a restart, so it isn't fatal.
+func (n *pebbleNoticer) setLastRepeated(containerName string, lastRepeated time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.lastRepeated[containerName] = lastRepeated
+	if err := os.MkdirAll(filepath.Dir(n.noticesPath), 0755); err != nil {
+		n.logger.Warningf("cannot record handled pebble notices: %v", err)
+		return
+	}
+	if err := utils.WriteYaml(n.noticesPath, n.lastRepeated); err != nil {
+		n.logger.Warningf("cannot record handled pebble notices: %v", err)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package uniter_test
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	pebbleclient "github.com/canonical/pebble/client"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v3/workertest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/service/pebble/notice"
+	"github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/uniter"
+	"github.com/juju/juju/worker/uniter/container"
+)
+
+type pebbleNoticerSuite struct {
+	noticesPath string
+}
+
+var _ = gc.Suite(&pebbleNoticerSuite{})
+
+func (s *pebbleNoticerSuite) SetUpTest(c *gc.C) {
+	s.noticesPath = filepath.Join(c.MkDir(), "state", "pebble-notices.yaml")
+}
+
+// noticesResult is the result of a single WaitNotices call.
+type noticesResult struct {
+	notices []*notice.Notice
+	err     error
+}
+
+type fakeNoticesClient struct {
+	fakePebbleClient
+	requests chan *notice.NoticesOptions
+	results  chan noticesResult
+}
+
+func newFakeNoticesClient() *fakeNoticesClient {
+	return &fakeNoticesClient{
+		requests: make(chan *notice.NoticesOptions, 10),
+		results:  make(chan noticesResult),
+	}
+}
+
+func (c *fakeNoticesClient) WaitNotices(ctx context.Context, serverTimeout time.Duration, opts *notice.NoticesOptions) ([]*notice.Notice, error) {
+	c.requests <- opts
+	select {
+	case result := <-c.results:
+		return result.notices, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *pebbleNoticerSuite) start(c *gc.C, clients map[string]*fakeNoticesClient) (*testclock.Clock, chan string, container.WorkloadEvents, func()) {
+	newClient := func(cfg *pebbleclient.Config) (uniter.PebbleClient, error) {
+		res := pebbleSocketPathRegexp.FindAllStringSubmatch(cfg.Socket, 1)
+		return clients[res[0][1]], nil
+	}
+	clock := testclock.NewClock(time.Time{})
+	var containerNames []string
+	for name := range clients {
+		containerNames = append(containerNames, name)
+	}
+	workloadEventChan := make(chan string)
+	workloadEvents := container.NewWorkloadEvents()
+	worker := uniter.NewPebbleNoticer(loggo.GetLogger("test"), clock, containerNames, s.noticesPath, workloadEventChan, workloadEvents, newClient)
+	return clock, workloadEventChan, workloadEvents, func() { workertest.CleanKill(c, worker) }
+}
+
+func (s *pebbleNoticerSuite) nextRequest(c *gc.C, client *fakeNoticesClient) *notice.NoticesOptions {
+	select {
+	case opts := <-client.requests:
+		return opts
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for notices request")
+	}
+	return nil
+}
+
+func (s *pebbleNoticerSuite) TestCustomNotices(c *gc.C) {
+	client := newFakeNoticesClient()
+	_, workloadEventChan, workloadEvents, kill := s.start(c, map[string]*fakeNoticesClient{"redis": client})
+	defer kill()
+
+	opts := s.nextRequest(c, client)
+	c.Assert(opts.Types, jc.DeepEquals, []notice.NoticeType{notice.CustomNotice})
+	c.Assert(opts.After.IsZero(), jc.IsTrue)
+
+	lastRepeated := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	client.results <- noticesResult{notices: []*notice.Notice{{
+		ID:           "1",
+		Type:         notice.CustomNotice,
+		Key:          "example.com/db-ready",
+		LastRepeated: lastRepeated,
+	}}}
+
+	select {
+	case id := <-workloadEventChan:
+		evt, cb, err := workloadEvents.GetWorkloadEvent(id)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(evt, gc.DeepEquals, container.WorkloadEvent{
+			Type:         container.CustomNoticeEvent,
+			WorkloadName: "redis",
+			NoticeID:     "1",
+			NoticeType:   "custom",
+			NoticeKey:    "example.com/db-ready",
+		})
+		cb(nil)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for event id")
+	}
+
+	// The next request only asks for notices repeated since.
+	opts = s.nextRequest(c, client)
+	c.Assert(opts.After, gc.Equals, lastRepeated)
+	c.Assert(workloadEvents.Events(), gc.HasLen, 0)
+}
+
+func (s *pebbleNoticerSuite) TestResumesAfterRestart(c *gc.C) {
+	redis := newFakeNoticesClient()
+	mysql := newFakeNoticesClient()
+	_, workloadEventChan, workloadEvents, kill := s.start(c, map[string]*fakeNoticesClient{
+		"redis": redis,
+		"mysql": mysql,
+	})
+
+	s.nextRequest(c, redis)
+	s.nextRequest(c, mysql)
+	lastRepeated := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	redis.results <- noticesResult{notices: []*notice.Notice{{
+		ID:           "1",
+		Type:         notice.CustomNotice,
+		Key:          "example.com/db-ready",
+		LastRepeated: lastRepeated,
+	}}}
+
+	select {
+	case id := <-workloadEventChan:
+		_, cb, err := workloadEvents.GetWorkloadEvent(id)
+		c.Assert(err, jc.ErrorIsNil)
+		cb(nil)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for event id")
+	}
+	s.nextRequest(c, redis)
+	kill()
+
+	// After a restart only notices repeated since the handled one are
+	// asked for, so its hook isn't run again.
+	redis = newFakeNoticesClient()
+	mysql = newFakeNoticesClient()
+	_, _, _, kill = s.start(c, map[string]*fakeNoticesClient{
+		"redis": redis,
+		"mysql": mysql,
+	})
+	defer kill()
+
+	opts := s.nextRequest(c, redis)
+	c.Check(opts.After, jc.DeepEquals, lastRepeated)
+	opts = s.nextRequest(c, mysql)
+	c.Check(opts.After.IsZero(), jc.IsTrue)
+}
+
+func (s *pebbleNoticerSuite) TestRetriesFailedNotice(c *gc.C) {
+	client := newFakeNoticesClient()
+	clock, workloadEventChan, workloadEvents, kill := s.start(c, map[string]*fakeNoticesClient{"redis": client})
+	defer kill()
+
+	s.nextRequest(c, client)
+	client.results <- noticesResult{notices: []*notice.Notice{{
+		ID:           "1",
+		Type:         notice.CustomNotice,
+		Key:          "example.com/db-ready",
+		LastRepeated: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	}}}
+
+	select {
+	case id := <-workloadEventChan:
+		_, cb, err := workloadEvents.GetWorkloadEvent(id)
+		c.Assert(err, jc.ErrorIsNil)
+		cb(errors.New("hook failed"))
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for event id")
+	}
+
+	// The notice wasn't handled, so it's asked for again after a delay.
+	err := clock.WaitAdvance(5*time.Second, testing.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	opts := s.nextRequest(c, client)
+	c.Assert(opts.After.IsZero(), jc.IsTrue)
+}
+
+func (s *pebbleNoticerSuite) TestRetriesRequestErrors(c *gc.C) {
+	client := newFakeNoticesClient()
+	clock, _, _, kill := s.start(c, map[string]*fakeNoticesClient{"redis": client})
+	defer kill()
+
+	s.nextRequest(c, client)
+	client.results <- noticesResult{err: errors.NotSupportedf("pebble notices")}
+
+	err := clock.WaitAdvance(5*time.Second, testing.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	s.nextRequest(c, client)
+}
@@ -4,6 +4,7 @@
 package uniter
 
 import (
+	"context"
 	"path"
 	"sync"
 	"time"
@@ -14,16 +15,49 @@ import (
 	"github.com/juju/worker/v3"
 	"gopkg.in/tomb.v2"
 
+	"github.com/juju/juju/internal/service/pebble/notice"
 	"github.com/juju/juju/worker/uniter/container"
 )
 
 // PebbleClient describes the subset of github.com/canonical/pebble/client.Client that we
-// need for the PebblePoller.
+// need for the PebblePoller and PebbleCheckWatcher, along with the notices API
+// needed by the PebbleNoticer.
 type PebbleClient interface {
 	SysInfo() (*client.SysInfo, error)
+	Checks(opts *client.ChecksOptions) ([]*client.CheckInfo, error)
+	WaitNotices(ctx context.Context, serverTimeout time.Duration, opts *notice.NoticesOptions) ([]*notice.Notice, error)
 	CloseIdleConnections()
 }
 
+// pebbleClient is the default PebbleClient, which talks to the notices API
+// with a separate client.
+type pebbleClient struct {
+	*client.Client
+	notices *notice.Client
+}
+
+func defaultPebbleClient(config *client.Config) (PebbleClient, error) {
+	pc, err := client.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &pebbleClient{
+		Client:  pc,
+		notices: notice.New(config.Socket),
+	}, nil
+}
+
+// WaitNotices implements PebbleClient.
+func (c *pebbleClient) WaitNotices(ctx context.Context, serverTimeout time.Duration, opts *notice.NoticesOptions) ([]*notice.Notice, error) {
+	return c.notices.WaitNotices(ctx, serverTimeout, opts)
+}
+
+// CloseIdleConnections implements PebbleClient.
+func (c *pebbleClient) CloseIdleConnections() {
+	c.Client.CloseIdleConnections()
+	c.notices.CloseIdleConnections()
+}
+
 // NewPebbleClientFunc is the function type used to create a PebbleClient.
 type NewPebbleClientFunc func(*client.Config) (PebbleClient, error)
 
@@ -53,9 +87,7 @@ func NewPebblePoller(logger Logger,
 	workloadEvents container.WorkloadEvents,
 	newPebbleClient NewPebbleClientFunc) worker.Worker {
 	if newPebbleClient == nil {
-		newPebbleClient = func(config *client.Config) (PebbleClient, error) {
-			return client.New(config)
-		}
+		newPebbleClient = defaultPebbleClient
 	}
 	p := &pebblePoller{
 		logger:            logger,
@@ -128,33 +160,47 @@ func (p *pebblePoller) poll(containerName string) error {
 	}
 
 	// We've just started up, so send a pebble-ready event.
-	errChan := make(chan error, 1)
-	eid := p.workloadEvents.AddWorkloadEvent(container.WorkloadEvent{
+	err = queueWorkloadEvent(p.tomb.Dying(), p.workloadEventChan, p.workloadEvents, container.WorkloadEvent{
 		Type:         container.ReadyEvent,
 		WorkloadName: containerName,
-	}, func(err error) {
+	})
+	if err == tomb.ErrDying {
+		return err
+	} else if err != nil {
+		return errors.Annotate(err, "failed to send pebble-ready event")
+	}
+
+	p.mut.Lock()
+	p.pebbleBootIDs[containerName] = info.BootID
+	p.mut.Unlock()
+
+	return nil
+}
+
+// queueWorkloadEvent queues the workload event for the uniter, and waits
+// for the hook it triggers to complete.
+func queueWorkloadEvent(
+	dying <-chan struct{},
+	workloadEventChan chan string,
+	workloadEvents container.WorkloadEvents,
+	evt container.WorkloadEvent,
+) error {
+	errChan := make(chan error, 1)
+	eid := workloadEvents.AddWorkloadEvent(evt, func(err error) {
 		errChan <- errors.Trace(err)
 	})
-	defer p.workloadEvents.RemoveWorkloadEvent(eid)
+	defer workloadEvents.RemoveWorkloadEvent(eid)
 
 	select {
-	case p.workloadEventChan <- eid:
-	case <-p.tomb.Dying():
+	case workloadEventChan <- eid:
+	case <-dying:
 		return tomb.ErrDying
 	}
 
 	select {
 	case err := <-errChan:
-		if err != nil {
-			return errors.Annotate(err, "failed to send pebble-ready event")
-		}
-	case <-p.tomb.Dying():
+		return err
+	case <-dying:
 		return tomb.ErrDying
 	}
-
-	p.mut.Lock()
-	p.pebbleBootIDs[containerName] = info.BootID
-	p.mut.Unlock()
-
-	return nil
 }
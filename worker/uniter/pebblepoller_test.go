@@ -4,6 +4,7 @@
 package uniter_test
 
 import (
+	"context"
 	"regexp"
 	"sync"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/juju/worker/v3/workertest"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/internal/service/pebble/notice"
 	"github.com/juju/juju/testing"
 	"github.com/juju/juju/worker/uniter"
 	"github.com/juju/juju/worker/uniter/container"
@@ -114,6 +116,7 @@ func (s *pebblePollerSuite) TestStart(c *gc.C) {
 
 type fakePebbleClient struct {
 	sysInfo pebbleclient.SysInfo
+	checks  []*pebbleclient.CheckInfo
 	err     error
 	mut     sync.Mutex
 	closed  bool
@@ -129,6 +132,32 @@ func (c *fakePebbleClient) SysInfo() (*pebbleclient.SysInfo, error) {
 	return &sysInfoCopy, nil
 }
 
+func (c *fakePebbleClient) Checks(*pebbleclient.ChecksOptions) ([]*pebbleclient.CheckInfo, error) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	checks := make([]*pebbleclient.CheckInfo, len(c.checks))
+	for i, check := range c.checks {
+		checkCopy := *check
+		checks[i] = &checkCopy
+	}
+	return checks, nil
+}
+
+func (c *fakePebbleClient) SetChecks(checks ...*pebbleclient.CheckInfo) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.err = nil
+	c.checks = checks
+}
+
+func (c *fakePebbleClient) WaitNotices(ctx context.Context, _ time.Duration, _ *notice.NoticesOptions) ([]*notice.Notice, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
 func (c *fakePebbleClient) TriggerStart() {
 	c.mut.Lock()
 	defer c.mut.Unlock()
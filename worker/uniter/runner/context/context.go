@@ -307,6 +307,18 @@ type HookContext struct {
 	// workloadName is the name of the container which the hook is in relation to.
 	workloadName string
 
+	// noticeID is the ID of the Pebble notice that triggered the hook.
+	noticeID string
+
+	// noticeType is the type of the Pebble notice that triggered the hook.
+	noticeType string
+
+	// noticeKey is the key of the Pebble notice that triggered the hook.
+	noticeKey string
+
+	// checkName is the name of the Pebble check that triggered the hook.
+	checkName string
+
 	// baseUpgradeTarget is the base that the unit's machine is to be
 	// updated to when Juju is issued the `upgrade-machine` command.
 	baseUpgradeTarget string
@@ -1276,6 +1288,16 @@ func (ctx *HookContext) HookVars(
 	if ctx.workloadName != "" {
 		vars = append(vars, "JUJU_WORKLOAD_NAME="+ctx.workloadName)
 	}
+	if ctx.noticeID != "" {
+		vars = append(vars,
+			"JUJU_NOTICE_ID="+ctx.noticeID,
+			"JUJU_NOTICE_TYPE="+ctx.noticeType,
+			"JUJU_NOTICE_KEY="+ctx.noticeKey,
+		)
+	}
+	if ctx.checkName != "" {
+		vars = append(vars, "JUJU_PEBBLE_CHECK_NAME="+ctx.checkName)
+	}
 
 	if ctx.baseUpgradeTarget != "" {
 		// We need to set both the base and the series for the hook. This until
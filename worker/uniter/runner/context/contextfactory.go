@@ -270,10 +270,18 @@ func (f *contextFactory) HookContext(hookInfo hook.Info) (*HookContext, error) {
 			return nil, errors.Annotatef(err, "could not retrieve storage for id: %v", hookInfo.StorageId)
 		}
 	}
-	if hookInfo.Kind.IsWorkload() {
+	if hook.IsWorkload(hookInfo.Kind) {
 		ctx.workloadName = hookInfo.WorkloadName
 		hookName = fmt.Sprintf("%s-%s", hookInfo.WorkloadName, hookName)
 	}
+	if hookInfo.Kind == hook.PebbleCustomNotice {
+		ctx.noticeID = hookInfo.NoticeID
+		ctx.noticeType = hookInfo.NoticeType
+		ctx.noticeKey = hookInfo.NoticeKey
+	}
+	if hookInfo.Kind == hook.PebbleCheckFailed || hookInfo.Kind == hook.PebbleCheckRecovered {
+		ctx.checkName = hookInfo.CheckName
+	}
 	if hookInfo.Kind == hooks.PreSeriesUpgrade {
 		ctx.baseUpgradeTarget = hookInfo.MachineUpgradeTarget
 	}
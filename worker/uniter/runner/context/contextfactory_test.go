@@ -164,6 +164,50 @@ func (s *ContextFactorySuite) TestWorkloadHookContext(c *gc.C) {
 	s.AssertNotSecretContext(c, ctx)
 }
 
+func (s *ContextFactorySuite) TestWorkloadCustomNoticeHookContext(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	s.setupContextFactory(c, ctrl)
+
+	hi := hook.Info{
+		Kind:         hook.PebbleCustomNotice,
+		WorkloadName: "test",
+		NoticeID:     "123",
+		NoticeType:   "custom",
+		NoticeKey:    "example.com/foo",
+	}
+	ctx, err := s.factory.HookContext(hi)
+	c.Assert(err, jc.ErrorIsNil)
+	s.AssertCoreContext(c, ctx)
+	s.AssertWorkloadContext(c, ctx, "test")
+	c.Assert(ctx.Id(), gc.Matches, `u/0-test-pebble-custom-notice-[0-9]+`)
+	s.AssertNotActionContext(c, ctx)
+	s.AssertNotRelationContext(c, ctx)
+	s.AssertNotStorageContext(c, ctx)
+	s.AssertNotSecretContext(c, ctx)
+}
+
+func (s *ContextFactorySuite) TestWorkloadCheckHookContext(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	s.setupContextFactory(c, ctrl)
+
+	hi := hook.Info{
+		Kind:         hook.PebbleCheckFailed,
+		WorkloadName: "test",
+		CheckName:    "ready",
+	}
+	ctx, err := s.factory.HookContext(hi)
+	c.Assert(err, jc.ErrorIsNil)
+	s.AssertCoreContext(c, ctx)
+	s.AssertWorkloadContext(c, ctx, "test")
+	c.Assert(ctx.Id(), gc.Matches, `u/0-test-pebble-check-failed-[0-9]+`)
+	s.AssertNotActionContext(c, ctx)
+	s.AssertNotRelationContext(c, ctx)
+	s.AssertNotStorageContext(c, ctx)
+	s.AssertNotSecretContext(c, ctx)
+}
+
 func (s *ContextFactorySuite) TestNewHookContextWithStorage(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
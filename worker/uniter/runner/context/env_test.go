@@ -140,6 +140,24 @@ func (s *EnvSuite) setSecret(ctx *context.HookContext) (expectVars []string) {
 	}
 }
 
+func (s *EnvSuite) setNotice(ctx *context.HookContext) (expectVars []string) {
+	context.SetEnvironmentHookContextNotice(ctx, "redis", "123", "custom", "example.com/foo")
+	return []string{
+		"JUJU_WORKLOAD_NAME=redis",
+		"JUJU_NOTICE_ID=123",
+		"JUJU_NOTICE_TYPE=custom",
+		"JUJU_NOTICE_KEY=example.com/foo",
+	}
+}
+
+func (s *EnvSuite) setCheck(ctx *context.HookContext) (expectVars []string) {
+	context.SetEnvironmentHookContextCheck(ctx, "redis", "ready")
+	return []string{
+		"JUJU_WORKLOAD_NAME=redis",
+		"JUJU_PEBBLE_CHECK_NAME=ready",
+	}
+}
+
 func (s *EnvSuite) setRelation(ctx *context.HookContext) (expectVars []string) {
 	context.SetEnvironmentHookContextRelation(ctx, 22, "an-endpoint", "that-unit/456", "that-app", "")
 	return []string{
@@ -230,9 +248,10 @@ func (s *EnvSuite) TestEnvUbuntu(c *gc.C) {
 	relationVars := s.setDepartingRelation(ctx)
 	secretVars := s.setSecret(ctx)
 	storageVars := s.setStorage(ctx)
+	noticeVars := s.setNotice(ctx)
 	actualVars, err = ctx.HookVars(paths, false, environmenter)
 	c.Assert(err, jc.ErrorIsNil)
-	s.assertVars(c, actualVars, contextVars, pathsVars, ubuntuVars, relationVars, secretVars, storageVars)
+	s.assertVars(c, actualVars, contextVars, pathsVars, ubuntuVars, relationVars, secretVars, storageVars, noticeVars)
 }
 
 func (s *EnvSuite) TestEnvCentos(c *gc.C) {
@@ -290,9 +309,10 @@ func (s *EnvSuite) TestEnvCentos(c *gc.C) {
 
 		relationVars := s.setRelation(ctx)
 		secretVars := s.setSecret(ctx)
+		checkVars := s.setCheck(ctx)
 		actualVars, err = ctx.HookVars(paths, false, environmenter)
 		c.Assert(err, jc.ErrorIsNil)
-		s.assertVars(c, actualVars, contextVars, pathsVars, centosVars, relationVars, secretVars)
+		s.assertVars(c, actualVars, contextVars, pathsVars, centosVars, relationVars, secretVars, checkVars)
 	}
 }
 
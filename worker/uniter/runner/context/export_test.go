@@ -175,6 +175,20 @@ func SetEnvironmentHookContextSecret(
 	context.secretMetadata = metadata
 }
 
+// SetEnvironmentHookContextNotice exists purely to set the fields used in hookVars.
+func SetEnvironmentHookContextNotice(context *HookContext, workloadName, noticeID, noticeType, noticeKey string) {
+	context.workloadName = workloadName
+	context.noticeID = noticeID
+	context.noticeType = noticeType
+	context.noticeKey = noticeKey
+}
+
+// SetEnvironmentHookContextCheck exists purely to set the fields used in hookVars.
+func SetEnvironmentHookContextCheck(context *HookContext, workloadName, checkName string) {
+	context.workloadName = workloadName
+	context.checkName = checkName
+}
+
 // SetEnvironmentHookContextRelation exists purely to set the fields used in hookVars.
 // It makes no assumptions about the validity of context.
 func SetEnvironmentHookContextRelation(context *HookContext, relationId int, endpointName, remoteUnitName, remoteAppName, departingUnitName string) {
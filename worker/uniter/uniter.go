@@ -932,6 +932,14 @@ func (u *Uniter) init(unitTag names.UnitTag) (err error) {
 		if err := u.catacomb.Add(pebblePoller); err != nil {
 			return errors.Trace(err)
 		}
+		pebbleNoticer := NewPebbleNoticer(u.logger, u.clock, u.containerNames, u.paths.State.PebbleNoticesFile, u.workloadEventChannel, u.workloadEvents, u.newPebbleClient)
+		if err := u.catacomb.Add(pebbleNoticer); err != nil {
+			return errors.Trace(err)
+		}
+		pebbleCheckWatcher := NewPebbleCheckWatcher(u.logger, u.clock, u.containerNames, u.workloadEventChannel, u.workloadEvents, u.newPebbleClient)
+		if err := u.catacomb.Add(pebbleCheckWatcher); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
 	return nil
@@ -17,6 +17,7 @@ import (
 	service6 "github.com/juju/juju/domain/modeldefaults/service"
 	service7 "github.com/juju/juju/domain/modelmanager/service"
 	service8 "github.com/juju/juju/domain/upgrade/service"
+	service9 "github.com/juju/juju/domain/user/service"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -43,6 +44,20 @@ func (m *MockControllerServiceFactory) EXPECT() *MockControllerServiceFactoryMoc
 	return m.recorder
 }
 
+// APIToken mocks base method.
+func (m *MockControllerServiceFactory) APIToken() *service9.TokenService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIToken")
+	ret0, _ := ret[0].(*service9.TokenService)
+	return ret0
+}
+
+// APIToken indicates an expected call of APIToken.
+func (mr *MockControllerServiceFactoryMockRecorder) APIToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIToken", reflect.TypeOf((*MockControllerServiceFactory)(nil).APIToken))
+}
+
 // AutocertCache mocks base method.
 func (m *MockControllerServiceFactory) AutocertCache() *service.Service {
 	m.ctrl.T.Helper()
@@ -169,6 +184,20 @@ func (mr *MockControllerServiceFactoryMockRecorder) ModelManager() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelManager", reflect.TypeOf((*MockControllerServiceFactory)(nil).ModelManager))
 }
 
+// Role mocks base method.
+func (m *MockControllerServiceFactory) Role() *service9.RoleService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Role")
+	ret0, _ := ret[0].(*service9.RoleService)
+	return ret0
+}
+
+// Role indicates an expected call of Role.
+func (mr *MockControllerServiceFactoryMockRecorder) Role() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Role", reflect.TypeOf((*MockControllerServiceFactory)(nil).Role))
+}
+
 // Upgrade mocks base method.
 func (m *MockControllerServiceFactory) Upgrade() *service8.Service {
 	m.ctrl.T.Helper()
@@ -182,3 +211,17 @@ func (mr *MockControllerServiceFactoryMockRecorder) Upgrade() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockControllerServiceFactory)(nil).Upgrade))
 }
+
+// UserGroup mocks base method.
+func (m *MockControllerServiceFactory) UserGroup() *service9.GroupService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGroup")
+	ret0, _ := ret[0].(*service9.GroupService)
+	return ret0
+}
+
+// UserGroup indicates an expected call of UserGroup.
+func (mr *MockControllerServiceFactoryMockRecorder) UserGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGroup", reflect.TypeOf((*MockControllerServiceFactory)(nil).UserGroup))
+}